@@ -0,0 +1,151 @@
+package calendar
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+const (
+	// floatingLayout is a local time without a time zone, shown at the same hour wherever the calendar is
+	floatingLayout = "20060102T150405"
+	utcLayout      = "20060102T150405Z"
+	// maxLineLength is the number of octets after which RFC 5545 lines are folded
+	maxLineLength = 75
+)
+
+// Calendar is an iCalendar object with its events
+type Calendar struct {
+	Name   string
+	Events []Event
+}
+
+// Event is a VEVENT. UID must not change between renders and Sequence must grow every time the event changes,
+// so subscribed calendars update the event instead of adding a new one.
+type Event struct {
+	UID         string
+	Sequence    int32
+	Stamp       time.Time
+	Start       time.Time
+	End         time.Time
+	Summary     string
+	Description string
+	URL         string
+	// Alarm is how long before the start a reminder goes off, 0 adds none
+	Alarm            time.Duration
+	AlarmDescription string
+	// AllDay events start and end on dates instead of times
+	AllDay bool
+	// Transparent events don't keep anyone busy, like birthdays
+	Transparent bool
+	// Rule, Exceptions and RecurrenceID describe recurring events read by Parse, Write ignores them
+	Rule         *Rule
+	Exceptions   []time.Time
+	RecurrenceID time.Time
+}
+
+// Write renders the calendar as an iCalendar stream
+func Write(w io.Writer, calendar Calendar) error {
+	lines := []string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//cookinator//meal plan//EN",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+	}
+	if calendar.Name != "" {
+		lines = append(lines, "X-WR-CALNAME:"+escape(calendar.Name))
+	}
+
+	for _, event := range calendar.Events {
+		lines = append(lines,
+			"BEGIN:VEVENT",
+			"UID:"+event.UID,
+			fmt.Sprintf("SEQUENCE:%d", event.Sequence),
+			"DTSTAMP:"+event.Stamp.UTC().Format(utcLayout),
+		)
+		if event.AllDay {
+			lines = append(lines,
+				"DTSTART;VALUE=DATE:"+event.Start.Format(dateLayout),
+				"DTEND;VALUE=DATE:"+event.End.Format(dateLayout),
+			)
+		} else {
+			lines = append(lines,
+				"DTSTART:"+event.Start.Format(floatingLayout),
+				"DTEND:"+event.End.Format(floatingLayout),
+			)
+		}
+		lines = append(lines, "SUMMARY:"+escape(event.Summary))
+		if event.Transparent {
+			lines = append(lines, "TRANSP:TRANSPARENT")
+		}
+		if event.Description != "" {
+			lines = append(lines, "DESCRIPTION:"+escape(event.Description))
+		}
+		if event.URL != "" {
+			lines = append(lines, "URL:"+event.URL)
+		}
+		if event.Alarm > 0 {
+			lines = append(lines,
+				"BEGIN:VALARM",
+				"ACTION:DISPLAY",
+				"TRIGGER:"+duration(-event.Alarm),
+				"DESCRIPTION:"+escape(event.AlarmDescription),
+				"END:VALARM",
+			)
+		}
+		lines = append(lines, "END:VEVENT")
+	}
+	lines = append(lines, "END:VCALENDAR")
+
+	for _, line := range lines {
+		_, err := io.WriteString(w, fold(line))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// escape escapes the characters that have a meaning in TEXT values
+func escape(text string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(text)
+}
+
+// fold splits a content line into lines of at most 75 octets, continuation lines start with a space.
+// Lines are never split inside a UTF-8 character.
+func fold(line string) string {
+	var b strings.Builder
+	length := 0
+	for _, r := range line {
+		size := len(string(r))
+		if length+size > maxLineLength {
+			b.WriteString("\r\n ")
+			length = 1
+		}
+		b.WriteRune(r)
+		length += size
+	}
+	b.WriteString("\r\n")
+	return b.String()
+}
+
+// duration formats a duration in whole minutes like -PT45M or -PT1H30M
+func duration(d time.Duration) string {
+	sign := ""
+	if d < 0 {
+		sign = "-"
+		d = -d
+	}
+	minutes := int(d.Round(time.Minute) / time.Minute)
+	hours, minutes := minutes/60, minutes%60
+	switch {
+	case hours > 0 && minutes > 0:
+		return fmt.Sprintf("%sPT%dH%dM", sign, hours, minutes)
+	case hours > 0:
+		return fmt.Sprintf("%sPT%dH", sign, hours)
+	default:
+		return fmt.Sprintf("%sPT%dM", sign, minutes)
+	}
+}
@@ -0,0 +1,69 @@
+package calendar
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWrite(t *testing.T) {
+	start := time.Date(2026, time.October, 19, 19, 0, 0, 0, time.UTC)
+	calendar := Calendar{
+		Name: "Meal plan",
+		Events: []Event{
+			{
+				UID:              "entry-1@cookinator",
+				Sequence:         2,
+				Stamp:            time.Date(2026, time.October, 18, 10, 30, 0, 0, time.UTC),
+				Start:            start,
+				End:              start.Add(time.Hour),
+				Summary:          "Dinner: Pasta, with tomatoes; quick",
+				Description:      "4 servings\nleftovers for lunch",
+				URL:              "https://example.com/recipes/1",
+				Alarm:            90 * time.Minute,
+				AlarmDescription: "Start preparing Pasta",
+			},
+			{
+				UID:     "entry-2@cookinator",
+				Stamp:   start,
+				Start:   start.AddDate(0, 0, 1),
+				End:     start.AddDate(0, 0, 1).Add(time.Hour),
+				Summary: "Dinner: Soup",
+			},
+		},
+	}
+
+	var b bytes.Buffer
+	require.NoError(t, Write(&b, calendar))
+	ics := b.String()
+
+	require.True(t, strings.HasPrefix(ics, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+	require.True(t, strings.HasSuffix(ics, "END:VCALENDAR\r\n"))
+	require.Equal(t, 2, strings.Count(ics, "BEGIN:VEVENT"))
+	require.Equal(t, 1, strings.Count(ics, "BEGIN:VALARM"))
+	require.Contains(t, ics, "UID:entry-1@cookinator\r\nSEQUENCE:2\r\nDTSTAMP:20261018T103000Z\r\n")
+	require.Contains(t, ics, "DTSTART:20261019T190000\r\nDTEND:20261019T200000\r\n")
+	require.Contains(t, ics, `SUMMARY:Dinner: Pasta\, with tomatoes\; quick`)
+	require.Contains(t, ics, `DESCRIPTION:4 servings\nleftovers for lunch`)
+	require.Contains(t, ics, "TRIGGER:-PT1H30M\r\n")
+}
+
+func TestFold(t *testing.T) {
+	line := "DESCRIPTION:" + strings.Repeat("é", 50)
+	folded := fold(line)
+
+	for _, part := range strings.Split(strings.TrimSuffix(folded, "\r\n"), "\r\n") {
+		require.LessOrEqual(t, len(part), maxLineLength)
+	}
+	require.Equal(t, line, strings.ReplaceAll(strings.TrimSuffix(folded, "\r\n"), "\r\n ", ""))
+	require.Equal(t, "SHORT\r\n", fold("SHORT"))
+}
+
+func TestDuration(t *testing.T) {
+	require.Equal(t, "-PT45M", duration(-45*time.Minute))
+	require.Equal(t, "-PT2H", duration(-2*time.Hour))
+	require.Equal(t, "PT1H5M", duration(65*time.Minute))
+}
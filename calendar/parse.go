@@ -0,0 +1,471 @@
+package calendar
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	dateLayout = "20060102"
+	// maxIterations bounds how many periods of a recurrence rule are looked at
+	maxIterations = 10000
+)
+
+var ErrInvalidCalendar = errors.New("invalid calendar")
+
+var weekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// Rule is the recurrence rule of an event. Only the frequency, interval, count, until and
+// the week days of weekly rules are supported, which covers the calendars people usually share.
+type Rule struct {
+	Frequency string
+	Interval  int
+	Count     int
+	Until     time.Time
+	ByDay     []time.Weekday
+}
+
+// Parse reads the events of an iCalendar stream. Times without a time zone, dates and times
+// in time zones that can't be loaded are read in loc.
+func Parse(r io.Reader, loc *time.Location) ([]Event, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+
+	events := []Event{}
+	var event *Event
+	// components holds the nesting of components, so the properties of alarms don't end up on events
+	components := []string{}
+	found := false
+	for i, line := range lines {
+		name, params, value, ok := parseLine(line)
+		if !ok {
+			return nil, fmt.Errorf("%w: line %d is not a property", ErrInvalidCalendar, i+1)
+		}
+
+		switch name {
+		case "BEGIN":
+			components = append(components, strings.ToUpper(value))
+			if strings.EqualFold(value, "VCALENDAR") {
+				found = true
+			}
+			if strings.EqualFold(value, "VEVENT") {
+				event = &Event{}
+			}
+			continue
+		case "END":
+			if len(components) == 0 || components[len(components)-1] != strings.ToUpper(value) {
+				return nil, fmt.Errorf("%w: unexpected END:%s on line %d", ErrInvalidCalendar, value, i+1)
+			}
+			components = components[:len(components)-1]
+			if strings.EqualFold(value, "VEVENT") && event != nil {
+				if event.Start.IsZero() {
+					return nil, fmt.Errorf("%w: event %q has no start", ErrInvalidCalendar, event.UID)
+				}
+				if event.End.IsZero() {
+					event.End = event.Start
+					if event.AllDay {
+						event.End = event.Start.AddDate(0, 0, 1)
+					}
+				}
+				events = append(events, *event)
+				event = nil
+			}
+			continue
+		}
+
+		if event == nil || components[len(components)-1] != "VEVENT" {
+			continue
+		}
+		err = event.set(name, params, value, loc)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s on line %d: %v", ErrInvalidCalendar, name, i+1, err)
+		}
+	}
+
+	if !found {
+		return nil, fmt.Errorf("%w: no VCALENDAR", ErrInvalidCalendar)
+	}
+	if len(components) > 0 {
+		return nil, fmt.Errorf("%w: %s is not closed", ErrInvalidCalendar, components[len(components)-1])
+	}
+	return events, nil
+}
+
+func (e *Event) set(name string, params map[string]string, value string, loc *time.Location) error {
+	var err error
+	switch name {
+	case "UID":
+		e.UID = value
+	case "SUMMARY":
+		e.Summary = unescape(value)
+	case "DESCRIPTION":
+		e.Description = unescape(value)
+	case "URL":
+		e.URL = value
+	case "SEQUENCE":
+		sequence, err := strconv.Atoi(value)
+		if err != nil {
+			return err
+		}
+		e.Sequence = int32(sequence)
+	case "TRANSP":
+		e.Transparent = strings.EqualFold(value, "TRANSPARENT")
+	case "DTSTART":
+		e.Start, e.AllDay, err = parseTime(value, params, loc)
+	case "DTEND":
+		e.End, _, err = parseTime(value, params, loc)
+	case "DURATION":
+		var d time.Duration
+		d, err = parseDuration(value)
+		if err == nil && !e.Start.IsZero() {
+			e.End = e.Start.Add(d)
+		}
+	case "RECURRENCE-ID":
+		e.RecurrenceID, _, err = parseTime(value, params, loc)
+	case "RRULE":
+		e.Rule, err = parseRule(value, loc)
+	case "EXDATE":
+		for _, v := range strings.Split(value, ",") {
+			var exception time.Time
+			exception, _, err = parseTime(v, params, loc)
+			if err != nil {
+				return err
+			}
+			e.Exceptions = append(e.Exceptions, exception)
+		}
+	}
+	return err
+}
+
+// unfold joins the continuation lines of a stream to the line they continue
+func unfold(r io.Reader) ([]string, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	lines := []string{}
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if len(lines) > 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		if line == "" {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines, scanner.Err()
+}
+
+// parseLine splits a content line like DTSTART;TZID=Europe/Bucharest:20261019T180000
+// into its name, parameters and value
+func parseLine(line string) (string, map[string]string, string, bool) {
+	quoted := false
+	colon := -1
+	for i, r := range line {
+		if r == '"' {
+			quoted = !quoted
+		}
+		if r == ':' && !quoted {
+			colon = i
+			break
+		}
+	}
+	if colon <= 0 {
+		return "", nil, "", false
+	}
+
+	parts := strings.Split(line[:colon], ";")
+	params := map[string]string{}
+	for _, param := range parts[1:] {
+		key, value, _ := strings.Cut(param, "=")
+		params[strings.ToUpper(key)] = strings.Trim(value, `"`)
+	}
+	return strings.ToUpper(parts[0]), params, line[colon+1:], true
+}
+
+func unescape(text string) string {
+	return strings.NewReplacer(`\\`, `\`, `\;`, ";", `\,`, ",", `\n`, "\n", `\N`, "\n").Replace(text)
+}
+
+// parseTime reads a DATE or DATE-TIME value and reports whether it was a date
+func parseTime(value string, params map[string]string, loc *time.Location) (time.Time, bool, error) {
+	if params["VALUE"] == "DATE" || len(value) == len(dateLayout) {
+		t, err := time.ParseInLocation(dateLayout, value, loc)
+		return t, true, err
+	}
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse(utcLayout, value)
+		return t, false, err
+	}
+
+	if tzid, ok := params["TZID"]; ok {
+		if zone, err := time.LoadLocation(tzid); err == nil {
+			loc = zone
+		}
+	}
+	t, err := time.ParseInLocation(floatingLayout, value, loc)
+	return t, false, err
+}
+
+// parseDuration reads a duration like PT1H30M, P1D or P2W
+func parseDuration(value string) (time.Duration, error) {
+	sign := time.Duration(1)
+	if strings.HasPrefix(value, "-") {
+		sign = -1
+	}
+	value = strings.TrimLeft(value, "+-")
+	if !strings.HasPrefix(value, "P") {
+		return 0, fmt.Errorf("invalid duration %q", value)
+	}
+
+	var d time.Duration
+	number := ""
+	for _, r := range value[1:] {
+		if r >= '0' && r <= '9' {
+			number += string(r)
+			continue
+		}
+		if r == 'T' {
+			continue
+		}
+		n, err := strconv.Atoi(number)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q", value)
+		}
+		number = ""
+
+		switch r {
+		case 'W':
+			d += time.Duration(n) * 7 * 24 * time.Hour
+		case 'D':
+			d += time.Duration(n) * 24 * time.Hour
+		case 'H':
+			d += time.Duration(n) * time.Hour
+		case 'M':
+			d += time.Duration(n) * time.Minute
+		case 'S':
+			d += time.Duration(n) * time.Second
+		default:
+			return 0, fmt.Errorf("invalid duration %q", value)
+		}
+	}
+	return sign * d, nil
+}
+
+// parseRule reads an RRULE value. Rules that pick week days of months or years can't be expanded,
+// so they are returned as nil and the event only happens at its start.
+func parseRule(value string, loc *time.Location) (*Rule, error) {
+	rule := &Rule{Interval: 1}
+	for _, part := range strings.Split(value, ";") {
+		key, v, _ := strings.Cut(part, "=")
+		var err error
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			rule.Frequency = strings.ToUpper(v)
+		case "INTERVAL":
+			rule.Interval, err = strconv.Atoi(v)
+		case "COUNT":
+			rule.Count, err = strconv.Atoi(v)
+		case "UNTIL":
+			var date bool
+			rule.Until, date, err = parseTime(v, nil, loc)
+			if date {
+				// a date includes the whole day
+				rule.Until = rule.Until.AddDate(0, 0, 1).Add(-time.Nanosecond)
+			}
+		case "BYDAY":
+			for _, day := range strings.Split(v, ",") {
+				weekday, ok := weekdays[strings.ToUpper(day)]
+				if !ok {
+					return nil, nil
+				}
+				rule.ByDay = append(rule.ByDay, weekday)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	switch rule.Frequency {
+	case "DAILY", "WEEKLY", "MONTHLY", "YEARLY":
+	default:
+		return nil, nil
+	}
+	if rule.Interval < 1 {
+		return nil, fmt.Errorf("invalid interval %d", rule.Interval)
+	}
+	if len(rule.ByDay) > 0 && rule.Frequency != "WEEKLY" {
+		return nil, nil
+	}
+	sort.Slice(rule.ByDay, func(i, j int) bool {
+		return fromMonday(rule.ByDay[i]) < fromMonday(rule.ByDay[j])
+	})
+	return rule, nil
+}
+
+// Expand returns the occurrences of the events that overlap from to to, with the recurring events
+// repeated at every occurrence. Occurrences moved by an event with a RECURRENCE-ID are only returned where they were moved to.
+func Expand(events []Event, from time.Time, to time.Time) []Event {
+	moved := map[string][]time.Time{}
+	for _, event := range events {
+		if !event.RecurrenceID.IsZero() {
+			moved[event.UID] = append(moved[event.UID], event.RecurrenceID)
+		}
+	}
+
+	result := []Event{}
+	for _, event := range events {
+		skip := append(append([]time.Time{}, event.Exceptions...), moved[event.UID]...)
+		if !event.RecurrenceID.IsZero() {
+			skip = nil
+		}
+		result = append(result, event.occurrences(from, to, skip)...)
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Start.Before(result[j].Start)
+	})
+	return result
+}
+
+func (e Event) occurrences(from time.Time, to time.Time, skip []time.Time) []Event {
+	length := e.End.Sub(e.Start)
+	result := []Event{}
+	emit := func(start time.Time) {
+		for _, s := range skip {
+			if s.Equal(start) {
+				return
+			}
+		}
+		end := start.Add(length)
+		if start.Before(to) && (end.After(from) || (length == 0 && !start.Before(from))) {
+			occurrence := e
+			occurrence.Start = start
+			occurrence.End = end
+			occurrence.Rule = nil
+			occurrence.Exceptions = nil
+			result = append(result, occurrence)
+		}
+	}
+
+	if e.Rule == nil || !e.RecurrenceID.IsZero() {
+		emit(e.Start)
+		return result
+	}
+
+	rule := e.Rule
+	count := 0
+	// the periods ending before from are skipped, only counting their starts when the rule has a count,
+	// so old series still reach the window within maxIterations
+	first := rule.periodsBefore(e.Start, from.Add(-length))
+	for i := 0; i < first; i++ {
+		if rule.Count == 0 {
+			break
+		}
+		count += len(rule.starts(e.Start, i))
+		if count >= rule.Count {
+			return result
+		}
+	}
+	for i := first; i < first+maxIterations; i++ {
+		for _, start := range rule.starts(e.Start, i) {
+			if !rule.Until.IsZero() && start.After(rule.Until) {
+				return result
+			}
+			count++
+			if rule.Count > 0 && count > rule.Count {
+				return result
+			}
+			if !start.Before(to) {
+				return result
+			}
+			emit(start)
+		}
+	}
+	return result
+}
+
+// periodsBefore returns how many periods of the rule surely end before t. Periods are taken
+// a day longer than they can last, so clock changes and shorter months never skip too many.
+func (r *Rule) periodsBefore(first time.Time, t time.Time) int {
+	days := int(t.Sub(first).Hours() / 24)
+	if days <= 0 {
+		return 0
+	}
+	var periodDays int
+	switch r.Frequency {
+	case "DAILY":
+		periodDays = 1
+	case "WEEKLY":
+		periodDays = 7
+	case "MONTHLY":
+		periodDays = 31
+	case "YEARLY":
+		periodDays = 366
+	default:
+		return 0
+	}
+	return max(days/(periodDays*r.Interval+1)-1, 0)
+}
+
+// starts returns the starts of the i-th period of the rule, keeping the wall clock time of the first start
+func (r *Rule) starts(first time.Time, i int) []time.Time {
+	year, month, day := first.Date()
+	hour, minute, second := first.Clock()
+	loc := first.Location()
+
+	switch r.Frequency {
+	case "DAILY":
+		return []time.Time{first.AddDate(0, 0, i*r.Interval)}
+	case "WEEKLY":
+		if len(r.ByDay) == 0 {
+			return []time.Time{first.AddDate(0, 0, 7*i*r.Interval)}
+		}
+		monday := first.AddDate(0, 0, 7*i*r.Interval-fromMonday(first.Weekday()))
+		starts := []time.Time{}
+		for _, weekday := range r.ByDay {
+			start := monday.AddDate(0, 0, fromMonday(weekday))
+			if !start.Before(first) {
+				starts = append(starts, start)
+			}
+		}
+		return starts
+	case "MONTHLY":
+		// months without the day of the first start are skipped, like the 31st in April
+		start := time.Date(year, month+time.Month(i*r.Interval), day, hour, minute, second, 0, loc)
+		if start.Day() != day {
+			return nil
+		}
+		return []time.Time{start}
+	case "YEARLY":
+		start := time.Date(year+i*r.Interval, month, day, hour, minute, second, 0, loc)
+		if start.Day() != day {
+			return nil
+		}
+		return []time.Time{start}
+	}
+	return nil
+}
+
+// fromMonday is the number of days from Monday to a week day
+func fromMonday(weekday time.Weekday) int {
+	return (int(weekday) + 6) % 7
+}
@@ -0,0 +1,170 @@
+package calendar
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testCalendar = `BEGIN:VCALENDAR
+VERSION:2.0
+PRODID:-//test//EN
+BEGIN:VEVENT
+UID:football@example.com
+DTSTART;TZID=Europe/Bucharest:20261005T173000
+DTEND;TZID=Europe/Bucharest:20261005T190000
+RRULE:FREQ=WEEKLY;BYDAY=MO,WE;UNTIL=20261031T235959Z
+EXDATE;TZID=Europe/Bucharest:20261007T173000
+SUMMARY:Football\, U10
+BEGIN:VALARM
+ACTION:DISPLAY
+TRIGGER:-PT30M
+DESCRIPTION:Leave for football
+END:VALARM
+END:VEVENT
+BEGIN:VEVENT
+UID:football@example.com
+RECURRENCE-ID;TZID=Europe/Bucharest:20261012T173000
+DTSTART;TZID=Europe/Bucharest:20261013T180000
+DURATION:PT1H
+SUMMARY:Football moved
+END:VEVENT
+BEGIN:VEVENT
+UID:trip@example.com
+DTSTART;VALUE=DATE:20261016
+DTEND;VALUE=DATE:20261018
+SUMMARY:Trip to the moun
+ tains
+END:VEVENT
+BEGIN:VEVENT
+UID:birthday@example.com
+DTSTART;VALUE=DATE:20261014
+SUMMARY:Birthday
+TRANSP:TRANSPARENT
+END:VEVENT
+END:VCALENDAR
+`
+
+func TestParse(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Bucharest")
+	require.NoError(t, err)
+
+	events, err := Parse(strings.NewReader(strings.ReplaceAll(testCalendar, "\n", "\r\n")), loc)
+	require.NoError(t, err)
+	require.Len(t, events, 4)
+
+	football := events[0]
+	require.Equal(t, "Football, U10", football.Summary)
+	require.Equal(t, time.Date(2026, time.October, 5, 17, 30, 0, 0, loc), football.Start)
+	require.Equal(t, 90*time.Minute, football.End.Sub(football.Start))
+	require.NotNil(t, football.Rule)
+	require.Equal(t, []time.Weekday{time.Monday, time.Wednesday}, football.Rule.ByDay)
+	require.Len(t, football.Exceptions, 1)
+
+	require.Equal(t, time.Hour, events[1].End.Sub(events[1].Start))
+	require.Equal(t, "Trip to the mountains", events[2].Summary)
+	require.True(t, events[2].AllDay)
+	require.True(t, events[3].Transparent)
+	require.Equal(t, events[3].Start.AddDate(0, 0, 1), events[3].End)
+}
+
+func TestParseInvalid(t *testing.T) {
+	_, err := Parse(strings.NewReader("not a calendar"), time.UTC)
+	require.True(t, errors.Is(err, ErrInvalidCalendar))
+
+	_, err = Parse(strings.NewReader("BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nEND:VCALENDAR\r\n"), time.UTC)
+	require.True(t, errors.Is(err, ErrInvalidCalendar))
+
+	_, err = Parse(strings.NewReader("BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nSUMMARY:x\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"), time.UTC)
+	require.True(t, errors.Is(err, ErrInvalidCalendar))
+}
+
+func TestExpand(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Bucharest")
+	require.NoError(t, err)
+
+	events, err := Parse(strings.NewReader(testCalendar), loc)
+	require.NoError(t, err)
+
+	from := time.Date(2026, time.October, 1, 0, 0, 0, 0, loc)
+	to := time.Date(2026, time.November, 1, 0, 0, 0, 0, loc)
+	occurrences := Expand(events, from, to)
+
+	starts := []string{}
+	for _, occurrence := range occurrences {
+		starts = append(starts, occurrence.Start.Format("Mon 02 15:04"))
+	}
+	// the 7th is an exception, the 12th was moved to the 13th and the clocks change on the 25th
+	require.Equal(t, []string{
+		"Mon 05 17:30",
+		"Tue 13 18:00",
+		"Wed 14 00:00",
+		"Wed 14 17:30",
+		"Fri 16 00:00",
+		"Mon 19 17:30",
+		"Wed 21 17:30",
+		"Mon 26 17:30",
+		"Wed 28 17:30",
+	}, starts)
+}
+
+func TestExpandCount(t *testing.T) {
+	start := time.Date(2026, time.January, 31, 9, 0, 0, 0, time.UTC)
+	events := []Event{{
+		UID:   "monthly",
+		Start: start,
+		End:   start.Add(time.Hour),
+		Rule:  &Rule{Frequency: "MONTHLY", Interval: 1, Count: 3},
+	}}
+
+	occurrences := Expand(events, start, start.AddDate(2, 0, 0))
+	require.Len(t, occurrences, 3)
+	// months without a 31st are skipped
+	require.Equal(t, time.March, occurrences[1].Start.Month())
+	require.Equal(t, time.May, occurrences[2].Start.Month())
+}
+
+func TestExpandOldSeries(t *testing.T) {
+	// a daily series started long before the window, with more periods than are looked at
+	start := time.Date(1990, time.March, 1, 7, 30, 0, 0, time.UTC)
+	events := []Event{{
+		UID:   "daily",
+		Start: start,
+		End:   start.Add(time.Hour),
+		Rule:  &Rule{Frequency: "DAILY", Interval: 1},
+	}}
+
+	from := time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)
+	occurrences := Expand(events, from, from.AddDate(0, 0, 7))
+	require.Len(t, occurrences, 7)
+	require.Equal(t, from.Add(7*time.Hour+30*time.Minute), occurrences[0].Start)
+
+	// the skipped periods still count towards the count of the rule
+	events[0].Rule = &Rule{Frequency: "DAILY", Interval: 1, Count: 13383}
+	occurrences = Expand(events, from, from.AddDate(0, 0, 7))
+	require.Len(t, occurrences, 2)
+}
+
+func TestWriteParse(t *testing.T) {
+	start := time.Date(2026, time.October, 19, 19, 0, 0, 0, time.UTC)
+	var b bytes.Buffer
+	require.NoError(t, Write(&b, Calendar{Events: []Event{{
+		UID:         "entry@cookinator",
+		Stamp:       start,
+		Start:       start,
+		End:         start.Add(time.Hour),
+		Summary:     "Dinner: Pasta, " + strings.Repeat("very ", 20) + "good",
+		Description: "4 servings\nquick",
+	}}}))
+
+	events, err := Parse(&b, time.UTC)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, "Dinner: Pasta, "+strings.Repeat("very ", 20)+"good", events[0].Summary)
+	require.Equal(t, "4 servings\nquick", events[0].Description)
+	require.Equal(t, start, events[0].Start)
+}
@@ -0,0 +1,208 @@
+package checklist
+
+import (
+	"encoding/csv"
+	"fmt"
+	"html/template"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Format is the way a list is written out
+type Format string
+
+const (
+	FormatText     = "text"
+	FormatMarkdown = "markdown"
+	FormatCSV      = "csv"
+	FormatHTML     = "html"
+)
+
+var Formats = []Format{
+	FormatText,
+	FormatMarkdown,
+	FormatCSV,
+	FormatHTML,
+}
+
+const (
+	uncheckedGlyph = "☐"
+	checkedGlyph   = "☑"
+)
+
+// contentTypes are the media types of the formats
+var contentTypes = map[Format]string{
+	FormatText:     "text/plain; charset=utf-8",
+	FormatMarkdown: "text/markdown; charset=utf-8",
+	FormatCSV:      "text/csv; charset=utf-8",
+	FormatHTML:     "text/html; charset=utf-8",
+}
+
+// List is a checklist of items grouped in sections, like the aisles of a store
+type List struct {
+	Title    string
+	Sections []Section
+}
+
+type Section struct {
+	Name  string
+	Items []Item
+}
+
+// Item is a line of a list, Unit is left out when it is empty
+type Item struct {
+	Name     string
+	Quantity float64
+	Unit     string
+	Checked  bool
+}
+
+// ContentType is the media type of a format, ok is false for unknown formats
+func ContentType(format Format) (string, bool) {
+	contentType, ok := contentTypes[format]
+	return contentType, ok
+}
+
+// Write renders the list in a format
+func Write(w io.Writer, format Format, list List) error {
+	switch format {
+	case FormatText:
+		return writeText(w, list)
+	case FormatMarkdown:
+		return writeMarkdown(w, list)
+	case FormatCSV:
+		return writeCSV(w, list)
+	case FormatHTML:
+		return htmlTemplate.Execute(w, list)
+	}
+	return fmt.Errorf("unknown format %q", format)
+}
+
+// Amount is the quantity and unit of an item, quantities are rounded to two decimals
+func (i Item) Amount() string {
+	quantity := strconv.FormatFloat(math.Round(i.Quantity*100)/100, 'f', -1, 64)
+	if i.Unit == "" {
+		return quantity
+	}
+	return quantity + " " + i.Unit
+}
+
+// Glyph is a ballot box, checked when the item is
+func (i Item) Glyph() string {
+	if i.Checked {
+		return checkedGlyph
+	}
+	return uncheckedGlyph
+}
+
+// writeText writes plain lines that can be pasted into a message
+func writeText(w io.Writer, list List) error {
+	var b strings.Builder
+	b.WriteString(list.Title + "\n")
+	for _, section := range list.Sections {
+		b.WriteString("\n" + strings.ToUpper(section.Name) + "\n")
+		for _, item := range section.Items {
+			fmt.Fprintf(&b, "%s %s %s\n", item.Glyph(), item.Amount(), item.Name)
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// writeMarkdown writes task lists, which most Markdown renderers show as checkboxes
+func writeMarkdown(w io.Writer, list List) error {
+	var b strings.Builder
+	b.WriteString("# " + escapeMarkdown(list.Title) + "\n")
+	for _, section := range list.Sections {
+		b.WriteString("\n## " + escapeMarkdown(section.Name) + "\n\n")
+		for _, item := range section.Items {
+			box := "[ ]"
+			if item.Checked {
+				box = "[x]"
+			}
+			fmt.Fprintf(&b, "- %s %s %s\n", box, item.Amount(), escapeMarkdown(item.Name))
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "*", `\*`, "_", `\_`, "`", "\\`", "[", `\[`, "]", `\]`, "#", `\#`, "<", `\<`, ">", `\>`,
+)
+
+func escapeMarkdown(s string) string {
+	return markdownEscaper.Replace(s)
+}
+
+// writeCSV writes a row per item, checked is TRUE or FALSE so spreadsheets read it as a checkbox
+func writeCSV(w io.Writer, list List) error {
+	writer := csv.NewWriter(w)
+	err := writer.Write([]string{"section", "checked", "quantity", "unit", "item"})
+	if err != nil {
+		return err
+	}
+	for _, section := range list.Sections {
+		for _, item := range section.Items {
+			err = writer.Write([]string{
+				escapeCSV(section.Name),
+				strings.ToUpper(strconv.FormatBool(item.Checked)),
+				strconv.FormatFloat(math.Round(item.Quantity*100)/100, 'f', -1, 64),
+				escapeCSV(item.Unit),
+				escapeCSV(item.Name),
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// escapeCSV keeps spreadsheets from running a cell typed in by the family as a formula, by prefixing
+// the cells that start like one with a quote
+func escapeCSV(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
+
+// htmlTemplate fits a list on one printed page, sections flow in columns
+var htmlTemplate = template.Must(template.New("list").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+@page { size: A4; margin: 12mm; }
+body { font-family: sans-serif; font-size: 11pt; margin: 0; }
+h1 { font-size: 16pt; margin: 0 0 8pt; }
+main { columns: 2; column-gap: 16pt; }
+section { break-inside: avoid; margin-bottom: 8pt; }
+h2 { font-size: 12pt; margin: 0 0 4pt; border-bottom: 1px solid #999; }
+ul { list-style: none; margin: 0; padding: 0; }
+li { margin: 2pt 0; }
+.checked { color: #777; text-decoration: line-through; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<main>
+{{- range .Sections}}
+<section>
+<h2>{{.Name}}</h2>
+<ul>
+{{- range .Items}}
+<li{{if .Checked}} class="checked"{{end}}>{{.Glyph}} {{.Amount}} {{.Name}}</li>
+{{- end}}
+</ul>
+</section>
+{{- end}}
+</main>
+</body>
+</html>
+`))
@@ -0,0 +1,95 @@
+package checklist
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testList() List {
+	return List{
+		Title: "Shopping list",
+		Sections: []Section{
+			{Name: "Produce", Items: []Item{
+				{Name: "apples", Quantity: 6, Unit: "pc"},
+				{Name: "lemons", Quantity: 2, Unit: "pc", Checked: true},
+			}},
+			{Name: "Dairy", Items: []Item{
+				{Name: "milk <whole>", Quantity: 1.0567, Unit: "qt"},
+			}},
+		},
+	}
+}
+
+func TestWriteText(t *testing.T) {
+	var b bytes.Buffer
+	require.NoError(t, Write(&b, FormatText, testList()))
+	require.Equal(t, "Shopping list\n\nPRODUCE\n☐ 6 pc apples\n☑ 2 pc lemons\n\nDAIRY\n☐ 1.06 qt milk <whole>\n", b.String())
+}
+
+func TestWriteMarkdown(t *testing.T) {
+	var b bytes.Buffer
+	require.NoError(t, Write(&b, FormatMarkdown, testList()))
+	require.Equal(t, "# Shopping list\n\n## Produce\n\n- [ ] 6 pc apples\n- [x] 2 pc lemons\n\n## Dairy\n\n- [ ] 1.06 qt milk \\<whole\\>\n", b.String())
+}
+
+func TestWriteCSV(t *testing.T) {
+	var b bytes.Buffer
+	require.NoError(t, Write(&b, FormatCSV, testList()))
+
+	records, err := csv.NewReader(&b).ReadAll()
+	require.NoError(t, err)
+	require.Equal(t, [][]string{
+		{"section", "checked", "quantity", "unit", "item"},
+		{"Produce", "FALSE", "6", "pc", "apples"},
+		{"Produce", "TRUE", "2", "pc", "lemons"},
+		{"Dairy", "FALSE", "1.06", "qt", "milk <whole>"},
+	}, records)
+
+	// cells starting like a formula are kept as text
+	list := List{Sections: []Section{{Name: "@Other", Items: []Item{
+		{Name: `=HYPERLINK("http://example.com","eggs")`, Quantity: 1, Unit: "pc"},
+		{Name: "+1 lemons", Quantity: 1, Unit: "pc"},
+		{Name: "-salt", Quantity: 1, Unit: "pc"},
+		{Name: "salt - coarse", Quantity: 1, Unit: "pc"},
+	}}}}
+	b.Reset()
+	require.NoError(t, Write(&b, FormatCSV, list))
+
+	records, err = csv.NewReader(&b).ReadAll()
+	require.NoError(t, err)
+	require.Equal(t, [][]string{
+		{"section", "checked", "quantity", "unit", "item"},
+		{"'@Other", "FALSE", "1", "pc", `'=HYPERLINK("http://example.com","eggs")`},
+		{"'@Other", "FALSE", "1", "pc", "'+1 lemons"},
+		{"'@Other", "FALSE", "1", "pc", "'-salt"},
+		{"'@Other", "FALSE", "1", "pc", "salt - coarse"},
+	}, records)
+}
+
+func TestWriteHTML(t *testing.T) {
+	var b bytes.Buffer
+	require.NoError(t, Write(&b, FormatHTML, testList()))
+
+	page := b.String()
+	require.True(t, strings.HasPrefix(page, "<!DOCTYPE html>"))
+	require.Contains(t, page, "<h2>Produce</h2>")
+	require.Contains(t, page, "<li>☐ 6 pc apples</li>")
+	require.Contains(t, page, `<li class="checked">☑ 2 pc lemons</li>`)
+	require.Contains(t, page, "milk &lt;whole&gt;")
+	require.Less(t, strings.Index(page, "Produce"), strings.Index(page, "Dairy"))
+}
+
+func TestWriteUnknownFormat(t *testing.T) {
+	var b bytes.Buffer
+	require.Error(t, Write(&b, "pdf", testList()))
+
+	_, ok := ContentType("pdf")
+	require.False(t, ok)
+	contentType, ok := ContentType(FormatCSV)
+	require.True(t, ok)
+	require.Equal(t, "text/csv; charset=utf-8", contentType)
+}
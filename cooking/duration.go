@@ -0,0 +1,134 @@
+package cooking
+
+import (
+	"errors"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var errInvalidDuration = errors.New("invalid duration, use minutes or a value like 45m or 1h30m")
+
+// durationRegex matches timers like "10 minutes", "1.5 hrs", "20-25 min" or "1h", and each part of "1h30m".
+// The unit ends a word or is followed by the digit starting the next part.
+var durationRegex = regexp.MustCompile(`(?i)(\d+(?:[.,]\d+)?)(?:\s*(?:-|–|to)\s*(\d+(?:[.,]\d+)?))?\s*(hours?|hrs?|h|minutes?|mins?|m|seconds?|secs?|s)(?:\b|\d)`)
+
+// stepSeparator splits a cooking process into steps on new lines and sentence ends
+var stepSeparator = regexp.MustCompile(`[\n\r]+|[.;!?]\s+`)
+
+// passiveWords mark steps where the cook can walk away while the timer runs. They are matched as whole words,
+// and past tenses and "leaves" are left out as they mostly describe ingredients, like roasted peppers or bay leaves.
+var passiveWords = []string{
+	"bake", "bakes", "baking", "roast", "roasts", "roasting", "simmer", "simmers", "simmering",
+	"rest", "rests", "resting", "marinate", "marinates", "marinating", "chill", "chills", "chilling",
+	"refrigerate", "refrigerates", "refrigerating", "freeze", "freezes", "freezing",
+	"proof", "proofs", "proofing", "rise", "rises", "rising", "soak", "soaks", "soaking",
+	"slow cook", "slow cooks", "slow cooking", "braise", "braises", "braising",
+	"let it", "let them", "leave", "cool", "cools", "cooling", "stand", "stands", "standing",
+}
+
+var passiveRegex = wordsRegex(passiveWords)
+
+// wordsRegex matches any of the words as a whole word, ignoring case. Accented letters count as letters,
+// which \b doesn't do, so "sauté" matches before a space.
+func wordsRegex(words []string) *regexp.Regexp {
+	quoted := []string{}
+	for _, word := range words {
+		quoted = append(quoted, regexp.QuoteMeta(word))
+	}
+	return regexp.MustCompile(`(?i)(?:^|[^\pL\pN_])(?:` + strings.Join(quoted, "|") + `)(?:[^\pL\pN_]|$)`)
+}
+
+// Step is a single instruction of a cooking process with the timers it mentions
+type Step struct {
+	Text     string
+	Duration time.Duration
+	Passive  bool
+}
+
+// ParseDurations returns every timer mentioned in text. For ranges like "20-25 minutes" the upper bound is used.
+func ParseDurations(text string) []time.Duration {
+	durations := []time.Duration{}
+	for start := 0; ; {
+		index := durationRegex.FindStringSubmatchIndex(text[start:])
+		if index == nil {
+			break
+		}
+		rest := text[start:]
+		value := rest[index[2]:index[3]]
+		if index[4] >= 0 {
+			value = rest[index[4]:index[5]]
+		}
+		unit := rest[index[6]:index[7]]
+		// the next match starts right after the unit, where the next part of a timer like "1h30m" begins
+		start += index[7]
+
+		amount, err := strconv.ParseFloat(strings.Replace(value, ",", ".", 1), 64)
+		if err != nil {
+			continue
+		}
+
+		var size time.Duration
+		switch strings.ToLower(unit)[0] {
+		case 'h':
+			size = time.Hour
+		case 'm':
+			size = time.Minute
+		default:
+			size = time.Second
+		}
+		durations = append(durations, time.Duration(amount*float64(size)))
+	}
+	return durations
+}
+
+// ParseSteps splits a cooking process into steps, summing the timers of each step
+func ParseSteps(process string) []Step {
+	steps := []Step{}
+	for _, text := range stepSeparator.Split(process, -1) {
+		text = strings.TrimSpace(text)
+		if text == "" {
+			continue
+		}
+		step := Step{Text: text, Passive: isPassive(text)}
+		for _, duration := range ParseDurations(text) {
+			step.Duration += duration
+		}
+		steps = append(steps, step)
+	}
+	return steps
+}
+
+// Times sums the timers of a cooking process into the total cook time and the part of it that is hands-on
+func Times(process string) (cook time.Duration, active time.Duration) {
+	for _, step := range ParseSteps(process) {
+		cook += step.Duration
+		if !step.Passive {
+			active += step.Duration
+		}
+	}
+	return cook, active
+}
+
+// Minutes rounds a duration up to whole minutes
+func Minutes(d time.Duration) int32 {
+	return int32(math.Ceil(d.Minutes()))
+}
+
+// ParseMinutes parses a query value like "30m", "1h30m" or a plain number of minutes
+func ParseMinutes(value string) (int32, error) {
+	if minutes, err := strconv.Atoi(value); err == nil && minutes >= 0 {
+		return int32(minutes), nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		return 0, errInvalidDuration
+	}
+	return Minutes(d), nil
+}
+
+func isPassive(text string) bool {
+	return passiveRegex.MatchString(text)
+}
@@ -0,0 +1,85 @@
+package cooking
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDurations(t *testing.T) {
+	testCases := []struct {
+		text     string
+		expected []time.Duration
+	}{
+		{"Fry for 10 minutes", []time.Duration{10 * time.Minute}},
+		{"Bake 1 hour 15 mins", []time.Duration{time.Hour, 15 * time.Minute}},
+		{"Simmer for 20-25 min", []time.Duration{25 * time.Minute}},
+		{"Rest 1.5 hrs", []time.Duration{90 * time.Minute}},
+		{"Boil for 30 seconds", []time.Duration{30 * time.Second}},
+		{"Roast for 1h30m", []time.Duration{time.Hour, 30 * time.Minute}},
+		{"Braise 2hrs15mins, then rest 5m", []time.Duration{2 * time.Hour, 15 * time.Minute, 5 * time.Minute}},
+		{"Add 2 eggs and 200 g flour", []time.Duration{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.text, func(t *testing.T) {
+			require.Equal(t, tc.expected, ParseDurations(tc.text))
+		})
+	}
+}
+
+func TestTimes(t *testing.T) {
+	process := "Chop the onions. Fry them for 5 minutes.\nBake for 40 minutes; let it rest 10 min"
+
+	steps := ParseSteps(process)
+	require.Len(t, steps, 4)
+	require.False(t, steps[1].Passive)
+	require.True(t, steps[2].Passive)
+
+	cook, active := Times(process)
+	require.Equal(t, 55*time.Minute, cook)
+	require.Equal(t, 5*time.Minute, active)
+
+	cook, _ = Times("Bake for 1h30m")
+	require.Equal(t, 90*time.Minute, cook)
+}
+
+func TestIsPassive(t *testing.T) {
+	testCases := []struct {
+		text     string
+		expected bool
+	}{
+		{"Bake for 30 minutes", true},
+		{"Let it rest", true},
+		{"Leave to cool on a rack", true},
+		{"Simmering gently for an hour", true},
+		{"Add the bay leaves and stir for 2 minutes", false},
+		{"Fry the roasted peppers for 3 minutes", false},
+		{"Whisk with a standard whisk for 1 minute", false},
+		{"Stir with interest for 5 minutes", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.text, func(t *testing.T) {
+			require.Equal(t, tc.expected, isPassive(tc.text))
+		})
+	}
+}
+
+func TestParseMinutes(t *testing.T) {
+	minutes, err := ParseMinutes("30m")
+	require.NoError(t, err)
+	require.Equal(t, int32(30), minutes)
+
+	minutes, err = ParseMinutes("1h30m")
+	require.NoError(t, err)
+	require.Equal(t, int32(90), minutes)
+
+	minutes, err = ParseMinutes("45")
+	require.NoError(t, err)
+	require.Equal(t, int32(45), minutes)
+
+	_, err = ParseMinutes("soon")
+	require.Error(t, err)
+}
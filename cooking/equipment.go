@@ -0,0 +1,38 @@
+package cooking
+
+// EquipmentUse is a piece of equipment needed by a recipe. Heavy use keeps it busy for most of the cooking.
+type EquipmentUse struct {
+	EquipmentID int32
+	Name        string
+	Heavy       bool
+}
+
+// HeavyConflicts returns the names of the equipment both recipes use heavily,
+// so they should not be cooked for the same meal
+func HeavyConflicts(a, b []EquipmentUse) []string {
+	heavy := map[int32]bool{}
+	for _, use := range a {
+		if use.Heavy {
+			heavy[use.EquipmentID] = true
+		}
+	}
+
+	conflicts := []string{}
+	for _, use := range b {
+		if use.Heavy && heavy[use.EquipmentID] {
+			conflicts = append(conflicts, use.Name)
+		}
+	}
+	return conflicts
+}
+
+// MissingEquipment returns the names of the needed equipment that is not owned
+func MissingEquipment(needed []EquipmentUse, owned map[int32]bool) []string {
+	missing := []string{}
+	for _, use := range needed {
+		if !owned[use.EquipmentID] {
+			missing = append(missing, use.Name)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,23 @@
+package cooking
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeavyConflicts(t *testing.T) {
+	roast := []EquipmentUse{{EquipmentID: 1, Name: "oven", Heavy: true}, {EquipmentID: 2, Name: "stovetop"}}
+	cake := []EquipmentUse{{EquipmentID: 1, Name: "oven", Heavy: true}, {EquipmentID: 3, Name: "stand mixer"}}
+	soup := []EquipmentUse{{EquipmentID: 2, Name: "stovetop", Heavy: true}}
+
+	require.Equal(t, []string{"oven"}, HeavyConflicts(roast, cake))
+	require.Empty(t, HeavyConflicts(roast, soup))
+}
+
+func TestMissingEquipment(t *testing.T) {
+	cake := []EquipmentUse{{EquipmentID: 1, Name: "oven", Heavy: true}, {EquipmentID: 3, Name: "stand mixer"}}
+
+	require.Equal(t, []string{"stand mixer"}, MissingEquipment(cake, map[int32]bool{1: true}))
+	require.Empty(t, MissingEquipment(cake, map[int32]bool{1: true, 3: true}))
+}
@@ -0,0 +1,84 @@
+package cooking
+
+import (
+	"regexp"
+	"time"
+)
+
+// PrepKind is the kind of a make-ahead task
+type PrepKind string
+
+const (
+	PrepMarinate = "marinate"
+	PrepSoak     = "soak"
+	PrepThaw     = "thaw"
+	PrepChop     = "chop"
+)
+
+// minMakeAhead is the shortest wait worth starting ahead, shorter marinades and soaks happen while cooking
+const minMakeAhead = time.Hour
+
+// overnightRegex matches steps left to wait overnight or from the day before
+var overnightRegex = regexp.MustCompile(`(?i)\b(overnight|the (day|night) before)\b`)
+
+// prepWords mark the steps of each kind, with the wait used when the step doesn't say how long.
+// They match whole words only and leave out the forms that are usually nouns, like pork chops.
+var prepWords = []struct {
+	kind  PrepKind
+	words *regexp.Regexp
+	wait  time.Duration
+}{
+	{PrepThaw, wordsRegex([]string{"thaw", "thaws", "thawed", "thawing", "defrost", "defrosts", "defrosted", "defrosting"}), 24 * time.Hour},
+	{PrepSoak, wordsRegex([]string{"soak", "soaks", "soaked", "soaking"}), 12 * time.Hour},
+	{PrepMarinate, wordsRegex([]string{"marinate", "marinates", "marinated", "marinating", "marinade"}), 4 * time.Hour},
+	{PrepChop, wordsRegex([]string{
+		"chop", "chopped", "chopping", "dice", "diced", "dicing", "mince", "minced", "mincing",
+		"slice", "sliced", "slicing", "grate", "grated", "grating", "julienne", "julienned",
+		"shred", "shredded", "shredding",
+	}), 0},
+}
+
+// PrepTask is a step of a recipe that can be done ahead. Lead is how long before cooking starts
+// the task has to be started, it is zero for tasks like chopping that only need to be done in time.
+type PrepTask struct {
+	Kind PrepKind
+	Text string
+	Lead time.Duration
+}
+
+// PrepTasks finds the make-ahead tasks of a cooking process: marinating, soaking and thawing
+// that wait at least an hour, and the chopping that can be done in a batch.
+func PrepTasks(process string) []PrepTask {
+	tasks := []PrepTask{}
+	for _, step := range ParseSteps(process) {
+		kind, wait, ok := prepKind(step.Text)
+		if !ok {
+			continue
+		}
+		task := PrepTask{Kind: kind, Text: step.Text}
+		if kind != PrepChop {
+			switch {
+			case overnightRegex.MatchString(step.Text):
+				task.Lead = max(step.Duration, 12*time.Hour)
+			case step.Duration > 0:
+				task.Lead = step.Duration
+			default:
+				task.Lead = wait
+			}
+			if task.Lead < minMakeAhead {
+				continue
+			}
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks
+}
+
+func prepKind(text string) (PrepKind, time.Duration, bool) {
+	for _, prep := range prepWords {
+		if prep.words.MatchString(text) {
+			return prep.kind, prep.wait, true
+		}
+	}
+	return "", 0, false
+}
@@ -0,0 +1,55 @@
+package cooking
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrepTasks(t *testing.T) {
+	testCases := []struct {
+		name     string
+		process  string
+		expected []PrepTask
+	}{
+		{
+			name:    "Timed",
+			process: "Marinate the chicken for 2 hours. Grill for 10 minutes",
+			expected: []PrepTask{
+				{Kind: PrepMarinate, Text: "Marinate the chicken for 2 hours", Lead: 2 * time.Hour},
+			},
+		},
+		{
+			name:    "Overnight",
+			process: "Soak the beans overnight.\nDice the onion. Simmer everything for 1 hour",
+			expected: []PrepTask{
+				{Kind: PrepSoak, Text: "Soak the beans overnight", Lead: 12 * time.Hour},
+				{Kind: PrepChop, Text: "Dice the onion"},
+			},
+		},
+		{
+			name:    "DefaultWait",
+			process: "Thaw the salmon in the fridge. Bake for 15 minutes",
+			expected: []PrepTask{
+				{Kind: PrepThaw, Text: "Thaw the salmon in the fridge", Lead: 24 * time.Hour},
+			},
+		},
+		{
+			name:     "WholeWords",
+			process:  "Sear the pork chops for 5 minutes. Fill the tart with mincemeat. Bake for 20 minutes",
+			expected: []PrepTask{},
+		},
+		{
+			name:     "ShortWait",
+			process:  "Soak the rice for 20 minutes. Boil for 12 minutes",
+			expected: []PrepTask{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, PrepTasks(tc.process))
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package cooking
+
+import (
+	"strings"
+	"unicode"
+)
+
+const (
+	nameWeight       = 0.6
+	ingredientWeight = 0.4
+)
+
+// NormalizeName lowercases a recipe name and replaces punctuation with single spaces
+func NormalizeName(name string) string {
+	fields := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+	return strings.Join(fields, " ")
+}
+
+// Trigrams returns the set of trigrams of the normalized name. Like pg_trgm every word
+// is padded with two spaces in front and one at the end.
+func Trigrams(name string) map[string]bool {
+	trigrams := map[string]bool{}
+	for _, word := range strings.Fields(NormalizeName(name)) {
+		padded := []rune("  " + word + " ")
+		for i := 0; i+3 <= len(padded); i++ {
+			trigrams[string(padded[i:i+3])] = true
+		}
+	}
+	return trigrams
+}
+
+// NameSimilarity is the trigram similarity of two recipe names, between 0 and 1
+func NameSimilarity(a, b string) float64 {
+	return jaccard(Trigrams(a), Trigrams(b))
+}
+
+// Jaccard is the size of the intersection over the size of the union of two sets of keys
+func Jaccard[K comparable](a, b []K) float64 {
+	setA := make(map[K]bool, len(a))
+	for _, key := range a {
+		setA[key] = true
+	}
+	setB := make(map[K]bool, len(b))
+	for _, key := range b {
+		setB[key] = true
+	}
+	return jaccard(setA, setB)
+}
+
+// Similarity combines the name and ingredient similarity of two recipes into a single score between 0 and 1
+func Similarity(nameSimilarity, ingredientSimilarity float64) float64 {
+	return nameWeight*nameSimilarity + ingredientWeight*ingredientSimilarity
+}
+
+func jaccard[K comparable](a, b map[K]bool) float64 {
+	if len(a) == 0 && len(b) == 0 {
+		return 0
+	}
+	shared := 0
+	for key := range a {
+		if b[key] {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(a)+len(b)-shared)
+}
@@ -0,0 +1,29 @@
+package cooking
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeName(t *testing.T) {
+	require.Equal(t, "mom s lasagna 2", NormalizeName("  Mom's   LASAGNA (2)!"))
+}
+
+func TestNameSimilarity(t *testing.T) {
+	require.Equal(t, 1.0, NameSimilarity("Chicken Curry", "chicken curry!"))
+	require.Greater(t, NameSimilarity("Chicken Curry", "Chicken Currie"), 0.5)
+	require.Less(t, NameSimilarity("Chicken Curry", "Apple Pie"), 0.1)
+	require.Zero(t, NameSimilarity("", ""))
+}
+
+func TestJaccard(t *testing.T) {
+	require.Equal(t, 0.5, Jaccard([]int32{1, 2, 3}, []int32{2, 3, 4}))
+	require.Equal(t, 1.0, Jaccard([]string{"a", "b"}, []string{"b", "a", "a"}))
+	require.Zero(t, Jaccard([]int32{}, []int32{}))
+}
+
+func TestSimilarity(t *testing.T) {
+	require.Equal(t, 1.0, Similarity(1, 1))
+	require.InDelta(t, 0.6, Similarity(1, 0), 1e-9)
+}
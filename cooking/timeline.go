@@ -0,0 +1,223 @@
+package cooking
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// untimedStep is how long a step without a timer is expected to take
+const untimedStep = 5 * time.Minute
+
+// temperatureRegex matches oven temperatures like "180°C", "350 F" or "200 degrees"
+var temperatureRegex = regexp.MustCompile(`(?i)(\d{2,3})\s*(?:°\s*([cf])?|degrees?\s*([cf])?|([cf])\b)`)
+
+// equipmentCues match the words of a step that tell it uses a piece of equipment, its name included. Like
+// passiveWords, past tenses are left out as they mostly describe ingredients, like roasted peppers or boiled eggs.
+var equipmentCues = map[string]*regexp.Regexp{
+	"oven": wordsRegex([]string{
+		"oven", "bake", "bakes", "baking", "roast", "roasts", "roasting", "broil", "broils", "broiling",
+	}),
+	"stovetop": wordsRegex([]string{
+		"stovetop", "stove", "fry", "fries", "frying", "saute", "sautes", "sauteing", "sauté", "sautés", "sautéing",
+		"simmer", "simmers", "simmering", "boil", "boils", "boiling", "sear", "sears", "searing",
+	}),
+	"grill":       wordsRegex([]string{"grill", "grills", "grilling"}),
+	"slow cooker": wordsRegex([]string{"slow cooker", "slow cook", "slow cooks", "slow cooking"}),
+}
+
+// sharedEquipment can be used by several dishes at once, like the burners of a stovetop
+var sharedEquipment = map[string]bool{"stovetop": true}
+
+// TimelineRecipe is one of the dishes cooked for a meal
+type TimelineRecipe struct {
+	Process   string
+	Equipment []EquipmentUse
+}
+
+// TimelineStep is a step of a dish scheduled in the meal's timeline. Temperature is in °C, zero when the step has none.
+type TimelineStep struct {
+	Recipe      int
+	Text        string
+	Start       time.Time
+	End         time.Time
+	Passive     bool
+	Equipment   []string
+	Temperature int
+}
+
+// Conflict is a piece of equipment two dishes need at the same time
+type Conflict struct {
+	Equipment string
+	Recipes   [2]int
+	Start     time.Time
+	End       time.Time
+	Reason    string
+}
+
+// Timeline is the merged schedule of the dishes of a meal. HandsOn is the time at least one
+// active step keeps the cook busy, Passive the rest of the time until serving.
+type Timeline struct {
+	Start     time.Time
+	Serve     time.Time
+	Steps     []TimelineStep
+	Conflicts []Conflict
+	HandsOn   time.Duration
+	Passive   time.Duration
+}
+
+// Schedule plans the steps of every dish backwards from the serve time, so they all finish together.
+// Exclusive equipment used by two dishes at once is a conflict, and so is the oven at two temperatures.
+func Schedule(recipes []TimelineRecipe, serve time.Time) Timeline {
+	timeline := Timeline{Start: serve, Serve: serve, Steps: []TimelineStep{}, Conflicts: []Conflict{}}
+	for i, recipe := range recipes {
+		steps := ParseSteps(recipe.Process)
+		end := serve
+		scheduled := make([]TimelineStep, len(steps))
+		for j := len(steps) - 1; j >= 0; j-- {
+			duration := steps[j].Duration
+			if duration == 0 {
+				duration = untimedStep
+			}
+			scheduled[j] = TimelineStep{
+				Recipe:      i,
+				Text:        steps[j].Text,
+				Start:       end.Add(-duration),
+				End:         end,
+				Passive:     steps[j].Passive,
+				Equipment:   stepEquipment(steps[j].Text, recipe.Equipment),
+				Temperature: Temperature(steps[j].Text),
+			}
+			end = scheduled[j].Start
+		}
+		// oven steps without a temperature bake at the one set last, often by a separate preheat step
+		oven := 0
+		for j := range scheduled {
+			if !slices.Contains(scheduled[j].Equipment, "oven") {
+				continue
+			}
+			if scheduled[j].Temperature == 0 {
+				scheduled[j].Temperature = oven
+			} else {
+				oven = scheduled[j].Temperature
+			}
+		}
+		if end.Before(timeline.Start) {
+			timeline.Start = end
+		}
+		timeline.Steps = append(timeline.Steps, scheduled...)
+	}
+	sort.SliceStable(timeline.Steps, func(i, j int) bool {
+		return timeline.Steps[i].Start.Before(timeline.Steps[j].Start)
+	})
+
+	for i, a := range timeline.Steps {
+		for _, b := range timeline.Steps[i+1:] {
+			if a.Recipe == b.Recipe || !b.Start.Before(a.End) {
+				continue
+			}
+			timeline.Conflicts = append(timeline.Conflicts, stepConflicts(a, b)...)
+		}
+	}
+
+	timeline.HandsOn = handsOn(timeline.Steps)
+	timeline.Passive = serve.Sub(timeline.Start) - timeline.HandsOn
+	return timeline
+}
+
+// Temperature returns the temperature a step mentions in °C, or zero. Fahrenheit is converted and
+// values without a unit above 300 are taken as Fahrenheit.
+func Temperature(text string) int {
+	match := temperatureRegex.FindStringSubmatch(text)
+	if match == nil {
+		return 0
+	}
+	value, err := strconv.Atoi(match[1])
+	if err != nil {
+		return 0
+	}
+	unit := strings.ToLower(match[2] + match[3] + match[4])
+	if unit == "f" || (unit == "" && value > 300) {
+		return int(math.Round(float64(value-32) * 5 / 9))
+	}
+	return value
+}
+
+// stepEquipment returns the equipment a step mentions by name or by a cue, like "bake" for the oven
+func stepEquipment(text string, equipment []EquipmentUse) []string {
+	names := map[string]bool{}
+	for name, cues := range equipmentCues {
+		if cues.MatchString(text) {
+			names[name] = true
+		}
+	}
+	for _, use := range equipment {
+		if use.Name != "" && wordsRegex([]string{use.Name}).MatchString(text) {
+			names[strings.ToLower(use.Name)] = true
+		}
+	}
+
+	result := []string{}
+	for name := range names {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func stepConflicts(a, b TimelineStep) []Conflict {
+	conflicts := []Conflict{}
+	for _, name := range a.Equipment {
+		if sharedEquipment[name] || !slices.Contains(b.Equipment, name) {
+			continue
+		}
+
+		reason := fmt.Sprintf("the %s is needed by both dishes", name)
+		if name == "oven" {
+			if a.Temperature == 0 || b.Temperature == 0 || a.Temperature == b.Temperature {
+				continue
+			}
+			reason = fmt.Sprintf("the oven is needed at %d°C and %d°C", a.Temperature, b.Temperature)
+		}
+		conflicts = append(conflicts, Conflict{
+			Equipment: name,
+			Recipes:   [2]int{a.Recipe, b.Recipe},
+			Start:     b.Start,
+			End:       earliest(a.End, b.End),
+			Reason:    reason,
+		})
+	}
+	return conflicts
+}
+
+// handsOn is how long the active steps keep the cook busy, overlapping steps count once
+func handsOn(steps []TimelineStep) time.Duration {
+	var total time.Duration
+	var until time.Time
+	for _, step := range steps {
+		if step.Passive {
+			continue
+		}
+		start := step.Start
+		if start.Before(until) {
+			start = until
+		}
+		if step.End.After(start) {
+			total += step.End.Sub(start)
+			until = step.End
+		}
+	}
+	return total
+}
+
+func earliest(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,111 @@
+package cooking
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemperature(t *testing.T) {
+	testCases := []struct {
+		text     string
+		expected int
+	}{
+		{"Bake at 180°C for 20 minutes", 180},
+		{"Roast at 400 F for 1 hour", 204},
+		{"Preheat the oven to 200 degrees", 200},
+		{"Heat the oven to 350", 0},
+		{"Simmer for 20 minutes", 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.text, func(t *testing.T) {
+			require.Equal(t, tc.expected, Temperature(tc.text))
+		})
+	}
+}
+
+func TestSchedule(t *testing.T) {
+	serve := time.Date(2026, time.October, 19, 19, 0, 0, 0, time.UTC)
+	recipes := []TimelineRecipe{
+		{Process: "Season the chicken. Roast at 220°C for 60 minutes. Let it rest 10 minutes"},
+		{Process: "Slice the potatoes for 15 minutes. Bake at 180°C for 40 minutes"},
+		{Process: "Wash the lettuce. Toss with the dressing"},
+	}
+
+	timeline := Schedule(recipes, serve)
+	require.Len(t, timeline.Steps, 7)
+	require.Equal(t, serve.Add(-75*time.Minute), timeline.Start)
+
+	for _, step := range timeline.Steps {
+		require.False(t, step.End.After(serve))
+	}
+	last := map[int]time.Time{}
+	for _, step := range timeline.Steps {
+		if step.End.After(last[step.Recipe]) {
+			last[step.Recipe] = step.End
+		}
+	}
+	for i := range recipes {
+		require.Equal(t, serve, last[i])
+	}
+
+	require.Len(t, timeline.Conflicts, 1)
+	require.Equal(t, "oven", timeline.Conflicts[0].Equipment)
+	require.ElementsMatch(t, []int{0, 1}, timeline.Conflicts[0].Recipes[:])
+	require.Equal(t, serve.Add(-40*time.Minute), timeline.Conflicts[0].Start)
+	require.Equal(t, serve.Add(-10*time.Minute), timeline.Conflicts[0].End)
+
+	// seasoning, slicing and the two salad steps, none of them overlap
+	require.Equal(t, 5*time.Minute+15*time.Minute+10*time.Minute, timeline.HandsOn)
+	require.Equal(t, 75*time.Minute-timeline.HandsOn, timeline.Passive)
+}
+
+func TestSchedulePreheat(t *testing.T) {
+	serve := time.Date(2026, time.October, 19, 19, 0, 0, 0, time.UTC)
+	recipes := []TimelineRecipe{
+		{Process: "Preheat the oven to 220°C. Roast the chicken for 60 minutes"},
+		{Process: "Preheat the oven to 180°C. Bake the potatoes for 40 minutes"},
+	}
+
+	timeline := Schedule(recipes, serve)
+	for _, step := range timeline.Steps {
+		if step.Recipe == 0 {
+			require.Equal(t, 220, step.Temperature)
+		} else {
+			require.Equal(t, 180, step.Temperature)
+		}
+	}
+
+	// the roast and the bake both carry the temperature of their preheat step
+	require.NotEmpty(t, timeline.Conflicts)
+	for _, conflict := range timeline.Conflicts {
+		require.Equal(t, "oven", conflict.Equipment)
+		require.Equal(t, "the oven is needed at 220°C and 180°C", conflict.Reason)
+	}
+	require.Equal(t, serve, timeline.Conflicts[len(timeline.Conflicts)-1].End)
+}
+
+func TestStepEquipment(t *testing.T) {
+	equipment := []EquipmentUse{{EquipmentID: 1, Name: "Pan"}}
+	testCases := []struct {
+		text     string
+		expected []string
+	}{
+		{"Roast the peppers for 20 minutes", []string{"oven"}},
+		{"Sauté the onions", []string{"stovetop"}},
+		{"Bring the water to a boil", []string{"stovetop"}},
+		{"Fry the bacon in a pan", []string{"pan", "stovetop"}},
+		// ingredients cooked earlier don't need the equipment again
+		{"Top with the roasted peppers and boiled eggs", []string{}},
+		{"Toss with the pancetta", []string{}},
+		{"Toss the baked beans with the grilled halloumi", []string{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.text, func(t *testing.T) {
+			require.Equal(t, tc.expected, stepEquipment(tc.text, equipment))
+		})
+	}
+}
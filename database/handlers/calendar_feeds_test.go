@@ -0,0 +1,56 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/andreiz53/cookinator/util"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpsertCalendarFeed(t *testing.T) {
+	family := createRandomFamily(t)
+
+	arg := UpsertCalendarFeedParams{
+		FamilyID: family.ID,
+		Token:    util.RandomString(32),
+	}
+	feed, err := testQueries.UpsertCalendarFeed(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.FamilyID, feed.FamilyID)
+	require.Equal(t, arg.Token, feed.Token)
+
+	// a new token replaces the previous one
+	arg.Token = util.RandomString(32)
+	feed2, err := testQueries.UpsertCalendarFeed(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.Token, feed2.Token)
+
+	feed3, err := testQueries.GetCalendarFeedByFamilyID(context.Background(), family.ID)
+	require.NoError(t, err)
+	require.Equal(t, feed2.Token, feed3.Token)
+
+	err = testQueries.DeleteCalendarFeed(context.Background(), family.ID)
+	require.NoError(t, err)
+
+	_, err = testQueries.GetCalendarFeedByFamilyID(context.Background(), family.ID)
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+}
+
+func TestGetCalendarEntriesByFamilyID(t *testing.T) {
+	plan := createRandomMealPlan(t)
+	dinner := createRandomMealPlanEntry(t, plan, 1, "dinner")
+	breakfast := createRandomMealPlanEntry(t, plan, 1, "breakfast")
+	createRandomMealPlanEntry(t, plan, 0, "lunch")
+
+	entries, err := testQueries.GetCalendarEntriesByFamilyID(context.Background(), GetCalendarEntriesByFamilyIDParams{
+		FamilyID: plan.FamilyID,
+		FromDay:  dinner.Day,
+	})
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, breakfast.ID, entries[0].ID)
+	require.Equal(t, dinner.ID, entries[1].ID)
+	require.NotEmpty(t, entries[1].RecipeName)
+}
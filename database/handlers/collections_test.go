@@ -0,0 +1,153 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/andreiz53/cookinator/util"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomCollection(t *testing.T, familyID uuid.UUID, shared bool) Collection {
+	user := createRandomUser(t)
+
+	arg := CreateCollectionParams{
+		Name:     util.RandomName(),
+		UserID:   user.ID,
+		FamilyID: util.PgUUID(familyID),
+		Shared:   shared,
+	}
+
+	collection, err := testQueries.CreateCollection(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, collection)
+
+	require.Equal(t, arg.Name, collection.Name)
+	require.Equal(t, arg.UserID, collection.UserID)
+	require.Equal(t, arg.FamilyID, collection.FamilyID)
+	require.Equal(t, arg.Shared, collection.Shared)
+
+	require.NotZero(t, collection.ID)
+	require.NotZero(t, collection.CreatedAt)
+
+	return collection
+}
+
+func TestCreateCollection(t *testing.T) {
+	// a user without a family has a private collection without a family
+	collection := createRandomCollection(t, uuid.Nil, false)
+	require.False(t, collection.FamilyID.Valid)
+
+	collection = createRandomCollection(t, createRandomFamily(t).ID, true)
+	require.True(t, collection.FamilyID.Valid)
+}
+
+func TestGetCollectionByID(t *testing.T) {
+	collection := createRandomCollection(t, uuid.Nil, false)
+
+	collection2, err := testQueries.GetCollectionByID(context.Background(), collection.ID)
+	require.NoError(t, err)
+	require.NotEmpty(t, collection2)
+
+	require.Equal(t, collection.ID, collection2.ID)
+	require.Equal(t, collection.Name, collection2.Name)
+	require.Equal(t, collection.UserID, collection2.UserID)
+	require.WithinDuration(t, collection.CreatedAt.Time, collection2.CreatedAt.Time, time.Second)
+}
+
+func TestGetCollectionsByUserID(t *testing.T) {
+	family := createRandomFamily(t)
+	private := createRandomCollection(t, family.ID, false)
+	shared := createRandomCollection(t, family.ID, true)
+
+	collections, err := testQueries.GetCollectionsByUserID(context.Background(), GetCollectionsByUserIDParams{
+		UserID:   uuid.New(),
+		FamilyID: util.PgUUID(family.ID),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(collections))
+	require.Equal(t, shared.ID, collections[0].ID)
+
+	collections, err = testQueries.GetCollectionsByUserID(context.Background(), GetCollectionsByUserIDParams{
+		UserID:   private.UserID,
+		FamilyID: util.PgUUID(family.ID),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(collections))
+}
+
+func TestUpdateCollection(t *testing.T) {
+	collection := createRandomCollection(t, uuid.Nil, false)
+
+	arg := UpdateCollectionParams{
+		ID:     collection.ID,
+		Name:   util.RandomName(),
+		Shared: true,
+	}
+
+	collection2, err := testQueries.UpdateCollection(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, collection2)
+
+	require.Equal(t, collection.ID, collection2.ID)
+	require.Equal(t, arg.Name, collection2.Name)
+	require.Equal(t, arg.Shared, collection2.Shared)
+}
+
+func TestDeleteCollection(t *testing.T) {
+	collection := createRandomCollection(t, uuid.Nil, false)
+
+	err := testQueries.DeleteCollection(context.Background(), collection.ID)
+	require.NoError(t, err)
+
+	collection2, err := testQueries.GetCollectionByID(context.Background(), collection.ID)
+	require.Error(t, err)
+	require.Empty(t, collection2)
+	require.EqualError(t, err, pgx.ErrNoRows.Error())
+}
+
+func TestCollectionRecipes(t *testing.T) {
+	collection := createRandomCollection(t, uuid.Nil, false)
+	recipe1 := createRandomRecipe(t)
+	recipe2 := createRandomRecipe(t)
+
+	for _, recipe := range []Recipe{recipe1, recipe2} {
+		err := testQueries.AddRecipeToCollection(context.Background(), AddRecipeToCollectionParams{
+			CollectionID: collection.ID,
+			RecipeID:     recipe.ID,
+		})
+		require.NoError(t, err)
+	}
+
+	recipes, err := testQueries.GetCollectionRecipes(context.Background(), collection.ID)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(recipes))
+	require.Equal(t, recipe1.ID, recipes[0].ID)
+	require.Equal(t, recipe2.ID, recipes[1].ID)
+
+	err = testQueries.UpdateCollectionRecipePosition(context.Background(), UpdateCollectionRecipePositionParams{
+		CollectionID: collection.ID,
+		RecipeID:     recipe1.ID,
+		Position:     5,
+	})
+	require.NoError(t, err)
+
+	recipes, err = testQueries.GetCollectionRecipes(context.Background(), collection.ID)
+	require.NoError(t, err)
+	require.Equal(t, recipe2.ID, recipes[0].ID)
+	require.Equal(t, recipe1.ID, recipes[1].ID)
+
+	err = testQueries.RemoveRecipeFromCollection(context.Background(), RemoveRecipeFromCollectionParams{
+		CollectionID: collection.ID,
+		RecipeID:     recipe2.ID,
+	})
+	require.NoError(t, err)
+
+	recipes, err = testQueries.GetCollectionRecipes(context.Background(), collection.ID)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(recipes))
+	require.Equal(t, recipe1.ID, recipes[0].ID)
+}
@@ -0,0 +1,110 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/andreiz53/cookinator/util"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomCookLog(t *testing.T, recipe Recipe, cookedOn time.Time) CookLog {
+	arg := CreateCookLogParams{
+		RecipeID:       recipe.ID,
+		FamilyID:       recipe.FamilyID,
+		CookedByUserID: createRandomUser(t).ID,
+		CookedOn:       util.NewDate(cookedOn),
+		Servings:       int32(util.RandomInt(1, 8)),
+		Notes:          util.RandomString(32),
+		Rating:         pgtype.Int2{Int16: int16(util.RandomInt(1, 5)), Valid: true},
+	}
+
+	log, err := testQueries.CreateCookLog(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, log)
+
+	require.Equal(t, arg.RecipeID, log.RecipeID)
+	require.Equal(t, arg.FamilyID, log.FamilyID)
+	require.Equal(t, arg.CookedByUserID, log.CookedByUserID)
+	require.Equal(t, arg.CookedOn.Time, log.CookedOn.Time)
+	require.Equal(t, arg.Servings, log.Servings)
+	require.Equal(t, arg.Notes, log.Notes)
+	require.Equal(t, arg.Rating, log.Rating)
+
+	require.NotZero(t, log.ID)
+	require.NotZero(t, log.CreatedAt)
+
+	return log
+}
+
+func TestCreateCookLog(t *testing.T) {
+	createRandomCookLog(t, createRandomRecipe(t), time.Now())
+}
+
+func TestGetCookLogByID(t *testing.T) {
+	log := createRandomCookLog(t, createRandomRecipe(t), time.Now())
+
+	log2, err := testQueries.GetCookLogByID(context.Background(), log.ID)
+	require.NoError(t, err)
+	require.Equal(t, log.ID, log2.ID)
+	require.Equal(t, log.RecipeID, log2.RecipeID)
+	require.Equal(t, log.Servings, log2.Servings)
+}
+
+func TestGetCookLogsByRecipeID(t *testing.T) {
+	recipe := createRandomRecipe(t)
+	older := createRandomCookLog(t, recipe, time.Now().AddDate(0, 0, -7))
+	newer := createRandomCookLog(t, recipe, time.Now())
+
+	logs, err := testQueries.GetCookLogsByRecipeID(context.Background(), recipe.ID)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(logs))
+	require.Equal(t, newer.ID, logs[0].ID)
+	require.Equal(t, older.ID, logs[1].ID)
+}
+
+func TestGetCookLogsByFamilyID(t *testing.T) {
+	recipe := createRandomRecipe(t)
+	for i := 0; i < 3; i++ {
+		createRandomCookLog(t, recipe, time.Now().AddDate(0, 0, -i))
+	}
+
+	logs, err := testQueries.GetCookLogsByFamilyID(context.Background(), GetCookLogsByFamilyIDParams{
+		FamilyID: recipe.FamilyID,
+		Limit:    2,
+		Offset:   1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(logs))
+	for _, log := range logs {
+		require.Equal(t, recipe.FamilyID, log.FamilyID)
+	}
+}
+
+func TestGetLastCookedByFamilyID(t *testing.T) {
+	recipe := createRandomRecipe(t)
+	createRandomCookLog(t, recipe, time.Now().AddDate(0, 0, -10))
+	latest := createRandomCookLog(t, recipe, time.Now().AddDate(0, 0, -3))
+
+	rows, err := testQueries.GetLastCookedByFamilyID(context.Background(), recipe.FamilyID)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(rows))
+	require.Equal(t, recipe.ID, rows[0].RecipeID)
+	require.Equal(t, latest.CookedOn.Time, rows[0].LastCookedOn.Time)
+	require.Equal(t, int64(2), rows[0].TimesCooked)
+}
+
+func TestDeleteCookLog(t *testing.T) {
+	log := createRandomCookLog(t, createRandomRecipe(t), time.Now())
+
+	err := testQueries.DeleteCookLog(context.Background(), log.ID)
+	require.NoError(t, err)
+
+	log2, err := testQueries.GetCookLogByID(context.Background(), log.ID)
+	require.Error(t, err)
+	require.Empty(t, log2)
+	require.EqualError(t, err, pgx.ErrNoRows.Error())
+}
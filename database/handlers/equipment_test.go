@@ -0,0 +1,101 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/andreiz53/cookinator/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomEquipment(t *testing.T) Equipment {
+	name := util.RandomName()
+
+	equipment, err := testQueries.CreateEquipment(context.Background(), name)
+	require.NoError(t, err)
+	require.NotEmpty(t, equipment)
+
+	require.Equal(t, name, equipment.Name)
+	require.NotZero(t, equipment.ID)
+
+	return equipment
+}
+
+func TestCreateEquipment(t *testing.T) {
+	createRandomEquipment(t)
+}
+
+func TestGetEquipment(t *testing.T) {
+	createRandomEquipment(t)
+
+	equipment, err := testQueries.GetEquipment(context.Background())
+	require.NoError(t, err)
+	require.NotEmpty(t, equipment)
+}
+
+func TestSetRecipeEquipmentTx(t *testing.T) {
+	store := NewStore(testDB)
+	recipe := createRandomRecipe(t)
+	oven := createRandomEquipment(t)
+	mixer := createRandomEquipment(t)
+
+	err := store.SetRecipeEquipmentTx(context.Background(), SetRecipeEquipmentTxParams{
+		RecipeID: recipe.ID,
+		Equipment: []AddRecipeEquipmentParams{
+			{EquipmentID: oven.ID, Heavy: true},
+			{EquipmentID: mixer.ID},
+		},
+	})
+	require.NoError(t, err)
+
+	equipment, err := testQueries.GetRecipeEquipment(context.Background(), recipe.ID)
+	require.NoError(t, err)
+	require.Len(t, equipment, 2)
+
+	err = store.SetRecipeEquipmentTx(context.Background(), SetRecipeEquipmentTxParams{
+		RecipeID:  recipe.ID,
+		Equipment: []AddRecipeEquipmentParams{{EquipmentID: oven.ID, Heavy: true}},
+	})
+	require.NoError(t, err)
+
+	equipment, err = testQueries.GetRecipeEquipment(context.Background(), recipe.ID)
+	require.NoError(t, err)
+	require.Len(t, equipment, 1)
+	require.Equal(t, oven.ID, equipment[0].EquipmentID)
+	require.True(t, equipment[0].Heavy)
+}
+
+func TestFilterRecipesOnlyPossible(t *testing.T) {
+	store := NewStore(testDB)
+	recipe := createRandomRecipe(t)
+	oven := createRandomEquipment(t)
+
+	err := store.SetRecipeEquipmentTx(context.Background(), SetRecipeEquipmentTxParams{
+		RecipeID:  recipe.ID,
+		Equipment: []AddRecipeEquipmentParams{{EquipmentID: oven.ID, Heavy: true}},
+	})
+	require.NoError(t, err)
+
+	arg := FilterRecipesByFamilyIDParams{
+		FamilyID:     recipe.FamilyID,
+		OnlyPossible: true,
+	}
+	recipes, err := testQueries.FilterRecipesByFamilyID(context.Background(), arg)
+	require.NoError(t, err)
+	require.Empty(t, recipes)
+
+	err = store.SetFamilyEquipmentTx(context.Background(), SetFamilyEquipmentTxParams{
+		FamilyID:     recipe.FamilyID,
+		EquipmentIDs: []int32{oven.ID},
+	})
+	require.NoError(t, err)
+
+	owned, err := testQueries.GetFamilyEquipment(context.Background(), recipe.FamilyID)
+	require.NoError(t, err)
+	require.Len(t, owned, 1)
+
+	recipes, err = testQueries.FilterRecipesByFamilyID(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, recipes, 1)
+	require.Equal(t, recipe.ID, recipes[0].ID)
+}
@@ -7,13 +7,18 @@ import (
 )
 
 const (
-	CodeDuplicateKey = "23505"
+	CodeDuplicateKey        = "23505"
+	CodeForeignKeyViolation = "23503"
 )
 
 var ErrDuplicateKey = &pgconn.PgError{
 	Code: CodeDuplicateKey,
 }
 
+var ErrForeignKeyViolation = &pgconn.PgError{
+	Code: CodeForeignKeyViolation,
+}
+
 func ErrorCode(err error) string {
 	var pgErr *pgconn.PgError
 	if errors.As(err, &pgErr) {
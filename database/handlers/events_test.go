@@ -0,0 +1,94 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/andreiz53/cookinator/util"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomEvent(t *testing.T, familyID uuid.UUID) Event {
+	arg := CreateEventParams{
+		FamilyID:     familyID,
+		Name:         util.RandomName(),
+		Day:          util.NewDate(time.Now().AddDate(0, 0, util.RandomInt(1, 60))),
+		Guests:       int32(util.RandomInt(1, 30)),
+		Restrictions: []string{"gluten", "vegetarian"},
+	}
+
+	event, err := testQueries.CreateEvent(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, event)
+
+	require.Equal(t, arg.FamilyID, event.FamilyID)
+	require.Equal(t, arg.Name, event.Name)
+	require.Equal(t, arg.Day.Time, event.Day.Time)
+	require.Equal(t, arg.Guests, event.Guests)
+	require.Equal(t, arg.Restrictions, event.Restrictions)
+
+	require.NotZero(t, event.ID)
+	require.NotZero(t, event.CreatedAt)
+
+	return event
+}
+
+func TestCreateEvent(t *testing.T) {
+	family := createRandomFamily(t)
+	createRandomEvent(t, family.ID)
+}
+
+func TestGetEventsByFamilyID(t *testing.T) {
+	family := createRandomFamily(t)
+	for i := 0; i < 3; i++ {
+		createRandomEvent(t, family.ID)
+	}
+
+	events, err := testQueries.GetEventsByFamilyID(context.Background(), family.ID)
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	for i := 1; i < len(events); i++ {
+		require.False(t, events[i].Day.Time.After(events[i-1].Day.Time))
+	}
+}
+
+func TestEventRecipes(t *testing.T) {
+	family := createRandomFamily(t)
+	event := createRandomEvent(t, family.ID)
+	recipe := createRandomFamilyRecipe(t, family.ID)
+
+	arg := AddEventRecipeParams{EventID: event.ID, RecipeID: recipe.ID}
+	err := testQueries.AddEventRecipe(context.Background(), arg)
+	require.NoError(t, err)
+	// adding a recipe twice keeps it once on the menu
+	err = testQueries.AddEventRecipe(context.Background(), arg)
+	require.NoError(t, err)
+
+	recipes, err := testQueries.GetEventRecipes(context.Background(), event.ID)
+	require.NoError(t, err)
+	require.Len(t, recipes, 1)
+	require.Equal(t, recipe.ID, recipes[0].ID)
+
+	err = testQueries.RemoveEventRecipe(context.Background(), RemoveEventRecipeParams{EventID: event.ID, RecipeID: recipe.ID})
+	require.NoError(t, err)
+
+	recipes, err = testQueries.GetEventRecipes(context.Background(), event.ID)
+	require.NoError(t, err)
+	require.Empty(t, recipes)
+}
+
+func TestDeleteEvent(t *testing.T) {
+	family := createRandomFamily(t)
+	event := createRandomEvent(t, family.ID)
+
+	err := testQueries.DeleteEvent(context.Background(), event.ID)
+	require.NoError(t, err)
+
+	event2, err := testQueries.GetEventByID(context.Background(), event.ID)
+	require.Error(t, err)
+	require.EqualError(t, err, pgx.ErrNoRows.Error())
+	require.Empty(t, event2)
+}
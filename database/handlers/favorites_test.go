@@ -0,0 +1,82 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomFavorite(t *testing.T) (User, Recipe) {
+	user := createRandomUser(t)
+	recipe := createRandomRecipe(t)
+
+	err := testQueries.AddFavorite(context.Background(), AddFavoriteParams{
+		UserID:   user.ID,
+		RecipeID: recipe.ID,
+	})
+	require.NoError(t, err)
+
+	return user, recipe
+}
+
+func TestAddFavorite(t *testing.T) {
+	user, recipe := createRandomFavorite(t)
+
+	// adding the same favorite twice is a no-op
+	err := testQueries.AddFavorite(context.Background(), AddFavoriteParams{
+		UserID:   user.ID,
+		RecipeID: recipe.ID,
+	})
+	require.NoError(t, err)
+
+	ids, err := testQueries.GetFavoriteRecipeIDsByUserID(context.Background(), user.ID)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(ids))
+	require.Equal(t, recipe.ID, ids[0])
+}
+
+func TestIsRecipeFavorited(t *testing.T) {
+	user, recipe := createRandomFavorite(t)
+
+	favorited, err := testQueries.IsRecipeFavorited(context.Background(), IsRecipeFavoritedParams{
+		UserID:   user.ID,
+		RecipeID: recipe.ID,
+	})
+	require.NoError(t, err)
+	require.True(t, favorited)
+
+	other := createRandomRecipe(t)
+	favorited, err = testQueries.IsRecipeFavorited(context.Background(), IsRecipeFavoritedParams{
+		UserID:   user.ID,
+		RecipeID: other.ID,
+	})
+	require.NoError(t, err)
+	require.False(t, favorited)
+}
+
+func TestGetFavoriteRecipesByUserID(t *testing.T) {
+	user, recipe := createRandomFavorite(t)
+
+	recipes, err := testQueries.GetFavoriteRecipesByUserID(context.Background(), user.ID)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(recipes))
+
+	require.Equal(t, recipe.ID, recipes[0].ID)
+	require.Equal(t, recipe.Name, recipes[0].Name)
+	checkRecipeItems(t, recipe.Items, recipes[0].Items)
+}
+
+func TestRemoveFavorite(t *testing.T) {
+	user, recipe := createRandomFavorite(t)
+
+	err := testQueries.RemoveFavorite(context.Background(), RemoveFavoriteParams{
+		UserID:   user.ID,
+		RecipeID: recipe.ID,
+	})
+	require.NoError(t, err)
+
+	ids, err := testQueries.GetFavoriteRecipeIDsByUserID(context.Background(), user.ID)
+	require.NoError(t, err)
+	require.Empty(t, ids)
+}
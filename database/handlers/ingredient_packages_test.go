@@ -0,0 +1,71 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/andreiz53/cookinator/util"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomIngredientPackage(t *testing.T, ingredient Ingredient) IngredientPackage {
+	arg := CreateIngredientPackageParams{
+		IngredientID: ingredient.ID,
+		Quantity:     float64(util.RandomInt(100, 2000)),
+		Unit:         "g",
+		PriceCents:   pgtype.Int4{Int32: int32(util.RandomInt(50, 1000)), Valid: true},
+	}
+
+	pkg, err := testQueries.CreateIngredientPackage(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, pkg)
+
+	require.Equal(t, arg.IngredientID, pkg.IngredientID)
+	require.Equal(t, arg.Quantity, pkg.Quantity)
+	require.Equal(t, arg.Unit, pkg.Unit)
+	require.Equal(t, arg.PriceCents, pkg.PriceCents)
+
+	require.NotZero(t, pkg.ID)
+	require.NotZero(t, pkg.CreatedAt)
+
+	return pkg
+}
+
+func TestCreateIngredientPackage(t *testing.T) {
+	ingredient := createRandomIngredient(t)
+	pkg := createRandomIngredientPackage(t, ingredient)
+
+	// a size is sold once per ingredient
+	_, err := testQueries.CreateIngredientPackage(context.Background(), CreateIngredientPackageParams{
+		IngredientID: ingredient.ID,
+		Quantity:     pkg.Quantity,
+		Unit:         pkg.Unit,
+	})
+	require.Equal(t, CodeDuplicateKey, ErrorCode(err))
+}
+
+func TestGetIngredientPackagesByIngredientID(t *testing.T) {
+	ingredient := createRandomIngredient(t)
+	for i := 0; i < 3; i++ {
+		createRandomIngredientPackage(t, ingredient)
+	}
+
+	packages, err := testQueries.GetIngredientPackagesByIngredientID(context.Background(), ingredient.ID)
+	require.NoError(t, err)
+	require.Len(t, packages, 3)
+	for i := 1; i < len(packages); i++ {
+		require.LessOrEqual(t, packages[i-1].Quantity, packages[i].Quantity)
+	}
+}
+
+func TestDeleteIngredientPackage(t *testing.T) {
+	pkg := createRandomIngredientPackage(t, createRandomIngredient(t))
+
+	err := testQueries.DeleteIngredientPackage(context.Background(), pkg.ID)
+	require.NoError(t, err)
+
+	_, err = testQueries.GetIngredientPackageByID(context.Background(), pkg.ID)
+	require.EqualError(t, err, pgx.ErrNoRows.Error())
+}
@@ -14,20 +14,35 @@ import (
 const createIngredient = `-- name: CreateIngredient :one
 INSERT INTO ingredients (
     name, 
-    density
-) VALUES ( $1, $2)
-RETURNING id, name, density
+    density,
+    allergens,
+    category
+) VALUES ( $1, $2, $3, $4)
+RETURNING id, name, density, allergens, category
 `
 
 type CreateIngredientParams struct {
-	Name    string         `json:"name"`
-	Density pgtype.Numeric `json:"density"`
+	Name      string         `json:"name"`
+	Density   pgtype.Numeric `json:"density"`
+	Allergens []string       `json:"allergens"`
+	Category  string         `json:"category"`
 }
 
 func (q *Queries) CreateIngredient(ctx context.Context, arg CreateIngredientParams) (Ingredient, error) {
-	row := q.db.QueryRow(ctx, createIngredient, arg.Name, arg.Density)
+	row := q.db.QueryRow(ctx, createIngredient,
+		arg.Name,
+		arg.Density,
+		arg.Allergens,
+		arg.Category,
+	)
 	var i Ingredient
-	err := row.Scan(&i.ID, &i.Name, &i.Density)
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Density,
+		&i.Allergens,
+		&i.Category,
+	)
 	return i, err
 }
 
@@ -42,31 +57,43 @@ func (q *Queries) DeleteIngredient(ctx context.Context, id int32) error {
 }
 
 const getIngredientByID = `-- name: GetIngredientByID :one
-SELECT id, name, density FROM ingredients
+SELECT id, name, density, allergens, category FROM ingredients
 WHERE id = $1
 `
 
 func (q *Queries) GetIngredientByID(ctx context.Context, id int32) (Ingredient, error) {
 	row := q.db.QueryRow(ctx, getIngredientByID, id)
 	var i Ingredient
-	err := row.Scan(&i.ID, &i.Name, &i.Density)
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Density,
+		&i.Allergens,
+		&i.Category,
+	)
 	return i, err
 }
 
 const getIngredientByName = `-- name: GetIngredientByName :one
-SELECT id, name, density FROM ingredients
+SELECT id, name, density, allergens, category FROM ingredients
 WHERE name = $1
 `
 
 func (q *Queries) GetIngredientByName(ctx context.Context, name string) (Ingredient, error) {
 	row := q.db.QueryRow(ctx, getIngredientByName, name)
 	var i Ingredient
-	err := row.Scan(&i.ID, &i.Name, &i.Density)
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Density,
+		&i.Allergens,
+		&i.Category,
+	)
 	return i, err
 }
 
 const getIngredients = `-- name: GetIngredients :many
-SELECT id, name, density FROM ingredients
+SELECT id, name, density, allergens, category FROM ingredients
 `
 
 func (q *Queries) GetIngredients(ctx context.Context) ([]Ingredient, error) {
@@ -78,7 +105,13 @@ func (q *Queries) GetIngredients(ctx context.Context) ([]Ingredient, error) {
 	var items []Ingredient
 	for rows.Next() {
 		var i Ingredient
-		if err := rows.Scan(&i.ID, &i.Name, &i.Density); err != nil {
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Density,
+			&i.Allergens,
+			&i.Category,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -92,20 +125,36 @@ func (q *Queries) GetIngredients(ctx context.Context) ([]Ingredient, error) {
 const updateIngredient = `-- name: UpdateIngredient :one
 UPDATE ingredients SET
     name = $2,
-    density = $3
+    density = $3,
+    allergens = $4,
+    category = $5
 WHERE id = $1
-RETURNING id, name, density
+RETURNING id, name, density, allergens, category
 `
 
 type UpdateIngredientParams struct {
-	ID      int32          `json:"id"`
-	Name    string         `json:"name"`
-	Density pgtype.Numeric `json:"density"`
+	ID        int32          `json:"id"`
+	Name      string         `json:"name"`
+	Density   pgtype.Numeric `json:"density"`
+	Allergens []string       `json:"allergens"`
+	Category  string         `json:"category"`
 }
 
 func (q *Queries) UpdateIngredient(ctx context.Context, arg UpdateIngredientParams) (Ingredient, error) {
-	row := q.db.QueryRow(ctx, updateIngredient, arg.ID, arg.Name, arg.Density)
+	row := q.db.QueryRow(ctx, updateIngredient,
+		arg.ID,
+		arg.Name,
+		arg.Density,
+		arg.Allergens,
+		arg.Category,
+	)
 	var i Ingredient
-	err := row.Scan(&i.ID, &i.Name, &i.Density)
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Density,
+		&i.Allergens,
+		&i.Category,
+	)
 	return i, err
 }
@@ -11,8 +11,10 @@ import (
 
 func createRandomIngredient(t *testing.T) Ingredient {
 	arg := CreateIngredientParams{
-		Name:    util.RandomName(),
-		Density: util.RandomPGNumeric(),
+		Name:      util.RandomName(),
+		Density:   util.RandomPGNumeric(),
+		Allergens: []string{"gluten"},
+		Category:  "bakery",
 	}
 
 	ingredient, err := testQueries.CreateIngredient(context.Background(), arg)
@@ -21,6 +23,8 @@ func createRandomIngredient(t *testing.T) Ingredient {
 
 	require.Equal(t, arg.Name, ingredient.Name)
 	require.Equal(t, arg.Density, ingredient.Density)
+	require.Equal(t, arg.Allergens, ingredient.Allergens)
+	require.Equal(t, arg.Category, ingredient.Category)
 	require.NotZero(t, ingredient.ID)
 
 	return ingredient
@@ -70,9 +74,11 @@ func TestUpdateIngredient(t *testing.T) {
 	ingredient := createRandomIngredient(t)
 
 	arg := UpdateIngredientParams{
-		ID:      ingredient.ID,
-		Name:    util.RandomName(),
-		Density: util.RandomPGNumeric(),
+		ID:        ingredient.ID,
+		Name:      util.RandomName(),
+		Density:   util.RandomPGNumeric(),
+		Allergens: []string{},
+		Category:  "produce",
 	}
 
 	ingredient2, err := testQueries.UpdateIngredient(context.Background(), arg)
@@ -82,6 +88,7 @@ func TestUpdateIngredient(t *testing.T) {
 	require.Equal(t, ingredient.ID, ingredient2.ID)
 	require.Equal(t, arg.Name, ingredient2.Name)
 	require.Equal(t, arg.Density, ingredient2.Density)
+	require.Equal(t, arg.Category, ingredient2.Category)
 }
 
 func TestDeleteIngredient(t *testing.T) {
@@ -0,0 +1,91 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/andreiz53/cookinator/util"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomInventoryItem(t *testing.T, family Family) InventoryItem {
+	ingredient := createRandomIngredient(t)
+
+	arg := CreateInventoryItemParams{
+		FamilyID:     family.ID,
+		IngredientID: ingredient.ID,
+		Quantity:     util.RandomFloat(0, 1000),
+		Unit:         RandomMeasureUnit(),
+		KeepAtLeast:  util.RandomFloat(0, 100),
+	}
+
+	item, err := testQueries.CreateInventoryItem(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, item)
+
+	require.Equal(t, arg.FamilyID, item.FamilyID)
+	require.Equal(t, arg.IngredientID, item.IngredientID)
+	require.Equal(t, arg.Quantity, item.Quantity)
+	require.Equal(t, arg.Unit, item.Unit)
+	require.Equal(t, arg.KeepAtLeast, item.KeepAtLeast)
+
+	require.NotZero(t, item.ID)
+	require.NotZero(t, item.CreatedAt)
+
+	return item
+}
+
+func TestCreateInventoryItem(t *testing.T) {
+	family := createRandomFamily(t)
+	item := createRandomInventoryItem(t, family)
+
+	// an ingredient is stocked once per family
+	_, err := testQueries.CreateInventoryItem(context.Background(), CreateInventoryItemParams{
+		FamilyID:     family.ID,
+		IngredientID: item.IngredientID,
+		Quantity:     1,
+		Unit:         item.Unit,
+	})
+	require.Equal(t, CodeDuplicateKey, ErrorCode(err))
+}
+
+func TestGetInventoryByFamilyID(t *testing.T) {
+	family := createRandomFamily(t)
+	for i := 0; i < 3; i++ {
+		createRandomInventoryItem(t, family)
+	}
+
+	inventory, err := testQueries.GetInventoryByFamilyID(context.Background(), family.ID)
+	require.NoError(t, err)
+	require.Len(t, inventory, 3)
+	for _, row := range inventory {
+		require.NotEmpty(t, row.IngredientName)
+	}
+}
+
+func TestUpdateInventoryItem(t *testing.T) {
+	item := createRandomInventoryItem(t, createRandomFamily(t))
+
+	arg := UpdateInventoryItemParams{
+		ID:          item.ID,
+		Quantity:    0,
+		Unit:        "g",
+		KeepAtLeast: 50,
+	}
+	updated, err := testQueries.UpdateInventoryItem(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.Quantity, updated.Quantity)
+	require.Equal(t, arg.Unit, updated.Unit)
+	require.Equal(t, arg.KeepAtLeast, updated.KeepAtLeast)
+}
+
+func TestDeleteInventoryItem(t *testing.T) {
+	item := createRandomInventoryItem(t, createRandomFamily(t))
+
+	err := testQueries.DeleteInventoryItem(context.Background(), item.ID)
+	require.NoError(t, err)
+
+	_, err = testQueries.GetInventoryItemByID(context.Background(), item.ID)
+	require.EqualError(t, err, pgx.ErrNoRows.Error())
+}
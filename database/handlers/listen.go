@@ -0,0 +1,35 @@
+package database
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// ShoppingListItemsChannel is notified with every change of a shopping list item
+const ShoppingListItemsChannel = "shopping_list_items"
+
+// Listen waits for notifications on a channel and calls fn with their payload until ctx is done
+// or the connection fails. It opens a connection of its own, which is busy for as long as it listens.
+// Listening is called once the channel is listened to, notifications sent before are never received.
+func Listen(ctx context.Context, dbSource string, channel string, listening func(), fn func(payload string)) error {
+	conn, err := pgx.Connect(ctx, dbSource)
+	if err != nil {
+		return err
+	}
+	defer conn.Close(context.Background())
+
+	_, err = conn.Exec(ctx, "LISTEN "+pgx.Identifier{channel}.Sanitize())
+	if err != nil {
+		return err
+	}
+	listening()
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+		fn(notification.Payload)
+	}
+}
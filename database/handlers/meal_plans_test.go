@@ -0,0 +1,167 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/andreiz53/cookinator/util"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomMealPlan(t *testing.T) MealPlan {
+	family := createRandomFamily(t)
+
+	arg := CreateMealPlanParams{
+		FamilyID:  family.ID,
+		WeekStart: util.WeekStart(time.Now()),
+	}
+
+	plan, err := testQueries.CreateMealPlan(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, plan)
+
+	require.Equal(t, arg.FamilyID, plan.FamilyID)
+	require.Equal(t, arg.WeekStart.Time, plan.WeekStart.Time)
+
+	require.NotZero(t, plan.ID)
+	require.NotZero(t, plan.CreatedAt)
+
+	return plan
+}
+
+func createRandomMealPlanEntry(t *testing.T, plan MealPlan, day int, slot string) MealPlanEntry {
+	recipe := createRandomFamilyRecipe(t, plan.FamilyID)
+
+	arg := CreateMealPlanEntryParams{
+		MealPlanID: plan.ID,
+		Day:        util.NewDate(plan.WeekStart.Time.AddDate(0, 0, day)),
+		Slot:       slot,
+		RecipeID:   recipe.ID,
+		Servings:   int32(util.RandomInt(1, 6)),
+		Notes:      util.RandomString(16),
+	}
+
+	entry, err := testQueries.CreateMealPlanEntry(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, entry)
+
+	require.Equal(t, arg.MealPlanID, entry.MealPlanID)
+	require.Equal(t, arg.Day.Time, entry.Day.Time)
+	require.Equal(t, arg.Slot, entry.Slot)
+	require.Equal(t, arg.RecipeID, entry.RecipeID)
+	require.Equal(t, arg.Servings, entry.Servings)
+	require.Equal(t, arg.Notes, entry.Notes)
+
+	return entry
+}
+
+func TestCreateMealPlan(t *testing.T) {
+	plan := createRandomMealPlan(t)
+
+	_, err := testQueries.CreateMealPlan(context.Background(), CreateMealPlanParams{
+		FamilyID:  plan.FamilyID,
+		WeekStart: plan.WeekStart,
+	})
+	require.Error(t, err)
+	require.Equal(t, CodeDuplicateKey, ErrorCode(err))
+}
+
+func TestGetMealPlanByWeek(t *testing.T) {
+	plan := createRandomMealPlan(t)
+
+	plan2, err := testQueries.GetMealPlanByWeek(context.Background(), GetMealPlanByWeekParams{
+		FamilyID:  plan.FamilyID,
+		WeekStart: plan.WeekStart,
+	})
+	require.NoError(t, err)
+	require.Equal(t, plan.ID, plan2.ID)
+}
+
+func TestGetMealPlanEntries(t *testing.T) {
+	plan := createRandomMealPlan(t)
+	dinner := createRandomMealPlanEntry(t, plan, 1, "dinner")
+	breakfast := createRandomMealPlanEntry(t, plan, 1, "breakfast")
+	monday := createRandomMealPlanEntry(t, plan, 0, "lunch")
+
+	entries, err := testQueries.GetMealPlanEntries(context.Background(), plan.ID)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	require.Equal(t, monday.ID, entries[0].ID)
+	require.Equal(t, breakfast.ID, entries[1].ID)
+	require.Equal(t, dinner.ID, entries[2].ID)
+	require.NotEmpty(t, entries[0].RecipeName)
+}
+
+func TestUpdateMealPlanEntry(t *testing.T) {
+	plan := createRandomMealPlan(t)
+	entry := createRandomMealPlanEntry(t, plan, 2, "lunch")
+
+	arg := UpdateMealPlanEntryParams{
+		ID:       entry.ID,
+		Day:      util.NewDate(plan.WeekStart.Time.AddDate(0, 0, 3)),
+		Slot:     "dinner",
+		RecipeID: entry.RecipeID,
+		Servings: entry.Servings + 1,
+		Notes:    util.RandomString(16),
+		Locked:   true,
+	}
+
+	entry2, err := testQueries.UpdateMealPlanEntry(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, entry.ID, entry2.ID)
+	require.Equal(t, arg.Day.Time, entry2.Day.Time)
+	require.Equal(t, arg.Slot, entry2.Slot)
+	require.Equal(t, arg.Servings, entry2.Servings)
+	require.Equal(t, arg.Notes, entry2.Notes)
+	require.True(t, entry2.Locked)
+	require.Equal(t, entry.Sequence+1, entry2.Sequence)
+	require.True(t, entry2.UpdatedAt.Valid)
+}
+
+func TestDeleteMealPlan(t *testing.T) {
+	plan := createRandomMealPlan(t)
+	entry := createRandomMealPlanEntry(t, plan, 0, "dinner")
+
+	err := testQueries.DeleteMealPlan(context.Background(), plan.ID)
+	require.NoError(t, err)
+
+	_, err = testQueries.GetMealPlanByID(context.Background(), plan.ID)
+	require.EqualError(t, err, pgx.ErrNoRows.Error())
+
+	_, err = testQueries.GetMealPlanEntryByID(context.Background(), entry.ID)
+	require.EqualError(t, err, pgx.ErrNoRows.Error())
+}
+
+func TestGetAutoServingsMealsByFamilyID(t *testing.T) {
+	plan := createRandomMealPlan(t)
+	entry := createRandomMealPlanEntry(t, plan, 1, "dinner")
+	_, err := testQueries.UpdateMealPlanEntry(context.Background(), UpdateMealPlanEntryParams{
+		ID:           entry.ID,
+		Day:          entry.Day,
+		Slot:         entry.Slot,
+		RecipeID:     entry.RecipeID,
+		Servings:     entry.Servings,
+		AutoServings: true,
+	})
+	require.NoError(t, err)
+
+	arg := GetAutoServingsMealsByFamilyIDParams{FamilyID: plan.FamilyID, FromDay: plan.WeekStart}
+	meals, err := testQueries.GetAutoServingsMealsByFamilyID(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, meals, 1)
+	require.Equal(t, plan.ID, meals[0].MealPlanID)
+	require.Equal(t, entry.Day.Time, meals[0].Day.Time)
+
+	// the servings of a final plan are settled
+	_, err = testQueries.UpdateMealPlanStatus(context.Background(), UpdateMealPlanStatusParams{
+		ID:     plan.ID,
+		Status: "final",
+	})
+	require.NoError(t, err)
+
+	meals, err = testQueries.GetAutoServingsMealsByFamilyID(context.Background(), arg)
+	require.NoError(t, err)
+	require.Empty(t, meals)
+}
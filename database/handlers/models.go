@@ -9,6 +9,71 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type BusySlot struct {
+	CalendarID uuid.UUID   `json:"calendar_id"`
+	Day        pgtype.Date `json:"day"`
+	Slot       string      `json:"slot"`
+	Away       bool        `json:"away"`
+	Summary    string      `json:"summary"`
+}
+
+type CalendarFeed struct {
+	FamilyID  uuid.UUID        `json:"family_id"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+	Token     string           `json:"token"`
+}
+
+type Collection struct {
+	ID        uuid.UUID        `json:"id"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+	UpdatedAt pgtype.Timestamp `json:"updated_at"`
+	Name      string           `json:"name"`
+	UserID    uuid.UUID        `json:"user_id"`
+	FamilyID  pgtype.UUID      `json:"family_id"`
+	Shared    bool             `json:"shared"`
+}
+
+type CollectionRecipe struct {
+	CollectionID uuid.UUID        `json:"collection_id"`
+	RecipeID     uuid.UUID        `json:"recipe_id"`
+	Position     int32            `json:"position"`
+	CreatedAt    pgtype.Timestamp `json:"created_at"`
+}
+
+type CookLog struct {
+	ID             uuid.UUID        `json:"id"`
+	CreatedAt      pgtype.Timestamp `json:"created_at"`
+	RecipeID       uuid.UUID        `json:"recipe_id"`
+	FamilyID       uuid.UUID        `json:"family_id"`
+	CookedByUserID uuid.UUID        `json:"cooked_by_user_id"`
+	CookedOn       pgtype.Date      `json:"cooked_on"`
+	Servings       int32            `json:"servings"`
+	Notes          string           `json:"notes"`
+	Rating         pgtype.Int2      `json:"rating"`
+}
+
+type Equipment struct {
+	ID   int32  `json:"id"`
+	Name string `json:"name"`
+}
+
+type Event struct {
+	ID           uuid.UUID        `json:"id"`
+	CreatedAt    pgtype.Timestamp `json:"created_at"`
+	UpdatedAt    pgtype.Timestamp `json:"updated_at"`
+	FamilyID     uuid.UUID        `json:"family_id"`
+	Name         string           `json:"name"`
+	Day          pgtype.Date      `json:"day"`
+	Guests       int32            `json:"guests"`
+	Restrictions []string         `json:"restrictions"`
+}
+
+type EventRecipe struct {
+	EventID   uuid.UUID        `json:"event_id"`
+	RecipeID  uuid.UUID        `json:"recipe_id"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+}
+
 type Family struct {
 	ID              uuid.UUID        `json:"id"`
 	CreatedAt       pgtype.Timestamp `json:"created_at"`
@@ -17,28 +82,227 @@ type Family struct {
 	CreatedByUserID uuid.UUID        `json:"created_by_user_id"`
 }
 
+type FamilyCalendar struct {
+	ID        uuid.UUID        `json:"id"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+	SyncedAt  pgtype.Timestamp `json:"synced_at"`
+	FamilyID  uuid.UUID        `json:"family_id"`
+	Name      string           `json:"name"`
+	Url       string           `json:"url"`
+	Timezone  string           `json:"timezone"`
+}
+
+type FamilyEquipment struct {
+	FamilyID    uuid.UUID        `json:"family_id"`
+	EquipmentID int32            `json:"equipment_id"`
+	CreatedAt   pgtype.Timestamp `json:"created_at"`
+}
+
+type Favorite struct {
+	UserID    uuid.UUID        `json:"user_id"`
+	RecipeID  uuid.UUID        `json:"recipe_id"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+}
+
 type Ingredient struct {
-	ID      int32          `json:"id"`
-	Name    string         `json:"name"`
-	Density pgtype.Numeric `json:"density"`
+	ID        int32          `json:"id"`
+	Name      string         `json:"name"`
+	Density   pgtype.Numeric `json:"density"`
+	Allergens []string       `json:"allergens"`
+	Category  string         `json:"category"`
+}
+
+type IngredientPackage struct {
+	ID           uuid.UUID        `json:"id"`
+	CreatedAt    pgtype.Timestamp `json:"created_at"`
+	IngredientID int32            `json:"ingredient_id"`
+	Quantity     float64          `json:"quantity"`
+	Unit         string           `json:"unit"`
+	PriceCents   pgtype.Int4      `json:"price_cents"`
+}
+
+type InventoryItem struct {
+	ID           uuid.UUID        `json:"id"`
+	CreatedAt    pgtype.Timestamp `json:"created_at"`
+	UpdatedAt    pgtype.Timestamp `json:"updated_at"`
+	FamilyID     uuid.UUID        `json:"family_id"`
+	IngredientID int32            `json:"ingredient_id"`
+	Quantity     float64          `json:"quantity"`
+	Unit         string           `json:"unit"`
+	KeepAtLeast  float64          `json:"keep_at_least"`
+}
+
+type MealAttendance struct {
+	MealPlanID uuid.UUID   `json:"meal_plan_id"`
+	Day        pgtype.Date `json:"day"`
+	Slot       string      `json:"slot"`
+	UserID     uuid.UUID   `json:"user_id"`
+	Attending  bool        `json:"attending"`
+}
+
+type MealGuest struct {
+	MealPlanID uuid.UUID   `json:"meal_plan_id"`
+	Day        pgtype.Date `json:"day"`
+	Slot       string      `json:"slot"`
+	Guests     int32       `json:"guests"`
+}
+
+type MealPlan struct {
+	ID          uuid.UUID        `json:"id"`
+	CreatedAt   pgtype.Timestamp `json:"created_at"`
+	UpdatedAt   pgtype.Timestamp `json:"updated_at"`
+	FamilyID    uuid.UUID        `json:"family_id"`
+	WeekStart   pgtype.Date      `json:"week_start"`
+	Status      string           `json:"status"`
+	FinalizedAt pgtype.Timestamp `json:"finalized_at"`
+}
+
+type MealPlanEntry struct {
+	ID            uuid.UUID        `json:"id"`
+	CreatedAt     pgtype.Timestamp `json:"created_at"`
+	MealPlanID    uuid.UUID        `json:"meal_plan_id"`
+	Day           pgtype.Date      `json:"day"`
+	Slot          string           `json:"slot"`
+	RecipeID      uuid.UUID        `json:"recipe_id"`
+	Servings      int32            `json:"servings"`
+	Notes         string           `json:"notes"`
+	Locked        bool             `json:"locked"`
+	LeftoverOf    pgtype.UUID      `json:"leftover_of"`
+	BatchServings pgtype.Int4      `json:"batch_servings"`
+	UpdatedAt     pgtype.Timestamp `json:"updated_at"`
+	Sequence      int32            `json:"sequence"`
+	AutoServings  bool             `json:"auto_servings"`
+}
+
+type MealPlanRotation struct {
+	FamilyID  uuid.UUID        `json:"family_id"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+	StartWeek pgtype.Date      `json:"start_week"`
+}
+
+type MealPlanRotationTemplate struct {
+	FamilyID   uuid.UUID `json:"family_id"`
+	Position   int32     `json:"position"`
+	TemplateID uuid.UUID `json:"template_id"`
+}
+
+type MealPlanTemplate struct {
+	ID        uuid.UUID        `json:"id"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+	FamilyID  uuid.UUID        `json:"family_id"`
+	Name      string           `json:"name"`
+}
+
+type MealPlanTemplateEntry struct {
+	ID         uuid.UUID `json:"id"`
+	TemplateID uuid.UUID `json:"template_id"`
+	Weekday    int32     `json:"weekday"`
+	Slot       string    `json:"slot"`
+	RecipeID   uuid.UUID `json:"recipe_id"`
+	Servings   int32     `json:"servings"`
+	Notes      string    `json:"notes"`
+}
+
+type MealPlanVote struct {
+	EntryID   uuid.UUID        `json:"entry_id"`
+	UserID    uuid.UUID        `json:"user_id"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+	RecipeID  uuid.UUID        `json:"recipe_id"`
+	Vote      string           `json:"vote"`
+}
+
+type MemberMealAbsence struct {
+	UserID  uuid.UUID `json:"user_id"`
+	Weekday int32     `json:"weekday"`
+	Slot    string    `json:"slot"`
 }
 
 type Recipe struct {
+	ID                 uuid.UUID        `json:"id"`
+	CreatedAt          pgtype.Timestamp `json:"created_at"`
+	UpdatedAt          pgtype.Timestamp `json:"updated_at"`
+	Name               string           `json:"name"`
+	CookingProcess     string           `json:"cooking_process"`
+	FamilyID           uuid.UUID        `json:"family_id"`
+	Items              []byte           `json:"items"`
+	PrepTimeMinutes    int32            `json:"prep_time_minutes"`
+	CookTimeMinutes    int32            `json:"cook_time_minutes"`
+	TotalTimeMinutes   int32            `json:"total_time_minutes"`
+	ActiveTimeMinutes  int32            `json:"active_time_minutes"`
+	Difficulty         string           `json:"difficulty"`
+	Cuisine            string           `json:"cuisine"`
+	Tags               []string         `json:"tags"`
+	CaloriesPerServing int32            `json:"calories_per_serving"`
+	ProteinPerServing  int32            `json:"protein_per_serving"`
+	CostPerServing     int32            `json:"cost_per_serving"`
+	Servings           int32            `json:"servings"`
+}
+
+type RecipeEquipment struct {
+	RecipeID    uuid.UUID `json:"recipe_id"`
+	EquipmentID int32     `json:"equipment_id"`
+	Heavy       bool      `json:"heavy"`
+}
+
+type ShoppingList struct {
+	ID         uuid.UUID        `json:"id"`
+	CreatedAt  pgtype.Timestamp `json:"created_at"`
+	UpdatedAt  pgtype.Timestamp `json:"updated_at"`
+	FamilyID   uuid.UUID        `json:"family_id"`
+	MealPlanID uuid.UUID        `json:"meal_plan_id"`
+}
+
+type ShoppingListItem struct {
 	ID             uuid.UUID        `json:"id"`
 	CreatedAt      pgtype.Timestamp `json:"created_at"`
 	UpdatedAt      pgtype.Timestamp `json:"updated_at"`
+	ShoppingListID uuid.UUID        `json:"shopping_list_id"`
+	IngredientID   pgtype.Int4      `json:"ingredient_id"`
 	Name           string           `json:"name"`
-	CookingProcess string           `json:"cooking_process"`
-	FamilyID       uuid.UUID        `json:"family_id"`
-	Items          []byte           `json:"items"`
+	Quantity       float64          `json:"quantity"`
+	Unit           string           `json:"unit"`
+	Manual         bool             `json:"manual"`
+	Checked        bool             `json:"checked"`
+	CheckedAt      pgtype.Timestamp `json:"checked_at"`
+	CheckedBy      pgtype.UUID      `json:"checked_by"`
+	Category       string           `json:"category"`
+	Packages       []byte           `json:"packages"`
+	Surplus        float64          `json:"surplus"`
+	Sources        []byte           `json:"sources"`
+	Edited         bool             `json:"edited"`
+	Dismissed      bool             `json:"dismissed"`
+	GeneratedUnit  pgtype.Text      `json:"generated_unit"`
+}
+
+type Staple struct {
+	ID           uuid.UUID        `json:"id"`
+	CreatedAt    pgtype.Timestamp `json:"created_at"`
+	UpdatedAt    pgtype.Timestamp `json:"updated_at"`
+	FamilyID     uuid.UUID        `json:"family_id"`
+	IngredientID int32            `json:"ingredient_id"`
+	Quantity     float64          `json:"quantity"`
+	Unit         string           `json:"unit"`
+	EveryWeeks   int32            `json:"every_weeks"`
+	FirstWeek    pgtype.Date      `json:"first_week"`
+}
+
+type StoreLayout struct {
+	ID         uuid.UUID        `json:"id"`
+	CreatedAt  pgtype.Timestamp `json:"created_at"`
+	UpdatedAt  pgtype.Timestamp `json:"updated_at"`
+	FamilyID   uuid.UUID        `json:"family_id"`
+	Name       string           `json:"name"`
+	Categories []string         `json:"categories"`
 }
 
 type User struct {
-	ID        uuid.UUID        `json:"id"`
-	CreatedAt pgtype.Timestamp `json:"created_at"`
-	UpdatedAt pgtype.Timestamp `json:"updated_at"`
-	FirstName string           `json:"first_name"`
-	Email     string           `json:"email"`
-	Password  string           `json:"password"`
-	FamilyID  uuid.UUID        `json:"family_id"`
+	ID            uuid.UUID        `json:"id"`
+	CreatedAt     pgtype.Timestamp `json:"created_at"`
+	UpdatedAt     pgtype.Timestamp `json:"updated_at"`
+	FirstName     string           `json:"first_name"`
+	Email         string           `json:"email"`
+	Password      string           `json:"password"`
+	FamilyID      uuid.UUID        `json:"family_id"`
+	PortionFactor float64          `json:"portion_factor"`
+	UnitSystem    string           `json:"unit_system"`
 }
@@ -8,35 +8,177 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type Querier interface {
+	AddEventRecipe(ctx context.Context, arg AddEventRecipeParams) error
+	AddFamilyEquipment(ctx context.Context, arg AddFamilyEquipmentParams) error
+	AddFavorite(ctx context.Context, arg AddFavoriteParams) error
+	AddMealPlanRotationTemplate(ctx context.Context, arg AddMealPlanRotationTemplateParams) error
+	AddRecipeEquipment(ctx context.Context, arg AddRecipeEquipmentParams) error
+	AddRecipeToCollection(ctx context.Context, arg AddRecipeToCollectionParams) error
+	CheckShoppingListItem(ctx context.Context, arg CheckShoppingListItemParams) (ShoppingListItem, error)
+	CreateBusySlot(ctx context.Context, arg CreateBusySlotParams) error
+	CreateCollection(ctx context.Context, arg CreateCollectionParams) (Collection, error)
+	CreateCookLog(ctx context.Context, arg CreateCookLogParams) (CookLog, error)
+	CreateEquipment(ctx context.Context, name string) (Equipment, error)
+	CreateEvent(ctx context.Context, arg CreateEventParams) (Event, error)
 	CreateFamily(ctx context.Context, arg CreateFamilyParams) (Family, error)
+	CreateFamilyCalendar(ctx context.Context, arg CreateFamilyCalendarParams) (FamilyCalendar, error)
 	CreateIngredient(ctx context.Context, arg CreateIngredientParams) (Ingredient, error)
+	CreateIngredientPackage(ctx context.Context, arg CreateIngredientPackageParams) (IngredientPackage, error)
+	CreateInventoryItem(ctx context.Context, arg CreateInventoryItemParams) (InventoryItem, error)
+	CreateMealAttendance(ctx context.Context, arg CreateMealAttendanceParams) error
+	CreateMealPlan(ctx context.Context, arg CreateMealPlanParams) (MealPlan, error)
+	CreateMealPlanEntry(ctx context.Context, arg CreateMealPlanEntryParams) (MealPlanEntry, error)
+	CreateMealPlanTemplate(ctx context.Context, arg CreateMealPlanTemplateParams) (MealPlanTemplate, error)
+	CreateMealPlanTemplateEntry(ctx context.Context, arg CreateMealPlanTemplateEntryParams) (MealPlanTemplateEntry, error)
+	CreateMemberMealAbsence(ctx context.Context, arg CreateMemberMealAbsenceParams) error
 	CreateRecipe(ctx context.Context, arg CreateRecipeParams) (Recipe, error)
+	CreateShoppingList(ctx context.Context, arg CreateShoppingListParams) (ShoppingList, error)
+	CreateShoppingListItem(ctx context.Context, arg CreateShoppingListItemParams) (ShoppingListItem, error)
+	CreateStaple(ctx context.Context, arg CreateStapleParams) (Staple, error)
+	CreateStoreLayout(ctx context.Context, arg CreateStoreLayoutParams) (StoreLayout, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
+	DeleteBusySlots(ctx context.Context, calendarID uuid.UUID) error
+	DeleteCalendarFeed(ctx context.Context, familyID uuid.UUID) error
+	DeleteCollection(ctx context.Context, id uuid.UUID) error
+	DeleteCookLog(ctx context.Context, id uuid.UUID) error
+	DeleteEvent(ctx context.Context, id uuid.UUID) error
 	DeleteFamily(ctx context.Context, id uuid.UUID) error
+	DeleteFamilyCalendar(ctx context.Context, id uuid.UUID) error
+	DeleteFamilyEquipment(ctx context.Context, familyID uuid.UUID) error
+	DeleteGeneratedShoppingListItem(ctx context.Context, id uuid.UUID) error
 	DeleteIngredient(ctx context.Context, id int32) error
+	DeleteIngredientPackage(ctx context.Context, id uuid.UUID) error
+	DeleteInventoryItem(ctx context.Context, id uuid.UUID) error
+	DeleteMealAttendance(ctx context.Context, arg DeleteMealAttendanceParams) error
+	DeleteMealPlan(ctx context.Context, id uuid.UUID) error
+	DeleteMealPlanEntry(ctx context.Context, id uuid.UUID) error
+	DeleteMealPlanRotation(ctx context.Context, familyID uuid.UUID) error
+	DeleteMealPlanRotationTemplates(ctx context.Context, familyID uuid.UUID) error
+	DeleteMealPlanTemplate(ctx context.Context, id uuid.UUID) error
+	DeleteMealPlanVote(ctx context.Context, arg DeleteMealPlanVoteParams) error
+	DeleteMemberMealAbsences(ctx context.Context, userID uuid.UUID) error
 	DeleteRecipe(ctx context.Context, id uuid.UUID) error
+	DeleteRecipeEquipment(ctx context.Context, recipeID uuid.UUID) error
+	DeleteShoppingList(ctx context.Context, id uuid.UUID) error
+	DeleteShoppingListItem(ctx context.Context, id uuid.UUID) error
+	DeleteStaple(ctx context.Context, id uuid.UUID) error
+	DeleteStoreLayout(ctx context.Context, id uuid.UUID) error
+	DeleteUnlockedMealPlanEntries(ctx context.Context, mealPlanID uuid.UUID) error
 	DeleteUser(ctx context.Context, id uuid.UUID) error
+	DismissShoppingListItem(ctx context.Context, id uuid.UUID) error
+	FilterRecipesByFamilyID(ctx context.Context, arg FilterRecipesByFamilyIDParams) ([]Recipe, error)
+	FinalizeMealPlan(ctx context.Context, id uuid.UUID) (MealPlan, error)
+	GetAllShoppingListItems(ctx context.Context, shoppingListID uuid.UUID) ([]ShoppingListItem, error)
+	GetAutoServingsMealsByFamilyID(ctx context.Context, arg GetAutoServingsMealsByFamilyIDParams) ([]GetAutoServingsMealsByFamilyIDRow, error)
+	GetBusySlotsByFamilyID(ctx context.Context, arg GetBusySlotsByFamilyIDParams) ([]BusySlot, error)
+	GetCalendarEntriesByFamilyID(ctx context.Context, arg GetCalendarEntriesByFamilyIDParams) ([]GetCalendarEntriesByFamilyIDRow, error)
+	GetCalendarFeedByFamilyID(ctx context.Context, familyID uuid.UUID) (CalendarFeed, error)
+	GetCollectionByID(ctx context.Context, id uuid.UUID) (Collection, error)
+	GetCollectionRecipes(ctx context.Context, collectionID uuid.UUID) ([]Recipe, error)
+	GetCollectionsByUserID(ctx context.Context, arg GetCollectionsByUserIDParams) ([]Collection, error)
+	GetCookLogByID(ctx context.Context, id uuid.UUID) (CookLog, error)
+	GetCookLogsByFamilyID(ctx context.Context, arg GetCookLogsByFamilyIDParams) ([]CookLog, error)
+	GetCookLogsByRecipeID(ctx context.Context, recipeID uuid.UUID) ([]CookLog, error)
+	GetEquipment(ctx context.Context) ([]Equipment, error)
+	GetEventByID(ctx context.Context, id uuid.UUID) (Event, error)
+	GetEventRecipes(ctx context.Context, eventID uuid.UUID) ([]Recipe, error)
+	GetEventsByFamilyID(ctx context.Context, familyID uuid.UUID) ([]Event, error)
 	GetFamilies(ctx context.Context) ([]Family, error)
 	GetFamilyByID(ctx context.Context, id uuid.UUID) (Family, error)
 	GetFamilyByUserID(ctx context.Context, createdByUserID uuid.UUID) (Family, error)
+	GetFamilyCalendarByID(ctx context.Context, id uuid.UUID) (FamilyCalendar, error)
+	GetFamilyCalendars(ctx context.Context, familyID uuid.UUID) ([]FamilyCalendar, error)
+	GetFamilyEquipment(ctx context.Context, familyID uuid.UUID) ([]Equipment, error)
+	GetFavoriteRecipeIDsByUserID(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
+	GetFavoriteRecipesByUserID(ctx context.Context, userID uuid.UUID) ([]Recipe, error)
 	GetIngredientByID(ctx context.Context, id int32) (Ingredient, error)
 	GetIngredientByName(ctx context.Context, name string) (Ingredient, error)
+	GetIngredientPackageByID(ctx context.Context, id uuid.UUID) (IngredientPackage, error)
+	GetIngredientPackages(ctx context.Context) ([]IngredientPackage, error)
+	GetIngredientPackagesByIngredientID(ctx context.Context, ingredientID int32) ([]IngredientPackage, error)
 	GetIngredients(ctx context.Context) ([]Ingredient, error)
+	GetInventoryByFamilyID(ctx context.Context, familyID uuid.UUID) ([]GetInventoryByFamilyIDRow, error)
+	GetInventoryItemByID(ctx context.Context, id uuid.UUID) (InventoryItem, error)
+	GetLastCookedByFamilyID(ctx context.Context, familyID uuid.UUID) ([]GetLastCookedByFamilyIDRow, error)
+	GetLeftoverServingsEaten(ctx context.Context, arg GetLeftoverServingsEatenParams) (int32, error)
+	GetLeftovers(ctx context.Context, leftoverOf pgtype.UUID) ([]MealPlanEntry, error)
+	GetLeftoversOutsideMealPlan(ctx context.Context, mealPlanID uuid.UUID) ([]MealPlanEntry, error)
+	GetMealAttendanceByFamilyID(ctx context.Context, arg GetMealAttendanceByFamilyIDParams) ([]MealAttendance, error)
+	GetMealGuestsByFamilyID(ctx context.Context, arg GetMealGuestsByFamilyIDParams) ([]MealGuest, error)
+	GetMealPlanByID(ctx context.Context, id uuid.UUID) (MealPlan, error)
+	GetMealPlanByWeek(ctx context.Context, arg GetMealPlanByWeekParams) (MealPlan, error)
+	GetMealPlanEntries(ctx context.Context, mealPlanID uuid.UUID) ([]GetMealPlanEntriesRow, error)
+	GetMealPlanEntryByID(ctx context.Context, id uuid.UUID) (MealPlanEntry, error)
+	GetMealPlanRotation(ctx context.Context, familyID uuid.UUID) (MealPlanRotation, error)
+	GetMealPlanRotationTemplates(ctx context.Context, familyID uuid.UUID) ([]GetMealPlanRotationTemplatesRow, error)
+	GetMealPlanTemplateByID(ctx context.Context, id uuid.UUID) (MealPlanTemplate, error)
+	GetMealPlanTemplateEntries(ctx context.Context, templateID uuid.UUID) ([]GetMealPlanTemplateEntriesRow, error)
+	GetMealPlanTemplatesByFamilyID(ctx context.Context, familyID uuid.UUID) ([]MealPlanTemplate, error)
+	GetMealPlanVotes(ctx context.Context, mealPlanID uuid.UUID) ([]MealPlanVote, error)
+	GetMealPlansByFamilyID(ctx context.Context, familyID uuid.UUID) ([]MealPlan, error)
+	GetMemberMealAbsencesByFamilyID(ctx context.Context, familyID uuid.UUID) ([]MemberMealAbsence, error)
+	GetPlannedRecipesByFamilyID(ctx context.Context, arg GetPlannedRecipesByFamilyIDParams) ([]GetPlannedRecipesByFamilyIDRow, error)
 	GetRecipeByID(ctx context.Context, id uuid.UUID) (Recipe, error)
+	GetRecipeEquipment(ctx context.Context, recipeID uuid.UUID) ([]GetRecipeEquipmentRow, error)
+	GetRecipeEquipmentByFamilyID(ctx context.Context, familyID uuid.UUID) ([]GetRecipeEquipmentByFamilyIDRow, error)
 	GetRecipes(ctx context.Context) ([]Recipe, error)
 	GetRecipesByFamilyID(ctx context.Context, familyID uuid.UUID) ([]Recipe, error)
+	GetShoppingListByID(ctx context.Context, id uuid.UUID) (ShoppingList, error)
+	GetShoppingListByMealPlanID(ctx context.Context, mealPlanID uuid.UUID) (ShoppingList, error)
+	GetShoppingListItemByID(ctx context.Context, id uuid.UUID) (ShoppingListItem, error)
+	GetShoppingListItems(ctx context.Context, shoppingListID uuid.UUID) ([]ShoppingListItem, error)
+	GetShoppingListsByFamilyID(ctx context.Context, familyID uuid.UUID) ([]ShoppingList, error)
+	GetStapleByID(ctx context.Context, id uuid.UUID) (Staple, error)
+	GetStaplesByFamilyID(ctx context.Context, familyID uuid.UUID) ([]GetStaplesByFamilyIDRow, error)
+	GetStoreLayoutByID(ctx context.Context, id uuid.UUID) (StoreLayout, error)
+	GetStoreLayoutsByFamilyID(ctx context.Context, familyID uuid.UUID) ([]StoreLayout, error)
 	GetUserByEmail(ctx context.Context, email string) (User, error)
 	GetUserByID(ctx context.Context, id uuid.UUID) (User, error)
 	GetUsers(ctx context.Context) ([]User, error)
+	GetUsersByFamilyID(ctx context.Context, familyID uuid.UUID) ([]User, error)
+	IsRecipeFavorited(ctx context.Context, arg IsRecipeFavoritedParams) (bool, error)
+	LockMealPlanEntries(ctx context.Context, mealPlanID uuid.UUID) error
+	MoveCollectionRecipes(ctx context.Context, arg MoveCollectionRecipesParams) error
+	MoveCookLogs(ctx context.Context, arg MoveCookLogsParams) error
+	MoveEventRecipes(ctx context.Context, arg MoveEventRecipesParams) error
+	MoveFavorites(ctx context.Context, arg MoveFavoritesParams) error
+	MoveMealPlanEntries(ctx context.Context, arg MoveMealPlanEntriesParams) error
+	MoveMealPlanTemplateEntries(ctx context.Context, arg MoveMealPlanTemplateEntriesParams) error
+	MoveMealPlanVotes(ctx context.Context, arg MoveMealPlanVotesParams) error
+	MoveRecipeEquipment(ctx context.Context, arg MoveRecipeEquipmentParams) error
+	RemoveEventRecipe(ctx context.Context, arg RemoveEventRecipeParams) error
+	RemoveFavorite(ctx context.Context, arg RemoveFavoriteParams) error
+	RemoveRecipeFromCollection(ctx context.Context, arg RemoveRecipeFromCollectionParams) error
+	TouchFamilyCalendar(ctx context.Context, id uuid.UUID) (FamilyCalendar, error)
+	TouchMealPlan(ctx context.Context, id uuid.UUID) error
+	TouchShoppingList(ctx context.Context, id uuid.UUID) error
+	UpdateAutoMealPlanEntryServings(ctx context.Context, arg UpdateAutoMealPlanEntryServingsParams) ([]MealPlanEntry, error)
+	UpdateCollection(ctx context.Context, arg UpdateCollectionParams) (Collection, error)
+	UpdateCollectionRecipePosition(ctx context.Context, arg UpdateCollectionRecipePositionParams) error
+	UpdateEvent(ctx context.Context, arg UpdateEventParams) (Event, error)
 	UpdateFamily(ctx context.Context, arg UpdateFamilyParams) (Family, error)
+	UpdateGeneratedShoppingListItem(ctx context.Context, arg UpdateGeneratedShoppingListItemParams) error
 	UpdateIngredient(ctx context.Context, arg UpdateIngredientParams) (Ingredient, error)
+	UpdateInventoryItem(ctx context.Context, arg UpdateInventoryItemParams) (InventoryItem, error)
+	UpdateMealPlanEntry(ctx context.Context, arg UpdateMealPlanEntryParams) (MealPlanEntry, error)
+	UpdateMealPlanStatus(ctx context.Context, arg UpdateMealPlanStatusParams) (MealPlan, error)
 	UpdateRecipe(ctx context.Context, arg UpdateRecipeParams) (Recipe, error)
+	UpdateShoppingListItem(ctx context.Context, arg UpdateShoppingListItemParams) (ShoppingListItem, error)
+	UpdateStaple(ctx context.Context, arg UpdateStapleParams) (Staple, error)
+	UpdateStoreLayout(ctx context.Context, arg UpdateStoreLayoutParams) (StoreLayout, error)
 	UpdateUserEmail(ctx context.Context, arg UpdateUserEmailParams) (User, error)
 	UpdateUserInfo(ctx context.Context, arg UpdateUserInfoParams) (User, error)
 	UpdateUserPassword(ctx context.Context, arg UpdateUserPasswordParams) (User, error)
+	UpdateUserPortionFactor(ctx context.Context, arg UpdateUserPortionFactorParams) (User, error)
+	UpdateUserUnitSystem(ctx context.Context, arg UpdateUserUnitSystemParams) (User, error)
+	UpsertCalendarFeed(ctx context.Context, arg UpsertCalendarFeedParams) (CalendarFeed, error)
+	UpsertMealGuests(ctx context.Context, arg UpsertMealGuestsParams) error
+	UpsertMealPlanRotation(ctx context.Context, arg UpsertMealPlanRotationParams) (MealPlanRotation, error)
+	UpsertMealPlanVote(ctx context.Context, arg UpsertMealPlanVoteParams) (MealPlanVote, error)
 }
 
 var _ Querier = (*Queries)(nil)
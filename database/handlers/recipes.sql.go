@@ -9,6 +9,7 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const createRecipe = `-- name: CreateRecipe :one
@@ -16,17 +17,37 @@ INSERT INTO recipes (
     name,
     cooking_process,
     family_id,
-    items
+    items,
+    prep_time_minutes,
+    cook_time_minutes,
+    active_time_minutes,
+    difficulty,
+    cuisine,
+    tags,
+    calories_per_serving,
+    protein_per_serving,
+    cost_per_serving,
+    servings
 ) VALUES (
-    $1, $2, $3, $4
-) RETURNING id, created_at, updated_at, name, cooking_process, family_id, items
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
+) RETURNING id, created_at, updated_at, name, cooking_process, family_id, items, prep_time_minutes, cook_time_minutes, total_time_minutes, active_time_minutes, difficulty, cuisine, tags, calories_per_serving, protein_per_serving, cost_per_serving, servings
 `
 
 type CreateRecipeParams struct {
-	Name           string    `json:"name"`
-	CookingProcess string    `json:"cooking_process"`
-	FamilyID       uuid.UUID `json:"family_id"`
-	Items          []byte    `json:"items"`
+	Name               string    `json:"name"`
+	CookingProcess     string    `json:"cooking_process"`
+	FamilyID           uuid.UUID `json:"family_id"`
+	Items              []byte    `json:"items"`
+	PrepTimeMinutes    int32     `json:"prep_time_minutes"`
+	CookTimeMinutes    int32     `json:"cook_time_minutes"`
+	ActiveTimeMinutes  int32     `json:"active_time_minutes"`
+	Difficulty         string    `json:"difficulty"`
+	Cuisine            string    `json:"cuisine"`
+	Tags               []string  `json:"tags"`
+	CaloriesPerServing int32     `json:"calories_per_serving"`
+	ProteinPerServing  int32     `json:"protein_per_serving"`
+	CostPerServing     int32     `json:"cost_per_serving"`
+	Servings           int32     `json:"servings"`
 }
 
 func (q *Queries) CreateRecipe(ctx context.Context, arg CreateRecipeParams) (Recipe, error) {
@@ -35,6 +56,16 @@ func (q *Queries) CreateRecipe(ctx context.Context, arg CreateRecipeParams) (Rec
 		arg.CookingProcess,
 		arg.FamilyID,
 		arg.Items,
+		arg.PrepTimeMinutes,
+		arg.CookTimeMinutes,
+		arg.ActiveTimeMinutes,
+		arg.Difficulty,
+		arg.Cuisine,
+		arg.Tags,
+		arg.CaloriesPerServing,
+		arg.ProteinPerServing,
+		arg.CostPerServing,
+		arg.Servings,
 	)
 	var i Recipe
 	err := row.Scan(
@@ -45,6 +76,17 @@ func (q *Queries) CreateRecipe(ctx context.Context, arg CreateRecipeParams) (Rec
 		&i.CookingProcess,
 		&i.FamilyID,
 		&i.Items,
+		&i.PrepTimeMinutes,
+		&i.CookTimeMinutes,
+		&i.TotalTimeMinutes,
+		&i.ActiveTimeMinutes,
+		&i.Difficulty,
+		&i.Cuisine,
+		&i.Tags,
+		&i.CaloriesPerServing,
+		&i.ProteinPerServing,
+		&i.CostPerServing,
+		&i.Servings,
 	)
 	return i, err
 }
@@ -59,8 +101,80 @@ func (q *Queries) DeleteRecipe(ctx context.Context, id uuid.UUID) error {
 	return err
 }
 
+const filterRecipesByFamilyID = `-- name: FilterRecipesByFamilyID :many
+SELECT recipes.id, recipes.created_at, recipes.updated_at, recipes.name, recipes.cooking_process, recipes.family_id, recipes.items, recipes.prep_time_minutes, recipes.cook_time_minutes, recipes.total_time_minutes, recipes.active_time_minutes, recipes.difficulty, recipes.cuisine, recipes.tags, recipes.calories_per_serving, recipes.protein_per_serving, recipes.cost_per_serving, recipes.servings FROM recipes
+WHERE family_id = $1
+    AND ($2::int IS NULL OR total_time_minutes <= $2)
+    AND ($3::varchar IS NULL OR difficulty = $3)
+    AND (NOT $4::boolean OR NOT EXISTS (
+        SELECT 1 FROM recipe_equipment
+        LEFT JOIN family_equipment ON family_equipment.equipment_id = recipe_equipment.equipment_id
+            AND family_equipment.family_id = recipes.family_id
+        WHERE recipe_equipment.recipe_id = recipes.id AND family_equipment.equipment_id IS NULL
+    ))
+ORDER BY
+    CASE WHEN $5::text = 'total_time' THEN total_time_minutes END,
+    CASE WHEN $5::text = 'prep_time' THEN prep_time_minutes END,
+    CASE WHEN $5::text = 'cook_time' THEN cook_time_minutes END,
+    CASE WHEN $5::text = 'active_time' THEN active_time_minutes END,
+    name
+`
+
+type FilterRecipesByFamilyIDParams struct {
+	FamilyID     uuid.UUID   `json:"family_id"`
+	MaxTotalTime pgtype.Int4 `json:"max_total_time"`
+	Difficulty   pgtype.Text `json:"difficulty"`
+	OnlyPossible bool        `json:"only_possible"`
+	SortBy       string      `json:"sort_by"`
+}
+
+func (q *Queries) FilterRecipesByFamilyID(ctx context.Context, arg FilterRecipesByFamilyIDParams) ([]Recipe, error) {
+	rows, err := q.db.Query(ctx, filterRecipesByFamilyID,
+		arg.FamilyID,
+		arg.MaxTotalTime,
+		arg.Difficulty,
+		arg.OnlyPossible,
+		arg.SortBy,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Recipe
+	for rows.Next() {
+		var i Recipe
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Name,
+			&i.CookingProcess,
+			&i.FamilyID,
+			&i.Items,
+			&i.PrepTimeMinutes,
+			&i.CookTimeMinutes,
+			&i.TotalTimeMinutes,
+			&i.ActiveTimeMinutes,
+			&i.Difficulty,
+			&i.Cuisine,
+			&i.Tags,
+			&i.CaloriesPerServing,
+			&i.ProteinPerServing,
+			&i.CostPerServing,
+			&i.Servings,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getRecipeByID = `-- name: GetRecipeByID :one
-SELECT id, created_at, updated_at, name, cooking_process, family_id, items FROM recipes
+SELECT id, created_at, updated_at, name, cooking_process, family_id, items, prep_time_minutes, cook_time_minutes, total_time_minutes, active_time_minutes, difficulty, cuisine, tags, calories_per_serving, protein_per_serving, cost_per_serving, servings FROM recipes
 WHERE id = $1
 `
 
@@ -75,12 +189,23 @@ func (q *Queries) GetRecipeByID(ctx context.Context, id uuid.UUID) (Recipe, erro
 		&i.CookingProcess,
 		&i.FamilyID,
 		&i.Items,
+		&i.PrepTimeMinutes,
+		&i.CookTimeMinutes,
+		&i.TotalTimeMinutes,
+		&i.ActiveTimeMinutes,
+		&i.Difficulty,
+		&i.Cuisine,
+		&i.Tags,
+		&i.CaloriesPerServing,
+		&i.ProteinPerServing,
+		&i.CostPerServing,
+		&i.Servings,
 	)
 	return i, err
 }
 
 const getRecipes = `-- name: GetRecipes :many
-SELECT id, created_at, updated_at, name, cooking_process, family_id, items FROM recipes
+SELECT id, created_at, updated_at, name, cooking_process, family_id, items, prep_time_minutes, cook_time_minutes, total_time_minutes, active_time_minutes, difficulty, cuisine, tags, calories_per_serving, protein_per_serving, cost_per_serving, servings FROM recipes
 `
 
 func (q *Queries) GetRecipes(ctx context.Context) ([]Recipe, error) {
@@ -100,6 +225,17 @@ func (q *Queries) GetRecipes(ctx context.Context) ([]Recipe, error) {
 			&i.CookingProcess,
 			&i.FamilyID,
 			&i.Items,
+			&i.PrepTimeMinutes,
+			&i.CookTimeMinutes,
+			&i.TotalTimeMinutes,
+			&i.ActiveTimeMinutes,
+			&i.Difficulty,
+			&i.Cuisine,
+			&i.Tags,
+			&i.CaloriesPerServing,
+			&i.ProteinPerServing,
+			&i.CostPerServing,
+			&i.Servings,
 		); err != nil {
 			return nil, err
 		}
@@ -112,7 +248,7 @@ func (q *Queries) GetRecipes(ctx context.Context) ([]Recipe, error) {
 }
 
 const getRecipesByFamilyID = `-- name: GetRecipesByFamilyID :many
-SELECT id, created_at, updated_at, name, cooking_process, family_id, items FROM recipes
+SELECT id, created_at, updated_at, name, cooking_process, family_id, items, prep_time_minutes, cook_time_minutes, total_time_minutes, active_time_minutes, difficulty, cuisine, tags, calories_per_serving, protein_per_serving, cost_per_serving, servings FROM recipes
 WHERE family_id = $1
 `
 
@@ -133,6 +269,17 @@ func (q *Queries) GetRecipesByFamilyID(ctx context.Context, familyID uuid.UUID)
 			&i.CookingProcess,
 			&i.FamilyID,
 			&i.Items,
+			&i.PrepTimeMinutes,
+			&i.CookTimeMinutes,
+			&i.TotalTimeMinutes,
+			&i.ActiveTimeMinutes,
+			&i.Difficulty,
+			&i.Cuisine,
+			&i.Tags,
+			&i.CaloriesPerServing,
+			&i.ProteinPerServing,
+			&i.CostPerServing,
+			&i.Servings,
 		); err != nil {
 			return nil, err
 		}
@@ -148,16 +295,36 @@ const updateRecipe = `-- name: UpdateRecipe :one
 UPDATE recipes SET
     name = $2,
     cooking_process = $3,
-    items = $4
+    items = $4,
+    prep_time_minutes = $5,
+    cook_time_minutes = $6,
+    active_time_minutes = $7,
+    difficulty = $8,
+    cuisine = $9,
+    tags = $10,
+    calories_per_serving = $11,
+    protein_per_serving = $12,
+    cost_per_serving = $13,
+    servings = $14
 WHERE id = $1
-RETURNING id, created_at, updated_at, name, cooking_process, family_id, items
+RETURNING id, created_at, updated_at, name, cooking_process, family_id, items, prep_time_minutes, cook_time_minutes, total_time_minutes, active_time_minutes, difficulty, cuisine, tags, calories_per_serving, protein_per_serving, cost_per_serving, servings
 `
 
 type UpdateRecipeParams struct {
-	ID             uuid.UUID `json:"id"`
-	Name           string    `json:"name"`
-	CookingProcess string    `json:"cooking_process"`
-	Items          []byte    `json:"items"`
+	ID                 uuid.UUID `json:"id"`
+	Name               string    `json:"name"`
+	CookingProcess     string    `json:"cooking_process"`
+	Items              []byte    `json:"items"`
+	PrepTimeMinutes    int32     `json:"prep_time_minutes"`
+	CookTimeMinutes    int32     `json:"cook_time_minutes"`
+	ActiveTimeMinutes  int32     `json:"active_time_minutes"`
+	Difficulty         string    `json:"difficulty"`
+	Cuisine            string    `json:"cuisine"`
+	Tags               []string  `json:"tags"`
+	CaloriesPerServing int32     `json:"calories_per_serving"`
+	ProteinPerServing  int32     `json:"protein_per_serving"`
+	CostPerServing     int32     `json:"cost_per_serving"`
+	Servings           int32     `json:"servings"`
 }
 
 func (q *Queries) UpdateRecipe(ctx context.Context, arg UpdateRecipeParams) (Recipe, error) {
@@ -166,6 +333,16 @@ func (q *Queries) UpdateRecipe(ctx context.Context, arg UpdateRecipeParams) (Rec
 		arg.Name,
 		arg.CookingProcess,
 		arg.Items,
+		arg.PrepTimeMinutes,
+		arg.CookTimeMinutes,
+		arg.ActiveTimeMinutes,
+		arg.Difficulty,
+		arg.Cuisine,
+		arg.Tags,
+		arg.CaloriesPerServing,
+		arg.ProteinPerServing,
+		arg.CostPerServing,
+		arg.Servings,
 	)
 	var i Recipe
 	err := row.Scan(
@@ -176,6 +353,17 @@ func (q *Queries) UpdateRecipe(ctx context.Context, arg UpdateRecipeParams) (Rec
 		&i.CookingProcess,
 		&i.FamilyID,
 		&i.Items,
+		&i.PrepTimeMinutes,
+		&i.CookTimeMinutes,
+		&i.TotalTimeMinutes,
+		&i.ActiveTimeMinutes,
+		&i.Difficulty,
+		&i.Cuisine,
+		&i.Tags,
+		&i.CaloriesPerServing,
+		&i.ProteinPerServing,
+		&i.CostPerServing,
+		&i.Servings,
 	)
 	return i, err
 }
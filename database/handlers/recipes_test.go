@@ -10,6 +10,7 @@ import (
 	"github.com/andreiz53/cookinator/util"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/require"
 )
 
@@ -32,18 +33,38 @@ func RandomRecipeItems() []RecipeItem {
 	return items
 }
 
+// RandomDifficulty generates a random recipe difficulty
+func RandomDifficulty() string {
+	difficulties := []string{"easy", "medium", "hard"}
+	return difficulties[util.RandomInt(0, len(difficulties)-1)]
+}
+
 func createRandomRecipe(t *testing.T) Recipe {
 	family := createRandomFamily(t)
+	return createRandomFamilyRecipe(t, family.ID)
+}
+
+func createRandomFamilyRecipe(t *testing.T, familyID uuid.UUID) Recipe {
 	recipeItems := RandomRecipeItems()
 	recipeItemsData, err := json.Marshal(recipeItems)
 	if err != nil {
 		log.Fatal("could not stringify json recipe items:", err)
 	}
 	arg := CreateRecipeParams{
-		Name:           util.RandomName(),
-		CookingProcess: util.RandomString(128),
-		FamilyID:       family.ID,
-		Items:          recipeItemsData,
+		Name:               util.RandomName(),
+		CookingProcess:     util.RandomString(128),
+		FamilyID:           familyID,
+		Items:              recipeItemsData,
+		PrepTimeMinutes:    int32(util.RandomInt(0, 30)),
+		CookTimeMinutes:    int32(util.RandomInt(0, 90)),
+		ActiveTimeMinutes:  int32(util.RandomInt(0, 30)),
+		Difficulty:         RandomDifficulty(),
+		Cuisine:            util.RandomString(8),
+		Tags:               []string{util.RandomString(6)},
+		CaloriesPerServing: int32(util.RandomInt(100, 900)),
+		ProteinPerServing:  int32(util.RandomInt(0, 60)),
+		CostPerServing:     int32(util.RandomInt(50, 1500)),
+		Servings:           int32(util.RandomInt(1, 8)),
 	}
 
 	recipe, err := testQueries.CreateRecipe(context.Background(), arg)
@@ -53,6 +74,16 @@ func createRandomRecipe(t *testing.T) Recipe {
 	require.Equal(t, arg.Name, recipe.Name)
 	require.Equal(t, arg.CookingProcess, recipe.CookingProcess)
 	require.Equal(t, arg.FamilyID, recipe.FamilyID)
+	require.Equal(t, arg.PrepTimeMinutes, recipe.PrepTimeMinutes)
+	require.Equal(t, arg.CookTimeMinutes, recipe.CookTimeMinutes)
+	require.Equal(t, arg.PrepTimeMinutes+arg.CookTimeMinutes, recipe.TotalTimeMinutes)
+	require.Equal(t, arg.ActiveTimeMinutes, recipe.ActiveTimeMinutes)
+	require.Equal(t, arg.Difficulty, recipe.Difficulty)
+	require.Equal(t, arg.Cuisine, recipe.Cuisine)
+	require.Equal(t, arg.Tags, recipe.Tags)
+	require.Equal(t, arg.CaloriesPerServing, recipe.CaloriesPerServing)
+	require.Equal(t, arg.CostPerServing, recipe.CostPerServing)
+	require.Equal(t, arg.Servings, recipe.Servings)
 	require.NotZero(t, recipe.ID)
 
 	checkRecipeItems(t, recipeItemsData, recipe.Items)
@@ -116,6 +147,37 @@ func TestGetRecipesByFamilyID(t *testing.T) {
 	require.True(t, len(recipes) >= 1)
 }
 
+func TestFilterRecipesByFamilyID(t *testing.T) {
+	family := createRandomFamily(t)
+	for i := 0; i < 5; i++ {
+		createRandomFamilyRecipe(t, family.ID)
+	}
+
+	recipes, err := testQueries.FilterRecipesByFamilyID(context.Background(), FilterRecipesByFamilyIDParams{
+		FamilyID: family.ID,
+		SortBy:   "total_time",
+	})
+	require.NoError(t, err)
+	require.Len(t, recipes, 5)
+	for i := 1; i < len(recipes); i++ {
+		require.LessOrEqual(t, recipes[i-1].TotalTimeMinutes, recipes[i].TotalTimeMinutes)
+	}
+
+	maxTotalTime := recipes[2].TotalTimeMinutes
+	difficulty := recipes[0].Difficulty
+	filtered, err := testQueries.FilterRecipesByFamilyID(context.Background(), FilterRecipesByFamilyIDParams{
+		FamilyID:     family.ID,
+		MaxTotalTime: pgtype.Int4{Int32: maxTotalTime, Valid: true},
+		Difficulty:   pgtype.Text{String: difficulty, Valid: true},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, filtered)
+	for _, recipe := range filtered {
+		require.LessOrEqual(t, recipe.TotalTimeMinutes, maxTotalTime)
+		require.Equal(t, difficulty, recipe.Difficulty)
+	}
+}
+
 func TestUpdateRecipe(t *testing.T) {
 	recipe := createRandomRecipe(t)
 	recipeItems := RandomRecipeItems()
@@ -125,10 +187,17 @@ func TestUpdateRecipe(t *testing.T) {
 	}
 
 	arg := UpdateRecipeParams{
-		ID:             recipe.ID,
-		Name:           util.RandomName(),
-		CookingProcess: util.RandomString(128),
-		Items:          recipeItemsData,
+		ID:                recipe.ID,
+		Name:              util.RandomName(),
+		CookingProcess:    util.RandomString(128),
+		Items:             recipeItemsData,
+		PrepTimeMinutes:   int32(util.RandomInt(0, 30)),
+		CookTimeMinutes:   int32(util.RandomInt(0, 90)),
+		ActiveTimeMinutes: int32(util.RandomInt(0, 30)),
+		Difficulty:        RandomDifficulty(),
+		Cuisine:           recipe.Cuisine,
+		Tags:              []string{},
+		Servings:          recipe.Servings,
 	}
 
 	recipe2, err := testQueries.UpdateRecipe(context.Background(), arg)
@@ -139,6 +208,9 @@ func TestUpdateRecipe(t *testing.T) {
 	require.Equal(t, arg.Name, recipe2.Name)
 	require.Equal(t, arg.CookingProcess, recipe2.CookingProcess)
 	require.Equal(t, recipe.FamilyID, recipe2.FamilyID)
+	require.Equal(t, arg.PrepTimeMinutes+arg.CookTimeMinutes, recipe2.TotalTimeMinutes)
+	require.Equal(t, arg.Difficulty, recipe2.Difficulty)
+	require.Empty(t, recipe2.Tags)
 
 	require.WithinDuration(t, recipe.CreatedAt.Time, recipe2.CreatedAt.Time, time.Second)
 
@@ -0,0 +1,172 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/andreiz53/cookinator/util"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomShoppingList(t *testing.T) ShoppingList {
+	plan := createRandomMealPlan(t)
+
+	arg := CreateShoppingListParams{
+		FamilyID:   plan.FamilyID,
+		MealPlanID: plan.ID,
+	}
+
+	list, err := testQueries.CreateShoppingList(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, list)
+
+	require.Equal(t, arg.FamilyID, list.FamilyID)
+	require.Equal(t, arg.MealPlanID, list.MealPlanID)
+
+	require.NotZero(t, list.ID)
+	require.NotZero(t, list.CreatedAt)
+
+	return list
+}
+
+func createRandomShoppingListItem(t *testing.T, list ShoppingList) ShoppingListItem {
+	ingredient := createRandomIngredient(t)
+
+	arg := CreateShoppingListItemParams{
+		ShoppingListID: list.ID,
+		IngredientID:   pgtype.Int4{Int32: ingredient.ID, Valid: true},
+		Name:           ingredient.Name,
+		Quantity:       util.RandomFloat(1, 500),
+		Unit:           RandomMeasureUnit(),
+		Category:       ingredient.Category,
+		Packages:       []byte("[]"),
+		Sources:        []byte("[]"),
+	}
+
+	item, err := testQueries.CreateShoppingListItem(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, item)
+
+	require.Equal(t, arg.ShoppingListID, item.ShoppingListID)
+	require.Equal(t, arg.IngredientID, item.IngredientID)
+	require.Equal(t, arg.Name, item.Name)
+	require.Equal(t, arg.Quantity, item.Quantity)
+	require.Equal(t, arg.Unit, item.Unit)
+	require.Equal(t, arg.Category, item.Category)
+	require.False(t, item.Manual)
+
+	return item
+}
+
+func TestCreateShoppingList(t *testing.T) {
+	createRandomShoppingList(t)
+}
+
+func TestGetShoppingListByMealPlanID(t *testing.T) {
+	list := createRandomShoppingList(t)
+
+	list2, err := testQueries.GetShoppingListByMealPlanID(context.Background(), list.MealPlanID)
+	require.NoError(t, err)
+	require.Equal(t, list.ID, list2.ID)
+}
+
+func TestUpdateShoppingListItem(t *testing.T) {
+	list := createRandomShoppingList(t)
+	item := createRandomShoppingListItem(t, list)
+
+	arg := UpdateShoppingListItemParams{
+		ID:       item.ID,
+		Name:     util.RandomName(),
+		Quantity: 2,
+		Unit:     "pc",
+		Category: "dairy",
+	}
+	updated, err := testQueries.UpdateShoppingListItem(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.Name, updated.Name)
+	require.Equal(t, arg.Category, updated.Category)
+	require.Equal(t, arg.Quantity, updated.Quantity)
+	require.Equal(t, arg.Unit, updated.Unit)
+	require.Equal(t, item.IngredientID, updated.IngredientID)
+	// a generated item changed by the family is edited
+	require.True(t, updated.Edited)
+
+	// and generating the list again doesn't change it
+	err = testQueries.UpdateGeneratedShoppingListItem(context.Background(), UpdateGeneratedShoppingListItemParams{
+		ID:       item.ID,
+		Quantity: 5,
+		Packages: []byte("[]"),
+		Sources:  []byte("[]"),
+	})
+	require.NoError(t, err)
+	updated, err = testQueries.GetShoppingListItemByID(context.Background(), item.ID)
+	require.NoError(t, err)
+	require.Equal(t, arg.Quantity, updated.Quantity)
+}
+
+func TestDismissShoppingListItem(t *testing.T) {
+	list := createRandomShoppingList(t)
+	item := createRandomShoppingListItem(t, list)
+
+	err := testQueries.DismissShoppingListItem(context.Background(), item.ID)
+	require.NoError(t, err)
+
+	items, err := testQueries.GetShoppingListItems(context.Background(), list.ID)
+	require.NoError(t, err)
+	require.Empty(t, items)
+
+	// the dismissed item is kept, so generating the list again doesn't add it back
+	items, err = testQueries.GetAllShoppingListItems(context.Background(), list.ID)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	require.True(t, items[0].Dismissed)
+
+	err = testQueries.DeleteGeneratedShoppingListItem(context.Background(), item.ID)
+	require.NoError(t, err)
+	_, err = testQueries.GetShoppingListItemByID(context.Background(), item.ID)
+	require.NoError(t, err)
+}
+
+func TestDeleteShoppingList(t *testing.T) {
+	list := createRandomShoppingList(t)
+	item := createRandomShoppingListItem(t, list)
+
+	err := testQueries.DeleteShoppingList(context.Background(), list.ID)
+	require.NoError(t, err)
+
+	_, err = testQueries.GetShoppingListByID(context.Background(), list.ID)
+	require.EqualError(t, err, pgx.ErrNoRows.Error())
+	_, err = testQueries.GetShoppingListItemByID(context.Background(), item.ID)
+	require.EqualError(t, err, pgx.ErrNoRows.Error())
+}
+
+func TestCheckShoppingListItem(t *testing.T) {
+	list := createRandomShoppingList(t)
+	item := createRandomShoppingListItem(t, list)
+	user := createRandomUser(t)
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	arg := CheckShoppingListItemParams{
+		ID:        item.ID,
+		Checked:   true,
+		CheckedAt: pgtype.Timestamp{Time: now, Valid: true},
+		CheckedBy: pgtype.UUID{Bytes: user.ID, Valid: true},
+	}
+	checked, err := testQueries.CheckShoppingListItem(context.Background(), arg)
+	require.NoError(t, err)
+	require.True(t, checked.Checked)
+	require.Equal(t, arg.CheckedBy, checked.CheckedBy)
+
+	// a change made before the last one is discarded
+	arg.Checked = false
+	arg.CheckedAt = pgtype.Timestamp{Time: now.Add(-time.Minute), Valid: true}
+	_, err = testQueries.CheckShoppingListItem(context.Background(), arg)
+	require.EqualError(t, err, pgx.ErrNoRows.Error())
+
+	item2, err := testQueries.GetShoppingListItemByID(context.Background(), item.ID)
+	require.NoError(t, err)
+	require.True(t, item2.Checked)
+}
@@ -0,0 +1,96 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/andreiz53/cookinator/util"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomStaple(t *testing.T, family Family, ingredient Ingredient) Staple {
+	arg := CreateStapleParams{
+		FamilyID:     family.ID,
+		IngredientID: ingredient.ID,
+		Quantity:     float64(util.RandomInt(1, 2000)),
+		Unit:         "g",
+		EveryWeeks:   int32(util.RandomInt(1, 4)),
+		FirstWeek:    util.WeekStart(time.Now()),
+	}
+
+	staple, err := testQueries.CreateStaple(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, staple)
+
+	require.Equal(t, arg.FamilyID, staple.FamilyID)
+	require.Equal(t, arg.IngredientID, staple.IngredientID)
+	require.Equal(t, arg.Quantity, staple.Quantity)
+	require.Equal(t, arg.Unit, staple.Unit)
+	require.Equal(t, arg.EveryWeeks, staple.EveryWeeks)
+	require.Equal(t, arg.FirstWeek.Time.Format(time.DateOnly), staple.FirstWeek.Time.Format(time.DateOnly))
+
+	require.NotZero(t, staple.ID)
+	require.NotZero(t, staple.CreatedAt)
+
+	return staple
+}
+
+func TestCreateStaple(t *testing.T) {
+	family := createRandomFamily(t)
+	ingredient := createRandomIngredient(t)
+	createRandomStaple(t, family, ingredient)
+
+	// an ingredient is a staple once per family
+	_, err := testQueries.CreateStaple(context.Background(), CreateStapleParams{
+		FamilyID:     family.ID,
+		IngredientID: ingredient.ID,
+		Quantity:     1,
+		Unit:         "pc",
+		EveryWeeks:   1,
+		FirstWeek:    util.WeekStart(time.Now()),
+	})
+	require.Equal(t, CodeDuplicateKey, ErrorCode(err))
+}
+
+func TestGetStaplesByFamilyID(t *testing.T) {
+	family := createRandomFamily(t)
+	for i := 0; i < 3; i++ {
+		createRandomStaple(t, family, createRandomIngredient(t))
+	}
+
+	staples, err := testQueries.GetStaplesByFamilyID(context.Background(), family.ID)
+	require.NoError(t, err)
+	require.Len(t, staples, 3)
+	for _, staple := range staples {
+		require.NotEmpty(t, staple.IngredientName)
+	}
+}
+
+func TestUpdateStaple(t *testing.T) {
+	staple := createRandomStaple(t, createRandomFamily(t), createRandomIngredient(t))
+
+	arg := UpdateStapleParams{
+		ID:         staple.ID,
+		Quantity:   staple.Quantity + 1,
+		Unit:       "pc",
+		EveryWeeks: 6,
+		FirstWeek:  staple.FirstWeek,
+	}
+	updated, err := testQueries.UpdateStaple(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.Quantity, updated.Quantity)
+	require.Equal(t, arg.Unit, updated.Unit)
+	require.Equal(t, arg.EveryWeeks, updated.EveryWeeks)
+}
+
+func TestDeleteStaple(t *testing.T) {
+	staple := createRandomStaple(t, createRandomFamily(t), createRandomIngredient(t))
+
+	err := testQueries.DeleteStaple(context.Background(), staple.ID)
+	require.NoError(t, err)
+
+	_, err = testQueries.GetStapleByID(context.Background(), staple.ID)
+	require.EqualError(t, err, pgx.ErrNoRows.Error())
+}
@@ -1,9 +1,30 @@
 package database
 
-import "github.com/jackc/pgx/v5"
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
 
 type Store interface {
 	Querier
+	MergeRecipesTx(ctx context.Context, arg MergeRecipesTxParams) (Recipe, error)
+	SetRecipeEquipmentTx(ctx context.Context, arg SetRecipeEquipmentTxParams) error
+	SetFamilyEquipmentTx(ctx context.Context, arg SetFamilyEquipmentTxParams) error
+	ReplaceMealPlanEntriesTx(ctx context.Context, arg ReplaceMealPlanEntriesTxParams) ([]MealPlanEntry, error)
+	BatchCookTx(ctx context.Context, arg BatchCookTxParams) (BatchCookTxResult, error)
+	CreateFamilyCalendarTx(ctx context.Context, arg CreateFamilyCalendarTxParams) (FamilyCalendar, error)
+	SyncFamilyCalendarTx(ctx context.Context, arg SyncFamilyCalendarTxParams) (FamilyCalendar, error)
+	CreateMealPlanTemplateTx(ctx context.Context, arg CreateMealPlanTemplateTxParams) (MealPlanTemplate, error)
+	SetMealPlanRotationTx(ctx context.Context, arg SetMealPlanRotationTxParams) (MealPlanRotation, error)
+	SetMealAttendanceTx(ctx context.Context, arg SetMealAttendanceTxParams) ([]MealPlanEntry, error)
+	SetMemberAttendanceTx(ctx context.Context, arg SetMemberAttendanceTxParams) (User, error)
+	FinalizeMealPlanTx(ctx context.Context, arg FinalizeMealPlanTxParams) (MealPlan, error)
+	GenerateShoppingListTx(ctx context.Context, arg GenerateShoppingListTxParams) (GenerateShoppingListTxResult, error)
+	ReorderCollectionRecipesTx(ctx context.Context, arg ReorderCollectionRecipesTxParams) error
 }
 
 type PostgresStore struct {
@@ -17,3 +38,598 @@ func NewStore(db *pgx.Conn) Store {
 		Queries: New(db),
 	}
 }
+
+// execTx executes a function within a database transaction
+func (store *PostgresStore) execTx(ctx context.Context, fn func(*Queries) error) error {
+	tx, err := store.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	q := New(tx)
+	err = fn(q)
+	if err != nil {
+		if rbErr := tx.Rollback(ctx); rbErr != nil {
+			return fmt.Errorf("tx err: %v, rb err: %v", err, rbErr)
+		}
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// MergeRecipesTxParams contains the input parameters of the merge recipes transaction
+type MergeRecipesTxParams struct {
+	TargetID uuid.UUID `json:"target_id"`
+	SourceID uuid.UUID `json:"source_id"`
+}
+
+// MergeRecipesTx moves everything referring to the source recipe to the target recipe and deletes the
+// source recipe: cook logs, favorites, collection entries, planned meals and their votes, template meals,
+// event menus and equipment
+func (store *PostgresStore) MergeRecipesTx(ctx context.Context, arg MergeRecipesTxParams) (Recipe, error) {
+	var result Recipe
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		err = q.MoveCookLogs(ctx, MoveCookLogsParams(arg))
+		if err != nil {
+			return err
+		}
+
+		err = q.MoveFavorites(ctx, MoveFavoritesParams(arg))
+		if err != nil {
+			return err
+		}
+
+		err = q.MoveCollectionRecipes(ctx, MoveCollectionRecipesParams(arg))
+		if err != nil {
+			return err
+		}
+
+		err = q.MoveMealPlanEntries(ctx, MoveMealPlanEntriesParams(arg))
+		if err != nil {
+			return err
+		}
+
+		err = q.MoveMealPlanVotes(ctx, MoveMealPlanVotesParams(arg))
+		if err != nil {
+			return err
+		}
+
+		err = q.MoveMealPlanTemplateEntries(ctx, MoveMealPlanTemplateEntriesParams(arg))
+		if err != nil {
+			return err
+		}
+
+		err = q.MoveEventRecipes(ctx, MoveEventRecipesParams(arg))
+		if err != nil {
+			return err
+		}
+
+		err = q.MoveRecipeEquipment(ctx, MoveRecipeEquipmentParams(arg))
+		if err != nil {
+			return err
+		}
+
+		err = q.DeleteRecipe(ctx, arg.SourceID)
+		if err != nil {
+			return err
+		}
+
+		result, err = q.GetRecipeByID(ctx, arg.TargetID)
+		return err
+	})
+
+	return result, err
+}
+
+// SetRecipeEquipmentTxParams contains the input parameters of the set recipe equipment transaction
+type SetRecipeEquipmentTxParams struct {
+	RecipeID  uuid.UUID                  `json:"recipe_id"`
+	Equipment []AddRecipeEquipmentParams `json:"equipment"`
+}
+
+// SetRecipeEquipmentTx replaces the equipment needed by a recipe
+func (store *PostgresStore) SetRecipeEquipmentTx(ctx context.Context, arg SetRecipeEquipmentTxParams) error {
+	return store.execTx(ctx, func(q *Queries) error {
+		err := q.DeleteRecipeEquipment(ctx, arg.RecipeID)
+		if err != nil {
+			return err
+		}
+
+		for _, equipment := range arg.Equipment {
+			equipment.RecipeID = arg.RecipeID
+			err = q.AddRecipeEquipment(ctx, equipment)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// SetFamilyEquipmentTxParams contains the input parameters of the set family equipment transaction
+type SetFamilyEquipmentTxParams struct {
+	FamilyID     uuid.UUID `json:"family_id"`
+	EquipmentIDs []int32   `json:"equipment_ids"`
+}
+
+// SetFamilyEquipmentTx replaces the equipment owned by a family
+func (store *PostgresStore) SetFamilyEquipmentTx(ctx context.Context, arg SetFamilyEquipmentTxParams) error {
+	return store.execTx(ctx, func(q *Queries) error {
+		err := q.DeleteFamilyEquipment(ctx, arg.FamilyID)
+		if err != nil {
+			return err
+		}
+
+		for _, equipmentID := range arg.EquipmentIDs {
+			err = q.AddFamilyEquipment(ctx, AddFamilyEquipmentParams{
+				FamilyID:    arg.FamilyID,
+				EquipmentID: equipmentID,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ReplaceMealPlanEntriesTxParams contains the input parameters of the replace meal plan entries transaction
+type ReplaceMealPlanEntriesTxParams struct {
+	MealPlanID uuid.UUID                   `json:"meal_plan_id"`
+	Entries    []CreateMealPlanEntryParams `json:"entries"`
+}
+
+// ReplaceMealPlanEntriesTx deletes the unlocked entries of a meal plan and creates the given ones instead.
+// An unlocked entry is kept while its leftovers are eaten at a locked meal or in another week, as deleting
+// it would delete them.
+func (store *PostgresStore) ReplaceMealPlanEntriesTx(ctx context.Context, arg ReplaceMealPlanEntriesTxParams) ([]MealPlanEntry, error) {
+	result := []MealPlanEntry{}
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		err := q.DeleteUnlockedMealPlanEntries(ctx, arg.MealPlanID)
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range arg.Entries {
+			entry.MealPlanID = arg.MealPlanID
+			created, err := q.CreateMealPlanEntry(ctx, entry)
+			if err != nil {
+				return err
+			}
+			result = append(result, created)
+		}
+
+		return q.TouchMealPlan(ctx, arg.MealPlanID)
+	})
+
+	return result, err
+}
+
+// BatchCookTxParams contains the input parameters of the batch cook transaction
+type BatchCookTxParams struct {
+	Entry     CreateMealPlanEntryParams   `json:"entry"`
+	Leftovers []CreateMealPlanEntryParams `json:"leftovers"`
+}
+
+// BatchCookTxResult is the result of the batch cook transaction
+type BatchCookTxResult struct {
+	Entry     MealPlanEntry   `json:"entry"`
+	Leftovers []MealPlanEntry `json:"leftovers"`
+}
+
+// BatchCookTx creates a meal plan entry cooking a batch and the entries eating its leftovers
+func (store *PostgresStore) BatchCookTx(ctx context.Context, arg BatchCookTxParams) (BatchCookTxResult, error) {
+	result := BatchCookTxResult{Leftovers: []MealPlanEntry{}}
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Entry, err = q.CreateMealPlanEntry(ctx, arg.Entry)
+		if err != nil {
+			return err
+		}
+
+		for _, leftover := range arg.Leftovers {
+			leftover.MealPlanID = result.Entry.MealPlanID
+			leftover.RecipeID = result.Entry.RecipeID
+			leftover.LeftoverOf = pgtype.UUID{Bytes: result.Entry.ID, Valid: true}
+			created, err := q.CreateMealPlanEntry(ctx, leftover)
+			if err != nil {
+				return err
+			}
+			result.Leftovers = append(result.Leftovers, created)
+		}
+
+		return q.TouchMealPlan(ctx, result.Entry.MealPlanID)
+	})
+
+	return result, err
+}
+
+// CreateFamilyCalendarTxParams contains the input parameters of the create family calendar transaction
+type CreateFamilyCalendarTxParams struct {
+	Calendar  CreateFamilyCalendarParams `json:"calendar"`
+	BusySlots []CreateBusySlotParams     `json:"busy_slots"`
+}
+
+// CreateFamilyCalendarTx creates an imported calendar with the slots its events keep busy
+func (store *PostgresStore) CreateFamilyCalendarTx(ctx context.Context, arg CreateFamilyCalendarTxParams) (FamilyCalendar, error) {
+	var result FamilyCalendar
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result, err = q.CreateFamilyCalendar(ctx, arg.Calendar)
+		if err != nil {
+			return err
+		}
+
+		return q.createBusySlots(ctx, result.ID, arg.BusySlots)
+	})
+
+	return result, err
+}
+
+// SyncFamilyCalendarTxParams contains the input parameters of the sync family calendar transaction
+type SyncFamilyCalendarTxParams struct {
+	CalendarID uuid.UUID              `json:"calendar_id"`
+	BusySlots  []CreateBusySlotParams `json:"busy_slots"`
+}
+
+// SyncFamilyCalendarTx replaces the busy slots of an imported calendar
+func (store *PostgresStore) SyncFamilyCalendarTx(ctx context.Context, arg SyncFamilyCalendarTxParams) (FamilyCalendar, error) {
+	var result FamilyCalendar
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		err := q.DeleteBusySlots(ctx, arg.CalendarID)
+		if err != nil {
+			return err
+		}
+
+		err = q.createBusySlots(ctx, arg.CalendarID, arg.BusySlots)
+		if err != nil {
+			return err
+		}
+
+		result, err = q.TouchFamilyCalendar(ctx, arg.CalendarID)
+		return err
+	})
+
+	return result, err
+}
+
+func (q *Queries) createBusySlots(ctx context.Context, calendarID uuid.UUID, slots []CreateBusySlotParams) error {
+	for _, slot := range slots {
+		slot.CalendarID = calendarID
+		err := q.CreateBusySlot(ctx, slot)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CreateMealPlanTemplateTxParams contains the input parameters of the create meal plan template transaction
+type CreateMealPlanTemplateTxParams struct {
+	Template CreateMealPlanTemplateParams        `json:"template"`
+	Entries  []CreateMealPlanTemplateEntryParams `json:"entries"`
+}
+
+// CreateMealPlanTemplateTx creates a meal plan template with its entries
+func (store *PostgresStore) CreateMealPlanTemplateTx(ctx context.Context, arg CreateMealPlanTemplateTxParams) (MealPlanTemplate, error) {
+	var result MealPlanTemplate
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result, err = q.CreateMealPlanTemplate(ctx, arg.Template)
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range arg.Entries {
+			entry.TemplateID = result.ID
+			_, err = q.CreateMealPlanTemplateEntry(ctx, entry)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	return result, err
+}
+
+// SetMealPlanRotationTxParams contains the input parameters of the set meal plan rotation transaction
+type SetMealPlanRotationTxParams struct {
+	FamilyID    uuid.UUID   `json:"family_id"`
+	StartWeek   pgtype.Date `json:"start_week"`
+	TemplateIDs []uuid.UUID `json:"template_ids"`
+}
+
+// SetMealPlanRotationTx replaces the templates a family rotates through, in order
+func (store *PostgresStore) SetMealPlanRotationTx(ctx context.Context, arg SetMealPlanRotationTxParams) (MealPlanRotation, error) {
+	var result MealPlanRotation
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result, err = q.UpsertMealPlanRotation(ctx, UpsertMealPlanRotationParams{
+			FamilyID:  arg.FamilyID,
+			StartWeek: arg.StartWeek,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = q.DeleteMealPlanRotationTemplates(ctx, arg.FamilyID)
+		if err != nil {
+			return err
+		}
+
+		for i, templateID := range arg.TemplateIDs {
+			err = q.AddMealPlanRotationTemplate(ctx, AddMealPlanRotationTemplateParams{
+				FamilyID:   arg.FamilyID,
+				Position:   int32(i),
+				TemplateID: templateID,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	return result, err
+}
+
+// SetMealAttendanceTxParams contains the input parameters of the set meal attendance transaction.
+// Servings are computed from the new attendance and set on the entries of the meal that follow it.
+type SetMealAttendanceTxParams struct {
+	Meal     UpsertMealGuestsParams       `json:"meal"`
+	Members  []CreateMealAttendanceParams `json:"members"`
+	Servings int32                        `json:"servings"`
+}
+
+// SetMealAttendanceTx replaces who eats a meal and updates the servings of its entries
+func (store *PostgresStore) SetMealAttendanceTx(ctx context.Context, arg SetMealAttendanceTxParams) ([]MealPlanEntry, error) {
+	var result []MealPlanEntry
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		err = q.DeleteMealAttendance(ctx, DeleteMealAttendanceParams{
+			MealPlanID: arg.Meal.MealPlanID,
+			Day:        arg.Meal.Day,
+			Slot:       arg.Meal.Slot,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, member := range arg.Members {
+			member.MealPlanID = arg.Meal.MealPlanID
+			member.Day = arg.Meal.Day
+			member.Slot = arg.Meal.Slot
+			err = q.CreateMealAttendance(ctx, member)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = q.UpsertMealGuests(ctx, arg.Meal)
+		if err != nil {
+			return err
+		}
+
+		result, err = q.UpdateAutoMealPlanEntryServings(ctx, UpdateAutoMealPlanEntryServingsParams{
+			Servings:   arg.Servings,
+			MealPlanID: arg.Meal.MealPlanID,
+			Day:        arg.Meal.Day,
+			Slot:       arg.Meal.Slot,
+		})
+		if err != nil {
+			return err
+		}
+		if len(result) > 0 {
+			return q.TouchMealPlan(ctx, arg.Meal.MealPlanID)
+		}
+		return nil
+	})
+
+	return result, err
+}
+
+// SetMemberAttendanceTxParams contains the input parameters of the set member attendance transaction.
+// Servings are the meals whose servings change with the member's new portion and usual absences.
+type SetMemberAttendanceTxParams struct {
+	UserID        uuid.UUID                               `json:"user_id"`
+	PortionFactor float64                                 `json:"portion_factor"`
+	Absences      []CreateMemberMealAbsenceParams         `json:"absences"`
+	Servings      []UpdateAutoMealPlanEntryServingsParams `json:"servings"`
+}
+
+// SetMemberAttendanceTx sets the portion and usual absences of a member and updates the servings of the meals they change
+func (store *PostgresStore) SetMemberAttendanceTx(ctx context.Context, arg SetMemberAttendanceTxParams) (User, error) {
+	var result User
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result, err = q.UpdateUserPortionFactor(ctx, UpdateUserPortionFactorParams{
+			ID:            arg.UserID,
+			PortionFactor: arg.PortionFactor,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = q.DeleteMemberMealAbsences(ctx, arg.UserID)
+		if err != nil {
+			return err
+		}
+
+		for _, absence := range arg.Absences {
+			absence.UserID = arg.UserID
+			err = q.CreateMemberMealAbsence(ctx, absence)
+			if err != nil {
+				return err
+			}
+		}
+
+		touched := map[uuid.UUID]bool{}
+		for _, servings := range arg.Servings {
+			entries, err := q.UpdateAutoMealPlanEntryServings(ctx, servings)
+			if err != nil {
+				return err
+			}
+			if len(entries) > 0 && !touched[servings.MealPlanID] {
+				touched[servings.MealPlanID] = true
+				err = q.TouchMealPlan(ctx, servings.MealPlanID)
+				if err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+
+	return result, err
+}
+
+// FinalizeMealPlanTxParams contains the input parameters of the finalize meal plan transaction
+type FinalizeMealPlanTxParams struct {
+	MealPlanID   uuid.UUID                   `json:"meal_plan_id"`
+	Vetoed       []uuid.UUID                 `json:"vetoed"`
+	Replacements []CreateMealPlanEntryParams `json:"replacements"`
+}
+
+// FinalizeMealPlanTx swaps the vetoed entries of a plan for their replacements, locks every entry
+// and marks the plan as final
+func (store *PostgresStore) FinalizeMealPlanTx(ctx context.Context, arg FinalizeMealPlanTxParams) (MealPlan, error) {
+	var result MealPlan
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		for _, id := range arg.Vetoed {
+			err = q.DeleteMealPlanEntry(ctx, id)
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, entry := range arg.Replacements {
+			entry.MealPlanID = arg.MealPlanID
+			_, err = q.CreateMealPlanEntry(ctx, entry)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = q.LockMealPlanEntries(ctx, arg.MealPlanID)
+		if err != nil {
+			return err
+		}
+
+		result, err = q.FinalizeMealPlan(ctx, arg.MealPlanID)
+		return err
+	})
+
+	return result, err
+}
+
+// GenerateShoppingListTxParams contains the input parameters of the generate shopping list transaction.
+// Create, Update and Delete are the changes to the generated items of a list generated before.
+type GenerateShoppingListTxParams struct {
+	FamilyID   uuid.UUID                               `json:"family_id"`
+	MealPlanID uuid.UUID                               `json:"meal_plan_id"`
+	Create     []CreateShoppingListItemParams          `json:"create"`
+	Update     []UpdateGeneratedShoppingListItemParams `json:"update"`
+	Delete     []uuid.UUID                             `json:"delete"`
+}
+
+// GenerateShoppingListTxResult is the result of the generate shopping list transaction
+type GenerateShoppingListTxResult struct {
+	List  ShoppingList       `json:"list"`
+	Items []ShoppingListItem `json:"items"`
+}
+
+// GenerateShoppingListTx creates the shopping list of a meal plan, or changes the generated items of the list
+// generated before. Items added by hand, checked, edited or dismissed are never changed, and all the items of
+// the list but the dismissed ones are returned.
+func (store *PostgresStore) GenerateShoppingListTx(ctx context.Context, arg GenerateShoppingListTxParams) (GenerateShoppingListTxResult, error) {
+	var result GenerateShoppingListTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.List, err = q.GetShoppingListByMealPlanID(ctx, arg.MealPlanID)
+		switch err {
+		case nil:
+			err = q.TouchShoppingList(ctx, result.List.ID)
+		case pgx.ErrNoRows:
+			result.List, err = q.CreateShoppingList(ctx, CreateShoppingListParams{
+				FamilyID:   arg.FamilyID,
+				MealPlanID: arg.MealPlanID,
+			})
+		}
+		if err != nil {
+			return err
+		}
+
+		for _, id := range arg.Delete {
+			err = q.DeleteGeneratedShoppingListItem(ctx, id)
+			if err != nil {
+				return err
+			}
+		}
+		for _, item := range arg.Update {
+			err = q.UpdateGeneratedShoppingListItem(ctx, item)
+			if err != nil {
+				return err
+			}
+		}
+		for _, item := range arg.Create {
+			item.ShoppingListID = result.List.ID
+			_, err = q.CreateShoppingListItem(ctx, item)
+			if err != nil {
+				return err
+			}
+		}
+
+		result.Items, err = q.GetShoppingListItems(ctx, result.List.ID)
+		return err
+	})
+
+	return result, err
+}
+
+// ReorderCollectionRecipesTxParams contains the input parameters of the reorder collection recipes transaction
+type ReorderCollectionRecipesTxParams struct {
+	CollectionID uuid.UUID   `json:"collection_id"`
+	RecipeIDs    []uuid.UUID `json:"recipe_ids"`
+}
+
+// ReorderCollectionRecipesTx stores the position of every recipe of a collection in the order given,
+// so a failure halfway leaves the previous order
+func (store *PostgresStore) ReorderCollectionRecipesTx(ctx context.Context, arg ReorderCollectionRecipesTxParams) error {
+	return store.execTx(ctx, func(q *Queries) error {
+		for position, recipeID := range arg.RecipeIDs {
+			err := q.UpdateCollectionRecipePosition(ctx, UpdateCollectionRecipePositionParams{
+				CollectionID: arg.CollectionID,
+				RecipeID:     recipeID,
+				Position:     int32(position),
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,79 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/andreiz53/cookinator/util"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomStoreLayout(t *testing.T, family Family) StoreLayout {
+	arg := CreateStoreLayoutParams{
+		FamilyID:   family.ID,
+		Name:       util.RandomName(),
+		Categories: []string{"produce", "bakery", "dairy"},
+	}
+
+	layout, err := testQueries.CreateStoreLayout(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, layout)
+
+	require.Equal(t, arg.FamilyID, layout.FamilyID)
+	require.Equal(t, arg.Name, layout.Name)
+	require.Equal(t, arg.Categories, layout.Categories)
+
+	require.NotZero(t, layout.ID)
+	require.NotZero(t, layout.CreatedAt)
+
+	return layout
+}
+
+func TestCreateStoreLayout(t *testing.T) {
+	family := createRandomFamily(t)
+	layout := createRandomStoreLayout(t, family)
+
+	// a store is named once per family
+	_, err := testQueries.CreateStoreLayout(context.Background(), CreateStoreLayoutParams{
+		FamilyID:   family.ID,
+		Name:       layout.Name,
+		Categories: []string{"frozen"},
+	})
+	require.Equal(t, CodeDuplicateKey, ErrorCode(err))
+}
+
+func TestGetStoreLayoutsByFamilyID(t *testing.T) {
+	family := createRandomFamily(t)
+	for i := 0; i < 3; i++ {
+		createRandomStoreLayout(t, family)
+	}
+
+	layouts, err := testQueries.GetStoreLayoutsByFamilyID(context.Background(), family.ID)
+	require.NoError(t, err)
+	require.Len(t, layouts, 3)
+}
+
+func TestUpdateStoreLayout(t *testing.T) {
+	layout := createRandomStoreLayout(t, createRandomFamily(t))
+
+	arg := UpdateStoreLayoutParams{
+		ID:         layout.ID,
+		Name:       util.RandomName(),
+		Categories: []string{"frozen", "produce"},
+	}
+	updated, err := testQueries.UpdateStoreLayout(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.Name, updated.Name)
+	require.Equal(t, arg.Categories, updated.Categories)
+}
+
+func TestDeleteStoreLayout(t *testing.T) {
+	layout := createRandomStoreLayout(t, createRandomFamily(t))
+
+	err := testQueries.DeleteStoreLayout(context.Background(), layout.ID)
+	require.NoError(t, err)
+
+	_, err = testQueries.GetStoreLayoutByID(context.Background(), layout.ID)
+	require.EqualError(t, err, pgx.ErrNoRows.Error())
+}
@@ -0,0 +1,552 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/andreiz53/cookinator/util"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeRecipesTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	target := createRandomRecipe(t)
+	source := createRandomFamilyRecipe(t, target.FamilyID)
+
+	log := createRandomCookLog(t, source, time.Now())
+
+	user := createRandomUser(t)
+	err := testQueries.AddFavorite(context.Background(), AddFavoriteParams{UserID: user.ID, RecipeID: source.ID})
+	require.NoError(t, err)
+	err = testQueries.AddFavorite(context.Background(), AddFavoriteParams{UserID: user.ID, RecipeID: target.ID})
+	require.NoError(t, err)
+
+	collection := createRandomCollection(t, target.FamilyID, false)
+	err = testQueries.AddRecipeToCollection(context.Background(), AddRecipeToCollectionParams{
+		CollectionID: collection.ID,
+		RecipeID:     source.ID,
+	})
+	require.NoError(t, err)
+
+	// planned meals, their votes, template meals, event menus and equipment follow the recipe
+	plan := createRandomMealPlan(t)
+	entry, err := testQueries.CreateMealPlanEntry(context.Background(), CreateMealPlanEntryParams{
+		MealPlanID: plan.ID,
+		Day:        plan.WeekStart,
+		Slot:       "dinner",
+		RecipeID:   source.ID,
+		Servings:   4,
+	})
+	require.NoError(t, err)
+	_, err = testQueries.UpsertMealPlanVote(context.Background(), UpsertMealPlanVoteParams{
+		EntryID:  entry.ID,
+		UserID:   user.ID,
+		RecipeID: source.ID,
+		Vote:     "up",
+	})
+	require.NoError(t, err)
+
+	template, err := testQueries.CreateMealPlanTemplate(context.Background(), CreateMealPlanTemplateParams{
+		FamilyID: plan.FamilyID,
+		Name:     util.RandomName(),
+	})
+	require.NoError(t, err)
+	templateEntry, err := testQueries.CreateMealPlanTemplateEntry(context.Background(), CreateMealPlanTemplateEntryParams{
+		TemplateID: template.ID,
+		Weekday:    2,
+		Slot:       "dinner",
+		RecipeID:   source.ID,
+		Servings:   4,
+	})
+	require.NoError(t, err)
+
+	// the event has both recipes on its menu, which keeps the target once
+	event := createRandomEvent(t, plan.FamilyID)
+	err = testQueries.AddEventRecipe(context.Background(), AddEventRecipeParams{EventID: event.ID, RecipeID: source.ID})
+	require.NoError(t, err)
+	err = testQueries.AddEventRecipe(context.Background(), AddEventRecipeParams{EventID: event.ID, RecipeID: target.ID})
+	require.NoError(t, err)
+
+	equipment := createRandomEquipment(t)
+	err = testQueries.AddRecipeEquipment(context.Background(), AddRecipeEquipmentParams{
+		RecipeID:    source.ID,
+		EquipmentID: equipment.ID,
+		Heavy:       true,
+	})
+	require.NoError(t, err)
+
+	merged, err := store.MergeRecipesTx(context.Background(), MergeRecipesTxParams{
+		TargetID: target.ID,
+		SourceID: source.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, target.ID, merged.ID)
+	require.Equal(t, target.Name, merged.Name)
+
+	_, err = testQueries.GetRecipeByID(context.Background(), source.ID)
+	require.EqualError(t, err, pgx.ErrNoRows.Error())
+
+	logs, err := testQueries.GetCookLogsByRecipeID(context.Background(), target.ID)
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+	require.Equal(t, log.ID, logs[0].ID)
+
+	favorited, err := testQueries.IsRecipeFavorited(context.Background(), IsRecipeFavoritedParams{UserID: user.ID, RecipeID: target.ID})
+	require.NoError(t, err)
+	require.True(t, favorited)
+
+	recipes, err := testQueries.GetCollectionRecipes(context.Background(), collection.ID)
+	require.NoError(t, err)
+	require.Len(t, recipes, 1)
+	require.Equal(t, target.ID, recipes[0].ID)
+
+	movedEntry, err := testQueries.GetMealPlanEntryByID(context.Background(), entry.ID)
+	require.NoError(t, err)
+	require.Equal(t, target.ID, movedEntry.RecipeID)
+
+	votes, err := testQueries.GetMealPlanVotes(context.Background(), plan.ID)
+	require.NoError(t, err)
+	require.Len(t, votes, 1)
+	require.Equal(t, target.ID, votes[0].RecipeID)
+
+	templateEntries, err := testQueries.GetMealPlanTemplateEntries(context.Background(), template.ID)
+	require.NoError(t, err)
+	require.Len(t, templateEntries, 1)
+	require.Equal(t, templateEntry.ID, templateEntries[0].ID)
+	require.Equal(t, target.ID, templateEntries[0].RecipeID)
+
+	menu, err := testQueries.GetEventRecipes(context.Background(), event.ID)
+	require.NoError(t, err)
+	require.Len(t, menu, 1)
+	require.Equal(t, target.ID, menu[0].ID)
+
+	recipeEquipment, err := testQueries.GetRecipeEquipment(context.Background(), target.ID)
+	require.NoError(t, err)
+	require.Len(t, recipeEquipment, 1)
+	require.Equal(t, equipment.ID, recipeEquipment[0].EquipmentID)
+	require.True(t, recipeEquipment[0].Heavy)
+}
+
+func TestReplaceMealPlanEntriesTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	plan := createRandomMealPlan(t)
+	unlocked := createRandomMealPlanEntry(t, plan, 0, "dinner")
+	locked, err := testQueries.UpdateMealPlanEntry(context.Background(), UpdateMealPlanEntryParams{
+		ID:       createRandomMealPlanEntry(t, plan, 1, "dinner").ID,
+		Day:      util.NewDate(plan.WeekStart.Time.AddDate(0, 0, 1)),
+		Slot:     "dinner",
+		RecipeID: unlocked.RecipeID,
+		Servings: 2,
+		Locked:   true,
+	})
+	require.NoError(t, err)
+
+	recipe := createRandomFamilyRecipe(t, plan.FamilyID)
+	created, err := store.ReplaceMealPlanEntriesTx(context.Background(), ReplaceMealPlanEntriesTxParams{
+		MealPlanID: plan.ID,
+		Entries: []CreateMealPlanEntryParams{
+			{
+				Day:      unlocked.Day,
+				Slot:     "dinner",
+				RecipeID: recipe.ID,
+				Servings: 4,
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, created, 1)
+	require.Equal(t, plan.ID, created[0].MealPlanID)
+
+	entries, err := testQueries.GetMealPlanEntries(context.Background(), plan.ID)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, created[0].ID, entries[0].ID)
+	require.Equal(t, locked.ID, entries[1].ID)
+}
+
+func TestBatchCookTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	plan := createRandomMealPlan(t)
+	recipe := createRandomFamilyRecipe(t, plan.FamilyID)
+
+	result, err := store.BatchCookTx(context.Background(), BatchCookTxParams{
+		Entry: CreateMealPlanEntryParams{
+			MealPlanID:    plan.ID,
+			Day:           plan.WeekStart,
+			Slot:          "dinner",
+			RecipeID:      recipe.ID,
+			Servings:      4,
+			BatchServings: pgtype.Int4{Int32: 10, Valid: true},
+		},
+		Leftovers: []CreateMealPlanEntryParams{
+			{Day: util.NewDate(plan.WeekStart.Time.AddDate(0, 0, 1)), Slot: "lunch", Servings: 2},
+			{Day: util.NewDate(plan.WeekStart.Time.AddDate(0, 0, 2)), Slot: "dinner", Servings: 4},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(10), result.Entry.BatchServings.Int32)
+	require.Len(t, result.Leftovers, 2)
+	for _, leftover := range result.Leftovers {
+		require.Equal(t, plan.ID, leftover.MealPlanID)
+		require.Equal(t, recipe.ID, leftover.RecipeID)
+		require.Equal(t, result.Entry.ID, uuid.UUID(leftover.LeftoverOf.Bytes))
+	}
+
+	eaten, err := testQueries.GetLeftoverServingsEaten(context.Background(), GetLeftoverServingsEatenParams{
+		EntryID:   result.Entry.ID,
+		ExcludeID: result.Leftovers[0].ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(4), eaten)
+
+	entries, err := testQueries.GetMealPlanEntries(context.Background(), plan.ID)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	require.Equal(t, result.Entry.ID, entries[0].ID)
+	require.Equal(t, int32(6), entries[0].LeftoverServingsEaten)
+
+	// deleting the cooked entry deletes its leftovers
+	err = testQueries.DeleteMealPlanEntry(context.Background(), result.Entry.ID)
+	require.NoError(t, err)
+	entries, err = testQueries.GetMealPlanEntries(context.Background(), plan.ID)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+func TestFamilyCalendarTx(t *testing.T) {
+	store := NewStore(testDB)
+	family := createRandomFamily(t)
+	day := util.NewDate(time.Now())
+
+	calendar, err := store.CreateFamilyCalendarTx(context.Background(), CreateFamilyCalendarTxParams{
+		Calendar: CreateFamilyCalendarParams{
+			FamilyID: family.ID,
+			Name:     util.RandomName(),
+			Timezone: "UTC",
+		},
+		BusySlots: []CreateBusySlotParams{
+			{Day: day, Slot: "lunch", Away: true, Summary: "Trip"},
+			{Day: day, Slot: "dinner", Summary: "Football"},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, family.ID, calendar.FamilyID)
+
+	arg := GetBusySlotsByFamilyIDParams{
+		FamilyID: family.ID,
+		FromDay:  day,
+		ToDay:    util.NewDate(day.Time.AddDate(0, 0, 1)),
+	}
+	slots, err := testQueries.GetBusySlotsByFamilyID(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, slots, 2)
+	require.Equal(t, "lunch", slots[0].Slot)
+	require.True(t, slots[0].Away)
+
+	synced, err := store.SyncFamilyCalendarTx(context.Background(), SyncFamilyCalendarTxParams{
+		CalendarID: calendar.ID,
+		BusySlots:  []CreateBusySlotParams{{Day: day, Slot: "breakfast"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, calendar.ID, synced.ID)
+
+	slots, err = testQueries.GetBusySlotsByFamilyID(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, slots, 1)
+	require.Equal(t, "breakfast", slots[0].Slot)
+
+	// deleting the calendar deletes its busy slots
+	err = testQueries.DeleteFamilyCalendar(context.Background(), calendar.ID)
+	require.NoError(t, err)
+	slots, err = testQueries.GetBusySlotsByFamilyID(context.Background(), arg)
+	require.NoError(t, err)
+	require.Empty(t, slots)
+}
+
+func TestMealPlanTemplateTx(t *testing.T) {
+	store := NewStore(testDB)
+	family := createRandomFamily(t)
+	recipe := createRandomFamilyRecipe(t, family.ID)
+
+	templates := []MealPlanTemplate{}
+	for i := 0; i < 2; i++ {
+		template, err := store.CreateMealPlanTemplateTx(context.Background(), CreateMealPlanTemplateTxParams{
+			Template: CreateMealPlanTemplateParams{
+				FamilyID: family.ID,
+				Name:     util.RandomName(),
+			},
+			Entries: []CreateMealPlanTemplateEntryParams{
+				{Weekday: 4, Slot: "dinner", RecipeID: recipe.ID, Servings: 4},
+				{Weekday: 0, Slot: "lunch", RecipeID: recipe.ID, Servings: 2},
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, family.ID, template.FamilyID)
+		templates = append(templates, template)
+	}
+
+	entries, err := testQueries.GetMealPlanTemplateEntries(context.Background(), templates[0].ID)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, int32(0), entries[0].Weekday)
+	require.Equal(t, recipe.Name, entries[0].RecipeName)
+
+	rotation, err := store.SetMealPlanRotationTx(context.Background(), SetMealPlanRotationTxParams{
+		FamilyID:    family.ID,
+		StartWeek:   util.WeekStart(time.Now()),
+		TemplateIDs: []uuid.UUID{templates[1].ID, templates[0].ID},
+	})
+	require.NoError(t, err)
+	require.Equal(t, family.ID, rotation.FamilyID)
+
+	rotated, err := testQueries.GetMealPlanRotationTemplates(context.Background(), family.ID)
+	require.NoError(t, err)
+	require.Len(t, rotated, 2)
+	require.Equal(t, templates[1].ID, rotated[0].ID)
+
+	// deleting a template takes it out of the rotation
+	err = testQueries.DeleteMealPlanTemplate(context.Background(), templates[1].ID)
+	require.NoError(t, err)
+	rotated, err = testQueries.GetMealPlanRotationTemplates(context.Background(), family.ID)
+	require.NoError(t, err)
+	require.Len(t, rotated, 1)
+	require.Equal(t, templates[0].ID, rotated[0].ID)
+}
+
+func TestMealAttendanceTx(t *testing.T) {
+	store := NewStore(testDB)
+	plan := createRandomMealPlan(t)
+	user := createRandomUser(t)
+	auto := createRandomMealPlanEntry(t, plan, 2, "dinner")
+	fixed := createRandomMealPlanEntry(t, plan, 2, "dinner")
+
+	auto, err := testQueries.UpdateMealPlanEntry(context.Background(), UpdateMealPlanEntryParams{
+		ID:           auto.ID,
+		Day:          auto.Day,
+		Slot:         auto.Slot,
+		RecipeID:     auto.RecipeID,
+		Servings:     auto.Servings,
+		AutoServings: true,
+	})
+	require.NoError(t, err)
+
+	updated, err := store.SetMealAttendanceTx(context.Background(), SetMealAttendanceTxParams{
+		Meal: UpsertMealGuestsParams{
+			MealPlanID: plan.ID,
+			Day:        auto.Day,
+			Slot:       auto.Slot,
+			Guests:     2,
+		},
+		Members:  []CreateMealAttendanceParams{{UserID: user.ID, Attending: false}},
+		Servings: 7,
+	})
+	require.NoError(t, err)
+	require.Len(t, updated, 1)
+	require.Equal(t, auto.ID, updated[0].ID)
+	require.Equal(t, int32(7), updated[0].Servings)
+	require.Equal(t, auto.Sequence+1, updated[0].Sequence)
+
+	entry, err := testQueries.GetMealPlanEntryByID(context.Background(), fixed.ID)
+	require.NoError(t, err)
+	require.Equal(t, fixed.Servings, entry.Servings)
+
+	week := GetMealAttendanceByFamilyIDParams{
+		FamilyID: plan.FamilyID,
+		FromDay:  plan.WeekStart,
+		ToDay:    util.NewDate(plan.WeekStart.Time.AddDate(0, 0, 7)),
+	}
+	attendance, err := testQueries.GetMealAttendanceByFamilyID(context.Background(), week)
+	require.NoError(t, err)
+	require.Len(t, attendance, 1)
+	require.False(t, attendance[0].Attending)
+
+	guests, err := testQueries.GetMealGuestsByFamilyID(context.Background(), GetMealGuestsByFamilyIDParams(week))
+	require.NoError(t, err)
+	require.Len(t, guests, 1)
+	require.Equal(t, int32(2), guests[0].Guests)
+
+	member, err := store.SetMemberAttendanceTx(context.Background(), SetMemberAttendanceTxParams{
+		UserID:        user.ID,
+		PortionFactor: 0.5,
+		Absences:      []CreateMemberMealAbsenceParams{{Weekday: 2, Slot: "dinner"}},
+		Servings: []UpdateAutoMealPlanEntryServingsParams{
+			{Servings: 3, MealPlanID: plan.ID, Day: auto.Day, Slot: auto.Slot},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0.5, member.PortionFactor)
+
+	entry, err = testQueries.GetMealPlanEntryByID(context.Background(), auto.ID)
+	require.NoError(t, err)
+	require.Equal(t, int32(3), entry.Servings)
+}
+
+func TestFinalizeMealPlanTx(t *testing.T) {
+	store := NewStore(testDB)
+	plan := createRandomMealPlan(t)
+	user := createRandomUser(t)
+	vetoed := createRandomMealPlanEntry(t, plan, 0, "dinner")
+	kept := createRandomMealPlanEntry(t, plan, 1, "dinner")
+
+	proposed, err := testQueries.UpdateMealPlanStatus(context.Background(), UpdateMealPlanStatusParams{
+		ID:     plan.ID,
+		Status: "proposed",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "proposed", proposed.Status)
+
+	vote, err := testQueries.UpsertMealPlanVote(context.Background(), UpsertMealPlanVoteParams{
+		EntryID:  vetoed.ID,
+		UserID:   user.ID,
+		RecipeID: vetoed.RecipeID,
+		Vote:     "veto",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "veto", vote.Vote)
+
+	votes, err := testQueries.GetMealPlanVotes(context.Background(), plan.ID)
+	require.NoError(t, err)
+	require.Len(t, votes, 1)
+
+	final, err := store.FinalizeMealPlanTx(context.Background(), FinalizeMealPlanTxParams{
+		MealPlanID: plan.ID,
+		Vetoed:     []uuid.UUID{vetoed.ID},
+		Replacements: []CreateMealPlanEntryParams{
+			{Day: vetoed.Day, Slot: vetoed.Slot, RecipeID: kept.RecipeID, Servings: vetoed.Servings},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "final", final.Status)
+	require.True(t, final.FinalizedAt.Valid)
+
+	entries, err := testQueries.GetMealPlanEntries(context.Background(), plan.ID)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	for _, entry := range entries {
+		require.NotEqual(t, vetoed.ID, entry.ID)
+		require.True(t, entry.Locked)
+	}
+
+	// the votes go with the vetoed entry
+	votes, err = testQueries.GetMealPlanVotes(context.Background(), plan.ID)
+	require.NoError(t, err)
+	require.Empty(t, votes)
+}
+
+func TestGenerateShoppingListTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	plan := createRandomMealPlan(t)
+	ingredient := createRandomIngredient(t)
+	other := createRandomIngredient(t)
+	item := CreateShoppingListItemParams{
+		IngredientID: pgtype.Int4{Int32: ingredient.ID, Valid: true},
+		Name:         ingredient.Name,
+		Quantity:     250,
+		Unit:         "g",
+		Category:     ingredient.Category,
+		Packages:     []byte(`[{"count":1}]`),
+		Surplus:      750,
+		Sources:      []byte(`[{"kind":"staple"}]`),
+	}
+	otherItem := item
+	otherItem.IngredientID = pgtype.Int4{Int32: other.ID, Valid: true}
+	otherItem.Name = other.Name
+
+	first, err := store.GenerateShoppingListTx(context.Background(), GenerateShoppingListTxParams{
+		FamilyID:   plan.FamilyID,
+		MealPlanID: plan.ID,
+		Create:     []CreateShoppingListItemParams{item, otherItem},
+	})
+	require.NoError(t, err)
+	require.Len(t, first.Items, 2)
+
+	manual, err := testQueries.CreateShoppingListItem(context.Background(), CreateShoppingListItemParams{
+		ShoppingListID: first.List.ID,
+		Name:           util.RandomName(),
+		Quantity:       1,
+		Unit:           "pc",
+		Category:       "other",
+		Packages:       []byte("[]"),
+		Sources:        []byte("[]"),
+		Manual:         true,
+	})
+	require.NoError(t, err)
+	checked, err := testQueries.CheckShoppingListItem(context.Background(), CheckShoppingListItemParams{
+		ID:        first.Items[1].ID,
+		Checked:   true,
+		CheckedAt: pgtype.Timestamp{Time: time.Now(), Valid: true},
+	})
+	require.NoError(t, err)
+	unchecked := first.Items[0]
+
+	// generating again keeps the list and only changes its unchecked generated items
+	second, err := store.GenerateShoppingListTx(context.Background(), GenerateShoppingListTxParams{
+		FamilyID:   plan.FamilyID,
+		MealPlanID: plan.ID,
+		Update: []UpdateGeneratedShoppingListItemParams{
+			{ID: unchecked.ID, Quantity: 500, Packages: []byte("[]"), Sources: []byte("[]")},
+			{ID: checked.ID, Quantity: 500, Packages: []byte("[]"), Sources: []byte("[]")},
+		},
+		Delete: []uuid.UUID{manual.ID, checked.ID},
+	})
+	require.NoError(t, err)
+	require.Equal(t, first.List.ID, second.List.ID)
+	require.Len(t, second.Items, 3)
+
+	items, err := testQueries.GetShoppingListItems(context.Background(), first.List.ID)
+	require.NoError(t, err)
+	byID := map[uuid.UUID]ShoppingListItem{}
+	for _, item := range items {
+		byID[item.ID] = item
+	}
+	require.Equal(t, 500.0, byID[unchecked.ID].Quantity)
+	require.JSONEq(t, "[]", string(byID[unchecked.ID].Sources))
+	require.Equal(t, checked.Quantity, byID[checked.ID].Quantity)
+	require.JSONEq(t, string(item.Packages), string(byID[checked.ID].Packages))
+	require.Contains(t, byID, manual.ID)
+
+	// an unchecked generated item is deleted
+	_, err = store.GenerateShoppingListTx(context.Background(), GenerateShoppingListTxParams{
+		FamilyID:   plan.FamilyID,
+		MealPlanID: plan.ID,
+		Delete:     []uuid.UUID{unchecked.ID},
+	})
+	require.NoError(t, err)
+	_, err = testQueries.GetShoppingListItemByID(context.Background(), unchecked.ID)
+	require.EqualError(t, err, pgx.ErrNoRows.Error())
+}
+
+func TestReorderCollectionRecipesTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	collection := createRandomCollection(t, uuid.Nil, false)
+	recipes := []Recipe{createRandomRecipe(t), createRandomRecipe(t), createRandomRecipe(t)}
+	for _, recipe := range recipes {
+		err := testQueries.AddRecipeToCollection(context.Background(), AddRecipeToCollectionParams{
+			CollectionID: collection.ID,
+			RecipeID:     recipe.ID,
+		})
+		require.NoError(t, err)
+	}
+
+	err := store.ReorderCollectionRecipesTx(context.Background(), ReorderCollectionRecipesTxParams{
+		CollectionID: collection.ID,
+		RecipeIDs:    []uuid.UUID{recipes[2].ID, recipes[0].ID, recipes[1].ID},
+	})
+	require.NoError(t, err)
+
+	reordered, err := testQueries.GetCollectionRecipes(context.Background(), collection.ID)
+	require.NoError(t, err)
+	require.Len(t, reordered, 3)
+	require.Equal(t, recipes[2].ID, reordered[0].ID)
+	require.Equal(t, recipes[0].ID, reordered[1].ID)
+	require.Equal(t, recipes[1].ID, reordered[2].ID)
+}
@@ -17,7 +17,7 @@ INSERT INTO users (
     email,
     password
 ) VALUES ( $1, $2, $3)
-RETURNING id, created_at, updated_at, first_name, email, password, family_id
+RETURNING id, created_at, updated_at, first_name, email, password, family_id, portion_factor, unit_system
 `
 
 type CreateUserParams struct {
@@ -37,6 +37,8 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 		&i.Email,
 		&i.Password,
 		&i.FamilyID,
+		&i.PortionFactor,
+		&i.UnitSystem,
 	)
 	return i, err
 }
@@ -52,7 +54,7 @@ func (q *Queries) DeleteUser(ctx context.Context, id uuid.UUID) error {
 }
 
 const getUserByEmail = `-- name: GetUserByEmail :one
-SELECT id, created_at, updated_at, first_name, email, password, family_id FROM users
+SELECT id, created_at, updated_at, first_name, email, password, family_id, portion_factor, unit_system FROM users
 WHERE email = $1
 `
 
@@ -67,12 +69,14 @@ func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error
 		&i.Email,
 		&i.Password,
 		&i.FamilyID,
+		&i.PortionFactor,
+		&i.UnitSystem,
 	)
 	return i, err
 }
 
 const getUserByID = `-- name: GetUserByID :one
-SELECT id, created_at, updated_at, first_name, email, password, family_id FROM users
+SELECT id, created_at, updated_at, first_name, email, password, family_id, portion_factor, unit_system FROM users
 WHERE id = $1
 `
 
@@ -87,12 +91,14 @@ func (q *Queries) GetUserByID(ctx context.Context, id uuid.UUID) (User, error) {
 		&i.Email,
 		&i.Password,
 		&i.FamilyID,
+		&i.PortionFactor,
+		&i.UnitSystem,
 	)
 	return i, err
 }
 
 const getUsers = `-- name: GetUsers :many
-SELECT id, created_at, updated_at, first_name, email, password, family_id FROM users
+SELECT id, created_at, updated_at, first_name, email, password, family_id, portion_factor, unit_system FROM users
 `
 
 func (q *Queries) GetUsers(ctx context.Context) ([]User, error) {
@@ -112,6 +118,44 @@ func (q *Queries) GetUsers(ctx context.Context) ([]User, error) {
 			&i.Email,
 			&i.Password,
 			&i.FamilyID,
+			&i.PortionFactor,
+			&i.UnitSystem,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUsersByFamilyID = `-- name: GetUsersByFamilyID :many
+SELECT id, created_at, updated_at, first_name, email, password, family_id, portion_factor, unit_system FROM users
+WHERE family_id = $1
+ORDER BY created_at
+`
+
+func (q *Queries) GetUsersByFamilyID(ctx context.Context, familyID uuid.UUID) ([]User, error) {
+	rows, err := q.db.Query(ctx, getUsersByFamilyID, familyID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []User
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.FirstName,
+			&i.Email,
+			&i.Password,
+			&i.FamilyID,
+			&i.PortionFactor,
+			&i.UnitSystem,
 		); err != nil {
 			return nil, err
 		}
@@ -128,7 +172,7 @@ UPDATE users SET
     updated_at = NOW(),
     email = $2
 WHERE id = $1
-RETURNING id, created_at, updated_at, first_name, email, password, family_id
+RETURNING id, created_at, updated_at, first_name, email, password, family_id, portion_factor, unit_system
 `
 
 type UpdateUserEmailParams struct {
@@ -147,6 +191,8 @@ func (q *Queries) UpdateUserEmail(ctx context.Context, arg UpdateUserEmailParams
 		&i.Email,
 		&i.Password,
 		&i.FamilyID,
+		&i.PortionFactor,
+		&i.UnitSystem,
 	)
 	return i, err
 }
@@ -155,7 +201,7 @@ const updateUserInfo = `-- name: UpdateUserInfo :one
 UPDATE users SET
     first_name = $2
 WHERE id = $1
-RETURNING id, created_at, updated_at, first_name, email, password, family_id
+RETURNING id, created_at, updated_at, first_name, email, password, family_id, portion_factor, unit_system
 `
 
 type UpdateUserInfoParams struct {
@@ -174,6 +220,8 @@ func (q *Queries) UpdateUserInfo(ctx context.Context, arg UpdateUserInfoParams)
 		&i.Email,
 		&i.Password,
 		&i.FamilyID,
+		&i.PortionFactor,
+		&i.UnitSystem,
 	)
 	return i, err
 }
@@ -182,7 +230,7 @@ const updateUserPassword = `-- name: UpdateUserPassword :one
 UPDATE users SET
     password = $2
 WHERE id = $1
-RETURNING id, created_at, updated_at, first_name, email, password, family_id
+RETURNING id, created_at, updated_at, first_name, email, password, family_id, portion_factor, unit_system
 `
 
 type UpdateUserPasswordParams struct {
@@ -201,6 +249,67 @@ func (q *Queries) UpdateUserPassword(ctx context.Context, arg UpdateUserPassword
 		&i.Email,
 		&i.Password,
 		&i.FamilyID,
+		&i.PortionFactor,
+		&i.UnitSystem,
+	)
+	return i, err
+}
+
+const updateUserPortionFactor = `-- name: UpdateUserPortionFactor :one
+UPDATE users SET
+    portion_factor = $2
+WHERE id = $1
+RETURNING id, created_at, updated_at, first_name, email, password, family_id, portion_factor, unit_system
+`
+
+type UpdateUserPortionFactorParams struct {
+	ID            uuid.UUID `json:"id"`
+	PortionFactor float64   `json:"portion_factor"`
+}
+
+func (q *Queries) UpdateUserPortionFactor(ctx context.Context, arg UpdateUserPortionFactorParams) (User, error) {
+	row := q.db.QueryRow(ctx, updateUserPortionFactor, arg.ID, arg.PortionFactor)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.FirstName,
+		&i.Email,
+		&i.Password,
+		&i.FamilyID,
+		&i.PortionFactor,
+		&i.UnitSystem,
+	)
+	return i, err
+}
+
+const updateUserUnitSystem = `-- name: UpdateUserUnitSystem :one
+UPDATE users SET
+    updated_at = NOW(),
+    unit_system = $2
+WHERE id = $1
+RETURNING id, created_at, updated_at, first_name, email, password, family_id, portion_factor, unit_system
+`
+
+type UpdateUserUnitSystemParams struct {
+	ID         uuid.UUID `json:"id"`
+	UnitSystem string    `json:"unit_system"`
+}
+
+func (q *Queries) UpdateUserUnitSystem(ctx context.Context, arg UpdateUserUnitSystemParams) (User, error) {
+	row := q.db.QueryRow(ctx, updateUserUnitSystem, arg.ID, arg.UnitSystem)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.FirstName,
+		&i.Email,
+		&i.Password,
+		&i.FamilyID,
+		&i.PortionFactor,
+		&i.UnitSystem,
 	)
 	return i, err
 }
@@ -24,6 +24,7 @@ func createRandomUser(t *testing.T) User {
 	require.Equal(t, arg.FirstName, user.FirstName)
 	require.Equal(t, arg.Email, user.Email)
 	require.Equal(t, arg.Password, user.Password)
+	require.Equal(t, 1.0, user.PortionFactor)
 
 	require.NotZero(t, user.ID)
 	require.NotZero(t, user.CreatedAt)
@@ -120,6 +121,19 @@ func TestUpdateUserInfo(t *testing.T) {
 	require.Equal(t, arg.FirstName, newUser.FirstName)
 }
 
+func TestUpdateUserUnitSystem(t *testing.T) {
+	user := createRandomUser(t)
+	require.Equal(t, "metric", user.UnitSystem)
+
+	newUser, err := testQueries.UpdateUserUnitSystem(context.Background(), UpdateUserUnitSystemParams{
+		ID:         user.ID,
+		UnitSystem: "imperial",
+	})
+	require.NoError(t, err)
+	require.Equal(t, user.ID, newUser.ID)
+	require.Equal(t, "imperial", newUser.UnitSystem)
+}
+
 func TestUpdateUserPassword(t *testing.T) {
 	user := createRandomUser(t)
 
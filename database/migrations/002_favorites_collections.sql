@@ -0,0 +1,38 @@
+-- +goose Up
+CREATE TABLE favorites (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    recipe_id UUID NOT NULL REFERENCES recipes(id) ON DELETE CASCADE,
+    created_at TIMESTAMP DEFAULT NOW(),
+    PRIMARY KEY (user_id, recipe_id)
+);
+
+CREATE TABLE collections (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    name VARCHAR(255) NOT NULL,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    family_id UUID REFERENCES families(id) ON DELETE SET NULL,
+    shared BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+CREATE TABLE collection_recipes (
+    collection_id UUID NOT NULL REFERENCES collections(id) ON DELETE CASCADE,
+    recipe_id UUID NOT NULL REFERENCES recipes(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT NOW(),
+    PRIMARY KEY (collection_id, recipe_id)
+);
+
+CREATE INDEX idx_favorites_recipe_id ON favorites(recipe_id);
+
+CREATE INDEX idx_collections_user_id ON collections(user_id);
+CREATE INDEX idx_collections_family_id ON collections(family_id);
+
+CREATE INDEX idx_collection_recipes_recipe_id ON collection_recipes(recipe_id);
+
+
+-- +goose Down
+DROP TABLE IF EXISTS collection_recipes;
+DROP TABLE IF EXISTS collections;
+DROP TABLE IF EXISTS favorites;
@@ -0,0 +1,19 @@
+-- +goose Up
+CREATE TABLE cook_logs (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at TIMESTAMP DEFAULT NOW(),
+    recipe_id UUID NOT NULL REFERENCES recipes(id) ON DELETE CASCADE,
+    family_id UUID NOT NULL REFERENCES families(id) ON DELETE CASCADE,
+    cooked_by_user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    cooked_on DATE NOT NULL DEFAULT CURRENT_DATE,
+    servings INTEGER NOT NULL CHECK (servings > 0),
+    notes TEXT NOT NULL DEFAULT '',
+    rating SMALLINT CHECK (rating BETWEEN 1 AND 5)
+);
+
+CREATE INDEX idx_cook_logs_recipe_id ON cook_logs(recipe_id);
+CREATE INDEX idx_cook_logs_family_id_cooked_on ON cook_logs(family_id, cooked_on);
+
+
+-- +goose Down
+DROP TABLE IF EXISTS cook_logs;
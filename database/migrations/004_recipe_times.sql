@@ -0,0 +1,20 @@
+-- +goose Up
+ALTER TABLE recipes
+    ADD COLUMN prep_time_minutes INTEGER NOT NULL DEFAULT 0 CHECK (prep_time_minutes >= 0),
+    ADD COLUMN cook_time_minutes INTEGER NOT NULL DEFAULT 0 CHECK (cook_time_minutes >= 0),
+    ADD COLUMN total_time_minutes INTEGER NOT NULL GENERATED ALWAYS AS (prep_time_minutes + cook_time_minutes) STORED,
+    ADD COLUMN active_time_minutes INTEGER NOT NULL DEFAULT 0 CHECK (active_time_minutes >= 0),
+    ADD COLUMN difficulty VARCHAR(16) NOT NULL DEFAULT 'medium' CHECK (difficulty IN ('easy', 'medium', 'hard'));
+
+CREATE INDEX idx_recipes_family_id_total_time ON recipes(family_id, total_time_minutes);
+
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_recipes_family_id_total_time;
+
+ALTER TABLE recipes
+    DROP COLUMN IF EXISTS difficulty,
+    DROP COLUMN IF EXISTS active_time_minutes,
+    DROP COLUMN IF EXISTS total_time_minutes,
+    DROP COLUMN IF EXISTS cook_time_minutes,
+    DROP COLUMN IF EXISTS prep_time_minutes;
@@ -0,0 +1,42 @@
+-- +goose Up
+CREATE TABLE equipment (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(255) UNIQUE NOT NULL
+);
+
+-- heavy marks equipment the recipe keeps busy for most of its cooking, like an oven while baking
+CREATE TABLE recipe_equipment (
+    recipe_id UUID NOT NULL REFERENCES recipes(id) ON DELETE CASCADE,
+    equipment_id INTEGER NOT NULL REFERENCES equipment(id) ON DELETE CASCADE,
+    heavy BOOLEAN NOT NULL DEFAULT FALSE,
+    PRIMARY KEY (recipe_id, equipment_id)
+);
+
+CREATE TABLE family_equipment (
+    family_id UUID NOT NULL REFERENCES families(id) ON DELETE CASCADE,
+    equipment_id INTEGER NOT NULL REFERENCES equipment(id) ON DELETE CASCADE,
+    created_at TIMESTAMP DEFAULT NOW(),
+    PRIMARY KEY (family_id, equipment_id)
+);
+
+CREATE INDEX idx_recipe_equipment_equipment_id ON recipe_equipment(equipment_id);
+CREATE INDEX idx_family_equipment_equipment_id ON family_equipment(equipment_id);
+
+INSERT INTO equipment (name) VALUES
+    ('oven'),
+    ('stovetop'),
+    ('microwave'),
+    ('stand mixer'),
+    ('hand mixer'),
+    ('slow cooker'),
+    ('pressure cooker'),
+    ('blender'),
+    ('food processor'),
+    ('air fryer'),
+    ('grill');
+
+
+-- +goose Down
+DROP TABLE IF EXISTS family_equipment;
+DROP TABLE IF EXISTS recipe_equipment;
+DROP TABLE IF EXISTS equipment;
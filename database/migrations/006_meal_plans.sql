@@ -0,0 +1,29 @@
+-- +goose Up
+CREATE TABLE meal_plans (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    family_id UUID NOT NULL REFERENCES families(id) ON DELETE CASCADE,
+    week_start DATE NOT NULL,
+    UNIQUE (family_id, week_start)
+);
+
+CREATE TABLE meal_plan_entries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at TIMESTAMP DEFAULT NOW(),
+    meal_plan_id UUID NOT NULL REFERENCES meal_plans(id) ON DELETE CASCADE,
+    day DATE NOT NULL,
+    slot VARCHAR(16) NOT NULL CHECK (slot IN ('breakfast', 'lunch', 'dinner', 'snack')),
+    recipe_id UUID NOT NULL REFERENCES recipes(id) ON DELETE CASCADE,
+    servings INTEGER NOT NULL CHECK (servings > 0),
+    notes TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_meal_plans_family_id_week_start ON meal_plans(family_id, week_start);
+CREATE INDEX idx_meal_plan_entries_meal_plan_id_day ON meal_plan_entries(meal_plan_id, day);
+CREATE INDEX idx_meal_plan_entries_recipe_id ON meal_plan_entries(recipe_id);
+
+
+-- +goose Down
+DROP TABLE IF EXISTS meal_plan_entries;
+DROP TABLE IF EXISTS meal_plans;
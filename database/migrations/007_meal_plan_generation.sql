@@ -0,0 +1,20 @@
+-- +goose Up
+ALTER TABLE recipes
+    ADD COLUMN cuisine VARCHAR(64) NOT NULL DEFAULT '',
+    ADD COLUMN tags TEXT[] NOT NULL DEFAULT '{}';
+
+ALTER TABLE meal_plan_entries
+    ADD COLUMN locked BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX idx_recipes_tags ON recipes USING GIN (tags);
+
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_recipes_tags;
+
+ALTER TABLE meal_plan_entries
+    DROP COLUMN IF EXISTS locked;
+
+ALTER TABLE recipes
+    DROP COLUMN IF EXISTS tags,
+    DROP COLUMN IF EXISTS cuisine;
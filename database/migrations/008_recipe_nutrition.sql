@@ -0,0 +1,12 @@
+-- +goose Up
+ALTER TABLE recipes
+    ADD COLUMN calories_per_serving INTEGER NOT NULL DEFAULT 0 CHECK (calories_per_serving >= 0),
+    ADD COLUMN protein_per_serving INTEGER NOT NULL DEFAULT 0 CHECK (protein_per_serving >= 0),
+    ADD COLUMN cost_per_serving INTEGER NOT NULL DEFAULT 0 CHECK (cost_per_serving >= 0);
+
+
+-- +goose Down
+ALTER TABLE recipes
+    DROP COLUMN IF EXISTS cost_per_serving,
+    DROP COLUMN IF EXISTS protein_per_serving,
+    DROP COLUMN IF EXISTS calories_per_serving;
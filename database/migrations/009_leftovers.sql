@@ -0,0 +1,14 @@
+-- +goose Up
+ALTER TABLE meal_plan_entries
+    ADD COLUMN leftover_of UUID REFERENCES meal_plan_entries(id) ON DELETE CASCADE,
+    ADD COLUMN batch_servings INTEGER CHECK (batch_servings > 0);
+
+CREATE INDEX idx_meal_plan_entries_leftover_of ON meal_plan_entries(leftover_of);
+
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_meal_plan_entries_leftover_of;
+
+ALTER TABLE meal_plan_entries
+    DROP COLUMN IF EXISTS batch_servings,
+    DROP COLUMN IF EXISTS leftover_of;
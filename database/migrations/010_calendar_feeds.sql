@@ -0,0 +1,18 @@
+-- +goose Up
+CREATE TABLE calendar_feeds (
+    family_id UUID PRIMARY KEY REFERENCES families(id) ON DELETE CASCADE,
+    created_at TIMESTAMP DEFAULT NOW(),
+    token TEXT UNIQUE NOT NULL
+);
+
+ALTER TABLE meal_plan_entries
+    ADD COLUMN updated_at TIMESTAMP DEFAULT NOW(),
+    ADD COLUMN sequence INTEGER NOT NULL DEFAULT 0;
+
+
+-- +goose Down
+ALTER TABLE meal_plan_entries
+    DROP COLUMN IF EXISTS sequence,
+    DROP COLUMN IF EXISTS updated_at;
+
+DROP TABLE IF EXISTS calendar_feeds;
@@ -0,0 +1,27 @@
+-- +goose Up
+CREATE TABLE family_calendars (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at TIMESTAMP DEFAULT NOW(),
+    synced_at TIMESTAMP DEFAULT NOW(),
+    family_id UUID NOT NULL REFERENCES families(id) ON DELETE CASCADE,
+    name VARCHAR(128) NOT NULL,
+    url TEXT NOT NULL DEFAULT '',
+    timezone VARCHAR(64) NOT NULL DEFAULT 'UTC'
+);
+
+CREATE TABLE busy_slots (
+    calendar_id UUID NOT NULL REFERENCES family_calendars(id) ON DELETE CASCADE,
+    day DATE NOT NULL,
+    slot VARCHAR(16) NOT NULL CHECK (slot IN ('breakfast', 'lunch', 'dinner', 'snack')),
+    away BOOLEAN NOT NULL DEFAULT FALSE,
+    summary TEXT NOT NULL DEFAULT '',
+    PRIMARY KEY (calendar_id, day, slot)
+);
+
+CREATE INDEX idx_family_calendars_family_id ON family_calendars(family_id);
+CREATE INDEX idx_busy_slots_day ON busy_slots(day);
+
+
+-- +goose Down
+DROP TABLE IF EXISTS busy_slots;
+DROP TABLE IF EXISTS family_calendars;
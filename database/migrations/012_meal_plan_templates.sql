@@ -0,0 +1,40 @@
+-- +goose Up
+CREATE TABLE meal_plan_templates (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at TIMESTAMP DEFAULT NOW(),
+    family_id UUID NOT NULL REFERENCES families(id) ON DELETE CASCADE,
+    name VARCHAR(128) NOT NULL,
+    UNIQUE (family_id, name)
+);
+
+CREATE TABLE meal_plan_template_entries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    template_id UUID NOT NULL REFERENCES meal_plan_templates(id) ON DELETE CASCADE,
+    weekday INTEGER NOT NULL CHECK (weekday BETWEEN 0 AND 6),
+    slot VARCHAR(16) NOT NULL CHECK (slot IN ('breakfast', 'lunch', 'dinner', 'snack')),
+    recipe_id UUID NOT NULL REFERENCES recipes(id) ON DELETE CASCADE,
+    servings INTEGER NOT NULL CHECK (servings > 0),
+    notes TEXT NOT NULL DEFAULT ''
+);
+
+CREATE TABLE meal_plan_rotations (
+    family_id UUID PRIMARY KEY REFERENCES families(id) ON DELETE CASCADE,
+    created_at TIMESTAMP DEFAULT NOW(),
+    start_week DATE NOT NULL
+);
+
+CREATE TABLE meal_plan_rotation_templates (
+    family_id UUID NOT NULL REFERENCES meal_plan_rotations(family_id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    template_id UUID NOT NULL REFERENCES meal_plan_templates(id) ON DELETE CASCADE,
+    PRIMARY KEY (family_id, position)
+);
+
+CREATE INDEX idx_meal_plan_template_entries_template_id ON meal_plan_template_entries(template_id);
+
+
+-- +goose Down
+DROP TABLE IF EXISTS meal_plan_rotation_templates;
+DROP TABLE IF EXISTS meal_plan_rotations;
+DROP TABLE IF EXISTS meal_plan_template_entries;
+DROP TABLE IF EXISTS meal_plan_templates;
@@ -0,0 +1,44 @@
+-- +goose Up
+ALTER TABLE users
+    ADD COLUMN portion_factor DOUBLE PRECISION NOT NULL DEFAULT 1 CHECK (portion_factor > 0 AND portion_factor <= 4);
+
+ALTER TABLE meal_plan_entries
+    ADD COLUMN auto_servings BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- meals a member usually skips, weekday 0 is Monday
+CREATE TABLE member_meal_absences (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    weekday INTEGER NOT NULL CHECK (weekday BETWEEN 0 AND 6),
+    slot VARCHAR(16) NOT NULL CHECK (slot IN ('breakfast', 'lunch', 'dinner', 'snack')),
+    PRIMARY KEY (user_id, weekday, slot)
+);
+
+-- who eats a meal of a plan when it differs from their usual pattern
+CREATE TABLE meal_attendance (
+    meal_plan_id UUID NOT NULL REFERENCES meal_plans(id) ON DELETE CASCADE,
+    day DATE NOT NULL,
+    slot VARCHAR(16) NOT NULL CHECK (slot IN ('breakfast', 'lunch', 'dinner', 'snack')),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    attending BOOLEAN NOT NULL,
+    PRIMARY KEY (meal_plan_id, day, slot, user_id)
+);
+
+CREATE TABLE meal_guests (
+    meal_plan_id UUID NOT NULL REFERENCES meal_plans(id) ON DELETE CASCADE,
+    day DATE NOT NULL,
+    slot VARCHAR(16) NOT NULL CHECK (slot IN ('breakfast', 'lunch', 'dinner', 'snack')),
+    guests INTEGER NOT NULL CHECK (guests >= 0),
+    PRIMARY KEY (meal_plan_id, day, slot)
+);
+
+
+-- +goose Down
+DROP TABLE IF EXISTS meal_guests;
+DROP TABLE IF EXISTS meal_attendance;
+DROP TABLE IF EXISTS member_meal_absences;
+
+ALTER TABLE meal_plan_entries
+    DROP COLUMN IF EXISTS auto_servings;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS portion_factor;
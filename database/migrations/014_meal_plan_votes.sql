@@ -0,0 +1,22 @@
+-- +goose Up
+ALTER TABLE meal_plans
+    ADD COLUMN status VARCHAR(16) NOT NULL DEFAULT 'draft' CHECK (status IN ('draft', 'proposed', 'final')),
+    ADD COLUMN finalized_at TIMESTAMP;
+
+-- votes count for the recipe they were cast on, changing the recipe of an entry discards them
+CREATE TABLE meal_plan_votes (
+    entry_id UUID NOT NULL REFERENCES meal_plan_entries(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP DEFAULT NOW(),
+    recipe_id UUID NOT NULL REFERENCES recipes(id) ON DELETE CASCADE,
+    vote VARCHAR(8) NOT NULL CHECK (vote IN ('up', 'down', 'veto')),
+    PRIMARY KEY (entry_id, user_id)
+);
+
+
+-- +goose Down
+DROP TABLE IF EXISTS meal_plan_votes;
+
+ALTER TABLE meal_plans
+    DROP COLUMN IF EXISTS finalized_at,
+    DROP COLUMN IF EXISTS status;
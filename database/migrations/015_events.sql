@@ -0,0 +1,40 @@
+-- +goose Up
+-- servings is how many the quantities of a recipe feed, it is scaled from there for a guest count
+ALTER TABLE recipes
+    ADD COLUMN servings INTEGER NOT NULL DEFAULT 4 CHECK (servings > 0);
+
+-- allergens is what guests may have to avoid in an ingredient, like gluten or peanuts
+ALTER TABLE ingredients
+    ADD COLUMN allergens TEXT[] NOT NULL DEFAULT '{}';
+
+CREATE TABLE events (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    family_id UUID NOT NULL REFERENCES families(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    day DATE NOT NULL,
+    guests INTEGER NOT NULL CHECK (guests > 0),
+    restrictions TEXT[] NOT NULL DEFAULT '{}'
+);
+
+CREATE TABLE event_recipes (
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    recipe_id UUID NOT NULL REFERENCES recipes(id) ON DELETE CASCADE,
+    created_at TIMESTAMP DEFAULT NOW(),
+    PRIMARY KEY (event_id, recipe_id)
+);
+
+CREATE INDEX idx_events_family_id_day ON events(family_id, day);
+CREATE INDEX idx_event_recipes_recipe_id ON event_recipes(recipe_id);
+
+
+-- +goose Down
+DROP TABLE IF EXISTS event_recipes;
+DROP TABLE IF EXISTS events;
+
+ALTER TABLE ingredients
+    DROP COLUMN IF EXISTS allergens;
+
+ALTER TABLE recipes
+    DROP COLUMN IF EXISTS servings;
@@ -0,0 +1,29 @@
+-- +goose Up
+CREATE TABLE shopping_lists (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    family_id UUID NOT NULL REFERENCES families(id) ON DELETE CASCADE,
+    meal_plan_id UUID NOT NULL UNIQUE REFERENCES meal_plans(id) ON DELETE CASCADE
+);
+
+-- manual items are added by the family, the rest are generated from the meal plan
+CREATE TABLE shopping_list_items (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    shopping_list_id UUID NOT NULL REFERENCES shopping_lists(id) ON DELETE CASCADE,
+    ingredient_id INTEGER REFERENCES ingredients(id) ON DELETE SET NULL,
+    name VARCHAR(255) NOT NULL,
+    quantity DOUBLE PRECISION NOT NULL CHECK (quantity > 0),
+    unit VARCHAR(10) NOT NULL,
+    manual BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+CREATE INDEX idx_shopping_lists_family_id ON shopping_lists(family_id);
+CREATE INDEX idx_shopping_list_items_shopping_list_id ON shopping_list_items(shopping_list_id);
+
+
+-- +goose Down
+DROP TABLE IF EXISTS shopping_list_items;
+DROP TABLE IF EXISTS shopping_lists;
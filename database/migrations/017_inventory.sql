@@ -0,0 +1,17 @@
+-- +goose Up
+-- keep_at_least is the safety margin of a staple, shopping lists restock it when cooking would go below it
+CREATE TABLE inventory_items (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    family_id UUID NOT NULL REFERENCES families(id) ON DELETE CASCADE,
+    ingredient_id INTEGER NOT NULL REFERENCES ingredients(id) ON DELETE CASCADE,
+    quantity DOUBLE PRECISION NOT NULL CHECK (quantity >= 0),
+    unit VARCHAR(10) NOT NULL,
+    keep_at_least DOUBLE PRECISION NOT NULL DEFAULT 0 CHECK (keep_at_least >= 0),
+    UNIQUE (family_id, ingredient_id)
+);
+
+
+-- +goose Down
+DROP TABLE IF EXISTS inventory_items;
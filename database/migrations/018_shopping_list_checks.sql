@@ -0,0 +1,41 @@
+-- +goose Up
+-- checked_at is when the item was last checked or unchecked, the latest change wins
+ALTER TABLE shopping_list_items
+    ADD COLUMN checked BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN checked_at TIMESTAMP,
+    ADD COLUMN checked_by UUID REFERENCES users(id) ON DELETE SET NULL;
+
+-- every change of an item is notified to the servers listening, so they can push it to the family
+-- +goose StatementBegin
+CREATE FUNCTION notify_shopping_list_item() RETURNS TRIGGER AS $$
+DECLARE
+    item shopping_list_items;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        item := OLD;
+    ELSE
+        item := NEW;
+    END IF;
+    PERFORM pg_notify('shopping_list_items', json_build_object(
+        'op', lower(TG_OP),
+        'shopping_list_id', item.shopping_list_id,
+        'item', row_to_json(item)
+    )::text);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER shopping_list_items_notify
+    AFTER INSERT OR UPDATE OR DELETE ON shopping_list_items
+    FOR EACH ROW EXECUTE FUNCTION notify_shopping_list_item();
+
+
+-- +goose Down
+DROP TRIGGER IF EXISTS shopping_list_items_notify ON shopping_list_items;
+DROP FUNCTION IF EXISTS notify_shopping_list_item;
+
+ALTER TABLE shopping_list_items
+    DROP COLUMN IF EXISTS checked_by,
+    DROP COLUMN IF EXISTS checked_at,
+    DROP COLUMN IF EXISTS checked;
@@ -0,0 +1,28 @@
+-- +goose Up
+ALTER TABLE ingredients
+    ADD COLUMN category VARCHAR(20) NOT NULL DEFAULT 'other';
+
+-- the category of an item is copied from its ingredient, so items added by hand can have one too
+ALTER TABLE shopping_list_items
+    ADD COLUMN category VARCHAR(20) NOT NULL DEFAULT 'other';
+
+-- categories are the aisles of a store in the order they are walked
+CREATE TABLE store_layouts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    family_id UUID NOT NULL REFERENCES families(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    categories TEXT[] NOT NULL DEFAULT '{}',
+    UNIQUE (family_id, name)
+);
+
+
+-- +goose Down
+DROP TABLE IF EXISTS store_layouts;
+
+ALTER TABLE shopping_list_items
+    DROP COLUMN IF EXISTS category;
+
+ALTER TABLE ingredients
+    DROP COLUMN IF EXISTS category;
@@ -0,0 +1,24 @@
+-- +goose Up
+-- the sizes an ingredient is sold in, price_cents is optional and used to buy the cheapest packages
+CREATE TABLE ingredient_packages (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at TIMESTAMP DEFAULT NOW(),
+    ingredient_id INTEGER NOT NULL REFERENCES ingredients(id) ON DELETE CASCADE,
+    quantity DOUBLE PRECISION NOT NULL CHECK (quantity > 0),
+    unit VARCHAR(10) NOT NULL,
+    price_cents INTEGER CHECK (price_cents >= 0),
+    UNIQUE (ingredient_id, quantity, unit)
+);
+
+-- the packages an item is bought in and how much of them is left over for the inventory
+ALTER TABLE shopping_list_items
+    ADD COLUMN packages JSONB NOT NULL DEFAULT '[]',
+    ADD COLUMN surplus DOUBLE PRECISION NOT NULL DEFAULT 0;
+
+
+-- +goose Down
+ALTER TABLE shopping_list_items
+    DROP COLUMN IF EXISTS surplus,
+    DROP COLUMN IF EXISTS packages;
+
+DROP TABLE IF EXISTS ingredient_packages;
@@ -0,0 +1,9 @@
+-- +goose Up
+-- the units quantities are shown in, metric or imperial
+ALTER TABLE users
+    ADD COLUMN unit_system VARCHAR(10) NOT NULL DEFAULT 'metric' CHECK (unit_system IN ('metric', 'imperial'));
+
+
+-- +goose Down
+ALTER TABLE users
+    DROP COLUMN IF EXISTS unit_system;
@@ -0,0 +1,18 @@
+-- +goose Up
+-- what a family buys regardless of the meal plan, every_weeks weeks from the week starting on first_week
+CREATE TABLE staples (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    family_id UUID NOT NULL REFERENCES families(id) ON DELETE CASCADE,
+    ingredient_id INTEGER NOT NULL REFERENCES ingredients(id) ON DELETE CASCADE,
+    quantity DOUBLE PRECISION NOT NULL CHECK (quantity > 0),
+    unit VARCHAR(10) NOT NULL,
+    every_weeks INTEGER NOT NULL DEFAULT 1 CHECK (every_weeks BETWEEN 1 AND 52),
+    first_week DATE NOT NULL,
+    UNIQUE (family_id, ingredient_id)
+);
+
+
+-- +goose Down
+DROP TABLE IF EXISTS staples;
@@ -0,0 +1,10 @@
+-- +goose Up
+-- sources are the meals of the plan and the staples a generated item is bought for,
+-- so generating the list again only changes the items whose sources changed
+ALTER TABLE shopping_list_items
+    ADD COLUMN sources JSONB NOT NULL DEFAULT '[]';
+
+
+-- +goose Down
+ALTER TABLE shopping_list_items
+    DROP COLUMN IF EXISTS sources;
@@ -0,0 +1,44 @@
+-- +goose Up
+-- the notification of an item change only holds its ids, a whole row with its packages and sources
+-- could go over the 8000 bytes NOTIFY allows, so the servers load the item themselves
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION notify_shopping_list_item() RETURNS TRIGGER AS $$
+DECLARE
+    item shopping_list_items;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        item := OLD;
+    ELSE
+        item := NEW;
+    END IF;
+    PERFORM pg_notify('shopping_list_items', json_build_object(
+        'op', lower(TG_OP),
+        'shopping_list_id', item.shopping_list_id,
+        'item_id', item.id
+    )::text);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION notify_shopping_list_item() RETURNS TRIGGER AS $$
+DECLARE
+    item shopping_list_items;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        item := OLD;
+    ELSE
+        item := NEW;
+    END IF;
+    PERFORM pg_notify('shopping_list_items', json_build_object(
+        'op', lower(TG_OP),
+        'shopping_list_id', item.shopping_list_id,
+        'item', row_to_json(item)
+    )::text);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- edited generated items belong to the family, generating the list again leaves them as they are,
+-- and deleted generated items are kept as dismissed so they aren't generated again
+ALTER TABLE shopping_list_items
+    ADD COLUMN edited BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN dismissed BOOLEAN NOT NULL DEFAULT FALSE;
+
+
+-- +goose Down
+ALTER TABLE shopping_list_items
+    DROP COLUMN IF EXISTS dismissed,
+    DROP COLUMN IF EXISTS edited;
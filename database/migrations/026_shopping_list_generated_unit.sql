@@ -0,0 +1,12 @@
+-- +goose Up
+-- generated_unit is the unit an item was generated in, generating the list again matches items by it
+-- since the family can change the unit of an item
+ALTER TABLE shopping_list_items
+    ADD COLUMN generated_unit TEXT;
+
+UPDATE shopping_list_items SET generated_unit = unit WHERE NOT manual;
+
+
+-- +goose Down
+ALTER TABLE shopping_list_items
+    DROP COLUMN IF EXISTS generated_unit;
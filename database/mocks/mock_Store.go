@@ -9,6 +9,7 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	uuid "github.com/google/uuid"
+	pgtype "github.com/jackc/pgx/v5/pgtype"
 )
 
 // MockStore is an autogenerated mock type for the Store type
@@ -24,245 +25,205 @@ func (_m *MockStore) EXPECT() *MockStore_Expecter {
 	return &MockStore_Expecter{mock: &_m.Mock}
 }
 
-// CreateFamily provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CreateFamily(ctx context.Context, arg database.CreateFamilyParams) (database.Family, error) {
+// AddEventRecipe provides a mock function with given fields: ctx, arg
+func (_m *MockStore) AddEventRecipe(ctx context.Context, arg database.AddEventRecipeParams) error {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateFamily")
+		panic("no return value specified for AddEventRecipe")
 	}
 
-	var r0 database.Family
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, database.CreateFamilyParams) (database.Family, error)); ok {
-		return rf(ctx, arg)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, database.CreateFamilyParams) database.Family); ok {
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.AddEventRecipeParams) error); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(database.Family)
-	}
-
-	if rf, ok := ret.Get(1).(func(context.Context, database.CreateFamilyParams) error); ok {
-		r1 = rf(ctx, arg)
-	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockStore_CreateFamily_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateFamily'
-type MockStore_CreateFamily_Call struct {
+// MockStore_AddEventRecipe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddEventRecipe'
+type MockStore_AddEventRecipe_Call struct {
 	*mock.Call
 }
 
-// CreateFamily is a helper method to define mock.On call
+// AddEventRecipe is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg database.CreateFamilyParams
-func (_e *MockStore_Expecter) CreateFamily(ctx interface{}, arg interface{}) *MockStore_CreateFamily_Call {
-	return &MockStore_CreateFamily_Call{Call: _e.mock.On("CreateFamily", ctx, arg)}
+//   - arg database.AddEventRecipeParams
+func (_e *MockStore_Expecter) AddEventRecipe(ctx interface{}, arg interface{}) *MockStore_AddEventRecipe_Call {
+	return &MockStore_AddEventRecipe_Call{Call: _e.mock.On("AddEventRecipe", ctx, arg)}
 }
 
-func (_c *MockStore_CreateFamily_Call) Run(run func(ctx context.Context, arg database.CreateFamilyParams)) *MockStore_CreateFamily_Call {
+func (_c *MockStore_AddEventRecipe_Call) Run(run func(ctx context.Context, arg database.AddEventRecipeParams)) *MockStore_AddEventRecipe_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(database.CreateFamilyParams))
+		run(args[0].(context.Context), args[1].(database.AddEventRecipeParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateFamily_Call) Return(_a0 database.Family, _a1 error) *MockStore_CreateFamily_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockStore_AddEventRecipe_Call) Return(_a0 error) *MockStore_AddEventRecipe_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockStore_CreateFamily_Call) RunAndReturn(run func(context.Context, database.CreateFamilyParams) (database.Family, error)) *MockStore_CreateFamily_Call {
+func (_c *MockStore_AddEventRecipe_Call) RunAndReturn(run func(context.Context, database.AddEventRecipeParams) error) *MockStore_AddEventRecipe_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateIngredient provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CreateIngredient(ctx context.Context, arg database.CreateIngredientParams) (database.Ingredient, error) {
+// AddFamilyEquipment provides a mock function with given fields: ctx, arg
+func (_m *MockStore) AddFamilyEquipment(ctx context.Context, arg database.AddFamilyEquipmentParams) error {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateIngredient")
+		panic("no return value specified for AddFamilyEquipment")
 	}
 
-	var r0 database.Ingredient
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, database.CreateIngredientParams) (database.Ingredient, error)); ok {
-		return rf(ctx, arg)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, database.CreateIngredientParams) database.Ingredient); ok {
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.AddFamilyEquipmentParams) error); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(database.Ingredient)
-	}
-
-	if rf, ok := ret.Get(1).(func(context.Context, database.CreateIngredientParams) error); ok {
-		r1 = rf(ctx, arg)
-	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockStore_CreateIngredient_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateIngredient'
-type MockStore_CreateIngredient_Call struct {
+// MockStore_AddFamilyEquipment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddFamilyEquipment'
+type MockStore_AddFamilyEquipment_Call struct {
 	*mock.Call
 }
 
-// CreateIngredient is a helper method to define mock.On call
+// AddFamilyEquipment is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg database.CreateIngredientParams
-func (_e *MockStore_Expecter) CreateIngredient(ctx interface{}, arg interface{}) *MockStore_CreateIngredient_Call {
-	return &MockStore_CreateIngredient_Call{Call: _e.mock.On("CreateIngredient", ctx, arg)}
+//   - arg database.AddFamilyEquipmentParams
+func (_e *MockStore_Expecter) AddFamilyEquipment(ctx interface{}, arg interface{}) *MockStore_AddFamilyEquipment_Call {
+	return &MockStore_AddFamilyEquipment_Call{Call: _e.mock.On("AddFamilyEquipment", ctx, arg)}
 }
 
-func (_c *MockStore_CreateIngredient_Call) Run(run func(ctx context.Context, arg database.CreateIngredientParams)) *MockStore_CreateIngredient_Call {
+func (_c *MockStore_AddFamilyEquipment_Call) Run(run func(ctx context.Context, arg database.AddFamilyEquipmentParams)) *MockStore_AddFamilyEquipment_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(database.CreateIngredientParams))
+		run(args[0].(context.Context), args[1].(database.AddFamilyEquipmentParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateIngredient_Call) Return(_a0 database.Ingredient, _a1 error) *MockStore_CreateIngredient_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockStore_AddFamilyEquipment_Call) Return(_a0 error) *MockStore_AddFamilyEquipment_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockStore_CreateIngredient_Call) RunAndReturn(run func(context.Context, database.CreateIngredientParams) (database.Ingredient, error)) *MockStore_CreateIngredient_Call {
+func (_c *MockStore_AddFamilyEquipment_Call) RunAndReturn(run func(context.Context, database.AddFamilyEquipmentParams) error) *MockStore_AddFamilyEquipment_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateRecipe provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CreateRecipe(ctx context.Context, arg database.CreateRecipeParams) (database.Recipe, error) {
+// AddFavorite provides a mock function with given fields: ctx, arg
+func (_m *MockStore) AddFavorite(ctx context.Context, arg database.AddFavoriteParams) error {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateRecipe")
+		panic("no return value specified for AddFavorite")
 	}
 
-	var r0 database.Recipe
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, database.CreateRecipeParams) (database.Recipe, error)); ok {
-		return rf(ctx, arg)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, database.CreateRecipeParams) database.Recipe); ok {
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.AddFavoriteParams) error); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(database.Recipe)
-	}
-
-	if rf, ok := ret.Get(1).(func(context.Context, database.CreateRecipeParams) error); ok {
-		r1 = rf(ctx, arg)
-	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockStore_CreateRecipe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRecipe'
-type MockStore_CreateRecipe_Call struct {
+// MockStore_AddFavorite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddFavorite'
+type MockStore_AddFavorite_Call struct {
 	*mock.Call
 }
 
-// CreateRecipe is a helper method to define mock.On call
+// AddFavorite is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg database.CreateRecipeParams
-func (_e *MockStore_Expecter) CreateRecipe(ctx interface{}, arg interface{}) *MockStore_CreateRecipe_Call {
-	return &MockStore_CreateRecipe_Call{Call: _e.mock.On("CreateRecipe", ctx, arg)}
+//   - arg database.AddFavoriteParams
+func (_e *MockStore_Expecter) AddFavorite(ctx interface{}, arg interface{}) *MockStore_AddFavorite_Call {
+	return &MockStore_AddFavorite_Call{Call: _e.mock.On("AddFavorite", ctx, arg)}
 }
 
-func (_c *MockStore_CreateRecipe_Call) Run(run func(ctx context.Context, arg database.CreateRecipeParams)) *MockStore_CreateRecipe_Call {
+func (_c *MockStore_AddFavorite_Call) Run(run func(ctx context.Context, arg database.AddFavoriteParams)) *MockStore_AddFavorite_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(database.CreateRecipeParams))
+		run(args[0].(context.Context), args[1].(database.AddFavoriteParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateRecipe_Call) Return(_a0 database.Recipe, _a1 error) *MockStore_CreateRecipe_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockStore_AddFavorite_Call) Return(_a0 error) *MockStore_AddFavorite_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockStore_CreateRecipe_Call) RunAndReturn(run func(context.Context, database.CreateRecipeParams) (database.Recipe, error)) *MockStore_CreateRecipe_Call {
+func (_c *MockStore_AddFavorite_Call) RunAndReturn(run func(context.Context, database.AddFavoriteParams) error) *MockStore_AddFavorite_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateUser provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CreateUser(ctx context.Context, arg database.CreateUserParams) (database.User, error) {
+// AddMealPlanRotationTemplate provides a mock function with given fields: ctx, arg
+func (_m *MockStore) AddMealPlanRotationTemplate(ctx context.Context, arg database.AddMealPlanRotationTemplateParams) error {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateUser")
+		panic("no return value specified for AddMealPlanRotationTemplate")
 	}
 
-	var r0 database.User
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, database.CreateUserParams) (database.User, error)); ok {
-		return rf(ctx, arg)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, database.CreateUserParams) database.User); ok {
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.AddMealPlanRotationTemplateParams) error); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(database.User)
-	}
-
-	if rf, ok := ret.Get(1).(func(context.Context, database.CreateUserParams) error); ok {
-		r1 = rf(ctx, arg)
-	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockStore_CreateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUser'
-type MockStore_CreateUser_Call struct {
+// MockStore_AddMealPlanRotationTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddMealPlanRotationTemplate'
+type MockStore_AddMealPlanRotationTemplate_Call struct {
 	*mock.Call
 }
 
-// CreateUser is a helper method to define mock.On call
+// AddMealPlanRotationTemplate is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg database.CreateUserParams
-func (_e *MockStore_Expecter) CreateUser(ctx interface{}, arg interface{}) *MockStore_CreateUser_Call {
-	return &MockStore_CreateUser_Call{Call: _e.mock.On("CreateUser", ctx, arg)}
+//   - arg database.AddMealPlanRotationTemplateParams
+func (_e *MockStore_Expecter) AddMealPlanRotationTemplate(ctx interface{}, arg interface{}) *MockStore_AddMealPlanRotationTemplate_Call {
+	return &MockStore_AddMealPlanRotationTemplate_Call{Call: _e.mock.On("AddMealPlanRotationTemplate", ctx, arg)}
 }
 
-func (_c *MockStore_CreateUser_Call) Run(run func(ctx context.Context, arg database.CreateUserParams)) *MockStore_CreateUser_Call {
+func (_c *MockStore_AddMealPlanRotationTemplate_Call) Run(run func(ctx context.Context, arg database.AddMealPlanRotationTemplateParams)) *MockStore_AddMealPlanRotationTemplate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(database.CreateUserParams))
+		run(args[0].(context.Context), args[1].(database.AddMealPlanRotationTemplateParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateUser_Call) Return(_a0 database.User, _a1 error) *MockStore_CreateUser_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockStore_AddMealPlanRotationTemplate_Call) Return(_a0 error) *MockStore_AddMealPlanRotationTemplate_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockStore_CreateUser_Call) RunAndReturn(run func(context.Context, database.CreateUserParams) (database.User, error)) *MockStore_CreateUser_Call {
+func (_c *MockStore_AddMealPlanRotationTemplate_Call) RunAndReturn(run func(context.Context, database.AddMealPlanRotationTemplateParams) error) *MockStore_AddMealPlanRotationTemplate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteFamily provides a mock function with given fields: ctx, id
-func (_m *MockStore) DeleteFamily(ctx context.Context, id uuid.UUID) error {
-	ret := _m.Called(ctx, id)
+// AddRecipeEquipment provides a mock function with given fields: ctx, arg
+func (_m *MockStore) AddRecipeEquipment(ctx context.Context, arg database.AddRecipeEquipmentParams) error {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteFamily")
+		panic("no return value specified for AddRecipeEquipment")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, database.AddRecipeEquipmentParams) error); ok {
+		r0 = rf(ctx, arg)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -270,46 +231,46 @@ func (_m *MockStore) DeleteFamily(ctx context.Context, id uuid.UUID) error {
 	return r0
 }
 
-// MockStore_DeleteFamily_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteFamily'
-type MockStore_DeleteFamily_Call struct {
+// MockStore_AddRecipeEquipment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddRecipeEquipment'
+type MockStore_AddRecipeEquipment_Call struct {
 	*mock.Call
 }
 
-// DeleteFamily is a helper method to define mock.On call
+// AddRecipeEquipment is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id uuid.UUID
-func (_e *MockStore_Expecter) DeleteFamily(ctx interface{}, id interface{}) *MockStore_DeleteFamily_Call {
-	return &MockStore_DeleteFamily_Call{Call: _e.mock.On("DeleteFamily", ctx, id)}
+//   - arg database.AddRecipeEquipmentParams
+func (_e *MockStore_Expecter) AddRecipeEquipment(ctx interface{}, arg interface{}) *MockStore_AddRecipeEquipment_Call {
+	return &MockStore_AddRecipeEquipment_Call{Call: _e.mock.On("AddRecipeEquipment", ctx, arg)}
 }
 
-func (_c *MockStore_DeleteFamily_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_DeleteFamily_Call {
+func (_c *MockStore_AddRecipeEquipment_Call) Run(run func(ctx context.Context, arg database.AddRecipeEquipmentParams)) *MockStore_AddRecipeEquipment_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(database.AddRecipeEquipmentParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_DeleteFamily_Call) Return(_a0 error) *MockStore_DeleteFamily_Call {
+func (_c *MockStore_AddRecipeEquipment_Call) Return(_a0 error) *MockStore_AddRecipeEquipment_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockStore_DeleteFamily_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_DeleteFamily_Call {
+func (_c *MockStore_AddRecipeEquipment_Call) RunAndReturn(run func(context.Context, database.AddRecipeEquipmentParams) error) *MockStore_AddRecipeEquipment_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteIngredient provides a mock function with given fields: ctx, id
-func (_m *MockStore) DeleteIngredient(ctx context.Context, id int32) error {
-	ret := _m.Called(ctx, id)
+// AddRecipeToCollection provides a mock function with given fields: ctx, arg
+func (_m *MockStore) AddRecipeToCollection(ctx context.Context, arg database.AddRecipeToCollectionParams) error {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteIngredient")
+		panic("no return value specified for AddRecipeToCollection")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, database.AddRecipeToCollectionParams) error); ok {
+		r0 = rf(ctx, arg)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -317,208 +278,8640 @@ func (_m *MockStore) DeleteIngredient(ctx context.Context, id int32) error {
 	return r0
 }
 
-// MockStore_DeleteIngredient_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteIngredient'
-type MockStore_DeleteIngredient_Call struct {
+// MockStore_AddRecipeToCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddRecipeToCollection'
+type MockStore_AddRecipeToCollection_Call struct {
 	*mock.Call
 }
 
-// DeleteIngredient is a helper method to define mock.On call
+// AddRecipeToCollection is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int32
-func (_e *MockStore_Expecter) DeleteIngredient(ctx interface{}, id interface{}) *MockStore_DeleteIngredient_Call {
-	return &MockStore_DeleteIngredient_Call{Call: _e.mock.On("DeleteIngredient", ctx, id)}
+//   - arg database.AddRecipeToCollectionParams
+func (_e *MockStore_Expecter) AddRecipeToCollection(ctx interface{}, arg interface{}) *MockStore_AddRecipeToCollection_Call {
+	return &MockStore_AddRecipeToCollection_Call{Call: _e.mock.On("AddRecipeToCollection", ctx, arg)}
 }
 
-func (_c *MockStore_DeleteIngredient_Call) Run(run func(ctx context.Context, id int32)) *MockStore_DeleteIngredient_Call {
+func (_c *MockStore_AddRecipeToCollection_Call) Run(run func(ctx context.Context, arg database.AddRecipeToCollectionParams)) *MockStore_AddRecipeToCollection_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int32))
+		run(args[0].(context.Context), args[1].(database.AddRecipeToCollectionParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_DeleteIngredient_Call) Return(_a0 error) *MockStore_DeleteIngredient_Call {
+func (_c *MockStore_AddRecipeToCollection_Call) Return(_a0 error) *MockStore_AddRecipeToCollection_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockStore_DeleteIngredient_Call) RunAndReturn(run func(context.Context, int32) error) *MockStore_DeleteIngredient_Call {
+func (_c *MockStore_AddRecipeToCollection_Call) RunAndReturn(run func(context.Context, database.AddRecipeToCollectionParams) error) *MockStore_AddRecipeToCollection_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteRecipe provides a mock function with given fields: ctx, id
-func (_m *MockStore) DeleteRecipe(ctx context.Context, id uuid.UUID) error {
-	ret := _m.Called(ctx, id)
+// BatchCookTx provides a mock function with given fields: ctx, arg
+func (_m *MockStore) BatchCookTx(ctx context.Context, arg database.BatchCookTxParams) (database.BatchCookTxResult, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteRecipe")
+		panic("no return value specified for BatchCookTx")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
-		r0 = rf(ctx, id)
+	var r0 database.BatchCookTxResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.BatchCookTxParams) (database.BatchCookTxResult, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.BatchCookTxParams) database.BatchCookTxResult); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(database.BatchCookTxResult)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, database.BatchCookTxParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockStore_DeleteRecipe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRecipe'
-type MockStore_DeleteRecipe_Call struct {
+// MockStore_BatchCookTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchCookTx'
+type MockStore_BatchCookTx_Call struct {
 	*mock.Call
 }
 
-// DeleteRecipe is a helper method to define mock.On call
+// BatchCookTx is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id uuid.UUID
-func (_e *MockStore_Expecter) DeleteRecipe(ctx interface{}, id interface{}) *MockStore_DeleteRecipe_Call {
-	return &MockStore_DeleteRecipe_Call{Call: _e.mock.On("DeleteRecipe", ctx, id)}
+//   - arg database.BatchCookTxParams
+func (_e *MockStore_Expecter) BatchCookTx(ctx interface{}, arg interface{}) *MockStore_BatchCookTx_Call {
+	return &MockStore_BatchCookTx_Call{Call: _e.mock.On("BatchCookTx", ctx, arg)}
 }
 
-func (_c *MockStore_DeleteRecipe_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_DeleteRecipe_Call {
+func (_c *MockStore_BatchCookTx_Call) Run(run func(ctx context.Context, arg database.BatchCookTxParams)) *MockStore_BatchCookTx_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(database.BatchCookTxParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_DeleteRecipe_Call) Return(_a0 error) *MockStore_DeleteRecipe_Call {
-	_c.Call.Return(_a0)
+func (_c *MockStore_BatchCookTx_Call) Return(_a0 database.BatchCookTxResult, _a1 error) *MockStore_BatchCookTx_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_DeleteRecipe_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_DeleteRecipe_Call {
+func (_c *MockStore_BatchCookTx_Call) RunAndReturn(run func(context.Context, database.BatchCookTxParams) (database.BatchCookTxResult, error)) *MockStore_BatchCookTx_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteUser provides a mock function with given fields: ctx, id
-func (_m *MockStore) DeleteUser(ctx context.Context, id uuid.UUID) error {
-	ret := _m.Called(ctx, id)
+// CheckShoppingListItem provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CheckShoppingListItem(ctx context.Context, arg database.CheckShoppingListItemParams) (database.ShoppingListItem, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteUser")
+		panic("no return value specified for CheckShoppingListItem")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
-		r0 = rf(ctx, id)
+	var r0 database.ShoppingListItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.CheckShoppingListItemParams) (database.ShoppingListItem, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.CheckShoppingListItemParams) database.ShoppingListItem); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(database.ShoppingListItem)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, database.CheckShoppingListItemParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockStore_DeleteUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUser'
-type MockStore_DeleteUser_Call struct {
+// MockStore_CheckShoppingListItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckShoppingListItem'
+type MockStore_CheckShoppingListItem_Call struct {
 	*mock.Call
 }
 
-// DeleteUser is a helper method to define mock.On call
+// CheckShoppingListItem is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id uuid.UUID
-func (_e *MockStore_Expecter) DeleteUser(ctx interface{}, id interface{}) *MockStore_DeleteUser_Call {
-	return &MockStore_DeleteUser_Call{Call: _e.mock.On("DeleteUser", ctx, id)}
+//   - arg database.CheckShoppingListItemParams
+func (_e *MockStore_Expecter) CheckShoppingListItem(ctx interface{}, arg interface{}) *MockStore_CheckShoppingListItem_Call {
+	return &MockStore_CheckShoppingListItem_Call{Call: _e.mock.On("CheckShoppingListItem", ctx, arg)}
 }
 
-func (_c *MockStore_DeleteUser_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_DeleteUser_Call {
+func (_c *MockStore_CheckShoppingListItem_Call) Run(run func(ctx context.Context, arg database.CheckShoppingListItemParams)) *MockStore_CheckShoppingListItem_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(database.CheckShoppingListItemParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_DeleteUser_Call) Return(_a0 error) *MockStore_DeleteUser_Call {
-	_c.Call.Return(_a0)
+func (_c *MockStore_CheckShoppingListItem_Call) Return(_a0 database.ShoppingListItem, _a1 error) *MockStore_CheckShoppingListItem_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_DeleteUser_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_DeleteUser_Call {
+func (_c *MockStore_CheckShoppingListItem_Call) RunAndReturn(run func(context.Context, database.CheckShoppingListItemParams) (database.ShoppingListItem, error)) *MockStore_CheckShoppingListItem_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetFamilies provides a mock function with given fields: ctx
-func (_m *MockStore) GetFamilies(ctx context.Context) ([]database.Family, error) {
-	ret := _m.Called(ctx)
+// CreateBusySlot provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateBusySlot(ctx context.Context, arg database.CreateBusySlotParams) error {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetFamilies")
+		panic("no return value specified for CreateBusySlot")
 	}
 
-	var r0 []database.Family
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]database.Family, error)); ok {
-		return rf(ctx)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context) []database.Family); ok {
-		r0 = rf(ctx)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.CreateBusySlotParams) error); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]database.Family)
-		}
+		r0 = ret.Error(0)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
-	} else {
-		r1 = ret.Error(1)
-	}
+	return r0
+}
 
-	return r0, r1
+// MockStore_CreateBusySlot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBusySlot'
+type MockStore_CreateBusySlot_Call struct {
+	*mock.Call
+}
+
+// CreateBusySlot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.CreateBusySlotParams
+func (_e *MockStore_Expecter) CreateBusySlot(ctx interface{}, arg interface{}) *MockStore_CreateBusySlot_Call {
+	return &MockStore_CreateBusySlot_Call{Call: _e.mock.On("CreateBusySlot", ctx, arg)}
+}
+
+func (_c *MockStore_CreateBusySlot_Call) Run(run func(ctx context.Context, arg database.CreateBusySlotParams)) *MockStore_CreateBusySlot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.CreateBusySlotParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateBusySlot_Call) Return(_a0 error) *MockStore_CreateBusySlot_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_CreateBusySlot_Call) RunAndReturn(run func(context.Context, database.CreateBusySlotParams) error) *MockStore_CreateBusySlot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateCollection provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateCollection(ctx context.Context, arg database.CreateCollectionParams) (database.Collection, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCollection")
+	}
+
+	var r0 database.Collection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.CreateCollectionParams) (database.Collection, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.CreateCollectionParams) database.Collection); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(database.Collection)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.CreateCollectionParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCollection'
+type MockStore_CreateCollection_Call struct {
+	*mock.Call
+}
+
+// CreateCollection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.CreateCollectionParams
+func (_e *MockStore_Expecter) CreateCollection(ctx interface{}, arg interface{}) *MockStore_CreateCollection_Call {
+	return &MockStore_CreateCollection_Call{Call: _e.mock.On("CreateCollection", ctx, arg)}
+}
+
+func (_c *MockStore_CreateCollection_Call) Run(run func(ctx context.Context, arg database.CreateCollectionParams)) *MockStore_CreateCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.CreateCollectionParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateCollection_Call) Return(_a0 database.Collection, _a1 error) *MockStore_CreateCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateCollection_Call) RunAndReturn(run func(context.Context, database.CreateCollectionParams) (database.Collection, error)) *MockStore_CreateCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateCookLog provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateCookLog(ctx context.Context, arg database.CreateCookLogParams) (database.CookLog, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCookLog")
+	}
+
+	var r0 database.CookLog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.CreateCookLogParams) (database.CookLog, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.CreateCookLogParams) database.CookLog); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(database.CookLog)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.CreateCookLogParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateCookLog_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCookLog'
+type MockStore_CreateCookLog_Call struct {
+	*mock.Call
+}
+
+// CreateCookLog is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.CreateCookLogParams
+func (_e *MockStore_Expecter) CreateCookLog(ctx interface{}, arg interface{}) *MockStore_CreateCookLog_Call {
+	return &MockStore_CreateCookLog_Call{Call: _e.mock.On("CreateCookLog", ctx, arg)}
+}
+
+func (_c *MockStore_CreateCookLog_Call) Run(run func(ctx context.Context, arg database.CreateCookLogParams)) *MockStore_CreateCookLog_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.CreateCookLogParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateCookLog_Call) Return(_a0 database.CookLog, _a1 error) *MockStore_CreateCookLog_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateCookLog_Call) RunAndReturn(run func(context.Context, database.CreateCookLogParams) (database.CookLog, error)) *MockStore_CreateCookLog_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateEquipment provides a mock function with given fields: ctx, name
+func (_m *MockStore) CreateEquipment(ctx context.Context, name string) (database.Equipment, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateEquipment")
+	}
+
+	var r0 database.Equipment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (database.Equipment, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) database.Equipment); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Get(0).(database.Equipment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateEquipment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateEquipment'
+type MockStore_CreateEquipment_Call struct {
+	*mock.Call
+}
+
+// CreateEquipment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *MockStore_Expecter) CreateEquipment(ctx interface{}, name interface{}) *MockStore_CreateEquipment_Call {
+	return &MockStore_CreateEquipment_Call{Call: _e.mock.On("CreateEquipment", ctx, name)}
+}
+
+func (_c *MockStore_CreateEquipment_Call) Run(run func(ctx context.Context, name string)) *MockStore_CreateEquipment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateEquipment_Call) Return(_a0 database.Equipment, _a1 error) *MockStore_CreateEquipment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateEquipment_Call) RunAndReturn(run func(context.Context, string) (database.Equipment, error)) *MockStore_CreateEquipment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateEvent provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateEvent(ctx context.Context, arg database.CreateEventParams) (database.Event, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateEvent")
+	}
+
+	var r0 database.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.CreateEventParams) (database.Event, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.CreateEventParams) database.Event); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(database.Event)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.CreateEventParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateEvent'
+type MockStore_CreateEvent_Call struct {
+	*mock.Call
+}
+
+// CreateEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.CreateEventParams
+func (_e *MockStore_Expecter) CreateEvent(ctx interface{}, arg interface{}) *MockStore_CreateEvent_Call {
+	return &MockStore_CreateEvent_Call{Call: _e.mock.On("CreateEvent", ctx, arg)}
+}
+
+func (_c *MockStore_CreateEvent_Call) Run(run func(ctx context.Context, arg database.CreateEventParams)) *MockStore_CreateEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.CreateEventParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateEvent_Call) Return(_a0 database.Event, _a1 error) *MockStore_CreateEvent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateEvent_Call) RunAndReturn(run func(context.Context, database.CreateEventParams) (database.Event, error)) *MockStore_CreateEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateFamily provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateFamily(ctx context.Context, arg database.CreateFamilyParams) (database.Family, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateFamily")
+	}
+
+	var r0 database.Family
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.CreateFamilyParams) (database.Family, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.CreateFamilyParams) database.Family); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(database.Family)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.CreateFamilyParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateFamily_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateFamily'
+type MockStore_CreateFamily_Call struct {
+	*mock.Call
+}
+
+// CreateFamily is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.CreateFamilyParams
+func (_e *MockStore_Expecter) CreateFamily(ctx interface{}, arg interface{}) *MockStore_CreateFamily_Call {
+	return &MockStore_CreateFamily_Call{Call: _e.mock.On("CreateFamily", ctx, arg)}
+}
+
+func (_c *MockStore_CreateFamily_Call) Run(run func(ctx context.Context, arg database.CreateFamilyParams)) *MockStore_CreateFamily_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.CreateFamilyParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateFamily_Call) Return(_a0 database.Family, _a1 error) *MockStore_CreateFamily_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateFamily_Call) RunAndReturn(run func(context.Context, database.CreateFamilyParams) (database.Family, error)) *MockStore_CreateFamily_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateFamilyCalendar provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateFamilyCalendar(ctx context.Context, arg database.CreateFamilyCalendarParams) (database.FamilyCalendar, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateFamilyCalendar")
+	}
+
+	var r0 database.FamilyCalendar
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.CreateFamilyCalendarParams) (database.FamilyCalendar, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.CreateFamilyCalendarParams) database.FamilyCalendar); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(database.FamilyCalendar)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.CreateFamilyCalendarParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateFamilyCalendar_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateFamilyCalendar'
+type MockStore_CreateFamilyCalendar_Call struct {
+	*mock.Call
+}
+
+// CreateFamilyCalendar is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.CreateFamilyCalendarParams
+func (_e *MockStore_Expecter) CreateFamilyCalendar(ctx interface{}, arg interface{}) *MockStore_CreateFamilyCalendar_Call {
+	return &MockStore_CreateFamilyCalendar_Call{Call: _e.mock.On("CreateFamilyCalendar", ctx, arg)}
+}
+
+func (_c *MockStore_CreateFamilyCalendar_Call) Run(run func(ctx context.Context, arg database.CreateFamilyCalendarParams)) *MockStore_CreateFamilyCalendar_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.CreateFamilyCalendarParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateFamilyCalendar_Call) Return(_a0 database.FamilyCalendar, _a1 error) *MockStore_CreateFamilyCalendar_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateFamilyCalendar_Call) RunAndReturn(run func(context.Context, database.CreateFamilyCalendarParams) (database.FamilyCalendar, error)) *MockStore_CreateFamilyCalendar_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateFamilyCalendarTx provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateFamilyCalendarTx(ctx context.Context, arg database.CreateFamilyCalendarTxParams) (database.FamilyCalendar, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateFamilyCalendarTx")
+	}
+
+	var r0 database.FamilyCalendar
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.CreateFamilyCalendarTxParams) (database.FamilyCalendar, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.CreateFamilyCalendarTxParams) database.FamilyCalendar); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(database.FamilyCalendar)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.CreateFamilyCalendarTxParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateFamilyCalendarTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateFamilyCalendarTx'
+type MockStore_CreateFamilyCalendarTx_Call struct {
+	*mock.Call
+}
+
+// CreateFamilyCalendarTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.CreateFamilyCalendarTxParams
+func (_e *MockStore_Expecter) CreateFamilyCalendarTx(ctx interface{}, arg interface{}) *MockStore_CreateFamilyCalendarTx_Call {
+	return &MockStore_CreateFamilyCalendarTx_Call{Call: _e.mock.On("CreateFamilyCalendarTx", ctx, arg)}
+}
+
+func (_c *MockStore_CreateFamilyCalendarTx_Call) Run(run func(ctx context.Context, arg database.CreateFamilyCalendarTxParams)) *MockStore_CreateFamilyCalendarTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.CreateFamilyCalendarTxParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateFamilyCalendarTx_Call) Return(_a0 database.FamilyCalendar, _a1 error) *MockStore_CreateFamilyCalendarTx_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateFamilyCalendarTx_Call) RunAndReturn(run func(context.Context, database.CreateFamilyCalendarTxParams) (database.FamilyCalendar, error)) *MockStore_CreateFamilyCalendarTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateIngredient provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateIngredient(ctx context.Context, arg database.CreateIngredientParams) (database.Ingredient, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateIngredient")
+	}
+
+	var r0 database.Ingredient
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.CreateIngredientParams) (database.Ingredient, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.CreateIngredientParams) database.Ingredient); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(database.Ingredient)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.CreateIngredientParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateIngredient_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateIngredient'
+type MockStore_CreateIngredient_Call struct {
+	*mock.Call
+}
+
+// CreateIngredient is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.CreateIngredientParams
+func (_e *MockStore_Expecter) CreateIngredient(ctx interface{}, arg interface{}) *MockStore_CreateIngredient_Call {
+	return &MockStore_CreateIngredient_Call{Call: _e.mock.On("CreateIngredient", ctx, arg)}
+}
+
+func (_c *MockStore_CreateIngredient_Call) Run(run func(ctx context.Context, arg database.CreateIngredientParams)) *MockStore_CreateIngredient_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.CreateIngredientParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateIngredient_Call) Return(_a0 database.Ingredient, _a1 error) *MockStore_CreateIngredient_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateIngredient_Call) RunAndReturn(run func(context.Context, database.CreateIngredientParams) (database.Ingredient, error)) *MockStore_CreateIngredient_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateIngredientPackage provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateIngredientPackage(ctx context.Context, arg database.CreateIngredientPackageParams) (database.IngredientPackage, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateIngredientPackage")
+	}
+
+	var r0 database.IngredientPackage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.CreateIngredientPackageParams) (database.IngredientPackage, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.CreateIngredientPackageParams) database.IngredientPackage); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(database.IngredientPackage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.CreateIngredientPackageParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateIngredientPackage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateIngredientPackage'
+type MockStore_CreateIngredientPackage_Call struct {
+	*mock.Call
+}
+
+// CreateIngredientPackage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.CreateIngredientPackageParams
+func (_e *MockStore_Expecter) CreateIngredientPackage(ctx interface{}, arg interface{}) *MockStore_CreateIngredientPackage_Call {
+	return &MockStore_CreateIngredientPackage_Call{Call: _e.mock.On("CreateIngredientPackage", ctx, arg)}
+}
+
+func (_c *MockStore_CreateIngredientPackage_Call) Run(run func(ctx context.Context, arg database.CreateIngredientPackageParams)) *MockStore_CreateIngredientPackage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.CreateIngredientPackageParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateIngredientPackage_Call) Return(_a0 database.IngredientPackage, _a1 error) *MockStore_CreateIngredientPackage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateIngredientPackage_Call) RunAndReturn(run func(context.Context, database.CreateIngredientPackageParams) (database.IngredientPackage, error)) *MockStore_CreateIngredientPackage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateInventoryItem provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateInventoryItem(ctx context.Context, arg database.CreateInventoryItemParams) (database.InventoryItem, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateInventoryItem")
+	}
+
+	var r0 database.InventoryItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.CreateInventoryItemParams) (database.InventoryItem, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.CreateInventoryItemParams) database.InventoryItem); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(database.InventoryItem)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.CreateInventoryItemParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateInventoryItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateInventoryItem'
+type MockStore_CreateInventoryItem_Call struct {
+	*mock.Call
+}
+
+// CreateInventoryItem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.CreateInventoryItemParams
+func (_e *MockStore_Expecter) CreateInventoryItem(ctx interface{}, arg interface{}) *MockStore_CreateInventoryItem_Call {
+	return &MockStore_CreateInventoryItem_Call{Call: _e.mock.On("CreateInventoryItem", ctx, arg)}
+}
+
+func (_c *MockStore_CreateInventoryItem_Call) Run(run func(ctx context.Context, arg database.CreateInventoryItemParams)) *MockStore_CreateInventoryItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.CreateInventoryItemParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateInventoryItem_Call) Return(_a0 database.InventoryItem, _a1 error) *MockStore_CreateInventoryItem_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateInventoryItem_Call) RunAndReturn(run func(context.Context, database.CreateInventoryItemParams) (database.InventoryItem, error)) *MockStore_CreateInventoryItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateMealAttendance provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateMealAttendance(ctx context.Context, arg database.CreateMealAttendanceParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateMealAttendance")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.CreateMealAttendanceParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_CreateMealAttendance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMealAttendance'
+type MockStore_CreateMealAttendance_Call struct {
+	*mock.Call
+}
+
+// CreateMealAttendance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.CreateMealAttendanceParams
+func (_e *MockStore_Expecter) CreateMealAttendance(ctx interface{}, arg interface{}) *MockStore_CreateMealAttendance_Call {
+	return &MockStore_CreateMealAttendance_Call{Call: _e.mock.On("CreateMealAttendance", ctx, arg)}
+}
+
+func (_c *MockStore_CreateMealAttendance_Call) Run(run func(ctx context.Context, arg database.CreateMealAttendanceParams)) *MockStore_CreateMealAttendance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.CreateMealAttendanceParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateMealAttendance_Call) Return(_a0 error) *MockStore_CreateMealAttendance_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_CreateMealAttendance_Call) RunAndReturn(run func(context.Context, database.CreateMealAttendanceParams) error) *MockStore_CreateMealAttendance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateMealPlan provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateMealPlan(ctx context.Context, arg database.CreateMealPlanParams) (database.MealPlan, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateMealPlan")
+	}
+
+	var r0 database.MealPlan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.CreateMealPlanParams) (database.MealPlan, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.CreateMealPlanParams) database.MealPlan); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(database.MealPlan)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.CreateMealPlanParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateMealPlan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMealPlan'
+type MockStore_CreateMealPlan_Call struct {
+	*mock.Call
+}
+
+// CreateMealPlan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.CreateMealPlanParams
+func (_e *MockStore_Expecter) CreateMealPlan(ctx interface{}, arg interface{}) *MockStore_CreateMealPlan_Call {
+	return &MockStore_CreateMealPlan_Call{Call: _e.mock.On("CreateMealPlan", ctx, arg)}
+}
+
+func (_c *MockStore_CreateMealPlan_Call) Run(run func(ctx context.Context, arg database.CreateMealPlanParams)) *MockStore_CreateMealPlan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.CreateMealPlanParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateMealPlan_Call) Return(_a0 database.MealPlan, _a1 error) *MockStore_CreateMealPlan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateMealPlan_Call) RunAndReturn(run func(context.Context, database.CreateMealPlanParams) (database.MealPlan, error)) *MockStore_CreateMealPlan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateMealPlanEntry provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateMealPlanEntry(ctx context.Context, arg database.CreateMealPlanEntryParams) (database.MealPlanEntry, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateMealPlanEntry")
+	}
+
+	var r0 database.MealPlanEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.CreateMealPlanEntryParams) (database.MealPlanEntry, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.CreateMealPlanEntryParams) database.MealPlanEntry); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(database.MealPlanEntry)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.CreateMealPlanEntryParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateMealPlanEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMealPlanEntry'
+type MockStore_CreateMealPlanEntry_Call struct {
+	*mock.Call
+}
+
+// CreateMealPlanEntry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.CreateMealPlanEntryParams
+func (_e *MockStore_Expecter) CreateMealPlanEntry(ctx interface{}, arg interface{}) *MockStore_CreateMealPlanEntry_Call {
+	return &MockStore_CreateMealPlanEntry_Call{Call: _e.mock.On("CreateMealPlanEntry", ctx, arg)}
+}
+
+func (_c *MockStore_CreateMealPlanEntry_Call) Run(run func(ctx context.Context, arg database.CreateMealPlanEntryParams)) *MockStore_CreateMealPlanEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.CreateMealPlanEntryParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateMealPlanEntry_Call) Return(_a0 database.MealPlanEntry, _a1 error) *MockStore_CreateMealPlanEntry_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateMealPlanEntry_Call) RunAndReturn(run func(context.Context, database.CreateMealPlanEntryParams) (database.MealPlanEntry, error)) *MockStore_CreateMealPlanEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateMealPlanTemplate provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateMealPlanTemplate(ctx context.Context, arg database.CreateMealPlanTemplateParams) (database.MealPlanTemplate, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateMealPlanTemplate")
+	}
+
+	var r0 database.MealPlanTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.CreateMealPlanTemplateParams) (database.MealPlanTemplate, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.CreateMealPlanTemplateParams) database.MealPlanTemplate); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(database.MealPlanTemplate)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.CreateMealPlanTemplateParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateMealPlanTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMealPlanTemplate'
+type MockStore_CreateMealPlanTemplate_Call struct {
+	*mock.Call
+}
+
+// CreateMealPlanTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.CreateMealPlanTemplateParams
+func (_e *MockStore_Expecter) CreateMealPlanTemplate(ctx interface{}, arg interface{}) *MockStore_CreateMealPlanTemplate_Call {
+	return &MockStore_CreateMealPlanTemplate_Call{Call: _e.mock.On("CreateMealPlanTemplate", ctx, arg)}
+}
+
+func (_c *MockStore_CreateMealPlanTemplate_Call) Run(run func(ctx context.Context, arg database.CreateMealPlanTemplateParams)) *MockStore_CreateMealPlanTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.CreateMealPlanTemplateParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateMealPlanTemplate_Call) Return(_a0 database.MealPlanTemplate, _a1 error) *MockStore_CreateMealPlanTemplate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateMealPlanTemplate_Call) RunAndReturn(run func(context.Context, database.CreateMealPlanTemplateParams) (database.MealPlanTemplate, error)) *MockStore_CreateMealPlanTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateMealPlanTemplateEntry provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateMealPlanTemplateEntry(ctx context.Context, arg database.CreateMealPlanTemplateEntryParams) (database.MealPlanTemplateEntry, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateMealPlanTemplateEntry")
+	}
+
+	var r0 database.MealPlanTemplateEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.CreateMealPlanTemplateEntryParams) (database.MealPlanTemplateEntry, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.CreateMealPlanTemplateEntryParams) database.MealPlanTemplateEntry); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(database.MealPlanTemplateEntry)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.CreateMealPlanTemplateEntryParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateMealPlanTemplateEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMealPlanTemplateEntry'
+type MockStore_CreateMealPlanTemplateEntry_Call struct {
+	*mock.Call
+}
+
+// CreateMealPlanTemplateEntry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.CreateMealPlanTemplateEntryParams
+func (_e *MockStore_Expecter) CreateMealPlanTemplateEntry(ctx interface{}, arg interface{}) *MockStore_CreateMealPlanTemplateEntry_Call {
+	return &MockStore_CreateMealPlanTemplateEntry_Call{Call: _e.mock.On("CreateMealPlanTemplateEntry", ctx, arg)}
+}
+
+func (_c *MockStore_CreateMealPlanTemplateEntry_Call) Run(run func(ctx context.Context, arg database.CreateMealPlanTemplateEntryParams)) *MockStore_CreateMealPlanTemplateEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.CreateMealPlanTemplateEntryParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateMealPlanTemplateEntry_Call) Return(_a0 database.MealPlanTemplateEntry, _a1 error) *MockStore_CreateMealPlanTemplateEntry_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateMealPlanTemplateEntry_Call) RunAndReturn(run func(context.Context, database.CreateMealPlanTemplateEntryParams) (database.MealPlanTemplateEntry, error)) *MockStore_CreateMealPlanTemplateEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateMealPlanTemplateTx provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateMealPlanTemplateTx(ctx context.Context, arg database.CreateMealPlanTemplateTxParams) (database.MealPlanTemplate, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateMealPlanTemplateTx")
+	}
+
+	var r0 database.MealPlanTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.CreateMealPlanTemplateTxParams) (database.MealPlanTemplate, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.CreateMealPlanTemplateTxParams) database.MealPlanTemplate); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(database.MealPlanTemplate)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.CreateMealPlanTemplateTxParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateMealPlanTemplateTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMealPlanTemplateTx'
+type MockStore_CreateMealPlanTemplateTx_Call struct {
+	*mock.Call
+}
+
+// CreateMealPlanTemplateTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.CreateMealPlanTemplateTxParams
+func (_e *MockStore_Expecter) CreateMealPlanTemplateTx(ctx interface{}, arg interface{}) *MockStore_CreateMealPlanTemplateTx_Call {
+	return &MockStore_CreateMealPlanTemplateTx_Call{Call: _e.mock.On("CreateMealPlanTemplateTx", ctx, arg)}
+}
+
+func (_c *MockStore_CreateMealPlanTemplateTx_Call) Run(run func(ctx context.Context, arg database.CreateMealPlanTemplateTxParams)) *MockStore_CreateMealPlanTemplateTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.CreateMealPlanTemplateTxParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateMealPlanTemplateTx_Call) Return(_a0 database.MealPlanTemplate, _a1 error) *MockStore_CreateMealPlanTemplateTx_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateMealPlanTemplateTx_Call) RunAndReturn(run func(context.Context, database.CreateMealPlanTemplateTxParams) (database.MealPlanTemplate, error)) *MockStore_CreateMealPlanTemplateTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateMemberMealAbsence provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateMemberMealAbsence(ctx context.Context, arg database.CreateMemberMealAbsenceParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateMemberMealAbsence")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.CreateMemberMealAbsenceParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_CreateMemberMealAbsence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMemberMealAbsence'
+type MockStore_CreateMemberMealAbsence_Call struct {
+	*mock.Call
+}
+
+// CreateMemberMealAbsence is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.CreateMemberMealAbsenceParams
+func (_e *MockStore_Expecter) CreateMemberMealAbsence(ctx interface{}, arg interface{}) *MockStore_CreateMemberMealAbsence_Call {
+	return &MockStore_CreateMemberMealAbsence_Call{Call: _e.mock.On("CreateMemberMealAbsence", ctx, arg)}
+}
+
+func (_c *MockStore_CreateMemberMealAbsence_Call) Run(run func(ctx context.Context, arg database.CreateMemberMealAbsenceParams)) *MockStore_CreateMemberMealAbsence_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.CreateMemberMealAbsenceParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateMemberMealAbsence_Call) Return(_a0 error) *MockStore_CreateMemberMealAbsence_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_CreateMemberMealAbsence_Call) RunAndReturn(run func(context.Context, database.CreateMemberMealAbsenceParams) error) *MockStore_CreateMemberMealAbsence_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateRecipe provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateRecipe(ctx context.Context, arg database.CreateRecipeParams) (database.Recipe, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRecipe")
+	}
+
+	var r0 database.Recipe
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.CreateRecipeParams) (database.Recipe, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.CreateRecipeParams) database.Recipe); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(database.Recipe)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.CreateRecipeParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateRecipe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRecipe'
+type MockStore_CreateRecipe_Call struct {
+	*mock.Call
+}
+
+// CreateRecipe is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.CreateRecipeParams
+func (_e *MockStore_Expecter) CreateRecipe(ctx interface{}, arg interface{}) *MockStore_CreateRecipe_Call {
+	return &MockStore_CreateRecipe_Call{Call: _e.mock.On("CreateRecipe", ctx, arg)}
+}
+
+func (_c *MockStore_CreateRecipe_Call) Run(run func(ctx context.Context, arg database.CreateRecipeParams)) *MockStore_CreateRecipe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.CreateRecipeParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateRecipe_Call) Return(_a0 database.Recipe, _a1 error) *MockStore_CreateRecipe_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateRecipe_Call) RunAndReturn(run func(context.Context, database.CreateRecipeParams) (database.Recipe, error)) *MockStore_CreateRecipe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateShoppingList provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateShoppingList(ctx context.Context, arg database.CreateShoppingListParams) (database.ShoppingList, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateShoppingList")
+	}
+
+	var r0 database.ShoppingList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.CreateShoppingListParams) (database.ShoppingList, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.CreateShoppingListParams) database.ShoppingList); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(database.ShoppingList)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.CreateShoppingListParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateShoppingList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateShoppingList'
+type MockStore_CreateShoppingList_Call struct {
+	*mock.Call
+}
+
+// CreateShoppingList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.CreateShoppingListParams
+func (_e *MockStore_Expecter) CreateShoppingList(ctx interface{}, arg interface{}) *MockStore_CreateShoppingList_Call {
+	return &MockStore_CreateShoppingList_Call{Call: _e.mock.On("CreateShoppingList", ctx, arg)}
+}
+
+func (_c *MockStore_CreateShoppingList_Call) Run(run func(ctx context.Context, arg database.CreateShoppingListParams)) *MockStore_CreateShoppingList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.CreateShoppingListParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateShoppingList_Call) Return(_a0 database.ShoppingList, _a1 error) *MockStore_CreateShoppingList_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateShoppingList_Call) RunAndReturn(run func(context.Context, database.CreateShoppingListParams) (database.ShoppingList, error)) *MockStore_CreateShoppingList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateShoppingListItem provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateShoppingListItem(ctx context.Context, arg database.CreateShoppingListItemParams) (database.ShoppingListItem, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateShoppingListItem")
+	}
+
+	var r0 database.ShoppingListItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.CreateShoppingListItemParams) (database.ShoppingListItem, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.CreateShoppingListItemParams) database.ShoppingListItem); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(database.ShoppingListItem)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.CreateShoppingListItemParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateShoppingListItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateShoppingListItem'
+type MockStore_CreateShoppingListItem_Call struct {
+	*mock.Call
+}
+
+// CreateShoppingListItem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.CreateShoppingListItemParams
+func (_e *MockStore_Expecter) CreateShoppingListItem(ctx interface{}, arg interface{}) *MockStore_CreateShoppingListItem_Call {
+	return &MockStore_CreateShoppingListItem_Call{Call: _e.mock.On("CreateShoppingListItem", ctx, arg)}
+}
+
+func (_c *MockStore_CreateShoppingListItem_Call) Run(run func(ctx context.Context, arg database.CreateShoppingListItemParams)) *MockStore_CreateShoppingListItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.CreateShoppingListItemParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateShoppingListItem_Call) Return(_a0 database.ShoppingListItem, _a1 error) *MockStore_CreateShoppingListItem_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateShoppingListItem_Call) RunAndReturn(run func(context.Context, database.CreateShoppingListItemParams) (database.ShoppingListItem, error)) *MockStore_CreateShoppingListItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateStaple provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateStaple(ctx context.Context, arg database.CreateStapleParams) (database.Staple, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateStaple")
+	}
+
+	var r0 database.Staple
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.CreateStapleParams) (database.Staple, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.CreateStapleParams) database.Staple); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(database.Staple)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.CreateStapleParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateStaple_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateStaple'
+type MockStore_CreateStaple_Call struct {
+	*mock.Call
+}
+
+// CreateStaple is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.CreateStapleParams
+func (_e *MockStore_Expecter) CreateStaple(ctx interface{}, arg interface{}) *MockStore_CreateStaple_Call {
+	return &MockStore_CreateStaple_Call{Call: _e.mock.On("CreateStaple", ctx, arg)}
+}
+
+func (_c *MockStore_CreateStaple_Call) Run(run func(ctx context.Context, arg database.CreateStapleParams)) *MockStore_CreateStaple_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.CreateStapleParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateStaple_Call) Return(_a0 database.Staple, _a1 error) *MockStore_CreateStaple_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateStaple_Call) RunAndReturn(run func(context.Context, database.CreateStapleParams) (database.Staple, error)) *MockStore_CreateStaple_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateStoreLayout provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateStoreLayout(ctx context.Context, arg database.CreateStoreLayoutParams) (database.StoreLayout, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateStoreLayout")
+	}
+
+	var r0 database.StoreLayout
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.CreateStoreLayoutParams) (database.StoreLayout, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.CreateStoreLayoutParams) database.StoreLayout); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(database.StoreLayout)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.CreateStoreLayoutParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateStoreLayout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateStoreLayout'
+type MockStore_CreateStoreLayout_Call struct {
+	*mock.Call
+}
+
+// CreateStoreLayout is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.CreateStoreLayoutParams
+func (_e *MockStore_Expecter) CreateStoreLayout(ctx interface{}, arg interface{}) *MockStore_CreateStoreLayout_Call {
+	return &MockStore_CreateStoreLayout_Call{Call: _e.mock.On("CreateStoreLayout", ctx, arg)}
+}
+
+func (_c *MockStore_CreateStoreLayout_Call) Run(run func(ctx context.Context, arg database.CreateStoreLayoutParams)) *MockStore_CreateStoreLayout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.CreateStoreLayoutParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateStoreLayout_Call) Return(_a0 database.StoreLayout, _a1 error) *MockStore_CreateStoreLayout_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateStoreLayout_Call) RunAndReturn(run func(context.Context, database.CreateStoreLayoutParams) (database.StoreLayout, error)) *MockStore_CreateStoreLayout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateUser provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateUser(ctx context.Context, arg database.CreateUserParams) (database.User, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateUser")
+	}
+
+	var r0 database.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.CreateUserParams) (database.User, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.CreateUserParams) database.User); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(database.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.CreateUserParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUser'
+type MockStore_CreateUser_Call struct {
+	*mock.Call
+}
+
+// CreateUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.CreateUserParams
+func (_e *MockStore_Expecter) CreateUser(ctx interface{}, arg interface{}) *MockStore_CreateUser_Call {
+	return &MockStore_CreateUser_Call{Call: _e.mock.On("CreateUser", ctx, arg)}
+}
+
+func (_c *MockStore_CreateUser_Call) Run(run func(ctx context.Context, arg database.CreateUserParams)) *MockStore_CreateUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.CreateUserParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateUser_Call) Return(_a0 database.User, _a1 error) *MockStore_CreateUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateUser_Call) RunAndReturn(run func(context.Context, database.CreateUserParams) (database.User, error)) *MockStore_CreateUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBusySlots provides a mock function with given fields: ctx, calendarID
+func (_m *MockStore) DeleteBusySlots(ctx context.Context, calendarID uuid.UUID) error {
+	ret := _m.Called(ctx, calendarID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBusySlots")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, calendarID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteBusySlots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBusySlots'
+type MockStore_DeleteBusySlots_Call struct {
+	*mock.Call
+}
+
+// DeleteBusySlots is a helper method to define mock.On call
+//   - ctx context.Context
+//   - calendarID uuid.UUID
+func (_e *MockStore_Expecter) DeleteBusySlots(ctx interface{}, calendarID interface{}) *MockStore_DeleteBusySlots_Call {
+	return &MockStore_DeleteBusySlots_Call{Call: _e.mock.On("DeleteBusySlots", ctx, calendarID)}
+}
+
+func (_c *MockStore_DeleteBusySlots_Call) Run(run func(ctx context.Context, calendarID uuid.UUID)) *MockStore_DeleteBusySlots_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteBusySlots_Call) Return(_a0 error) *MockStore_DeleteBusySlots_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteBusySlots_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_DeleteBusySlots_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteCalendarFeed provides a mock function with given fields: ctx, familyID
+func (_m *MockStore) DeleteCalendarFeed(ctx context.Context, familyID uuid.UUID) error {
+	ret := _m.Called(ctx, familyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCalendarFeed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, familyID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteCalendarFeed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCalendarFeed'
+type MockStore_DeleteCalendarFeed_Call struct {
+	*mock.Call
+}
+
+// DeleteCalendarFeed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - familyID uuid.UUID
+func (_e *MockStore_Expecter) DeleteCalendarFeed(ctx interface{}, familyID interface{}) *MockStore_DeleteCalendarFeed_Call {
+	return &MockStore_DeleteCalendarFeed_Call{Call: _e.mock.On("DeleteCalendarFeed", ctx, familyID)}
+}
+
+func (_c *MockStore_DeleteCalendarFeed_Call) Run(run func(ctx context.Context, familyID uuid.UUID)) *MockStore_DeleteCalendarFeed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteCalendarFeed_Call) Return(_a0 error) *MockStore_DeleteCalendarFeed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteCalendarFeed_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_DeleteCalendarFeed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteCollection provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeleteCollection(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCollection")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCollection'
+type MockStore_DeleteCollection_Call struct {
+	*mock.Call
+}
+
+// DeleteCollection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) DeleteCollection(ctx interface{}, id interface{}) *MockStore_DeleteCollection_Call {
+	return &MockStore_DeleteCollection_Call{Call: _e.mock.On("DeleteCollection", ctx, id)}
+}
+
+func (_c *MockStore_DeleteCollection_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_DeleteCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteCollection_Call) Return(_a0 error) *MockStore_DeleteCollection_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteCollection_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_DeleteCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteCookLog provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeleteCookLog(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCookLog")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteCookLog_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCookLog'
+type MockStore_DeleteCookLog_Call struct {
+	*mock.Call
+}
+
+// DeleteCookLog is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) DeleteCookLog(ctx interface{}, id interface{}) *MockStore_DeleteCookLog_Call {
+	return &MockStore_DeleteCookLog_Call{Call: _e.mock.On("DeleteCookLog", ctx, id)}
+}
+
+func (_c *MockStore_DeleteCookLog_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_DeleteCookLog_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteCookLog_Call) Return(_a0 error) *MockStore_DeleteCookLog_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteCookLog_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_DeleteCookLog_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteEvent provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeleteEvent(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteEvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteEvent'
+type MockStore_DeleteEvent_Call struct {
+	*mock.Call
+}
+
+// DeleteEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) DeleteEvent(ctx interface{}, id interface{}) *MockStore_DeleteEvent_Call {
+	return &MockStore_DeleteEvent_Call{Call: _e.mock.On("DeleteEvent", ctx, id)}
+}
+
+func (_c *MockStore_DeleteEvent_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_DeleteEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteEvent_Call) Return(_a0 error) *MockStore_DeleteEvent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteEvent_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_DeleteEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteFamily provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeleteFamily(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteFamily")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteFamily_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteFamily'
+type MockStore_DeleteFamily_Call struct {
+	*mock.Call
+}
+
+// DeleteFamily is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) DeleteFamily(ctx interface{}, id interface{}) *MockStore_DeleteFamily_Call {
+	return &MockStore_DeleteFamily_Call{Call: _e.mock.On("DeleteFamily", ctx, id)}
+}
+
+func (_c *MockStore_DeleteFamily_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_DeleteFamily_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteFamily_Call) Return(_a0 error) *MockStore_DeleteFamily_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteFamily_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_DeleteFamily_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteFamilyCalendar provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeleteFamilyCalendar(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteFamilyCalendar")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteFamilyCalendar_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteFamilyCalendar'
+type MockStore_DeleteFamilyCalendar_Call struct {
+	*mock.Call
+}
+
+// DeleteFamilyCalendar is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) DeleteFamilyCalendar(ctx interface{}, id interface{}) *MockStore_DeleteFamilyCalendar_Call {
+	return &MockStore_DeleteFamilyCalendar_Call{Call: _e.mock.On("DeleteFamilyCalendar", ctx, id)}
+}
+
+func (_c *MockStore_DeleteFamilyCalendar_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_DeleteFamilyCalendar_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteFamilyCalendar_Call) Return(_a0 error) *MockStore_DeleteFamilyCalendar_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteFamilyCalendar_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_DeleteFamilyCalendar_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteFamilyEquipment provides a mock function with given fields: ctx, familyID
+func (_m *MockStore) DeleteFamilyEquipment(ctx context.Context, familyID uuid.UUID) error {
+	ret := _m.Called(ctx, familyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteFamilyEquipment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, familyID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteFamilyEquipment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteFamilyEquipment'
+type MockStore_DeleteFamilyEquipment_Call struct {
+	*mock.Call
+}
+
+// DeleteFamilyEquipment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - familyID uuid.UUID
+func (_e *MockStore_Expecter) DeleteFamilyEquipment(ctx interface{}, familyID interface{}) *MockStore_DeleteFamilyEquipment_Call {
+	return &MockStore_DeleteFamilyEquipment_Call{Call: _e.mock.On("DeleteFamilyEquipment", ctx, familyID)}
+}
+
+func (_c *MockStore_DeleteFamilyEquipment_Call) Run(run func(ctx context.Context, familyID uuid.UUID)) *MockStore_DeleteFamilyEquipment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteFamilyEquipment_Call) Return(_a0 error) *MockStore_DeleteFamilyEquipment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteFamilyEquipment_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_DeleteFamilyEquipment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteGeneratedShoppingListItem provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeleteGeneratedShoppingListItem(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteGeneratedShoppingListItem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteGeneratedShoppingListItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteGeneratedShoppingListItem'
+type MockStore_DeleteGeneratedShoppingListItem_Call struct {
+	*mock.Call
+}
+
+// DeleteGeneratedShoppingListItem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) DeleteGeneratedShoppingListItem(ctx interface{}, id interface{}) *MockStore_DeleteGeneratedShoppingListItem_Call {
+	return &MockStore_DeleteGeneratedShoppingListItem_Call{Call: _e.mock.On("DeleteGeneratedShoppingListItem", ctx, id)}
+}
+
+func (_c *MockStore_DeleteGeneratedShoppingListItem_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_DeleteGeneratedShoppingListItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteGeneratedShoppingListItem_Call) Return(_a0 error) *MockStore_DeleteGeneratedShoppingListItem_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteGeneratedShoppingListItem_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_DeleteGeneratedShoppingListItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteIngredient provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeleteIngredient(ctx context.Context, id int32) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteIngredient")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteIngredient_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteIngredient'
+type MockStore_DeleteIngredient_Call struct {
+	*mock.Call
+}
+
+// DeleteIngredient is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockStore_Expecter) DeleteIngredient(ctx interface{}, id interface{}) *MockStore_DeleteIngredient_Call {
+	return &MockStore_DeleteIngredient_Call{Call: _e.mock.On("DeleteIngredient", ctx, id)}
+}
+
+func (_c *MockStore_DeleteIngredient_Call) Run(run func(ctx context.Context, id int32)) *MockStore_DeleteIngredient_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteIngredient_Call) Return(_a0 error) *MockStore_DeleteIngredient_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteIngredient_Call) RunAndReturn(run func(context.Context, int32) error) *MockStore_DeleteIngredient_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteIngredientPackage provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeleteIngredientPackage(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteIngredientPackage")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteIngredientPackage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteIngredientPackage'
+type MockStore_DeleteIngredientPackage_Call struct {
+	*mock.Call
+}
+
+// DeleteIngredientPackage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) DeleteIngredientPackage(ctx interface{}, id interface{}) *MockStore_DeleteIngredientPackage_Call {
+	return &MockStore_DeleteIngredientPackage_Call{Call: _e.mock.On("DeleteIngredientPackage", ctx, id)}
+}
+
+func (_c *MockStore_DeleteIngredientPackage_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_DeleteIngredientPackage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteIngredientPackage_Call) Return(_a0 error) *MockStore_DeleteIngredientPackage_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteIngredientPackage_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_DeleteIngredientPackage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteInventoryItem provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeleteInventoryItem(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteInventoryItem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteInventoryItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteInventoryItem'
+type MockStore_DeleteInventoryItem_Call struct {
+	*mock.Call
+}
+
+// DeleteInventoryItem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) DeleteInventoryItem(ctx interface{}, id interface{}) *MockStore_DeleteInventoryItem_Call {
+	return &MockStore_DeleteInventoryItem_Call{Call: _e.mock.On("DeleteInventoryItem", ctx, id)}
+}
+
+func (_c *MockStore_DeleteInventoryItem_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_DeleteInventoryItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteInventoryItem_Call) Return(_a0 error) *MockStore_DeleteInventoryItem_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteInventoryItem_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_DeleteInventoryItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteMealAttendance provides a mock function with given fields: ctx, arg
+func (_m *MockStore) DeleteMealAttendance(ctx context.Context, arg database.DeleteMealAttendanceParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMealAttendance")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.DeleteMealAttendanceParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteMealAttendance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMealAttendance'
+type MockStore_DeleteMealAttendance_Call struct {
+	*mock.Call
+}
+
+// DeleteMealAttendance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.DeleteMealAttendanceParams
+func (_e *MockStore_Expecter) DeleteMealAttendance(ctx interface{}, arg interface{}) *MockStore_DeleteMealAttendance_Call {
+	return &MockStore_DeleteMealAttendance_Call{Call: _e.mock.On("DeleteMealAttendance", ctx, arg)}
+}
+
+func (_c *MockStore_DeleteMealAttendance_Call) Run(run func(ctx context.Context, arg database.DeleteMealAttendanceParams)) *MockStore_DeleteMealAttendance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.DeleteMealAttendanceParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteMealAttendance_Call) Return(_a0 error) *MockStore_DeleteMealAttendance_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteMealAttendance_Call) RunAndReturn(run func(context.Context, database.DeleteMealAttendanceParams) error) *MockStore_DeleteMealAttendance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteMealPlan provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeleteMealPlan(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMealPlan")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteMealPlan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMealPlan'
+type MockStore_DeleteMealPlan_Call struct {
+	*mock.Call
+}
+
+// DeleteMealPlan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) DeleteMealPlan(ctx interface{}, id interface{}) *MockStore_DeleteMealPlan_Call {
+	return &MockStore_DeleteMealPlan_Call{Call: _e.mock.On("DeleteMealPlan", ctx, id)}
+}
+
+func (_c *MockStore_DeleteMealPlan_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_DeleteMealPlan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteMealPlan_Call) Return(_a0 error) *MockStore_DeleteMealPlan_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteMealPlan_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_DeleteMealPlan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteMealPlanEntry provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeleteMealPlanEntry(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMealPlanEntry")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteMealPlanEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMealPlanEntry'
+type MockStore_DeleteMealPlanEntry_Call struct {
+	*mock.Call
+}
+
+// DeleteMealPlanEntry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) DeleteMealPlanEntry(ctx interface{}, id interface{}) *MockStore_DeleteMealPlanEntry_Call {
+	return &MockStore_DeleteMealPlanEntry_Call{Call: _e.mock.On("DeleteMealPlanEntry", ctx, id)}
+}
+
+func (_c *MockStore_DeleteMealPlanEntry_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_DeleteMealPlanEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteMealPlanEntry_Call) Return(_a0 error) *MockStore_DeleteMealPlanEntry_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteMealPlanEntry_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_DeleteMealPlanEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteMealPlanRotation provides a mock function with given fields: ctx, familyID
+func (_m *MockStore) DeleteMealPlanRotation(ctx context.Context, familyID uuid.UUID) error {
+	ret := _m.Called(ctx, familyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMealPlanRotation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, familyID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteMealPlanRotation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMealPlanRotation'
+type MockStore_DeleteMealPlanRotation_Call struct {
+	*mock.Call
+}
+
+// DeleteMealPlanRotation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - familyID uuid.UUID
+func (_e *MockStore_Expecter) DeleteMealPlanRotation(ctx interface{}, familyID interface{}) *MockStore_DeleteMealPlanRotation_Call {
+	return &MockStore_DeleteMealPlanRotation_Call{Call: _e.mock.On("DeleteMealPlanRotation", ctx, familyID)}
+}
+
+func (_c *MockStore_DeleteMealPlanRotation_Call) Run(run func(ctx context.Context, familyID uuid.UUID)) *MockStore_DeleteMealPlanRotation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteMealPlanRotation_Call) Return(_a0 error) *MockStore_DeleteMealPlanRotation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteMealPlanRotation_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_DeleteMealPlanRotation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteMealPlanRotationTemplates provides a mock function with given fields: ctx, familyID
+func (_m *MockStore) DeleteMealPlanRotationTemplates(ctx context.Context, familyID uuid.UUID) error {
+	ret := _m.Called(ctx, familyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMealPlanRotationTemplates")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, familyID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteMealPlanRotationTemplates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMealPlanRotationTemplates'
+type MockStore_DeleteMealPlanRotationTemplates_Call struct {
+	*mock.Call
+}
+
+// DeleteMealPlanRotationTemplates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - familyID uuid.UUID
+func (_e *MockStore_Expecter) DeleteMealPlanRotationTemplates(ctx interface{}, familyID interface{}) *MockStore_DeleteMealPlanRotationTemplates_Call {
+	return &MockStore_DeleteMealPlanRotationTemplates_Call{Call: _e.mock.On("DeleteMealPlanRotationTemplates", ctx, familyID)}
+}
+
+func (_c *MockStore_DeleteMealPlanRotationTemplates_Call) Run(run func(ctx context.Context, familyID uuid.UUID)) *MockStore_DeleteMealPlanRotationTemplates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteMealPlanRotationTemplates_Call) Return(_a0 error) *MockStore_DeleteMealPlanRotationTemplates_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteMealPlanRotationTemplates_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_DeleteMealPlanRotationTemplates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteMealPlanTemplate provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeleteMealPlanTemplate(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMealPlanTemplate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteMealPlanTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMealPlanTemplate'
+type MockStore_DeleteMealPlanTemplate_Call struct {
+	*mock.Call
+}
+
+// DeleteMealPlanTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) DeleteMealPlanTemplate(ctx interface{}, id interface{}) *MockStore_DeleteMealPlanTemplate_Call {
+	return &MockStore_DeleteMealPlanTemplate_Call{Call: _e.mock.On("DeleteMealPlanTemplate", ctx, id)}
+}
+
+func (_c *MockStore_DeleteMealPlanTemplate_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_DeleteMealPlanTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteMealPlanTemplate_Call) Return(_a0 error) *MockStore_DeleteMealPlanTemplate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteMealPlanTemplate_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_DeleteMealPlanTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteMealPlanVote provides a mock function with given fields: ctx, arg
+func (_m *MockStore) DeleteMealPlanVote(ctx context.Context, arg database.DeleteMealPlanVoteParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMealPlanVote")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.DeleteMealPlanVoteParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteMealPlanVote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMealPlanVote'
+type MockStore_DeleteMealPlanVote_Call struct {
+	*mock.Call
+}
+
+// DeleteMealPlanVote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.DeleteMealPlanVoteParams
+func (_e *MockStore_Expecter) DeleteMealPlanVote(ctx interface{}, arg interface{}) *MockStore_DeleteMealPlanVote_Call {
+	return &MockStore_DeleteMealPlanVote_Call{Call: _e.mock.On("DeleteMealPlanVote", ctx, arg)}
+}
+
+func (_c *MockStore_DeleteMealPlanVote_Call) Run(run func(ctx context.Context, arg database.DeleteMealPlanVoteParams)) *MockStore_DeleteMealPlanVote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.DeleteMealPlanVoteParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteMealPlanVote_Call) Return(_a0 error) *MockStore_DeleteMealPlanVote_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteMealPlanVote_Call) RunAndReturn(run func(context.Context, database.DeleteMealPlanVoteParams) error) *MockStore_DeleteMealPlanVote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteMemberMealAbsences provides a mock function with given fields: ctx, userID
+func (_m *MockStore) DeleteMemberMealAbsences(ctx context.Context, userID uuid.UUID) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMemberMealAbsences")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteMemberMealAbsences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMemberMealAbsences'
+type MockStore_DeleteMemberMealAbsences_Call struct {
+	*mock.Call
+}
+
+// DeleteMemberMealAbsences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *MockStore_Expecter) DeleteMemberMealAbsences(ctx interface{}, userID interface{}) *MockStore_DeleteMemberMealAbsences_Call {
+	return &MockStore_DeleteMemberMealAbsences_Call{Call: _e.mock.On("DeleteMemberMealAbsences", ctx, userID)}
+}
+
+func (_c *MockStore_DeleteMemberMealAbsences_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockStore_DeleteMemberMealAbsences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteMemberMealAbsences_Call) Return(_a0 error) *MockStore_DeleteMemberMealAbsences_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteMemberMealAbsences_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_DeleteMemberMealAbsences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteRecipe provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeleteRecipe(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRecipe")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteRecipe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRecipe'
+type MockStore_DeleteRecipe_Call struct {
+	*mock.Call
+}
+
+// DeleteRecipe is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) DeleteRecipe(ctx interface{}, id interface{}) *MockStore_DeleteRecipe_Call {
+	return &MockStore_DeleteRecipe_Call{Call: _e.mock.On("DeleteRecipe", ctx, id)}
+}
+
+func (_c *MockStore_DeleteRecipe_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_DeleteRecipe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteRecipe_Call) Return(_a0 error) *MockStore_DeleteRecipe_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteRecipe_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_DeleteRecipe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteRecipeEquipment provides a mock function with given fields: ctx, recipeID
+func (_m *MockStore) DeleteRecipeEquipment(ctx context.Context, recipeID uuid.UUID) error {
+	ret := _m.Called(ctx, recipeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRecipeEquipment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, recipeID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteRecipeEquipment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRecipeEquipment'
+type MockStore_DeleteRecipeEquipment_Call struct {
+	*mock.Call
+}
+
+// DeleteRecipeEquipment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - recipeID uuid.UUID
+func (_e *MockStore_Expecter) DeleteRecipeEquipment(ctx interface{}, recipeID interface{}) *MockStore_DeleteRecipeEquipment_Call {
+	return &MockStore_DeleteRecipeEquipment_Call{Call: _e.mock.On("DeleteRecipeEquipment", ctx, recipeID)}
+}
+
+func (_c *MockStore_DeleteRecipeEquipment_Call) Run(run func(ctx context.Context, recipeID uuid.UUID)) *MockStore_DeleteRecipeEquipment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteRecipeEquipment_Call) Return(_a0 error) *MockStore_DeleteRecipeEquipment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteRecipeEquipment_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_DeleteRecipeEquipment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteShoppingList provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeleteShoppingList(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteShoppingList")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteShoppingList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteShoppingList'
+type MockStore_DeleteShoppingList_Call struct {
+	*mock.Call
+}
+
+// DeleteShoppingList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) DeleteShoppingList(ctx interface{}, id interface{}) *MockStore_DeleteShoppingList_Call {
+	return &MockStore_DeleteShoppingList_Call{Call: _e.mock.On("DeleteShoppingList", ctx, id)}
+}
+
+func (_c *MockStore_DeleteShoppingList_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_DeleteShoppingList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteShoppingList_Call) Return(_a0 error) *MockStore_DeleteShoppingList_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteShoppingList_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_DeleteShoppingList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteShoppingListItem provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeleteShoppingListItem(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteShoppingListItem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteShoppingListItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteShoppingListItem'
+type MockStore_DeleteShoppingListItem_Call struct {
+	*mock.Call
+}
+
+// DeleteShoppingListItem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) DeleteShoppingListItem(ctx interface{}, id interface{}) *MockStore_DeleteShoppingListItem_Call {
+	return &MockStore_DeleteShoppingListItem_Call{Call: _e.mock.On("DeleteShoppingListItem", ctx, id)}
+}
+
+func (_c *MockStore_DeleteShoppingListItem_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_DeleteShoppingListItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteShoppingListItem_Call) Return(_a0 error) *MockStore_DeleteShoppingListItem_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteShoppingListItem_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_DeleteShoppingListItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteStaple provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeleteStaple(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteStaple")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteStaple_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteStaple'
+type MockStore_DeleteStaple_Call struct {
+	*mock.Call
+}
+
+// DeleteStaple is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) DeleteStaple(ctx interface{}, id interface{}) *MockStore_DeleteStaple_Call {
+	return &MockStore_DeleteStaple_Call{Call: _e.mock.On("DeleteStaple", ctx, id)}
+}
+
+func (_c *MockStore_DeleteStaple_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_DeleteStaple_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteStaple_Call) Return(_a0 error) *MockStore_DeleteStaple_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteStaple_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_DeleteStaple_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteStoreLayout provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeleteStoreLayout(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteStoreLayout")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteStoreLayout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteStoreLayout'
+type MockStore_DeleteStoreLayout_Call struct {
+	*mock.Call
+}
+
+// DeleteStoreLayout is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) DeleteStoreLayout(ctx interface{}, id interface{}) *MockStore_DeleteStoreLayout_Call {
+	return &MockStore_DeleteStoreLayout_Call{Call: _e.mock.On("DeleteStoreLayout", ctx, id)}
+}
+
+func (_c *MockStore_DeleteStoreLayout_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_DeleteStoreLayout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteStoreLayout_Call) Return(_a0 error) *MockStore_DeleteStoreLayout_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteStoreLayout_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_DeleteStoreLayout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUnlockedMealPlanEntries provides a mock function with given fields: ctx, mealPlanID
+func (_m *MockStore) DeleteUnlockedMealPlanEntries(ctx context.Context, mealPlanID uuid.UUID) error {
+	ret := _m.Called(ctx, mealPlanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUnlockedMealPlanEntries")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, mealPlanID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteUnlockedMealPlanEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUnlockedMealPlanEntries'
+type MockStore_DeleteUnlockedMealPlanEntries_Call struct {
+	*mock.Call
+}
+
+// DeleteUnlockedMealPlanEntries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mealPlanID uuid.UUID
+func (_e *MockStore_Expecter) DeleteUnlockedMealPlanEntries(ctx interface{}, mealPlanID interface{}) *MockStore_DeleteUnlockedMealPlanEntries_Call {
+	return &MockStore_DeleteUnlockedMealPlanEntries_Call{Call: _e.mock.On("DeleteUnlockedMealPlanEntries", ctx, mealPlanID)}
+}
+
+func (_c *MockStore_DeleteUnlockedMealPlanEntries_Call) Run(run func(ctx context.Context, mealPlanID uuid.UUID)) *MockStore_DeleteUnlockedMealPlanEntries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteUnlockedMealPlanEntries_Call) Return(_a0 error) *MockStore_DeleteUnlockedMealPlanEntries_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteUnlockedMealPlanEntries_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_DeleteUnlockedMealPlanEntries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUser provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeleteUser(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUser'
+type MockStore_DeleteUser_Call struct {
+	*mock.Call
+}
+
+// DeleteUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) DeleteUser(ctx interface{}, id interface{}) *MockStore_DeleteUser_Call {
+	return &MockStore_DeleteUser_Call{Call: _e.mock.On("DeleteUser", ctx, id)}
+}
+
+func (_c *MockStore_DeleteUser_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_DeleteUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteUser_Call) Return(_a0 error) *MockStore_DeleteUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteUser_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_DeleteUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DismissShoppingListItem provides a mock function with given fields: ctx, id
+func (_m *MockStore) DismissShoppingListItem(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DismissShoppingListItem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DismissShoppingListItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DismissShoppingListItem'
+type MockStore_DismissShoppingListItem_Call struct {
+	*mock.Call
+}
+
+// DismissShoppingListItem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) DismissShoppingListItem(ctx interface{}, id interface{}) *MockStore_DismissShoppingListItem_Call {
+	return &MockStore_DismissShoppingListItem_Call{Call: _e.mock.On("DismissShoppingListItem", ctx, id)}
+}
+
+func (_c *MockStore_DismissShoppingListItem_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_DismissShoppingListItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DismissShoppingListItem_Call) Return(_a0 error) *MockStore_DismissShoppingListItem_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DismissShoppingListItem_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_DismissShoppingListItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FilterRecipesByFamilyID provides a mock function with given fields: ctx, arg
+func (_m *MockStore) FilterRecipesByFamilyID(ctx context.Context, arg database.FilterRecipesByFamilyIDParams) ([]database.Recipe, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FilterRecipesByFamilyID")
+	}
+
+	var r0 []database.Recipe
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.FilterRecipesByFamilyIDParams) ([]database.Recipe, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.FilterRecipesByFamilyIDParams) []database.Recipe); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]database.Recipe)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.FilterRecipesByFamilyIDParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_FilterRecipesByFamilyID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FilterRecipesByFamilyID'
+type MockStore_FilterRecipesByFamilyID_Call struct {
+	*mock.Call
+}
+
+// FilterRecipesByFamilyID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.FilterRecipesByFamilyIDParams
+func (_e *MockStore_Expecter) FilterRecipesByFamilyID(ctx interface{}, arg interface{}) *MockStore_FilterRecipesByFamilyID_Call {
+	return &MockStore_FilterRecipesByFamilyID_Call{Call: _e.mock.On("FilterRecipesByFamilyID", ctx, arg)}
+}
+
+func (_c *MockStore_FilterRecipesByFamilyID_Call) Run(run func(ctx context.Context, arg database.FilterRecipesByFamilyIDParams)) *MockStore_FilterRecipesByFamilyID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.FilterRecipesByFamilyIDParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_FilterRecipesByFamilyID_Call) Return(_a0 []database.Recipe, _a1 error) *MockStore_FilterRecipesByFamilyID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_FilterRecipesByFamilyID_Call) RunAndReturn(run func(context.Context, database.FilterRecipesByFamilyIDParams) ([]database.Recipe, error)) *MockStore_FilterRecipesByFamilyID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FinalizeMealPlan provides a mock function with given fields: ctx, id
+func (_m *MockStore) FinalizeMealPlan(ctx context.Context, id uuid.UUID) (database.MealPlan, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FinalizeMealPlan")
+	}
+
+	var r0 database.MealPlan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (database.MealPlan, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) database.MealPlan); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(database.MealPlan)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_FinalizeMealPlan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FinalizeMealPlan'
+type MockStore_FinalizeMealPlan_Call struct {
+	*mock.Call
+}
+
+// FinalizeMealPlan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) FinalizeMealPlan(ctx interface{}, id interface{}) *MockStore_FinalizeMealPlan_Call {
+	return &MockStore_FinalizeMealPlan_Call{Call: _e.mock.On("FinalizeMealPlan", ctx, id)}
+}
+
+func (_c *MockStore_FinalizeMealPlan_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_FinalizeMealPlan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_FinalizeMealPlan_Call) Return(_a0 database.MealPlan, _a1 error) *MockStore_FinalizeMealPlan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_FinalizeMealPlan_Call) RunAndReturn(run func(context.Context, uuid.UUID) (database.MealPlan, error)) *MockStore_FinalizeMealPlan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FinalizeMealPlanTx provides a mock function with given fields: ctx, arg
+func (_m *MockStore) FinalizeMealPlanTx(ctx context.Context, arg database.FinalizeMealPlanTxParams) (database.MealPlan, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FinalizeMealPlanTx")
+	}
+
+	var r0 database.MealPlan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.FinalizeMealPlanTxParams) (database.MealPlan, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.FinalizeMealPlanTxParams) database.MealPlan); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(database.MealPlan)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.FinalizeMealPlanTxParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_FinalizeMealPlanTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FinalizeMealPlanTx'
+type MockStore_FinalizeMealPlanTx_Call struct {
+	*mock.Call
+}
+
+// FinalizeMealPlanTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.FinalizeMealPlanTxParams
+func (_e *MockStore_Expecter) FinalizeMealPlanTx(ctx interface{}, arg interface{}) *MockStore_FinalizeMealPlanTx_Call {
+	return &MockStore_FinalizeMealPlanTx_Call{Call: _e.mock.On("FinalizeMealPlanTx", ctx, arg)}
+}
+
+func (_c *MockStore_FinalizeMealPlanTx_Call) Run(run func(ctx context.Context, arg database.FinalizeMealPlanTxParams)) *MockStore_FinalizeMealPlanTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.FinalizeMealPlanTxParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_FinalizeMealPlanTx_Call) Return(_a0 database.MealPlan, _a1 error) *MockStore_FinalizeMealPlanTx_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_FinalizeMealPlanTx_Call) RunAndReturn(run func(context.Context, database.FinalizeMealPlanTxParams) (database.MealPlan, error)) *MockStore_FinalizeMealPlanTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GenerateShoppingListTx provides a mock function with given fields: ctx, arg
+func (_m *MockStore) GenerateShoppingListTx(ctx context.Context, arg database.GenerateShoppingListTxParams) (database.GenerateShoppingListTxResult, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateShoppingListTx")
+	}
+
+	var r0 database.GenerateShoppingListTxResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.GenerateShoppingListTxParams) (database.GenerateShoppingListTxResult, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.GenerateShoppingListTxParams) database.GenerateShoppingListTxResult); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(database.GenerateShoppingListTxResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.GenerateShoppingListTxParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GenerateShoppingListTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateShoppingListTx'
+type MockStore_GenerateShoppingListTx_Call struct {
+	*mock.Call
+}
+
+// GenerateShoppingListTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.GenerateShoppingListTxParams
+func (_e *MockStore_Expecter) GenerateShoppingListTx(ctx interface{}, arg interface{}) *MockStore_GenerateShoppingListTx_Call {
+	return &MockStore_GenerateShoppingListTx_Call{Call: _e.mock.On("GenerateShoppingListTx", ctx, arg)}
+}
+
+func (_c *MockStore_GenerateShoppingListTx_Call) Run(run func(ctx context.Context, arg database.GenerateShoppingListTxParams)) *MockStore_GenerateShoppingListTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.GenerateShoppingListTxParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_GenerateShoppingListTx_Call) Return(_a0 database.GenerateShoppingListTxResult, _a1 error) *MockStore_GenerateShoppingListTx_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GenerateShoppingListTx_Call) RunAndReturn(run func(context.Context, database.GenerateShoppingListTxParams) (database.GenerateShoppingListTxResult, error)) *MockStore_GenerateShoppingListTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllShoppingListItems provides a mock function with given fields: ctx, shoppingListID
+func (_m *MockStore) GetAllShoppingListItems(ctx context.Context, shoppingListID uuid.UUID) ([]database.ShoppingListItem, error) {
+	ret := _m.Called(ctx, shoppingListID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllShoppingListItems")
+	}
+
+	var r0 []database.ShoppingListItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]database.ShoppingListItem, error)); ok {
+		return rf(ctx, shoppingListID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []database.ShoppingListItem); ok {
+		r0 = rf(ctx, shoppingListID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]database.ShoppingListItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, shoppingListID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetAllShoppingListItems_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllShoppingListItems'
+type MockStore_GetAllShoppingListItems_Call struct {
+	*mock.Call
+}
+
+// GetAllShoppingListItems is a helper method to define mock.On call
+//   - ctx context.Context
+//   - shoppingListID uuid.UUID
+func (_e *MockStore_Expecter) GetAllShoppingListItems(ctx interface{}, shoppingListID interface{}) *MockStore_GetAllShoppingListItems_Call {
+	return &MockStore_GetAllShoppingListItems_Call{Call: _e.mock.On("GetAllShoppingListItems", ctx, shoppingListID)}
+}
+
+func (_c *MockStore_GetAllShoppingListItems_Call) Run(run func(ctx context.Context, shoppingListID uuid.UUID)) *MockStore_GetAllShoppingListItems_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetAllShoppingListItems_Call) Return(_a0 []database.ShoppingListItem, _a1 error) *MockStore_GetAllShoppingListItems_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetAllShoppingListItems_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]database.ShoppingListItem, error)) *MockStore_GetAllShoppingListItems_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAutoServingsMealsByFamilyID provides a mock function with given fields: ctx, arg
+func (_m *MockStore) GetAutoServingsMealsByFamilyID(ctx context.Context, arg database.GetAutoServingsMealsByFamilyIDParams) ([]database.GetAutoServingsMealsByFamilyIDRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAutoServingsMealsByFamilyID")
+	}
+
+	var r0 []database.GetAutoServingsMealsByFamilyIDRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.GetAutoServingsMealsByFamilyIDParams) ([]database.GetAutoServingsMealsByFamilyIDRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.GetAutoServingsMealsByFamilyIDParams) []database.GetAutoServingsMealsByFamilyIDRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]database.GetAutoServingsMealsByFamilyIDRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.GetAutoServingsMealsByFamilyIDParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetAutoServingsMealsByFamilyID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAutoServingsMealsByFamilyID'
+type MockStore_GetAutoServingsMealsByFamilyID_Call struct {
+	*mock.Call
+}
+
+// GetAutoServingsMealsByFamilyID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.GetAutoServingsMealsByFamilyIDParams
+func (_e *MockStore_Expecter) GetAutoServingsMealsByFamilyID(ctx interface{}, arg interface{}) *MockStore_GetAutoServingsMealsByFamilyID_Call {
+	return &MockStore_GetAutoServingsMealsByFamilyID_Call{Call: _e.mock.On("GetAutoServingsMealsByFamilyID", ctx, arg)}
+}
+
+func (_c *MockStore_GetAutoServingsMealsByFamilyID_Call) Run(run func(ctx context.Context, arg database.GetAutoServingsMealsByFamilyIDParams)) *MockStore_GetAutoServingsMealsByFamilyID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.GetAutoServingsMealsByFamilyIDParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetAutoServingsMealsByFamilyID_Call) Return(_a0 []database.GetAutoServingsMealsByFamilyIDRow, _a1 error) *MockStore_GetAutoServingsMealsByFamilyID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetAutoServingsMealsByFamilyID_Call) RunAndReturn(run func(context.Context, database.GetAutoServingsMealsByFamilyIDParams) ([]database.GetAutoServingsMealsByFamilyIDRow, error)) *MockStore_GetAutoServingsMealsByFamilyID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBusySlotsByFamilyID provides a mock function with given fields: ctx, arg
+func (_m *MockStore) GetBusySlotsByFamilyID(ctx context.Context, arg database.GetBusySlotsByFamilyIDParams) ([]database.BusySlot, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBusySlotsByFamilyID")
+	}
+
+	var r0 []database.BusySlot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.GetBusySlotsByFamilyIDParams) ([]database.BusySlot, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.GetBusySlotsByFamilyIDParams) []database.BusySlot); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]database.BusySlot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.GetBusySlotsByFamilyIDParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetBusySlotsByFamilyID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBusySlotsByFamilyID'
+type MockStore_GetBusySlotsByFamilyID_Call struct {
+	*mock.Call
+}
+
+// GetBusySlotsByFamilyID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.GetBusySlotsByFamilyIDParams
+func (_e *MockStore_Expecter) GetBusySlotsByFamilyID(ctx interface{}, arg interface{}) *MockStore_GetBusySlotsByFamilyID_Call {
+	return &MockStore_GetBusySlotsByFamilyID_Call{Call: _e.mock.On("GetBusySlotsByFamilyID", ctx, arg)}
+}
+
+func (_c *MockStore_GetBusySlotsByFamilyID_Call) Run(run func(ctx context.Context, arg database.GetBusySlotsByFamilyIDParams)) *MockStore_GetBusySlotsByFamilyID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.GetBusySlotsByFamilyIDParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetBusySlotsByFamilyID_Call) Return(_a0 []database.BusySlot, _a1 error) *MockStore_GetBusySlotsByFamilyID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetBusySlotsByFamilyID_Call) RunAndReturn(run func(context.Context, database.GetBusySlotsByFamilyIDParams) ([]database.BusySlot, error)) *MockStore_GetBusySlotsByFamilyID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCalendarEntriesByFamilyID provides a mock function with given fields: ctx, arg
+func (_m *MockStore) GetCalendarEntriesByFamilyID(ctx context.Context, arg database.GetCalendarEntriesByFamilyIDParams) ([]database.GetCalendarEntriesByFamilyIDRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCalendarEntriesByFamilyID")
+	}
+
+	var r0 []database.GetCalendarEntriesByFamilyIDRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.GetCalendarEntriesByFamilyIDParams) ([]database.GetCalendarEntriesByFamilyIDRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.GetCalendarEntriesByFamilyIDParams) []database.GetCalendarEntriesByFamilyIDRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]database.GetCalendarEntriesByFamilyIDRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.GetCalendarEntriesByFamilyIDParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetCalendarEntriesByFamilyID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCalendarEntriesByFamilyID'
+type MockStore_GetCalendarEntriesByFamilyID_Call struct {
+	*mock.Call
+}
+
+// GetCalendarEntriesByFamilyID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.GetCalendarEntriesByFamilyIDParams
+func (_e *MockStore_Expecter) GetCalendarEntriesByFamilyID(ctx interface{}, arg interface{}) *MockStore_GetCalendarEntriesByFamilyID_Call {
+	return &MockStore_GetCalendarEntriesByFamilyID_Call{Call: _e.mock.On("GetCalendarEntriesByFamilyID", ctx, arg)}
+}
+
+func (_c *MockStore_GetCalendarEntriesByFamilyID_Call) Run(run func(ctx context.Context, arg database.GetCalendarEntriesByFamilyIDParams)) *MockStore_GetCalendarEntriesByFamilyID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.GetCalendarEntriesByFamilyIDParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetCalendarEntriesByFamilyID_Call) Return(_a0 []database.GetCalendarEntriesByFamilyIDRow, _a1 error) *MockStore_GetCalendarEntriesByFamilyID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetCalendarEntriesByFamilyID_Call) RunAndReturn(run func(context.Context, database.GetCalendarEntriesByFamilyIDParams) ([]database.GetCalendarEntriesByFamilyIDRow, error)) *MockStore_GetCalendarEntriesByFamilyID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCalendarFeedByFamilyID provides a mock function with given fields: ctx, familyID
+func (_m *MockStore) GetCalendarFeedByFamilyID(ctx context.Context, familyID uuid.UUID) (database.CalendarFeed, error) {
+	ret := _m.Called(ctx, familyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCalendarFeedByFamilyID")
+	}
+
+	var r0 database.CalendarFeed
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (database.CalendarFeed, error)); ok {
+		return rf(ctx, familyID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) database.CalendarFeed); ok {
+		r0 = rf(ctx, familyID)
+	} else {
+		r0 = ret.Get(0).(database.CalendarFeed)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, familyID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetCalendarFeedByFamilyID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCalendarFeedByFamilyID'
+type MockStore_GetCalendarFeedByFamilyID_Call struct {
+	*mock.Call
+}
+
+// GetCalendarFeedByFamilyID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - familyID uuid.UUID
+func (_e *MockStore_Expecter) GetCalendarFeedByFamilyID(ctx interface{}, familyID interface{}) *MockStore_GetCalendarFeedByFamilyID_Call {
+	return &MockStore_GetCalendarFeedByFamilyID_Call{Call: _e.mock.On("GetCalendarFeedByFamilyID", ctx, familyID)}
+}
+
+func (_c *MockStore_GetCalendarFeedByFamilyID_Call) Run(run func(ctx context.Context, familyID uuid.UUID)) *MockStore_GetCalendarFeedByFamilyID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetCalendarFeedByFamilyID_Call) Return(_a0 database.CalendarFeed, _a1 error) *MockStore_GetCalendarFeedByFamilyID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetCalendarFeedByFamilyID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (database.CalendarFeed, error)) *MockStore_GetCalendarFeedByFamilyID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCollectionByID provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetCollectionByID(ctx context.Context, id uuid.UUID) (database.Collection, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollectionByID")
+	}
+
+	var r0 database.Collection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (database.Collection, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) database.Collection); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(database.Collection)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetCollectionByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCollectionByID'
+type MockStore_GetCollectionByID_Call struct {
+	*mock.Call
+}
+
+// GetCollectionByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) GetCollectionByID(ctx interface{}, id interface{}) *MockStore_GetCollectionByID_Call {
+	return &MockStore_GetCollectionByID_Call{Call: _e.mock.On("GetCollectionByID", ctx, id)}
+}
+
+func (_c *MockStore_GetCollectionByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_GetCollectionByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetCollectionByID_Call) Return(_a0 database.Collection, _a1 error) *MockStore_GetCollectionByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetCollectionByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (database.Collection, error)) *MockStore_GetCollectionByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCollectionRecipes provides a mock function with given fields: ctx, collectionID
+func (_m *MockStore) GetCollectionRecipes(ctx context.Context, collectionID uuid.UUID) ([]database.Recipe, error) {
+	ret := _m.Called(ctx, collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollectionRecipes")
+	}
+
+	var r0 []database.Recipe
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]database.Recipe, error)); ok {
+		return rf(ctx, collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []database.Recipe); ok {
+		r0 = rf(ctx, collectionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]database.Recipe)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetCollectionRecipes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCollectionRecipes'
+type MockStore_GetCollectionRecipes_Call struct {
+	*mock.Call
+}
+
+// GetCollectionRecipes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collectionID uuid.UUID
+func (_e *MockStore_Expecter) GetCollectionRecipes(ctx interface{}, collectionID interface{}) *MockStore_GetCollectionRecipes_Call {
+	return &MockStore_GetCollectionRecipes_Call{Call: _e.mock.On("GetCollectionRecipes", ctx, collectionID)}
+}
+
+func (_c *MockStore_GetCollectionRecipes_Call) Run(run func(ctx context.Context, collectionID uuid.UUID)) *MockStore_GetCollectionRecipes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetCollectionRecipes_Call) Return(_a0 []database.Recipe, _a1 error) *MockStore_GetCollectionRecipes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetCollectionRecipes_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]database.Recipe, error)) *MockStore_GetCollectionRecipes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCollectionsByUserID provides a mock function with given fields: ctx, arg
+func (_m *MockStore) GetCollectionsByUserID(ctx context.Context, arg database.GetCollectionsByUserIDParams) ([]database.Collection, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollectionsByUserID")
+	}
+
+	var r0 []database.Collection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.GetCollectionsByUserIDParams) ([]database.Collection, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.GetCollectionsByUserIDParams) []database.Collection); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]database.Collection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.GetCollectionsByUserIDParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetCollectionsByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCollectionsByUserID'
+type MockStore_GetCollectionsByUserID_Call struct {
+	*mock.Call
+}
+
+// GetCollectionsByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.GetCollectionsByUserIDParams
+func (_e *MockStore_Expecter) GetCollectionsByUserID(ctx interface{}, arg interface{}) *MockStore_GetCollectionsByUserID_Call {
+	return &MockStore_GetCollectionsByUserID_Call{Call: _e.mock.On("GetCollectionsByUserID", ctx, arg)}
+}
+
+func (_c *MockStore_GetCollectionsByUserID_Call) Run(run func(ctx context.Context, arg database.GetCollectionsByUserIDParams)) *MockStore_GetCollectionsByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.GetCollectionsByUserIDParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetCollectionsByUserID_Call) Return(_a0 []database.Collection, _a1 error) *MockStore_GetCollectionsByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetCollectionsByUserID_Call) RunAndReturn(run func(context.Context, database.GetCollectionsByUserIDParams) ([]database.Collection, error)) *MockStore_GetCollectionsByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCookLogByID provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetCookLogByID(ctx context.Context, id uuid.UUID) (database.CookLog, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCookLogByID")
+	}
+
+	var r0 database.CookLog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (database.CookLog, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) database.CookLog); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(database.CookLog)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetCookLogByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCookLogByID'
+type MockStore_GetCookLogByID_Call struct {
+	*mock.Call
+}
+
+// GetCookLogByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) GetCookLogByID(ctx interface{}, id interface{}) *MockStore_GetCookLogByID_Call {
+	return &MockStore_GetCookLogByID_Call{Call: _e.mock.On("GetCookLogByID", ctx, id)}
+}
+
+func (_c *MockStore_GetCookLogByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_GetCookLogByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetCookLogByID_Call) Return(_a0 database.CookLog, _a1 error) *MockStore_GetCookLogByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetCookLogByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (database.CookLog, error)) *MockStore_GetCookLogByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCookLogsByFamilyID provides a mock function with given fields: ctx, arg
+func (_m *MockStore) GetCookLogsByFamilyID(ctx context.Context, arg database.GetCookLogsByFamilyIDParams) ([]database.CookLog, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCookLogsByFamilyID")
+	}
+
+	var r0 []database.CookLog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.GetCookLogsByFamilyIDParams) ([]database.CookLog, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.GetCookLogsByFamilyIDParams) []database.CookLog); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]database.CookLog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.GetCookLogsByFamilyIDParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetCookLogsByFamilyID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCookLogsByFamilyID'
+type MockStore_GetCookLogsByFamilyID_Call struct {
+	*mock.Call
+}
+
+// GetCookLogsByFamilyID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.GetCookLogsByFamilyIDParams
+func (_e *MockStore_Expecter) GetCookLogsByFamilyID(ctx interface{}, arg interface{}) *MockStore_GetCookLogsByFamilyID_Call {
+	return &MockStore_GetCookLogsByFamilyID_Call{Call: _e.mock.On("GetCookLogsByFamilyID", ctx, arg)}
+}
+
+func (_c *MockStore_GetCookLogsByFamilyID_Call) Run(run func(ctx context.Context, arg database.GetCookLogsByFamilyIDParams)) *MockStore_GetCookLogsByFamilyID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.GetCookLogsByFamilyIDParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetCookLogsByFamilyID_Call) Return(_a0 []database.CookLog, _a1 error) *MockStore_GetCookLogsByFamilyID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetCookLogsByFamilyID_Call) RunAndReturn(run func(context.Context, database.GetCookLogsByFamilyIDParams) ([]database.CookLog, error)) *MockStore_GetCookLogsByFamilyID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCookLogsByRecipeID provides a mock function with given fields: ctx, recipeID
+func (_m *MockStore) GetCookLogsByRecipeID(ctx context.Context, recipeID uuid.UUID) ([]database.CookLog, error) {
+	ret := _m.Called(ctx, recipeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCookLogsByRecipeID")
+	}
+
+	var r0 []database.CookLog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]database.CookLog, error)); ok {
+		return rf(ctx, recipeID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []database.CookLog); ok {
+		r0 = rf(ctx, recipeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]database.CookLog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, recipeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetCookLogsByRecipeID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCookLogsByRecipeID'
+type MockStore_GetCookLogsByRecipeID_Call struct {
+	*mock.Call
+}
+
+// GetCookLogsByRecipeID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - recipeID uuid.UUID
+func (_e *MockStore_Expecter) GetCookLogsByRecipeID(ctx interface{}, recipeID interface{}) *MockStore_GetCookLogsByRecipeID_Call {
+	return &MockStore_GetCookLogsByRecipeID_Call{Call: _e.mock.On("GetCookLogsByRecipeID", ctx, recipeID)}
+}
+
+func (_c *MockStore_GetCookLogsByRecipeID_Call) Run(run func(ctx context.Context, recipeID uuid.UUID)) *MockStore_GetCookLogsByRecipeID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetCookLogsByRecipeID_Call) Return(_a0 []database.CookLog, _a1 error) *MockStore_GetCookLogsByRecipeID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetCookLogsByRecipeID_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]database.CookLog, error)) *MockStore_GetCookLogsByRecipeID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEquipment provides a mock function with given fields: ctx
+func (_m *MockStore) GetEquipment(ctx context.Context) ([]database.Equipment, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEquipment")
+	}
+
+	var r0 []database.Equipment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]database.Equipment, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []database.Equipment); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]database.Equipment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetEquipment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEquipment'
+type MockStore_GetEquipment_Call struct {
+	*mock.Call
+}
+
+// GetEquipment is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockStore_Expecter) GetEquipment(ctx interface{}) *MockStore_GetEquipment_Call {
+	return &MockStore_GetEquipment_Call{Call: _e.mock.On("GetEquipment", ctx)}
+}
+
+func (_c *MockStore_GetEquipment_Call) Run(run func(ctx context.Context)) *MockStore_GetEquipment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetEquipment_Call) Return(_a0 []database.Equipment, _a1 error) *MockStore_GetEquipment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetEquipment_Call) RunAndReturn(run func(context.Context) ([]database.Equipment, error)) *MockStore_GetEquipment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEventByID provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetEventByID(ctx context.Context, id uuid.UUID) (database.Event, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEventByID")
+	}
+
+	var r0 database.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (database.Event, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) database.Event); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(database.Event)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetEventByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEventByID'
+type MockStore_GetEventByID_Call struct {
+	*mock.Call
+}
+
+// GetEventByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) GetEventByID(ctx interface{}, id interface{}) *MockStore_GetEventByID_Call {
+	return &MockStore_GetEventByID_Call{Call: _e.mock.On("GetEventByID", ctx, id)}
+}
+
+func (_c *MockStore_GetEventByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_GetEventByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetEventByID_Call) Return(_a0 database.Event, _a1 error) *MockStore_GetEventByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetEventByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (database.Event, error)) *MockStore_GetEventByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEventRecipes provides a mock function with given fields: ctx, eventID
+func (_m *MockStore) GetEventRecipes(ctx context.Context, eventID uuid.UUID) ([]database.Recipe, error) {
+	ret := _m.Called(ctx, eventID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEventRecipes")
+	}
+
+	var r0 []database.Recipe
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]database.Recipe, error)); ok {
+		return rf(ctx, eventID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []database.Recipe); ok {
+		r0 = rf(ctx, eventID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]database.Recipe)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, eventID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetEventRecipes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEventRecipes'
+type MockStore_GetEventRecipes_Call struct {
+	*mock.Call
+}
+
+// GetEventRecipes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID uuid.UUID
+func (_e *MockStore_Expecter) GetEventRecipes(ctx interface{}, eventID interface{}) *MockStore_GetEventRecipes_Call {
+	return &MockStore_GetEventRecipes_Call{Call: _e.mock.On("GetEventRecipes", ctx, eventID)}
+}
+
+func (_c *MockStore_GetEventRecipes_Call) Run(run func(ctx context.Context, eventID uuid.UUID)) *MockStore_GetEventRecipes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetEventRecipes_Call) Return(_a0 []database.Recipe, _a1 error) *MockStore_GetEventRecipes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetEventRecipes_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]database.Recipe, error)) *MockStore_GetEventRecipes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEventsByFamilyID provides a mock function with given fields: ctx, familyID
+func (_m *MockStore) GetEventsByFamilyID(ctx context.Context, familyID uuid.UUID) ([]database.Event, error) {
+	ret := _m.Called(ctx, familyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEventsByFamilyID")
+	}
+
+	var r0 []database.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]database.Event, error)); ok {
+		return rf(ctx, familyID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []database.Event); ok {
+		r0 = rf(ctx, familyID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]database.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, familyID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetEventsByFamilyID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEventsByFamilyID'
+type MockStore_GetEventsByFamilyID_Call struct {
+	*mock.Call
+}
+
+// GetEventsByFamilyID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - familyID uuid.UUID
+func (_e *MockStore_Expecter) GetEventsByFamilyID(ctx interface{}, familyID interface{}) *MockStore_GetEventsByFamilyID_Call {
+	return &MockStore_GetEventsByFamilyID_Call{Call: _e.mock.On("GetEventsByFamilyID", ctx, familyID)}
+}
+
+func (_c *MockStore_GetEventsByFamilyID_Call) Run(run func(ctx context.Context, familyID uuid.UUID)) *MockStore_GetEventsByFamilyID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetEventsByFamilyID_Call) Return(_a0 []database.Event, _a1 error) *MockStore_GetEventsByFamilyID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetEventsByFamilyID_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]database.Event, error)) *MockStore_GetEventsByFamilyID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFamilies provides a mock function with given fields: ctx
+func (_m *MockStore) GetFamilies(ctx context.Context) ([]database.Family, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFamilies")
+	}
+
+	var r0 []database.Family
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]database.Family, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []database.Family); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]database.Family)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetFamilies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFamilies'
+type MockStore_GetFamilies_Call struct {
+	*mock.Call
+}
+
+// GetFamilies is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockStore_Expecter) GetFamilies(ctx interface{}) *MockStore_GetFamilies_Call {
+	return &MockStore_GetFamilies_Call{Call: _e.mock.On("GetFamilies", ctx)}
+}
+
+func (_c *MockStore_GetFamilies_Call) Run(run func(ctx context.Context)) *MockStore_GetFamilies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetFamilies_Call) Return(_a0 []database.Family, _a1 error) *MockStore_GetFamilies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetFamilies_Call) RunAndReturn(run func(context.Context) ([]database.Family, error)) *MockStore_GetFamilies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFamilyByID provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetFamilyByID(ctx context.Context, id uuid.UUID) (database.Family, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFamilyByID")
+	}
+
+	var r0 database.Family
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (database.Family, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) database.Family); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(database.Family)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetFamilyByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFamilyByID'
+type MockStore_GetFamilyByID_Call struct {
+	*mock.Call
+}
+
+// GetFamilyByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) GetFamilyByID(ctx interface{}, id interface{}) *MockStore_GetFamilyByID_Call {
+	return &MockStore_GetFamilyByID_Call{Call: _e.mock.On("GetFamilyByID", ctx, id)}
+}
+
+func (_c *MockStore_GetFamilyByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_GetFamilyByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetFamilyByID_Call) Return(_a0 database.Family, _a1 error) *MockStore_GetFamilyByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetFamilyByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (database.Family, error)) *MockStore_GetFamilyByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFamilyByUserID provides a mock function with given fields: ctx, createdByUserID
+func (_m *MockStore) GetFamilyByUserID(ctx context.Context, createdByUserID uuid.UUID) (database.Family, error) {
+	ret := _m.Called(ctx, createdByUserID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFamilyByUserID")
+	}
+
+	var r0 database.Family
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (database.Family, error)); ok {
+		return rf(ctx, createdByUserID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) database.Family); ok {
+		r0 = rf(ctx, createdByUserID)
+	} else {
+		r0 = ret.Get(0).(database.Family)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, createdByUserID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetFamilyByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFamilyByUserID'
+type MockStore_GetFamilyByUserID_Call struct {
+	*mock.Call
+}
+
+// GetFamilyByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - createdByUserID uuid.UUID
+func (_e *MockStore_Expecter) GetFamilyByUserID(ctx interface{}, createdByUserID interface{}) *MockStore_GetFamilyByUserID_Call {
+	return &MockStore_GetFamilyByUserID_Call{Call: _e.mock.On("GetFamilyByUserID", ctx, createdByUserID)}
+}
+
+func (_c *MockStore_GetFamilyByUserID_Call) Run(run func(ctx context.Context, createdByUserID uuid.UUID)) *MockStore_GetFamilyByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetFamilyByUserID_Call) Return(_a0 database.Family, _a1 error) *MockStore_GetFamilyByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetFamilyByUserID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (database.Family, error)) *MockStore_GetFamilyByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFamilyCalendarByID provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetFamilyCalendarByID(ctx context.Context, id uuid.UUID) (database.FamilyCalendar, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFamilyCalendarByID")
+	}
+
+	var r0 database.FamilyCalendar
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (database.FamilyCalendar, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) database.FamilyCalendar); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(database.FamilyCalendar)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetFamilyCalendarByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFamilyCalendarByID'
+type MockStore_GetFamilyCalendarByID_Call struct {
+	*mock.Call
+}
+
+// GetFamilyCalendarByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) GetFamilyCalendarByID(ctx interface{}, id interface{}) *MockStore_GetFamilyCalendarByID_Call {
+	return &MockStore_GetFamilyCalendarByID_Call{Call: _e.mock.On("GetFamilyCalendarByID", ctx, id)}
+}
+
+func (_c *MockStore_GetFamilyCalendarByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_GetFamilyCalendarByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetFamilyCalendarByID_Call) Return(_a0 database.FamilyCalendar, _a1 error) *MockStore_GetFamilyCalendarByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetFamilyCalendarByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (database.FamilyCalendar, error)) *MockStore_GetFamilyCalendarByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFamilyCalendars provides a mock function with given fields: ctx, familyID
+func (_m *MockStore) GetFamilyCalendars(ctx context.Context, familyID uuid.UUID) ([]database.FamilyCalendar, error) {
+	ret := _m.Called(ctx, familyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFamilyCalendars")
+	}
+
+	var r0 []database.FamilyCalendar
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]database.FamilyCalendar, error)); ok {
+		return rf(ctx, familyID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []database.FamilyCalendar); ok {
+		r0 = rf(ctx, familyID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]database.FamilyCalendar)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, familyID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetFamilyCalendars_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFamilyCalendars'
+type MockStore_GetFamilyCalendars_Call struct {
+	*mock.Call
+}
+
+// GetFamilyCalendars is a helper method to define mock.On call
+//   - ctx context.Context
+//   - familyID uuid.UUID
+func (_e *MockStore_Expecter) GetFamilyCalendars(ctx interface{}, familyID interface{}) *MockStore_GetFamilyCalendars_Call {
+	return &MockStore_GetFamilyCalendars_Call{Call: _e.mock.On("GetFamilyCalendars", ctx, familyID)}
+}
+
+func (_c *MockStore_GetFamilyCalendars_Call) Run(run func(ctx context.Context, familyID uuid.UUID)) *MockStore_GetFamilyCalendars_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetFamilyCalendars_Call) Return(_a0 []database.FamilyCalendar, _a1 error) *MockStore_GetFamilyCalendars_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetFamilyCalendars_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]database.FamilyCalendar, error)) *MockStore_GetFamilyCalendars_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFamilyEquipment provides a mock function with given fields: ctx, familyID
+func (_m *MockStore) GetFamilyEquipment(ctx context.Context, familyID uuid.UUID) ([]database.Equipment, error) {
+	ret := _m.Called(ctx, familyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFamilyEquipment")
+	}
+
+	var r0 []database.Equipment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]database.Equipment, error)); ok {
+		return rf(ctx, familyID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []database.Equipment); ok {
+		r0 = rf(ctx, familyID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]database.Equipment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, familyID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetFamilyEquipment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFamilyEquipment'
+type MockStore_GetFamilyEquipment_Call struct {
+	*mock.Call
+}
+
+// GetFamilyEquipment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - familyID uuid.UUID
+func (_e *MockStore_Expecter) GetFamilyEquipment(ctx interface{}, familyID interface{}) *MockStore_GetFamilyEquipment_Call {
+	return &MockStore_GetFamilyEquipment_Call{Call: _e.mock.On("GetFamilyEquipment", ctx, familyID)}
+}
+
+func (_c *MockStore_GetFamilyEquipment_Call) Run(run func(ctx context.Context, familyID uuid.UUID)) *MockStore_GetFamilyEquipment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetFamilyEquipment_Call) Return(_a0 []database.Equipment, _a1 error) *MockStore_GetFamilyEquipment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetFamilyEquipment_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]database.Equipment, error)) *MockStore_GetFamilyEquipment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFavoriteRecipeIDsByUserID provides a mock function with given fields: ctx, userID
+func (_m *MockStore) GetFavoriteRecipeIDsByUserID(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFavoriteRecipeIDsByUserID")
+	}
+
+	var r0 []uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]uuid.UUID, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []uuid.UUID); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetFavoriteRecipeIDsByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFavoriteRecipeIDsByUserID'
+type MockStore_GetFavoriteRecipeIDsByUserID_Call struct {
+	*mock.Call
+}
+
+// GetFavoriteRecipeIDsByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *MockStore_Expecter) GetFavoriteRecipeIDsByUserID(ctx interface{}, userID interface{}) *MockStore_GetFavoriteRecipeIDsByUserID_Call {
+	return &MockStore_GetFavoriteRecipeIDsByUserID_Call{Call: _e.mock.On("GetFavoriteRecipeIDsByUserID", ctx, userID)}
+}
+
+func (_c *MockStore_GetFavoriteRecipeIDsByUserID_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockStore_GetFavoriteRecipeIDsByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetFavoriteRecipeIDsByUserID_Call) Return(_a0 []uuid.UUID, _a1 error) *MockStore_GetFavoriteRecipeIDsByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetFavoriteRecipeIDsByUserID_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]uuid.UUID, error)) *MockStore_GetFavoriteRecipeIDsByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFavoriteRecipesByUserID provides a mock function with given fields: ctx, userID
+func (_m *MockStore) GetFavoriteRecipesByUserID(ctx context.Context, userID uuid.UUID) ([]database.Recipe, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFavoriteRecipesByUserID")
+	}
+
+	var r0 []database.Recipe
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]database.Recipe, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []database.Recipe); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]database.Recipe)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetFavoriteRecipesByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFavoriteRecipesByUserID'
+type MockStore_GetFavoriteRecipesByUserID_Call struct {
+	*mock.Call
+}
+
+// GetFavoriteRecipesByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *MockStore_Expecter) GetFavoriteRecipesByUserID(ctx interface{}, userID interface{}) *MockStore_GetFavoriteRecipesByUserID_Call {
+	return &MockStore_GetFavoriteRecipesByUserID_Call{Call: _e.mock.On("GetFavoriteRecipesByUserID", ctx, userID)}
+}
+
+func (_c *MockStore_GetFavoriteRecipesByUserID_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockStore_GetFavoriteRecipesByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetFavoriteRecipesByUserID_Call) Return(_a0 []database.Recipe, _a1 error) *MockStore_GetFavoriteRecipesByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetFavoriteRecipesByUserID_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]database.Recipe, error)) *MockStore_GetFavoriteRecipesByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetIngredientByID provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetIngredientByID(ctx context.Context, id int32) (database.Ingredient, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetIngredientByID")
+	}
+
+	var r0 database.Ingredient
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (database.Ingredient, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) database.Ingredient); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(database.Ingredient)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetIngredientByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIngredientByID'
+type MockStore_GetIngredientByID_Call struct {
+	*mock.Call
+}
+
+// GetIngredientByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockStore_Expecter) GetIngredientByID(ctx interface{}, id interface{}) *MockStore_GetIngredientByID_Call {
+	return &MockStore_GetIngredientByID_Call{Call: _e.mock.On("GetIngredientByID", ctx, id)}
+}
+
+func (_c *MockStore_GetIngredientByID_Call) Run(run func(ctx context.Context, id int32)) *MockStore_GetIngredientByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetIngredientByID_Call) Return(_a0 database.Ingredient, _a1 error) *MockStore_GetIngredientByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetIngredientByID_Call) RunAndReturn(run func(context.Context, int32) (database.Ingredient, error)) *MockStore_GetIngredientByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetIngredientByName provides a mock function with given fields: ctx, name
+func (_m *MockStore) GetIngredientByName(ctx context.Context, name string) (database.Ingredient, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetIngredientByName")
+	}
+
+	var r0 database.Ingredient
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (database.Ingredient, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) database.Ingredient); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Get(0).(database.Ingredient)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetIngredientByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIngredientByName'
+type MockStore_GetIngredientByName_Call struct {
+	*mock.Call
+}
+
+// GetIngredientByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *MockStore_Expecter) GetIngredientByName(ctx interface{}, name interface{}) *MockStore_GetIngredientByName_Call {
+	return &MockStore_GetIngredientByName_Call{Call: _e.mock.On("GetIngredientByName", ctx, name)}
+}
+
+func (_c *MockStore_GetIngredientByName_Call) Run(run func(ctx context.Context, name string)) *MockStore_GetIngredientByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetIngredientByName_Call) Return(_a0 database.Ingredient, _a1 error) *MockStore_GetIngredientByName_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetIngredientByName_Call) RunAndReturn(run func(context.Context, string) (database.Ingredient, error)) *MockStore_GetIngredientByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetIngredientPackageByID provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetIngredientPackageByID(ctx context.Context, id uuid.UUID) (database.IngredientPackage, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetIngredientPackageByID")
+	}
+
+	var r0 database.IngredientPackage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (database.IngredientPackage, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) database.IngredientPackage); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(database.IngredientPackage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetIngredientPackageByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIngredientPackageByID'
+type MockStore_GetIngredientPackageByID_Call struct {
+	*mock.Call
+}
+
+// GetIngredientPackageByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) GetIngredientPackageByID(ctx interface{}, id interface{}) *MockStore_GetIngredientPackageByID_Call {
+	return &MockStore_GetIngredientPackageByID_Call{Call: _e.mock.On("GetIngredientPackageByID", ctx, id)}
+}
+
+func (_c *MockStore_GetIngredientPackageByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_GetIngredientPackageByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetIngredientPackageByID_Call) Return(_a0 database.IngredientPackage, _a1 error) *MockStore_GetIngredientPackageByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetIngredientPackageByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (database.IngredientPackage, error)) *MockStore_GetIngredientPackageByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetIngredientPackages provides a mock function with given fields: ctx
+func (_m *MockStore) GetIngredientPackages(ctx context.Context) ([]database.IngredientPackage, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetIngredientPackages")
+	}
+
+	var r0 []database.IngredientPackage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]database.IngredientPackage, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []database.IngredientPackage); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]database.IngredientPackage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetIngredientPackages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIngredientPackages'
+type MockStore_GetIngredientPackages_Call struct {
+	*mock.Call
+}
+
+// GetIngredientPackages is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockStore_Expecter) GetIngredientPackages(ctx interface{}) *MockStore_GetIngredientPackages_Call {
+	return &MockStore_GetIngredientPackages_Call{Call: _e.mock.On("GetIngredientPackages", ctx)}
+}
+
+func (_c *MockStore_GetIngredientPackages_Call) Run(run func(ctx context.Context)) *MockStore_GetIngredientPackages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetIngredientPackages_Call) Return(_a0 []database.IngredientPackage, _a1 error) *MockStore_GetIngredientPackages_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetIngredientPackages_Call) RunAndReturn(run func(context.Context) ([]database.IngredientPackage, error)) *MockStore_GetIngredientPackages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetIngredientPackagesByIngredientID provides a mock function with given fields: ctx, ingredientID
+func (_m *MockStore) GetIngredientPackagesByIngredientID(ctx context.Context, ingredientID int32) ([]database.IngredientPackage, error) {
+	ret := _m.Called(ctx, ingredientID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetIngredientPackagesByIngredientID")
+	}
+
+	var r0 []database.IngredientPackage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) ([]database.IngredientPackage, error)); ok {
+		return rf(ctx, ingredientID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) []database.IngredientPackage); ok {
+		r0 = rf(ctx, ingredientID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]database.IngredientPackage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, ingredientID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetIngredientPackagesByIngredientID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIngredientPackagesByIngredientID'
+type MockStore_GetIngredientPackagesByIngredientID_Call struct {
+	*mock.Call
+}
+
+// GetIngredientPackagesByIngredientID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ingredientID int32
+func (_e *MockStore_Expecter) GetIngredientPackagesByIngredientID(ctx interface{}, ingredientID interface{}) *MockStore_GetIngredientPackagesByIngredientID_Call {
+	return &MockStore_GetIngredientPackagesByIngredientID_Call{Call: _e.mock.On("GetIngredientPackagesByIngredientID", ctx, ingredientID)}
+}
+
+func (_c *MockStore_GetIngredientPackagesByIngredientID_Call) Run(run func(ctx context.Context, ingredientID int32)) *MockStore_GetIngredientPackagesByIngredientID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetIngredientPackagesByIngredientID_Call) Return(_a0 []database.IngredientPackage, _a1 error) *MockStore_GetIngredientPackagesByIngredientID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetIngredientPackagesByIngredientID_Call) RunAndReturn(run func(context.Context, int32) ([]database.IngredientPackage, error)) *MockStore_GetIngredientPackagesByIngredientID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetIngredients provides a mock function with given fields: ctx
+func (_m *MockStore) GetIngredients(ctx context.Context) ([]database.Ingredient, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetIngredients")
+	}
+
+	var r0 []database.Ingredient
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]database.Ingredient, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []database.Ingredient); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]database.Ingredient)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetIngredients_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIngredients'
+type MockStore_GetIngredients_Call struct {
+	*mock.Call
+}
+
+// GetIngredients is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockStore_Expecter) GetIngredients(ctx interface{}) *MockStore_GetIngredients_Call {
+	return &MockStore_GetIngredients_Call{Call: _e.mock.On("GetIngredients", ctx)}
+}
+
+func (_c *MockStore_GetIngredients_Call) Run(run func(ctx context.Context)) *MockStore_GetIngredients_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetIngredients_Call) Return(_a0 []database.Ingredient, _a1 error) *MockStore_GetIngredients_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetIngredients_Call) RunAndReturn(run func(context.Context) ([]database.Ingredient, error)) *MockStore_GetIngredients_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetInventoryByFamilyID provides a mock function with given fields: ctx, familyID
+func (_m *MockStore) GetInventoryByFamilyID(ctx context.Context, familyID uuid.UUID) ([]database.GetInventoryByFamilyIDRow, error) {
+	ret := _m.Called(ctx, familyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInventoryByFamilyID")
+	}
+
+	var r0 []database.GetInventoryByFamilyIDRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]database.GetInventoryByFamilyIDRow, error)); ok {
+		return rf(ctx, familyID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []database.GetInventoryByFamilyIDRow); ok {
+		r0 = rf(ctx, familyID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]database.GetInventoryByFamilyIDRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, familyID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetInventoryByFamilyID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInventoryByFamilyID'
+type MockStore_GetInventoryByFamilyID_Call struct {
+	*mock.Call
+}
+
+// GetInventoryByFamilyID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - familyID uuid.UUID
+func (_e *MockStore_Expecter) GetInventoryByFamilyID(ctx interface{}, familyID interface{}) *MockStore_GetInventoryByFamilyID_Call {
+	return &MockStore_GetInventoryByFamilyID_Call{Call: _e.mock.On("GetInventoryByFamilyID", ctx, familyID)}
+}
+
+func (_c *MockStore_GetInventoryByFamilyID_Call) Run(run func(ctx context.Context, familyID uuid.UUID)) *MockStore_GetInventoryByFamilyID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetInventoryByFamilyID_Call) Return(_a0 []database.GetInventoryByFamilyIDRow, _a1 error) *MockStore_GetInventoryByFamilyID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetInventoryByFamilyID_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]database.GetInventoryByFamilyIDRow, error)) *MockStore_GetInventoryByFamilyID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetInventoryItemByID provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetInventoryItemByID(ctx context.Context, id uuid.UUID) (database.InventoryItem, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInventoryItemByID")
+	}
+
+	var r0 database.InventoryItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (database.InventoryItem, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) database.InventoryItem); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(database.InventoryItem)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetInventoryItemByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInventoryItemByID'
+type MockStore_GetInventoryItemByID_Call struct {
+	*mock.Call
+}
+
+// GetInventoryItemByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) GetInventoryItemByID(ctx interface{}, id interface{}) *MockStore_GetInventoryItemByID_Call {
+	return &MockStore_GetInventoryItemByID_Call{Call: _e.mock.On("GetInventoryItemByID", ctx, id)}
+}
+
+func (_c *MockStore_GetInventoryItemByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_GetInventoryItemByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetInventoryItemByID_Call) Return(_a0 database.InventoryItem, _a1 error) *MockStore_GetInventoryItemByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetInventoryItemByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (database.InventoryItem, error)) *MockStore_GetInventoryItemByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLastCookedByFamilyID provides a mock function with given fields: ctx, familyID
+func (_m *MockStore) GetLastCookedByFamilyID(ctx context.Context, familyID uuid.UUID) ([]database.GetLastCookedByFamilyIDRow, error) {
+	ret := _m.Called(ctx, familyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLastCookedByFamilyID")
+	}
+
+	var r0 []database.GetLastCookedByFamilyIDRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]database.GetLastCookedByFamilyIDRow, error)); ok {
+		return rf(ctx, familyID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []database.GetLastCookedByFamilyIDRow); ok {
+		r0 = rf(ctx, familyID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]database.GetLastCookedByFamilyIDRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, familyID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetLastCookedByFamilyID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLastCookedByFamilyID'
+type MockStore_GetLastCookedByFamilyID_Call struct {
+	*mock.Call
+}
+
+// GetLastCookedByFamilyID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - familyID uuid.UUID
+func (_e *MockStore_Expecter) GetLastCookedByFamilyID(ctx interface{}, familyID interface{}) *MockStore_GetLastCookedByFamilyID_Call {
+	return &MockStore_GetLastCookedByFamilyID_Call{Call: _e.mock.On("GetLastCookedByFamilyID", ctx, familyID)}
+}
+
+func (_c *MockStore_GetLastCookedByFamilyID_Call) Run(run func(ctx context.Context, familyID uuid.UUID)) *MockStore_GetLastCookedByFamilyID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetLastCookedByFamilyID_Call) Return(_a0 []database.GetLastCookedByFamilyIDRow, _a1 error) *MockStore_GetLastCookedByFamilyID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetLastCookedByFamilyID_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]database.GetLastCookedByFamilyIDRow, error)) *MockStore_GetLastCookedByFamilyID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLeftoverServingsEaten provides a mock function with given fields: ctx, arg
+func (_m *MockStore) GetLeftoverServingsEaten(ctx context.Context, arg database.GetLeftoverServingsEatenParams) (int32, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLeftoverServingsEaten")
+	}
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.GetLeftoverServingsEatenParams) (int32, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.GetLeftoverServingsEatenParams) int32); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.GetLeftoverServingsEatenParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetLeftoverServingsEaten_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLeftoverServingsEaten'
+type MockStore_GetLeftoverServingsEaten_Call struct {
+	*mock.Call
+}
+
+// GetLeftoverServingsEaten is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.GetLeftoverServingsEatenParams
+func (_e *MockStore_Expecter) GetLeftoverServingsEaten(ctx interface{}, arg interface{}) *MockStore_GetLeftoverServingsEaten_Call {
+	return &MockStore_GetLeftoverServingsEaten_Call{Call: _e.mock.On("GetLeftoverServingsEaten", ctx, arg)}
+}
+
+func (_c *MockStore_GetLeftoverServingsEaten_Call) Run(run func(ctx context.Context, arg database.GetLeftoverServingsEatenParams)) *MockStore_GetLeftoverServingsEaten_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.GetLeftoverServingsEatenParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetLeftoverServingsEaten_Call) Return(_a0 int32, _a1 error) *MockStore_GetLeftoverServingsEaten_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetLeftoverServingsEaten_Call) RunAndReturn(run func(context.Context, database.GetLeftoverServingsEatenParams) (int32, error)) *MockStore_GetLeftoverServingsEaten_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLeftovers provides a mock function with given fields: ctx, leftoverOf
+func (_m *MockStore) GetLeftovers(ctx context.Context, leftoverOf pgtype.UUID) ([]database.MealPlanEntry, error) {
+	ret := _m.Called(ctx, leftoverOf)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLeftovers")
+	}
+
+	var r0 []database.MealPlanEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.UUID) ([]database.MealPlanEntry, error)); ok {
+		return rf(ctx, leftoverOf)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.UUID) []database.MealPlanEntry); ok {
+		r0 = rf(ctx, leftoverOf)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]database.MealPlanEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = rf(ctx, leftoverOf)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetLeftovers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLeftovers'
+type MockStore_GetLeftovers_Call struct {
+	*mock.Call
+}
+
+// GetLeftovers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - leftoverOf pgtype.UUID
+func (_e *MockStore_Expecter) GetLeftovers(ctx interface{}, leftoverOf interface{}) *MockStore_GetLeftovers_Call {
+	return &MockStore_GetLeftovers_Call{Call: _e.mock.On("GetLeftovers", ctx, leftoverOf)}
+}
+
+func (_c *MockStore_GetLeftovers_Call) Run(run func(ctx context.Context, leftoverOf pgtype.UUID)) *MockStore_GetLeftovers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetLeftovers_Call) Return(_a0 []database.MealPlanEntry, _a1 error) *MockStore_GetLeftovers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetLeftovers_Call) RunAndReturn(run func(context.Context, pgtype.UUID) ([]database.MealPlanEntry, error)) *MockStore_GetLeftovers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLeftoversOutsideMealPlan provides a mock function with given fields: ctx, mealPlanID
+func (_m *MockStore) GetLeftoversOutsideMealPlan(ctx context.Context, mealPlanID uuid.UUID) ([]database.MealPlanEntry, error) {
+	ret := _m.Called(ctx, mealPlanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLeftoversOutsideMealPlan")
+	}
+
+	var r0 []database.MealPlanEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]database.MealPlanEntry, error)); ok {
+		return rf(ctx, mealPlanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []database.MealPlanEntry); ok {
+		r0 = rf(ctx, mealPlanID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]database.MealPlanEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, mealPlanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetLeftoversOutsideMealPlan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLeftoversOutsideMealPlan'
+type MockStore_GetLeftoversOutsideMealPlan_Call struct {
+	*mock.Call
+}
+
+// GetLeftoversOutsideMealPlan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mealPlanID uuid.UUID
+func (_e *MockStore_Expecter) GetLeftoversOutsideMealPlan(ctx interface{}, mealPlanID interface{}) *MockStore_GetLeftoversOutsideMealPlan_Call {
+	return &MockStore_GetLeftoversOutsideMealPlan_Call{Call: _e.mock.On("GetLeftoversOutsideMealPlan", ctx, mealPlanID)}
+}
+
+func (_c *MockStore_GetLeftoversOutsideMealPlan_Call) Run(run func(ctx context.Context, mealPlanID uuid.UUID)) *MockStore_GetLeftoversOutsideMealPlan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetLeftoversOutsideMealPlan_Call) Return(_a0 []database.MealPlanEntry, _a1 error) *MockStore_GetLeftoversOutsideMealPlan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetLeftoversOutsideMealPlan_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]database.MealPlanEntry, error)) *MockStore_GetLeftoversOutsideMealPlan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMealAttendanceByFamilyID provides a mock function with given fields: ctx, arg
+func (_m *MockStore) GetMealAttendanceByFamilyID(ctx context.Context, arg database.GetMealAttendanceByFamilyIDParams) ([]database.MealAttendance, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMealAttendanceByFamilyID")
+	}
+
+	var r0 []database.MealAttendance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.GetMealAttendanceByFamilyIDParams) ([]database.MealAttendance, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.GetMealAttendanceByFamilyIDParams) []database.MealAttendance); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]database.MealAttendance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.GetMealAttendanceByFamilyIDParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetMealAttendanceByFamilyID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMealAttendanceByFamilyID'
+type MockStore_GetMealAttendanceByFamilyID_Call struct {
+	*mock.Call
+}
+
+// GetMealAttendanceByFamilyID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.GetMealAttendanceByFamilyIDParams
+func (_e *MockStore_Expecter) GetMealAttendanceByFamilyID(ctx interface{}, arg interface{}) *MockStore_GetMealAttendanceByFamilyID_Call {
+	return &MockStore_GetMealAttendanceByFamilyID_Call{Call: _e.mock.On("GetMealAttendanceByFamilyID", ctx, arg)}
+}
+
+func (_c *MockStore_GetMealAttendanceByFamilyID_Call) Run(run func(ctx context.Context, arg database.GetMealAttendanceByFamilyIDParams)) *MockStore_GetMealAttendanceByFamilyID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.GetMealAttendanceByFamilyIDParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetMealAttendanceByFamilyID_Call) Return(_a0 []database.MealAttendance, _a1 error) *MockStore_GetMealAttendanceByFamilyID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetMealAttendanceByFamilyID_Call) RunAndReturn(run func(context.Context, database.GetMealAttendanceByFamilyIDParams) ([]database.MealAttendance, error)) *MockStore_GetMealAttendanceByFamilyID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMealGuestsByFamilyID provides a mock function with given fields: ctx, arg
+func (_m *MockStore) GetMealGuestsByFamilyID(ctx context.Context, arg database.GetMealGuestsByFamilyIDParams) ([]database.MealGuest, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMealGuestsByFamilyID")
+	}
+
+	var r0 []database.MealGuest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.GetMealGuestsByFamilyIDParams) ([]database.MealGuest, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.GetMealGuestsByFamilyIDParams) []database.MealGuest); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]database.MealGuest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.GetMealGuestsByFamilyIDParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetMealGuestsByFamilyID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMealGuestsByFamilyID'
+type MockStore_GetMealGuestsByFamilyID_Call struct {
+	*mock.Call
+}
+
+// GetMealGuestsByFamilyID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.GetMealGuestsByFamilyIDParams
+func (_e *MockStore_Expecter) GetMealGuestsByFamilyID(ctx interface{}, arg interface{}) *MockStore_GetMealGuestsByFamilyID_Call {
+	return &MockStore_GetMealGuestsByFamilyID_Call{Call: _e.mock.On("GetMealGuestsByFamilyID", ctx, arg)}
+}
+
+func (_c *MockStore_GetMealGuestsByFamilyID_Call) Run(run func(ctx context.Context, arg database.GetMealGuestsByFamilyIDParams)) *MockStore_GetMealGuestsByFamilyID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.GetMealGuestsByFamilyIDParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetMealGuestsByFamilyID_Call) Return(_a0 []database.MealGuest, _a1 error) *MockStore_GetMealGuestsByFamilyID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetMealGuestsByFamilyID_Call) RunAndReturn(run func(context.Context, database.GetMealGuestsByFamilyIDParams) ([]database.MealGuest, error)) *MockStore_GetMealGuestsByFamilyID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMealPlanByID provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetMealPlanByID(ctx context.Context, id uuid.UUID) (database.MealPlan, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMealPlanByID")
+	}
+
+	var r0 database.MealPlan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (database.MealPlan, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) database.MealPlan); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(database.MealPlan)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetMealPlanByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMealPlanByID'
+type MockStore_GetMealPlanByID_Call struct {
+	*mock.Call
+}
+
+// GetMealPlanByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) GetMealPlanByID(ctx interface{}, id interface{}) *MockStore_GetMealPlanByID_Call {
+	return &MockStore_GetMealPlanByID_Call{Call: _e.mock.On("GetMealPlanByID", ctx, id)}
+}
+
+func (_c *MockStore_GetMealPlanByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_GetMealPlanByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetMealPlanByID_Call) Return(_a0 database.MealPlan, _a1 error) *MockStore_GetMealPlanByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetMealPlanByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (database.MealPlan, error)) *MockStore_GetMealPlanByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMealPlanByWeek provides a mock function with given fields: ctx, arg
+func (_m *MockStore) GetMealPlanByWeek(ctx context.Context, arg database.GetMealPlanByWeekParams) (database.MealPlan, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMealPlanByWeek")
+	}
+
+	var r0 database.MealPlan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.GetMealPlanByWeekParams) (database.MealPlan, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.GetMealPlanByWeekParams) database.MealPlan); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(database.MealPlan)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.GetMealPlanByWeekParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetMealPlanByWeek_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMealPlanByWeek'
+type MockStore_GetMealPlanByWeek_Call struct {
+	*mock.Call
+}
+
+// GetMealPlanByWeek is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.GetMealPlanByWeekParams
+func (_e *MockStore_Expecter) GetMealPlanByWeek(ctx interface{}, arg interface{}) *MockStore_GetMealPlanByWeek_Call {
+	return &MockStore_GetMealPlanByWeek_Call{Call: _e.mock.On("GetMealPlanByWeek", ctx, arg)}
+}
+
+func (_c *MockStore_GetMealPlanByWeek_Call) Run(run func(ctx context.Context, arg database.GetMealPlanByWeekParams)) *MockStore_GetMealPlanByWeek_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.GetMealPlanByWeekParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetMealPlanByWeek_Call) Return(_a0 database.MealPlan, _a1 error) *MockStore_GetMealPlanByWeek_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetMealPlanByWeek_Call) RunAndReturn(run func(context.Context, database.GetMealPlanByWeekParams) (database.MealPlan, error)) *MockStore_GetMealPlanByWeek_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMealPlanEntries provides a mock function with given fields: ctx, mealPlanID
+func (_m *MockStore) GetMealPlanEntries(ctx context.Context, mealPlanID uuid.UUID) ([]database.GetMealPlanEntriesRow, error) {
+	ret := _m.Called(ctx, mealPlanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMealPlanEntries")
+	}
+
+	var r0 []database.GetMealPlanEntriesRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]database.GetMealPlanEntriesRow, error)); ok {
+		return rf(ctx, mealPlanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []database.GetMealPlanEntriesRow); ok {
+		r0 = rf(ctx, mealPlanID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]database.GetMealPlanEntriesRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, mealPlanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetMealPlanEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMealPlanEntries'
+type MockStore_GetMealPlanEntries_Call struct {
+	*mock.Call
+}
+
+// GetMealPlanEntries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mealPlanID uuid.UUID
+func (_e *MockStore_Expecter) GetMealPlanEntries(ctx interface{}, mealPlanID interface{}) *MockStore_GetMealPlanEntries_Call {
+	return &MockStore_GetMealPlanEntries_Call{Call: _e.mock.On("GetMealPlanEntries", ctx, mealPlanID)}
+}
+
+func (_c *MockStore_GetMealPlanEntries_Call) Run(run func(ctx context.Context, mealPlanID uuid.UUID)) *MockStore_GetMealPlanEntries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetMealPlanEntries_Call) Return(_a0 []database.GetMealPlanEntriesRow, _a1 error) *MockStore_GetMealPlanEntries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetMealPlanEntries_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]database.GetMealPlanEntriesRow, error)) *MockStore_GetMealPlanEntries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMealPlanEntryByID provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetMealPlanEntryByID(ctx context.Context, id uuid.UUID) (database.MealPlanEntry, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMealPlanEntryByID")
+	}
+
+	var r0 database.MealPlanEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (database.MealPlanEntry, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) database.MealPlanEntry); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(database.MealPlanEntry)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetMealPlanEntryByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMealPlanEntryByID'
+type MockStore_GetMealPlanEntryByID_Call struct {
+	*mock.Call
+}
+
+// GetMealPlanEntryByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) GetMealPlanEntryByID(ctx interface{}, id interface{}) *MockStore_GetMealPlanEntryByID_Call {
+	return &MockStore_GetMealPlanEntryByID_Call{Call: _e.mock.On("GetMealPlanEntryByID", ctx, id)}
+}
+
+func (_c *MockStore_GetMealPlanEntryByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_GetMealPlanEntryByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetMealPlanEntryByID_Call) Return(_a0 database.MealPlanEntry, _a1 error) *MockStore_GetMealPlanEntryByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetMealPlanEntryByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (database.MealPlanEntry, error)) *MockStore_GetMealPlanEntryByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMealPlanRotation provides a mock function with given fields: ctx, familyID
+func (_m *MockStore) GetMealPlanRotation(ctx context.Context, familyID uuid.UUID) (database.MealPlanRotation, error) {
+	ret := _m.Called(ctx, familyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMealPlanRotation")
+	}
+
+	var r0 database.MealPlanRotation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (database.MealPlanRotation, error)); ok {
+		return rf(ctx, familyID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) database.MealPlanRotation); ok {
+		r0 = rf(ctx, familyID)
+	} else {
+		r0 = ret.Get(0).(database.MealPlanRotation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, familyID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetMealPlanRotation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMealPlanRotation'
+type MockStore_GetMealPlanRotation_Call struct {
+	*mock.Call
+}
+
+// GetMealPlanRotation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - familyID uuid.UUID
+func (_e *MockStore_Expecter) GetMealPlanRotation(ctx interface{}, familyID interface{}) *MockStore_GetMealPlanRotation_Call {
+	return &MockStore_GetMealPlanRotation_Call{Call: _e.mock.On("GetMealPlanRotation", ctx, familyID)}
+}
+
+func (_c *MockStore_GetMealPlanRotation_Call) Run(run func(ctx context.Context, familyID uuid.UUID)) *MockStore_GetMealPlanRotation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetMealPlanRotation_Call) Return(_a0 database.MealPlanRotation, _a1 error) *MockStore_GetMealPlanRotation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetMealPlanRotation_Call) RunAndReturn(run func(context.Context, uuid.UUID) (database.MealPlanRotation, error)) *MockStore_GetMealPlanRotation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMealPlanRotationTemplates provides a mock function with given fields: ctx, familyID
+func (_m *MockStore) GetMealPlanRotationTemplates(ctx context.Context, familyID uuid.UUID) ([]database.GetMealPlanRotationTemplatesRow, error) {
+	ret := _m.Called(ctx, familyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMealPlanRotationTemplates")
+	}
+
+	var r0 []database.GetMealPlanRotationTemplatesRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]database.GetMealPlanRotationTemplatesRow, error)); ok {
+		return rf(ctx, familyID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []database.GetMealPlanRotationTemplatesRow); ok {
+		r0 = rf(ctx, familyID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]database.GetMealPlanRotationTemplatesRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, familyID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetMealPlanRotationTemplates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMealPlanRotationTemplates'
+type MockStore_GetMealPlanRotationTemplates_Call struct {
+	*mock.Call
+}
+
+// GetMealPlanRotationTemplates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - familyID uuid.UUID
+func (_e *MockStore_Expecter) GetMealPlanRotationTemplates(ctx interface{}, familyID interface{}) *MockStore_GetMealPlanRotationTemplates_Call {
+	return &MockStore_GetMealPlanRotationTemplates_Call{Call: _e.mock.On("GetMealPlanRotationTemplates", ctx, familyID)}
+}
+
+func (_c *MockStore_GetMealPlanRotationTemplates_Call) Run(run func(ctx context.Context, familyID uuid.UUID)) *MockStore_GetMealPlanRotationTemplates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetMealPlanRotationTemplates_Call) Return(_a0 []database.GetMealPlanRotationTemplatesRow, _a1 error) *MockStore_GetMealPlanRotationTemplates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetMealPlanRotationTemplates_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]database.GetMealPlanRotationTemplatesRow, error)) *MockStore_GetMealPlanRotationTemplates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMealPlanTemplateByID provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetMealPlanTemplateByID(ctx context.Context, id uuid.UUID) (database.MealPlanTemplate, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMealPlanTemplateByID")
+	}
+
+	var r0 database.MealPlanTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (database.MealPlanTemplate, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) database.MealPlanTemplate); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(database.MealPlanTemplate)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetMealPlanTemplateByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMealPlanTemplateByID'
+type MockStore_GetMealPlanTemplateByID_Call struct {
+	*mock.Call
+}
+
+// GetMealPlanTemplateByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) GetMealPlanTemplateByID(ctx interface{}, id interface{}) *MockStore_GetMealPlanTemplateByID_Call {
+	return &MockStore_GetMealPlanTemplateByID_Call{Call: _e.mock.On("GetMealPlanTemplateByID", ctx, id)}
+}
+
+func (_c *MockStore_GetMealPlanTemplateByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_GetMealPlanTemplateByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetMealPlanTemplateByID_Call) Return(_a0 database.MealPlanTemplate, _a1 error) *MockStore_GetMealPlanTemplateByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetMealPlanTemplateByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (database.MealPlanTemplate, error)) *MockStore_GetMealPlanTemplateByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMealPlanTemplateEntries provides a mock function with given fields: ctx, templateID
+func (_m *MockStore) GetMealPlanTemplateEntries(ctx context.Context, templateID uuid.UUID) ([]database.GetMealPlanTemplateEntriesRow, error) {
+	ret := _m.Called(ctx, templateID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMealPlanTemplateEntries")
+	}
+
+	var r0 []database.GetMealPlanTemplateEntriesRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]database.GetMealPlanTemplateEntriesRow, error)); ok {
+		return rf(ctx, templateID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []database.GetMealPlanTemplateEntriesRow); ok {
+		r0 = rf(ctx, templateID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]database.GetMealPlanTemplateEntriesRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, templateID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetMealPlanTemplateEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMealPlanTemplateEntries'
+type MockStore_GetMealPlanTemplateEntries_Call struct {
+	*mock.Call
+}
+
+// GetMealPlanTemplateEntries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - templateID uuid.UUID
+func (_e *MockStore_Expecter) GetMealPlanTemplateEntries(ctx interface{}, templateID interface{}) *MockStore_GetMealPlanTemplateEntries_Call {
+	return &MockStore_GetMealPlanTemplateEntries_Call{Call: _e.mock.On("GetMealPlanTemplateEntries", ctx, templateID)}
+}
+
+func (_c *MockStore_GetMealPlanTemplateEntries_Call) Run(run func(ctx context.Context, templateID uuid.UUID)) *MockStore_GetMealPlanTemplateEntries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetMealPlanTemplateEntries_Call) Return(_a0 []database.GetMealPlanTemplateEntriesRow, _a1 error) *MockStore_GetMealPlanTemplateEntries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetMealPlanTemplateEntries_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]database.GetMealPlanTemplateEntriesRow, error)) *MockStore_GetMealPlanTemplateEntries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMealPlanTemplatesByFamilyID provides a mock function with given fields: ctx, familyID
+func (_m *MockStore) GetMealPlanTemplatesByFamilyID(ctx context.Context, familyID uuid.UUID) ([]database.MealPlanTemplate, error) {
+	ret := _m.Called(ctx, familyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMealPlanTemplatesByFamilyID")
+	}
+
+	var r0 []database.MealPlanTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]database.MealPlanTemplate, error)); ok {
+		return rf(ctx, familyID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []database.MealPlanTemplate); ok {
+		r0 = rf(ctx, familyID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]database.MealPlanTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, familyID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetMealPlanTemplatesByFamilyID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMealPlanTemplatesByFamilyID'
+type MockStore_GetMealPlanTemplatesByFamilyID_Call struct {
+	*mock.Call
+}
+
+// GetMealPlanTemplatesByFamilyID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - familyID uuid.UUID
+func (_e *MockStore_Expecter) GetMealPlanTemplatesByFamilyID(ctx interface{}, familyID interface{}) *MockStore_GetMealPlanTemplatesByFamilyID_Call {
+	return &MockStore_GetMealPlanTemplatesByFamilyID_Call{Call: _e.mock.On("GetMealPlanTemplatesByFamilyID", ctx, familyID)}
+}
+
+func (_c *MockStore_GetMealPlanTemplatesByFamilyID_Call) Run(run func(ctx context.Context, familyID uuid.UUID)) *MockStore_GetMealPlanTemplatesByFamilyID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetMealPlanTemplatesByFamilyID_Call) Return(_a0 []database.MealPlanTemplate, _a1 error) *MockStore_GetMealPlanTemplatesByFamilyID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetMealPlanTemplatesByFamilyID_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]database.MealPlanTemplate, error)) *MockStore_GetMealPlanTemplatesByFamilyID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMealPlanVotes provides a mock function with given fields: ctx, mealPlanID
+func (_m *MockStore) GetMealPlanVotes(ctx context.Context, mealPlanID uuid.UUID) ([]database.MealPlanVote, error) {
+	ret := _m.Called(ctx, mealPlanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMealPlanVotes")
+	}
+
+	var r0 []database.MealPlanVote
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]database.MealPlanVote, error)); ok {
+		return rf(ctx, mealPlanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []database.MealPlanVote); ok {
+		r0 = rf(ctx, mealPlanID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]database.MealPlanVote)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, mealPlanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetMealPlanVotes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMealPlanVotes'
+type MockStore_GetMealPlanVotes_Call struct {
+	*mock.Call
+}
+
+// GetMealPlanVotes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mealPlanID uuid.UUID
+func (_e *MockStore_Expecter) GetMealPlanVotes(ctx interface{}, mealPlanID interface{}) *MockStore_GetMealPlanVotes_Call {
+	return &MockStore_GetMealPlanVotes_Call{Call: _e.mock.On("GetMealPlanVotes", ctx, mealPlanID)}
+}
+
+func (_c *MockStore_GetMealPlanVotes_Call) Run(run func(ctx context.Context, mealPlanID uuid.UUID)) *MockStore_GetMealPlanVotes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetMealPlanVotes_Call) Return(_a0 []database.MealPlanVote, _a1 error) *MockStore_GetMealPlanVotes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetMealPlanVotes_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]database.MealPlanVote, error)) *MockStore_GetMealPlanVotes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMealPlansByFamilyID provides a mock function with given fields: ctx, familyID
+func (_m *MockStore) GetMealPlansByFamilyID(ctx context.Context, familyID uuid.UUID) ([]database.MealPlan, error) {
+	ret := _m.Called(ctx, familyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMealPlansByFamilyID")
+	}
+
+	var r0 []database.MealPlan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]database.MealPlan, error)); ok {
+		return rf(ctx, familyID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []database.MealPlan); ok {
+		r0 = rf(ctx, familyID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]database.MealPlan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, familyID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetMealPlansByFamilyID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMealPlansByFamilyID'
+type MockStore_GetMealPlansByFamilyID_Call struct {
+	*mock.Call
+}
+
+// GetMealPlansByFamilyID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - familyID uuid.UUID
+func (_e *MockStore_Expecter) GetMealPlansByFamilyID(ctx interface{}, familyID interface{}) *MockStore_GetMealPlansByFamilyID_Call {
+	return &MockStore_GetMealPlansByFamilyID_Call{Call: _e.mock.On("GetMealPlansByFamilyID", ctx, familyID)}
+}
+
+func (_c *MockStore_GetMealPlansByFamilyID_Call) Run(run func(ctx context.Context, familyID uuid.UUID)) *MockStore_GetMealPlansByFamilyID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetMealPlansByFamilyID_Call) Return(_a0 []database.MealPlan, _a1 error) *MockStore_GetMealPlansByFamilyID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetMealPlansByFamilyID_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]database.MealPlan, error)) *MockStore_GetMealPlansByFamilyID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMemberMealAbsencesByFamilyID provides a mock function with given fields: ctx, familyID
+func (_m *MockStore) GetMemberMealAbsencesByFamilyID(ctx context.Context, familyID uuid.UUID) ([]database.MemberMealAbsence, error) {
+	ret := _m.Called(ctx, familyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMemberMealAbsencesByFamilyID")
+	}
+
+	var r0 []database.MemberMealAbsence
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]database.MemberMealAbsence, error)); ok {
+		return rf(ctx, familyID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []database.MemberMealAbsence); ok {
+		r0 = rf(ctx, familyID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]database.MemberMealAbsence)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, familyID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetMemberMealAbsencesByFamilyID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMemberMealAbsencesByFamilyID'
+type MockStore_GetMemberMealAbsencesByFamilyID_Call struct {
+	*mock.Call
+}
+
+// GetMemberMealAbsencesByFamilyID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - familyID uuid.UUID
+func (_e *MockStore_Expecter) GetMemberMealAbsencesByFamilyID(ctx interface{}, familyID interface{}) *MockStore_GetMemberMealAbsencesByFamilyID_Call {
+	return &MockStore_GetMemberMealAbsencesByFamilyID_Call{Call: _e.mock.On("GetMemberMealAbsencesByFamilyID", ctx, familyID)}
+}
+
+func (_c *MockStore_GetMemberMealAbsencesByFamilyID_Call) Run(run func(ctx context.Context, familyID uuid.UUID)) *MockStore_GetMemberMealAbsencesByFamilyID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetMemberMealAbsencesByFamilyID_Call) Return(_a0 []database.MemberMealAbsence, _a1 error) *MockStore_GetMemberMealAbsencesByFamilyID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetMemberMealAbsencesByFamilyID_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]database.MemberMealAbsence, error)) *MockStore_GetMemberMealAbsencesByFamilyID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPlannedRecipesByFamilyID provides a mock function with given fields: ctx, arg
+func (_m *MockStore) GetPlannedRecipesByFamilyID(ctx context.Context, arg database.GetPlannedRecipesByFamilyIDParams) ([]database.GetPlannedRecipesByFamilyIDRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPlannedRecipesByFamilyID")
+	}
+
+	var r0 []database.GetPlannedRecipesByFamilyIDRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.GetPlannedRecipesByFamilyIDParams) ([]database.GetPlannedRecipesByFamilyIDRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.GetPlannedRecipesByFamilyIDParams) []database.GetPlannedRecipesByFamilyIDRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]database.GetPlannedRecipesByFamilyIDRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.GetPlannedRecipesByFamilyIDParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetPlannedRecipesByFamilyID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPlannedRecipesByFamilyID'
+type MockStore_GetPlannedRecipesByFamilyID_Call struct {
+	*mock.Call
+}
+
+// GetPlannedRecipesByFamilyID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.GetPlannedRecipesByFamilyIDParams
+func (_e *MockStore_Expecter) GetPlannedRecipesByFamilyID(ctx interface{}, arg interface{}) *MockStore_GetPlannedRecipesByFamilyID_Call {
+	return &MockStore_GetPlannedRecipesByFamilyID_Call{Call: _e.mock.On("GetPlannedRecipesByFamilyID", ctx, arg)}
+}
+
+func (_c *MockStore_GetPlannedRecipesByFamilyID_Call) Run(run func(ctx context.Context, arg database.GetPlannedRecipesByFamilyIDParams)) *MockStore_GetPlannedRecipesByFamilyID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.GetPlannedRecipesByFamilyIDParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetPlannedRecipesByFamilyID_Call) Return(_a0 []database.GetPlannedRecipesByFamilyIDRow, _a1 error) *MockStore_GetPlannedRecipesByFamilyID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetPlannedRecipesByFamilyID_Call) RunAndReturn(run func(context.Context, database.GetPlannedRecipesByFamilyIDParams) ([]database.GetPlannedRecipesByFamilyIDRow, error)) *MockStore_GetPlannedRecipesByFamilyID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRecipeByID provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetRecipeByID(ctx context.Context, id uuid.UUID) (database.Recipe, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRecipeByID")
+	}
+
+	var r0 database.Recipe
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (database.Recipe, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) database.Recipe); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(database.Recipe)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetRecipeByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecipeByID'
+type MockStore_GetRecipeByID_Call struct {
+	*mock.Call
+}
+
+// GetRecipeByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) GetRecipeByID(ctx interface{}, id interface{}) *MockStore_GetRecipeByID_Call {
+	return &MockStore_GetRecipeByID_Call{Call: _e.mock.On("GetRecipeByID", ctx, id)}
+}
+
+func (_c *MockStore_GetRecipeByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_GetRecipeByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetRecipeByID_Call) Return(_a0 database.Recipe, _a1 error) *MockStore_GetRecipeByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetRecipeByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (database.Recipe, error)) *MockStore_GetRecipeByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRecipeEquipment provides a mock function with given fields: ctx, recipeID
+func (_m *MockStore) GetRecipeEquipment(ctx context.Context, recipeID uuid.UUID) ([]database.GetRecipeEquipmentRow, error) {
+	ret := _m.Called(ctx, recipeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRecipeEquipment")
+	}
+
+	var r0 []database.GetRecipeEquipmentRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]database.GetRecipeEquipmentRow, error)); ok {
+		return rf(ctx, recipeID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []database.GetRecipeEquipmentRow); ok {
+		r0 = rf(ctx, recipeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]database.GetRecipeEquipmentRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, recipeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetRecipeEquipment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecipeEquipment'
+type MockStore_GetRecipeEquipment_Call struct {
+	*mock.Call
+}
+
+// GetRecipeEquipment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - recipeID uuid.UUID
+func (_e *MockStore_Expecter) GetRecipeEquipment(ctx interface{}, recipeID interface{}) *MockStore_GetRecipeEquipment_Call {
+	return &MockStore_GetRecipeEquipment_Call{Call: _e.mock.On("GetRecipeEquipment", ctx, recipeID)}
+}
+
+func (_c *MockStore_GetRecipeEquipment_Call) Run(run func(ctx context.Context, recipeID uuid.UUID)) *MockStore_GetRecipeEquipment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetRecipeEquipment_Call) Return(_a0 []database.GetRecipeEquipmentRow, _a1 error) *MockStore_GetRecipeEquipment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetRecipeEquipment_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]database.GetRecipeEquipmentRow, error)) *MockStore_GetRecipeEquipment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRecipeEquipmentByFamilyID provides a mock function with given fields: ctx, familyID
+func (_m *MockStore) GetRecipeEquipmentByFamilyID(ctx context.Context, familyID uuid.UUID) ([]database.GetRecipeEquipmentByFamilyIDRow, error) {
+	ret := _m.Called(ctx, familyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRecipeEquipmentByFamilyID")
+	}
+
+	var r0 []database.GetRecipeEquipmentByFamilyIDRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]database.GetRecipeEquipmentByFamilyIDRow, error)); ok {
+		return rf(ctx, familyID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []database.GetRecipeEquipmentByFamilyIDRow); ok {
+		r0 = rf(ctx, familyID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]database.GetRecipeEquipmentByFamilyIDRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, familyID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetRecipeEquipmentByFamilyID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecipeEquipmentByFamilyID'
+type MockStore_GetRecipeEquipmentByFamilyID_Call struct {
+	*mock.Call
+}
+
+// GetRecipeEquipmentByFamilyID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - familyID uuid.UUID
+func (_e *MockStore_Expecter) GetRecipeEquipmentByFamilyID(ctx interface{}, familyID interface{}) *MockStore_GetRecipeEquipmentByFamilyID_Call {
+	return &MockStore_GetRecipeEquipmentByFamilyID_Call{Call: _e.mock.On("GetRecipeEquipmentByFamilyID", ctx, familyID)}
+}
+
+func (_c *MockStore_GetRecipeEquipmentByFamilyID_Call) Run(run func(ctx context.Context, familyID uuid.UUID)) *MockStore_GetRecipeEquipmentByFamilyID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetRecipeEquipmentByFamilyID_Call) Return(_a0 []database.GetRecipeEquipmentByFamilyIDRow, _a1 error) *MockStore_GetRecipeEquipmentByFamilyID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetRecipeEquipmentByFamilyID_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]database.GetRecipeEquipmentByFamilyIDRow, error)) *MockStore_GetRecipeEquipmentByFamilyID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRecipes provides a mock function with given fields: ctx
+func (_m *MockStore) GetRecipes(ctx context.Context) ([]database.Recipe, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRecipes")
+	}
+
+	var r0 []database.Recipe
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]database.Recipe, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []database.Recipe); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]database.Recipe)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetRecipes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecipes'
+type MockStore_GetRecipes_Call struct {
+	*mock.Call
+}
+
+// GetRecipes is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockStore_Expecter) GetRecipes(ctx interface{}) *MockStore_GetRecipes_Call {
+	return &MockStore_GetRecipes_Call{Call: _e.mock.On("GetRecipes", ctx)}
+}
+
+func (_c *MockStore_GetRecipes_Call) Run(run func(ctx context.Context)) *MockStore_GetRecipes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetRecipes_Call) Return(_a0 []database.Recipe, _a1 error) *MockStore_GetRecipes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetRecipes_Call) RunAndReturn(run func(context.Context) ([]database.Recipe, error)) *MockStore_GetRecipes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRecipesByFamilyID provides a mock function with given fields: ctx, familyID
+func (_m *MockStore) GetRecipesByFamilyID(ctx context.Context, familyID uuid.UUID) ([]database.Recipe, error) {
+	ret := _m.Called(ctx, familyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRecipesByFamilyID")
+	}
+
+	var r0 []database.Recipe
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]database.Recipe, error)); ok {
+		return rf(ctx, familyID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []database.Recipe); ok {
+		r0 = rf(ctx, familyID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]database.Recipe)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, familyID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetRecipesByFamilyID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecipesByFamilyID'
+type MockStore_GetRecipesByFamilyID_Call struct {
+	*mock.Call
+}
+
+// GetRecipesByFamilyID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - familyID uuid.UUID
+func (_e *MockStore_Expecter) GetRecipesByFamilyID(ctx interface{}, familyID interface{}) *MockStore_GetRecipesByFamilyID_Call {
+	return &MockStore_GetRecipesByFamilyID_Call{Call: _e.mock.On("GetRecipesByFamilyID", ctx, familyID)}
+}
+
+func (_c *MockStore_GetRecipesByFamilyID_Call) Run(run func(ctx context.Context, familyID uuid.UUID)) *MockStore_GetRecipesByFamilyID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetRecipesByFamilyID_Call) Return(_a0 []database.Recipe, _a1 error) *MockStore_GetRecipesByFamilyID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetRecipesByFamilyID_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]database.Recipe, error)) *MockStore_GetRecipesByFamilyID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetShoppingListByID provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetShoppingListByID(ctx context.Context, id uuid.UUID) (database.ShoppingList, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetShoppingListByID")
+	}
+
+	var r0 database.ShoppingList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (database.ShoppingList, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) database.ShoppingList); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(database.ShoppingList)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetShoppingListByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetShoppingListByID'
+type MockStore_GetShoppingListByID_Call struct {
+	*mock.Call
+}
+
+// GetShoppingListByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) GetShoppingListByID(ctx interface{}, id interface{}) *MockStore_GetShoppingListByID_Call {
+	return &MockStore_GetShoppingListByID_Call{Call: _e.mock.On("GetShoppingListByID", ctx, id)}
+}
+
+func (_c *MockStore_GetShoppingListByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_GetShoppingListByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetShoppingListByID_Call) Return(_a0 database.ShoppingList, _a1 error) *MockStore_GetShoppingListByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetShoppingListByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (database.ShoppingList, error)) *MockStore_GetShoppingListByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetShoppingListByMealPlanID provides a mock function with given fields: ctx, mealPlanID
+func (_m *MockStore) GetShoppingListByMealPlanID(ctx context.Context, mealPlanID uuid.UUID) (database.ShoppingList, error) {
+	ret := _m.Called(ctx, mealPlanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetShoppingListByMealPlanID")
+	}
+
+	var r0 database.ShoppingList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (database.ShoppingList, error)); ok {
+		return rf(ctx, mealPlanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) database.ShoppingList); ok {
+		r0 = rf(ctx, mealPlanID)
+	} else {
+		r0 = ret.Get(0).(database.ShoppingList)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, mealPlanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetShoppingListByMealPlanID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetShoppingListByMealPlanID'
+type MockStore_GetShoppingListByMealPlanID_Call struct {
+	*mock.Call
+}
+
+// GetShoppingListByMealPlanID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mealPlanID uuid.UUID
+func (_e *MockStore_Expecter) GetShoppingListByMealPlanID(ctx interface{}, mealPlanID interface{}) *MockStore_GetShoppingListByMealPlanID_Call {
+	return &MockStore_GetShoppingListByMealPlanID_Call{Call: _e.mock.On("GetShoppingListByMealPlanID", ctx, mealPlanID)}
+}
+
+func (_c *MockStore_GetShoppingListByMealPlanID_Call) Run(run func(ctx context.Context, mealPlanID uuid.UUID)) *MockStore_GetShoppingListByMealPlanID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetShoppingListByMealPlanID_Call) Return(_a0 database.ShoppingList, _a1 error) *MockStore_GetShoppingListByMealPlanID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetShoppingListByMealPlanID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (database.ShoppingList, error)) *MockStore_GetShoppingListByMealPlanID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetShoppingListItemByID provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetShoppingListItemByID(ctx context.Context, id uuid.UUID) (database.ShoppingListItem, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetShoppingListItemByID")
+	}
+
+	var r0 database.ShoppingListItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (database.ShoppingListItem, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) database.ShoppingListItem); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(database.ShoppingListItem)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetShoppingListItemByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetShoppingListItemByID'
+type MockStore_GetShoppingListItemByID_Call struct {
+	*mock.Call
+}
+
+// GetShoppingListItemByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) GetShoppingListItemByID(ctx interface{}, id interface{}) *MockStore_GetShoppingListItemByID_Call {
+	return &MockStore_GetShoppingListItemByID_Call{Call: _e.mock.On("GetShoppingListItemByID", ctx, id)}
+}
+
+func (_c *MockStore_GetShoppingListItemByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_GetShoppingListItemByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetShoppingListItemByID_Call) Return(_a0 database.ShoppingListItem, _a1 error) *MockStore_GetShoppingListItemByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetShoppingListItemByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (database.ShoppingListItem, error)) *MockStore_GetShoppingListItemByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetShoppingListItems provides a mock function with given fields: ctx, shoppingListID
+func (_m *MockStore) GetShoppingListItems(ctx context.Context, shoppingListID uuid.UUID) ([]database.ShoppingListItem, error) {
+	ret := _m.Called(ctx, shoppingListID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetShoppingListItems")
+	}
+
+	var r0 []database.ShoppingListItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]database.ShoppingListItem, error)); ok {
+		return rf(ctx, shoppingListID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []database.ShoppingListItem); ok {
+		r0 = rf(ctx, shoppingListID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]database.ShoppingListItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, shoppingListID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetShoppingListItems_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetShoppingListItems'
+type MockStore_GetShoppingListItems_Call struct {
+	*mock.Call
+}
+
+// GetShoppingListItems is a helper method to define mock.On call
+//   - ctx context.Context
+//   - shoppingListID uuid.UUID
+func (_e *MockStore_Expecter) GetShoppingListItems(ctx interface{}, shoppingListID interface{}) *MockStore_GetShoppingListItems_Call {
+	return &MockStore_GetShoppingListItems_Call{Call: _e.mock.On("GetShoppingListItems", ctx, shoppingListID)}
+}
+
+func (_c *MockStore_GetShoppingListItems_Call) Run(run func(ctx context.Context, shoppingListID uuid.UUID)) *MockStore_GetShoppingListItems_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetShoppingListItems_Call) Return(_a0 []database.ShoppingListItem, _a1 error) *MockStore_GetShoppingListItems_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetShoppingListItems_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]database.ShoppingListItem, error)) *MockStore_GetShoppingListItems_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetShoppingListsByFamilyID provides a mock function with given fields: ctx, familyID
+func (_m *MockStore) GetShoppingListsByFamilyID(ctx context.Context, familyID uuid.UUID) ([]database.ShoppingList, error) {
+	ret := _m.Called(ctx, familyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetShoppingListsByFamilyID")
+	}
+
+	var r0 []database.ShoppingList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]database.ShoppingList, error)); ok {
+		return rf(ctx, familyID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []database.ShoppingList); ok {
+		r0 = rf(ctx, familyID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]database.ShoppingList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, familyID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetShoppingListsByFamilyID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetShoppingListsByFamilyID'
+type MockStore_GetShoppingListsByFamilyID_Call struct {
+	*mock.Call
+}
+
+// GetShoppingListsByFamilyID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - familyID uuid.UUID
+func (_e *MockStore_Expecter) GetShoppingListsByFamilyID(ctx interface{}, familyID interface{}) *MockStore_GetShoppingListsByFamilyID_Call {
+	return &MockStore_GetShoppingListsByFamilyID_Call{Call: _e.mock.On("GetShoppingListsByFamilyID", ctx, familyID)}
+}
+
+func (_c *MockStore_GetShoppingListsByFamilyID_Call) Run(run func(ctx context.Context, familyID uuid.UUID)) *MockStore_GetShoppingListsByFamilyID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetShoppingListsByFamilyID_Call) Return(_a0 []database.ShoppingList, _a1 error) *MockStore_GetShoppingListsByFamilyID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetShoppingListsByFamilyID_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]database.ShoppingList, error)) *MockStore_GetShoppingListsByFamilyID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStapleByID provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetStapleByID(ctx context.Context, id uuid.UUID) (database.Staple, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStapleByID")
+	}
+
+	var r0 database.Staple
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (database.Staple, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) database.Staple); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(database.Staple)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetStapleByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStapleByID'
+type MockStore_GetStapleByID_Call struct {
+	*mock.Call
+}
+
+// GetStapleByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) GetStapleByID(ctx interface{}, id interface{}) *MockStore_GetStapleByID_Call {
+	return &MockStore_GetStapleByID_Call{Call: _e.mock.On("GetStapleByID", ctx, id)}
+}
+
+func (_c *MockStore_GetStapleByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_GetStapleByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetStapleByID_Call) Return(_a0 database.Staple, _a1 error) *MockStore_GetStapleByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetStapleByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (database.Staple, error)) *MockStore_GetStapleByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStaplesByFamilyID provides a mock function with given fields: ctx, familyID
+func (_m *MockStore) GetStaplesByFamilyID(ctx context.Context, familyID uuid.UUID) ([]database.GetStaplesByFamilyIDRow, error) {
+	ret := _m.Called(ctx, familyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStaplesByFamilyID")
+	}
+
+	var r0 []database.GetStaplesByFamilyIDRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]database.GetStaplesByFamilyIDRow, error)); ok {
+		return rf(ctx, familyID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []database.GetStaplesByFamilyIDRow); ok {
+		r0 = rf(ctx, familyID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]database.GetStaplesByFamilyIDRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, familyID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetStaplesByFamilyID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStaplesByFamilyID'
+type MockStore_GetStaplesByFamilyID_Call struct {
+	*mock.Call
+}
+
+// GetStaplesByFamilyID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - familyID uuid.UUID
+func (_e *MockStore_Expecter) GetStaplesByFamilyID(ctx interface{}, familyID interface{}) *MockStore_GetStaplesByFamilyID_Call {
+	return &MockStore_GetStaplesByFamilyID_Call{Call: _e.mock.On("GetStaplesByFamilyID", ctx, familyID)}
+}
+
+func (_c *MockStore_GetStaplesByFamilyID_Call) Run(run func(ctx context.Context, familyID uuid.UUID)) *MockStore_GetStaplesByFamilyID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetStaplesByFamilyID_Call) Return(_a0 []database.GetStaplesByFamilyIDRow, _a1 error) *MockStore_GetStaplesByFamilyID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetStaplesByFamilyID_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]database.GetStaplesByFamilyIDRow, error)) *MockStore_GetStaplesByFamilyID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStoreLayoutByID provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetStoreLayoutByID(ctx context.Context, id uuid.UUID) (database.StoreLayout, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStoreLayoutByID")
+	}
+
+	var r0 database.StoreLayout
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (database.StoreLayout, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) database.StoreLayout); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(database.StoreLayout)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetStoreLayoutByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStoreLayoutByID'
+type MockStore_GetStoreLayoutByID_Call struct {
+	*mock.Call
+}
+
+// GetStoreLayoutByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) GetStoreLayoutByID(ctx interface{}, id interface{}) *MockStore_GetStoreLayoutByID_Call {
+	return &MockStore_GetStoreLayoutByID_Call{Call: _e.mock.On("GetStoreLayoutByID", ctx, id)}
+}
+
+func (_c *MockStore_GetStoreLayoutByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_GetStoreLayoutByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetStoreLayoutByID_Call) Return(_a0 database.StoreLayout, _a1 error) *MockStore_GetStoreLayoutByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetStoreLayoutByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (database.StoreLayout, error)) *MockStore_GetStoreLayoutByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStoreLayoutsByFamilyID provides a mock function with given fields: ctx, familyID
+func (_m *MockStore) GetStoreLayoutsByFamilyID(ctx context.Context, familyID uuid.UUID) ([]database.StoreLayout, error) {
+	ret := _m.Called(ctx, familyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStoreLayoutsByFamilyID")
+	}
+
+	var r0 []database.StoreLayout
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]database.StoreLayout, error)); ok {
+		return rf(ctx, familyID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []database.StoreLayout); ok {
+		r0 = rf(ctx, familyID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]database.StoreLayout)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, familyID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetStoreLayoutsByFamilyID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStoreLayoutsByFamilyID'
+type MockStore_GetStoreLayoutsByFamilyID_Call struct {
+	*mock.Call
+}
+
+// GetStoreLayoutsByFamilyID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - familyID uuid.UUID
+func (_e *MockStore_Expecter) GetStoreLayoutsByFamilyID(ctx interface{}, familyID interface{}) *MockStore_GetStoreLayoutsByFamilyID_Call {
+	return &MockStore_GetStoreLayoutsByFamilyID_Call{Call: _e.mock.On("GetStoreLayoutsByFamilyID", ctx, familyID)}
+}
+
+func (_c *MockStore_GetStoreLayoutsByFamilyID_Call) Run(run func(ctx context.Context, familyID uuid.UUID)) *MockStore_GetStoreLayoutsByFamilyID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetStoreLayoutsByFamilyID_Call) Return(_a0 []database.StoreLayout, _a1 error) *MockStore_GetStoreLayoutsByFamilyID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetStoreLayoutsByFamilyID_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]database.StoreLayout, error)) *MockStore_GetStoreLayoutsByFamilyID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserByEmail provides a mock function with given fields: ctx, email
+func (_m *MockStore) GetUserByEmail(ctx context.Context, email string) (database.User, error) {
+	ret := _m.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByEmail")
+	}
+
+	var r0 database.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (database.User, error)); ok {
+		return rf(ctx, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) database.User); ok {
+		r0 = rf(ctx, email)
+	} else {
+		r0 = ret.Get(0).(database.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetUserByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByEmail'
+type MockStore_GetUserByEmail_Call struct {
+	*mock.Call
+}
+
+// GetUserByEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *MockStore_Expecter) GetUserByEmail(ctx interface{}, email interface{}) *MockStore_GetUserByEmail_Call {
+	return &MockStore_GetUserByEmail_Call{Call: _e.mock.On("GetUserByEmail", ctx, email)}
+}
+
+func (_c *MockStore_GetUserByEmail_Call) Run(run func(ctx context.Context, email string)) *MockStore_GetUserByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetUserByEmail_Call) Return(_a0 database.User, _a1 error) *MockStore_GetUserByEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetUserByEmail_Call) RunAndReturn(run func(context.Context, string) (database.User, error)) *MockStore_GetUserByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserByID provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetUserByID(ctx context.Context, id uuid.UUID) (database.User, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByID")
+	}
+
+	var r0 database.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (database.User, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) database.User); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(database.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetUserByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByID'
+type MockStore_GetUserByID_Call struct {
+	*mock.Call
+}
+
+// GetUserByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) GetUserByID(ctx interface{}, id interface{}) *MockStore_GetUserByID_Call {
+	return &MockStore_GetUserByID_Call{Call: _e.mock.On("GetUserByID", ctx, id)}
+}
+
+func (_c *MockStore_GetUserByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_GetUserByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetUserByID_Call) Return(_a0 database.User, _a1 error) *MockStore_GetUserByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetUserByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (database.User, error)) *MockStore_GetUserByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUsers provides a mock function with given fields: ctx
+func (_m *MockStore) GetUsers(ctx context.Context) ([]database.User, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUsers")
+	}
+
+	var r0 []database.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]database.User, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []database.User); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]database.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsers'
+type MockStore_GetUsers_Call struct {
+	*mock.Call
+}
+
+// GetUsers is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockStore_Expecter) GetUsers(ctx interface{}) *MockStore_GetUsers_Call {
+	return &MockStore_GetUsers_Call{Call: _e.mock.On("GetUsers", ctx)}
+}
+
+func (_c *MockStore_GetUsers_Call) Run(run func(ctx context.Context)) *MockStore_GetUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetUsers_Call) Return(_a0 []database.User, _a1 error) *MockStore_GetUsers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetUsers_Call) RunAndReturn(run func(context.Context) ([]database.User, error)) *MockStore_GetUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUsersByFamilyID provides a mock function with given fields: ctx, familyID
+func (_m *MockStore) GetUsersByFamilyID(ctx context.Context, familyID uuid.UUID) ([]database.User, error) {
+	ret := _m.Called(ctx, familyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUsersByFamilyID")
+	}
+
+	var r0 []database.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]database.User, error)); ok {
+		return rf(ctx, familyID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []database.User); ok {
+		r0 = rf(ctx, familyID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]database.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, familyID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetUsersByFamilyID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsersByFamilyID'
+type MockStore_GetUsersByFamilyID_Call struct {
+	*mock.Call
+}
+
+// GetUsersByFamilyID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - familyID uuid.UUID
+func (_e *MockStore_Expecter) GetUsersByFamilyID(ctx interface{}, familyID interface{}) *MockStore_GetUsersByFamilyID_Call {
+	return &MockStore_GetUsersByFamilyID_Call{Call: _e.mock.On("GetUsersByFamilyID", ctx, familyID)}
+}
+
+func (_c *MockStore_GetUsersByFamilyID_Call) Run(run func(ctx context.Context, familyID uuid.UUID)) *MockStore_GetUsersByFamilyID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetUsersByFamilyID_Call) Return(_a0 []database.User, _a1 error) *MockStore_GetUsersByFamilyID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetUsersByFamilyID_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]database.User, error)) *MockStore_GetUsersByFamilyID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsRecipeFavorited provides a mock function with given fields: ctx, arg
+func (_m *MockStore) IsRecipeFavorited(ctx context.Context, arg database.IsRecipeFavoritedParams) (bool, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsRecipeFavorited")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.IsRecipeFavoritedParams) (bool, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.IsRecipeFavoritedParams) bool); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.IsRecipeFavoritedParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_IsRecipeFavorited_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsRecipeFavorited'
+type MockStore_IsRecipeFavorited_Call struct {
+	*mock.Call
+}
+
+// IsRecipeFavorited is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.IsRecipeFavoritedParams
+func (_e *MockStore_Expecter) IsRecipeFavorited(ctx interface{}, arg interface{}) *MockStore_IsRecipeFavorited_Call {
+	return &MockStore_IsRecipeFavorited_Call{Call: _e.mock.On("IsRecipeFavorited", ctx, arg)}
+}
+
+func (_c *MockStore_IsRecipeFavorited_Call) Run(run func(ctx context.Context, arg database.IsRecipeFavoritedParams)) *MockStore_IsRecipeFavorited_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.IsRecipeFavoritedParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_IsRecipeFavorited_Call) Return(_a0 bool, _a1 error) *MockStore_IsRecipeFavorited_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_IsRecipeFavorited_Call) RunAndReturn(run func(context.Context, database.IsRecipeFavoritedParams) (bool, error)) *MockStore_IsRecipeFavorited_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockMealPlanEntries provides a mock function with given fields: ctx, mealPlanID
+func (_m *MockStore) LockMealPlanEntries(ctx context.Context, mealPlanID uuid.UUID) error {
+	ret := _m.Called(ctx, mealPlanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockMealPlanEntries")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, mealPlanID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_LockMealPlanEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockMealPlanEntries'
+type MockStore_LockMealPlanEntries_Call struct {
+	*mock.Call
+}
+
+// LockMealPlanEntries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mealPlanID uuid.UUID
+func (_e *MockStore_Expecter) LockMealPlanEntries(ctx interface{}, mealPlanID interface{}) *MockStore_LockMealPlanEntries_Call {
+	return &MockStore_LockMealPlanEntries_Call{Call: _e.mock.On("LockMealPlanEntries", ctx, mealPlanID)}
+}
+
+func (_c *MockStore_LockMealPlanEntries_Call) Run(run func(ctx context.Context, mealPlanID uuid.UUID)) *MockStore_LockMealPlanEntries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_LockMealPlanEntries_Call) Return(_a0 error) *MockStore_LockMealPlanEntries_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_LockMealPlanEntries_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_LockMealPlanEntries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MergeRecipesTx provides a mock function with given fields: ctx, arg
+func (_m *MockStore) MergeRecipesTx(ctx context.Context, arg database.MergeRecipesTxParams) (database.Recipe, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeRecipesTx")
+	}
+
+	var r0 database.Recipe
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.MergeRecipesTxParams) (database.Recipe, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.MergeRecipesTxParams) database.Recipe); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(database.Recipe)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.MergeRecipesTxParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_MergeRecipesTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MergeRecipesTx'
+type MockStore_MergeRecipesTx_Call struct {
+	*mock.Call
+}
+
+// MergeRecipesTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.MergeRecipesTxParams
+func (_e *MockStore_Expecter) MergeRecipesTx(ctx interface{}, arg interface{}) *MockStore_MergeRecipesTx_Call {
+	return &MockStore_MergeRecipesTx_Call{Call: _e.mock.On("MergeRecipesTx", ctx, arg)}
+}
+
+func (_c *MockStore_MergeRecipesTx_Call) Run(run func(ctx context.Context, arg database.MergeRecipesTxParams)) *MockStore_MergeRecipesTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.MergeRecipesTxParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_MergeRecipesTx_Call) Return(_a0 database.Recipe, _a1 error) *MockStore_MergeRecipesTx_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_MergeRecipesTx_Call) RunAndReturn(run func(context.Context, database.MergeRecipesTxParams) (database.Recipe, error)) *MockStore_MergeRecipesTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveCollectionRecipes provides a mock function with given fields: ctx, arg
+func (_m *MockStore) MoveCollectionRecipes(ctx context.Context, arg database.MoveCollectionRecipesParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveCollectionRecipes")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.MoveCollectionRecipesParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_MoveCollectionRecipes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveCollectionRecipes'
+type MockStore_MoveCollectionRecipes_Call struct {
+	*mock.Call
+}
+
+// MoveCollectionRecipes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.MoveCollectionRecipesParams
+func (_e *MockStore_Expecter) MoveCollectionRecipes(ctx interface{}, arg interface{}) *MockStore_MoveCollectionRecipes_Call {
+	return &MockStore_MoveCollectionRecipes_Call{Call: _e.mock.On("MoveCollectionRecipes", ctx, arg)}
+}
+
+func (_c *MockStore_MoveCollectionRecipes_Call) Run(run func(ctx context.Context, arg database.MoveCollectionRecipesParams)) *MockStore_MoveCollectionRecipes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.MoveCollectionRecipesParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_MoveCollectionRecipes_Call) Return(_a0 error) *MockStore_MoveCollectionRecipes_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_MoveCollectionRecipes_Call) RunAndReturn(run func(context.Context, database.MoveCollectionRecipesParams) error) *MockStore_MoveCollectionRecipes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveCookLogs provides a mock function with given fields: ctx, arg
+func (_m *MockStore) MoveCookLogs(ctx context.Context, arg database.MoveCookLogsParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveCookLogs")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.MoveCookLogsParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_MoveCookLogs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveCookLogs'
+type MockStore_MoveCookLogs_Call struct {
+	*mock.Call
+}
+
+// MoveCookLogs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.MoveCookLogsParams
+func (_e *MockStore_Expecter) MoveCookLogs(ctx interface{}, arg interface{}) *MockStore_MoveCookLogs_Call {
+	return &MockStore_MoveCookLogs_Call{Call: _e.mock.On("MoveCookLogs", ctx, arg)}
+}
+
+func (_c *MockStore_MoveCookLogs_Call) Run(run func(ctx context.Context, arg database.MoveCookLogsParams)) *MockStore_MoveCookLogs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.MoveCookLogsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_MoveCookLogs_Call) Return(_a0 error) *MockStore_MoveCookLogs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_MoveCookLogs_Call) RunAndReturn(run func(context.Context, database.MoveCookLogsParams) error) *MockStore_MoveCookLogs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveEventRecipes provides a mock function with given fields: ctx, arg
+func (_m *MockStore) MoveEventRecipes(ctx context.Context, arg database.MoveEventRecipesParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveEventRecipes")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.MoveEventRecipesParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_MoveEventRecipes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveEventRecipes'
+type MockStore_MoveEventRecipes_Call struct {
+	*mock.Call
+}
+
+// MoveEventRecipes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.MoveEventRecipesParams
+func (_e *MockStore_Expecter) MoveEventRecipes(ctx interface{}, arg interface{}) *MockStore_MoveEventRecipes_Call {
+	return &MockStore_MoveEventRecipes_Call{Call: _e.mock.On("MoveEventRecipes", ctx, arg)}
+}
+
+func (_c *MockStore_MoveEventRecipes_Call) Run(run func(ctx context.Context, arg database.MoveEventRecipesParams)) *MockStore_MoveEventRecipes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.MoveEventRecipesParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_MoveEventRecipes_Call) Return(_a0 error) *MockStore_MoveEventRecipes_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_MoveEventRecipes_Call) RunAndReturn(run func(context.Context, database.MoveEventRecipesParams) error) *MockStore_MoveEventRecipes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveFavorites provides a mock function with given fields: ctx, arg
+func (_m *MockStore) MoveFavorites(ctx context.Context, arg database.MoveFavoritesParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveFavorites")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.MoveFavoritesParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_MoveFavorites_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveFavorites'
+type MockStore_MoveFavorites_Call struct {
+	*mock.Call
+}
+
+// MoveFavorites is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.MoveFavoritesParams
+func (_e *MockStore_Expecter) MoveFavorites(ctx interface{}, arg interface{}) *MockStore_MoveFavorites_Call {
+	return &MockStore_MoveFavorites_Call{Call: _e.mock.On("MoveFavorites", ctx, arg)}
+}
+
+func (_c *MockStore_MoveFavorites_Call) Run(run func(ctx context.Context, arg database.MoveFavoritesParams)) *MockStore_MoveFavorites_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.MoveFavoritesParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_MoveFavorites_Call) Return(_a0 error) *MockStore_MoveFavorites_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_MoveFavorites_Call) RunAndReturn(run func(context.Context, database.MoveFavoritesParams) error) *MockStore_MoveFavorites_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveMealPlanEntries provides a mock function with given fields: ctx, arg
+func (_m *MockStore) MoveMealPlanEntries(ctx context.Context, arg database.MoveMealPlanEntriesParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveMealPlanEntries")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.MoveMealPlanEntriesParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_MoveMealPlanEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveMealPlanEntries'
+type MockStore_MoveMealPlanEntries_Call struct {
+	*mock.Call
+}
+
+// MoveMealPlanEntries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.MoveMealPlanEntriesParams
+func (_e *MockStore_Expecter) MoveMealPlanEntries(ctx interface{}, arg interface{}) *MockStore_MoveMealPlanEntries_Call {
+	return &MockStore_MoveMealPlanEntries_Call{Call: _e.mock.On("MoveMealPlanEntries", ctx, arg)}
+}
+
+func (_c *MockStore_MoveMealPlanEntries_Call) Run(run func(ctx context.Context, arg database.MoveMealPlanEntriesParams)) *MockStore_MoveMealPlanEntries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.MoveMealPlanEntriesParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_MoveMealPlanEntries_Call) Return(_a0 error) *MockStore_MoveMealPlanEntries_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_MoveMealPlanEntries_Call) RunAndReturn(run func(context.Context, database.MoveMealPlanEntriesParams) error) *MockStore_MoveMealPlanEntries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveMealPlanTemplateEntries provides a mock function with given fields: ctx, arg
+func (_m *MockStore) MoveMealPlanTemplateEntries(ctx context.Context, arg database.MoveMealPlanTemplateEntriesParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveMealPlanTemplateEntries")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.MoveMealPlanTemplateEntriesParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_MoveMealPlanTemplateEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveMealPlanTemplateEntries'
+type MockStore_MoveMealPlanTemplateEntries_Call struct {
+	*mock.Call
+}
+
+// MoveMealPlanTemplateEntries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.MoveMealPlanTemplateEntriesParams
+func (_e *MockStore_Expecter) MoveMealPlanTemplateEntries(ctx interface{}, arg interface{}) *MockStore_MoveMealPlanTemplateEntries_Call {
+	return &MockStore_MoveMealPlanTemplateEntries_Call{Call: _e.mock.On("MoveMealPlanTemplateEntries", ctx, arg)}
+}
+
+func (_c *MockStore_MoveMealPlanTemplateEntries_Call) Run(run func(ctx context.Context, arg database.MoveMealPlanTemplateEntriesParams)) *MockStore_MoveMealPlanTemplateEntries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.MoveMealPlanTemplateEntriesParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_MoveMealPlanTemplateEntries_Call) Return(_a0 error) *MockStore_MoveMealPlanTemplateEntries_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_MoveMealPlanTemplateEntries_Call) RunAndReturn(run func(context.Context, database.MoveMealPlanTemplateEntriesParams) error) *MockStore_MoveMealPlanTemplateEntries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveMealPlanVotes provides a mock function with given fields: ctx, arg
+func (_m *MockStore) MoveMealPlanVotes(ctx context.Context, arg database.MoveMealPlanVotesParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveMealPlanVotes")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.MoveMealPlanVotesParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_MoveMealPlanVotes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveMealPlanVotes'
+type MockStore_MoveMealPlanVotes_Call struct {
+	*mock.Call
+}
+
+// MoveMealPlanVotes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.MoveMealPlanVotesParams
+func (_e *MockStore_Expecter) MoveMealPlanVotes(ctx interface{}, arg interface{}) *MockStore_MoveMealPlanVotes_Call {
+	return &MockStore_MoveMealPlanVotes_Call{Call: _e.mock.On("MoveMealPlanVotes", ctx, arg)}
+}
+
+func (_c *MockStore_MoveMealPlanVotes_Call) Run(run func(ctx context.Context, arg database.MoveMealPlanVotesParams)) *MockStore_MoveMealPlanVotes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.MoveMealPlanVotesParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_MoveMealPlanVotes_Call) Return(_a0 error) *MockStore_MoveMealPlanVotes_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_MoveMealPlanVotes_Call) RunAndReturn(run func(context.Context, database.MoveMealPlanVotesParams) error) *MockStore_MoveMealPlanVotes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveRecipeEquipment provides a mock function with given fields: ctx, arg
+func (_m *MockStore) MoveRecipeEquipment(ctx context.Context, arg database.MoveRecipeEquipmentParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveRecipeEquipment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.MoveRecipeEquipmentParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_MoveRecipeEquipment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveRecipeEquipment'
+type MockStore_MoveRecipeEquipment_Call struct {
+	*mock.Call
+}
+
+// MoveRecipeEquipment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.MoveRecipeEquipmentParams
+func (_e *MockStore_Expecter) MoveRecipeEquipment(ctx interface{}, arg interface{}) *MockStore_MoveRecipeEquipment_Call {
+	return &MockStore_MoveRecipeEquipment_Call{Call: _e.mock.On("MoveRecipeEquipment", ctx, arg)}
+}
+
+func (_c *MockStore_MoveRecipeEquipment_Call) Run(run func(ctx context.Context, arg database.MoveRecipeEquipmentParams)) *MockStore_MoveRecipeEquipment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.MoveRecipeEquipmentParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_MoveRecipeEquipment_Call) Return(_a0 error) *MockStore_MoveRecipeEquipment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_MoveRecipeEquipment_Call) RunAndReturn(run func(context.Context, database.MoveRecipeEquipmentParams) error) *MockStore_MoveRecipeEquipment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveEventRecipe provides a mock function with given fields: ctx, arg
+func (_m *MockStore) RemoveEventRecipe(ctx context.Context, arg database.RemoveEventRecipeParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveEventRecipe")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.RemoveEventRecipeParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_RemoveEventRecipe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveEventRecipe'
+type MockStore_RemoveEventRecipe_Call struct {
+	*mock.Call
+}
+
+// RemoveEventRecipe is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.RemoveEventRecipeParams
+func (_e *MockStore_Expecter) RemoveEventRecipe(ctx interface{}, arg interface{}) *MockStore_RemoveEventRecipe_Call {
+	return &MockStore_RemoveEventRecipe_Call{Call: _e.mock.On("RemoveEventRecipe", ctx, arg)}
+}
+
+func (_c *MockStore_RemoveEventRecipe_Call) Run(run func(ctx context.Context, arg database.RemoveEventRecipeParams)) *MockStore_RemoveEventRecipe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.RemoveEventRecipeParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_RemoveEventRecipe_Call) Return(_a0 error) *MockStore_RemoveEventRecipe_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_RemoveEventRecipe_Call) RunAndReturn(run func(context.Context, database.RemoveEventRecipeParams) error) *MockStore_RemoveEventRecipe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveFavorite provides a mock function with given fields: ctx, arg
+func (_m *MockStore) RemoveFavorite(ctx context.Context, arg database.RemoveFavoriteParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveFavorite")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.RemoveFavoriteParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_RemoveFavorite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveFavorite'
+type MockStore_RemoveFavorite_Call struct {
+	*mock.Call
+}
+
+// RemoveFavorite is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.RemoveFavoriteParams
+func (_e *MockStore_Expecter) RemoveFavorite(ctx interface{}, arg interface{}) *MockStore_RemoveFavorite_Call {
+	return &MockStore_RemoveFavorite_Call{Call: _e.mock.On("RemoveFavorite", ctx, arg)}
+}
+
+func (_c *MockStore_RemoveFavorite_Call) Run(run func(ctx context.Context, arg database.RemoveFavoriteParams)) *MockStore_RemoveFavorite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.RemoveFavoriteParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_RemoveFavorite_Call) Return(_a0 error) *MockStore_RemoveFavorite_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_RemoveFavorite_Call) RunAndReturn(run func(context.Context, database.RemoveFavoriteParams) error) *MockStore_RemoveFavorite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveRecipeFromCollection provides a mock function with given fields: ctx, arg
+func (_m *MockStore) RemoveRecipeFromCollection(ctx context.Context, arg database.RemoveRecipeFromCollectionParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveRecipeFromCollection")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.RemoveRecipeFromCollectionParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_RemoveRecipeFromCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveRecipeFromCollection'
+type MockStore_RemoveRecipeFromCollection_Call struct {
+	*mock.Call
+}
+
+// RemoveRecipeFromCollection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.RemoveRecipeFromCollectionParams
+func (_e *MockStore_Expecter) RemoveRecipeFromCollection(ctx interface{}, arg interface{}) *MockStore_RemoveRecipeFromCollection_Call {
+	return &MockStore_RemoveRecipeFromCollection_Call{Call: _e.mock.On("RemoveRecipeFromCollection", ctx, arg)}
+}
+
+func (_c *MockStore_RemoveRecipeFromCollection_Call) Run(run func(ctx context.Context, arg database.RemoveRecipeFromCollectionParams)) *MockStore_RemoveRecipeFromCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.RemoveRecipeFromCollectionParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_RemoveRecipeFromCollection_Call) Return(_a0 error) *MockStore_RemoveRecipeFromCollection_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_RemoveRecipeFromCollection_Call) RunAndReturn(run func(context.Context, database.RemoveRecipeFromCollectionParams) error) *MockStore_RemoveRecipeFromCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReorderCollectionRecipesTx provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ReorderCollectionRecipesTx(ctx context.Context, arg database.ReorderCollectionRecipesTxParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReorderCollectionRecipesTx")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.ReorderCollectionRecipesTxParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_ReorderCollectionRecipesTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReorderCollectionRecipesTx'
+type MockStore_ReorderCollectionRecipesTx_Call struct {
+	*mock.Call
+}
+
+// ReorderCollectionRecipesTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.ReorderCollectionRecipesTxParams
+func (_e *MockStore_Expecter) ReorderCollectionRecipesTx(ctx interface{}, arg interface{}) *MockStore_ReorderCollectionRecipesTx_Call {
+	return &MockStore_ReorderCollectionRecipesTx_Call{Call: _e.mock.On("ReorderCollectionRecipesTx", ctx, arg)}
+}
+
+func (_c *MockStore_ReorderCollectionRecipesTx_Call) Run(run func(ctx context.Context, arg database.ReorderCollectionRecipesTxParams)) *MockStore_ReorderCollectionRecipesTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.ReorderCollectionRecipesTxParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_ReorderCollectionRecipesTx_Call) Return(_a0 error) *MockStore_ReorderCollectionRecipesTx_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_ReorderCollectionRecipesTx_Call) RunAndReturn(run func(context.Context, database.ReorderCollectionRecipesTxParams) error) *MockStore_ReorderCollectionRecipesTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplaceMealPlanEntriesTx provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ReplaceMealPlanEntriesTx(ctx context.Context, arg database.ReplaceMealPlanEntriesTxParams) ([]database.MealPlanEntry, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceMealPlanEntriesTx")
+	}
+
+	var r0 []database.MealPlanEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.ReplaceMealPlanEntriesTxParams) ([]database.MealPlanEntry, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.ReplaceMealPlanEntriesTxParams) []database.MealPlanEntry); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]database.MealPlanEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.ReplaceMealPlanEntriesTxParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ReplaceMealPlanEntriesTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceMealPlanEntriesTx'
+type MockStore_ReplaceMealPlanEntriesTx_Call struct {
+	*mock.Call
+}
+
+// ReplaceMealPlanEntriesTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.ReplaceMealPlanEntriesTxParams
+func (_e *MockStore_Expecter) ReplaceMealPlanEntriesTx(ctx interface{}, arg interface{}) *MockStore_ReplaceMealPlanEntriesTx_Call {
+	return &MockStore_ReplaceMealPlanEntriesTx_Call{Call: _e.mock.On("ReplaceMealPlanEntriesTx", ctx, arg)}
+}
+
+func (_c *MockStore_ReplaceMealPlanEntriesTx_Call) Run(run func(ctx context.Context, arg database.ReplaceMealPlanEntriesTxParams)) *MockStore_ReplaceMealPlanEntriesTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.ReplaceMealPlanEntriesTxParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_ReplaceMealPlanEntriesTx_Call) Return(_a0 []database.MealPlanEntry, _a1 error) *MockStore_ReplaceMealPlanEntriesTx_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ReplaceMealPlanEntriesTx_Call) RunAndReturn(run func(context.Context, database.ReplaceMealPlanEntriesTxParams) ([]database.MealPlanEntry, error)) *MockStore_ReplaceMealPlanEntriesTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetFamilyEquipmentTx provides a mock function with given fields: ctx, arg
+func (_m *MockStore) SetFamilyEquipmentTx(ctx context.Context, arg database.SetFamilyEquipmentTxParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetFamilyEquipmentTx")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.SetFamilyEquipmentTxParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_SetFamilyEquipmentTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetFamilyEquipmentTx'
+type MockStore_SetFamilyEquipmentTx_Call struct {
+	*mock.Call
+}
+
+// SetFamilyEquipmentTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.SetFamilyEquipmentTxParams
+func (_e *MockStore_Expecter) SetFamilyEquipmentTx(ctx interface{}, arg interface{}) *MockStore_SetFamilyEquipmentTx_Call {
+	return &MockStore_SetFamilyEquipmentTx_Call{Call: _e.mock.On("SetFamilyEquipmentTx", ctx, arg)}
+}
+
+func (_c *MockStore_SetFamilyEquipmentTx_Call) Run(run func(ctx context.Context, arg database.SetFamilyEquipmentTxParams)) *MockStore_SetFamilyEquipmentTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.SetFamilyEquipmentTxParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_SetFamilyEquipmentTx_Call) Return(_a0 error) *MockStore_SetFamilyEquipmentTx_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_SetFamilyEquipmentTx_Call) RunAndReturn(run func(context.Context, database.SetFamilyEquipmentTxParams) error) *MockStore_SetFamilyEquipmentTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetMealAttendanceTx provides a mock function with given fields: ctx, arg
+func (_m *MockStore) SetMealAttendanceTx(ctx context.Context, arg database.SetMealAttendanceTxParams) ([]database.MealPlanEntry, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetMealAttendanceTx")
+	}
+
+	var r0 []database.MealPlanEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.SetMealAttendanceTxParams) ([]database.MealPlanEntry, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.SetMealAttendanceTxParams) []database.MealPlanEntry); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]database.MealPlanEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.SetMealAttendanceTxParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_SetMealAttendanceTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetMealAttendanceTx'
+type MockStore_SetMealAttendanceTx_Call struct {
+	*mock.Call
+}
+
+// SetMealAttendanceTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.SetMealAttendanceTxParams
+func (_e *MockStore_Expecter) SetMealAttendanceTx(ctx interface{}, arg interface{}) *MockStore_SetMealAttendanceTx_Call {
+	return &MockStore_SetMealAttendanceTx_Call{Call: _e.mock.On("SetMealAttendanceTx", ctx, arg)}
+}
+
+func (_c *MockStore_SetMealAttendanceTx_Call) Run(run func(ctx context.Context, arg database.SetMealAttendanceTxParams)) *MockStore_SetMealAttendanceTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.SetMealAttendanceTxParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_SetMealAttendanceTx_Call) Return(_a0 []database.MealPlanEntry, _a1 error) *MockStore_SetMealAttendanceTx_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_SetMealAttendanceTx_Call) RunAndReturn(run func(context.Context, database.SetMealAttendanceTxParams) ([]database.MealPlanEntry, error)) *MockStore_SetMealAttendanceTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetMealPlanRotationTx provides a mock function with given fields: ctx, arg
+func (_m *MockStore) SetMealPlanRotationTx(ctx context.Context, arg database.SetMealPlanRotationTxParams) (database.MealPlanRotation, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetMealPlanRotationTx")
+	}
+
+	var r0 database.MealPlanRotation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.SetMealPlanRotationTxParams) (database.MealPlanRotation, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.SetMealPlanRotationTxParams) database.MealPlanRotation); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(database.MealPlanRotation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.SetMealPlanRotationTxParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_SetMealPlanRotationTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetMealPlanRotationTx'
+type MockStore_SetMealPlanRotationTx_Call struct {
+	*mock.Call
+}
+
+// SetMealPlanRotationTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.SetMealPlanRotationTxParams
+func (_e *MockStore_Expecter) SetMealPlanRotationTx(ctx interface{}, arg interface{}) *MockStore_SetMealPlanRotationTx_Call {
+	return &MockStore_SetMealPlanRotationTx_Call{Call: _e.mock.On("SetMealPlanRotationTx", ctx, arg)}
+}
+
+func (_c *MockStore_SetMealPlanRotationTx_Call) Run(run func(ctx context.Context, arg database.SetMealPlanRotationTxParams)) *MockStore_SetMealPlanRotationTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.SetMealPlanRotationTxParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_SetMealPlanRotationTx_Call) Return(_a0 database.MealPlanRotation, _a1 error) *MockStore_SetMealPlanRotationTx_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_SetMealPlanRotationTx_Call) RunAndReturn(run func(context.Context, database.SetMealPlanRotationTxParams) (database.MealPlanRotation, error)) *MockStore_SetMealPlanRotationTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetMemberAttendanceTx provides a mock function with given fields: ctx, arg
+func (_m *MockStore) SetMemberAttendanceTx(ctx context.Context, arg database.SetMemberAttendanceTxParams) (database.User, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetMemberAttendanceTx")
+	}
+
+	var r0 database.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.SetMemberAttendanceTxParams) (database.User, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.SetMemberAttendanceTxParams) database.User); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(database.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.SetMemberAttendanceTxParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_SetMemberAttendanceTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetMemberAttendanceTx'
+type MockStore_SetMemberAttendanceTx_Call struct {
+	*mock.Call
+}
+
+// SetMemberAttendanceTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.SetMemberAttendanceTxParams
+func (_e *MockStore_Expecter) SetMemberAttendanceTx(ctx interface{}, arg interface{}) *MockStore_SetMemberAttendanceTx_Call {
+	return &MockStore_SetMemberAttendanceTx_Call{Call: _e.mock.On("SetMemberAttendanceTx", ctx, arg)}
+}
+
+func (_c *MockStore_SetMemberAttendanceTx_Call) Run(run func(ctx context.Context, arg database.SetMemberAttendanceTxParams)) *MockStore_SetMemberAttendanceTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.SetMemberAttendanceTxParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_SetMemberAttendanceTx_Call) Return(_a0 database.User, _a1 error) *MockStore_SetMemberAttendanceTx_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_SetMemberAttendanceTx_Call) RunAndReturn(run func(context.Context, database.SetMemberAttendanceTxParams) (database.User, error)) *MockStore_SetMemberAttendanceTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetRecipeEquipmentTx provides a mock function with given fields: ctx, arg
+func (_m *MockStore) SetRecipeEquipmentTx(ctx context.Context, arg database.SetRecipeEquipmentTxParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetRecipeEquipmentTx")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.SetRecipeEquipmentTxParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_SetRecipeEquipmentTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetRecipeEquipmentTx'
+type MockStore_SetRecipeEquipmentTx_Call struct {
+	*mock.Call
+}
+
+// SetRecipeEquipmentTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.SetRecipeEquipmentTxParams
+func (_e *MockStore_Expecter) SetRecipeEquipmentTx(ctx interface{}, arg interface{}) *MockStore_SetRecipeEquipmentTx_Call {
+	return &MockStore_SetRecipeEquipmentTx_Call{Call: _e.mock.On("SetRecipeEquipmentTx", ctx, arg)}
+}
+
+func (_c *MockStore_SetRecipeEquipmentTx_Call) Run(run func(ctx context.Context, arg database.SetRecipeEquipmentTxParams)) *MockStore_SetRecipeEquipmentTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.SetRecipeEquipmentTxParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_SetRecipeEquipmentTx_Call) Return(_a0 error) *MockStore_SetRecipeEquipmentTx_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_SetRecipeEquipmentTx_Call) RunAndReturn(run func(context.Context, database.SetRecipeEquipmentTxParams) error) *MockStore_SetRecipeEquipmentTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SyncFamilyCalendarTx provides a mock function with given fields: ctx, arg
+func (_m *MockStore) SyncFamilyCalendarTx(ctx context.Context, arg database.SyncFamilyCalendarTxParams) (database.FamilyCalendar, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SyncFamilyCalendarTx")
+	}
+
+	var r0 database.FamilyCalendar
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.SyncFamilyCalendarTxParams) (database.FamilyCalendar, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.SyncFamilyCalendarTxParams) database.FamilyCalendar); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(database.FamilyCalendar)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.SyncFamilyCalendarTxParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_SyncFamilyCalendarTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SyncFamilyCalendarTx'
+type MockStore_SyncFamilyCalendarTx_Call struct {
+	*mock.Call
+}
+
+// SyncFamilyCalendarTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.SyncFamilyCalendarTxParams
+func (_e *MockStore_Expecter) SyncFamilyCalendarTx(ctx interface{}, arg interface{}) *MockStore_SyncFamilyCalendarTx_Call {
+	return &MockStore_SyncFamilyCalendarTx_Call{Call: _e.mock.On("SyncFamilyCalendarTx", ctx, arg)}
+}
+
+func (_c *MockStore_SyncFamilyCalendarTx_Call) Run(run func(ctx context.Context, arg database.SyncFamilyCalendarTxParams)) *MockStore_SyncFamilyCalendarTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.SyncFamilyCalendarTxParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_SyncFamilyCalendarTx_Call) Return(_a0 database.FamilyCalendar, _a1 error) *MockStore_SyncFamilyCalendarTx_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_SyncFamilyCalendarTx_Call) RunAndReturn(run func(context.Context, database.SyncFamilyCalendarTxParams) (database.FamilyCalendar, error)) *MockStore_SyncFamilyCalendarTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TouchFamilyCalendar provides a mock function with given fields: ctx, id
+func (_m *MockStore) TouchFamilyCalendar(ctx context.Context, id uuid.UUID) (database.FamilyCalendar, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TouchFamilyCalendar")
+	}
+
+	var r0 database.FamilyCalendar
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (database.FamilyCalendar, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) database.FamilyCalendar); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(database.FamilyCalendar)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_TouchFamilyCalendar_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TouchFamilyCalendar'
+type MockStore_TouchFamilyCalendar_Call struct {
+	*mock.Call
+}
+
+// TouchFamilyCalendar is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) TouchFamilyCalendar(ctx interface{}, id interface{}) *MockStore_TouchFamilyCalendar_Call {
+	return &MockStore_TouchFamilyCalendar_Call{Call: _e.mock.On("TouchFamilyCalendar", ctx, id)}
+}
+
+func (_c *MockStore_TouchFamilyCalendar_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_TouchFamilyCalendar_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_TouchFamilyCalendar_Call) Return(_a0 database.FamilyCalendar, _a1 error) *MockStore_TouchFamilyCalendar_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_TouchFamilyCalendar_Call) RunAndReturn(run func(context.Context, uuid.UUID) (database.FamilyCalendar, error)) *MockStore_TouchFamilyCalendar_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TouchMealPlan provides a mock function with given fields: ctx, id
+func (_m *MockStore) TouchMealPlan(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TouchMealPlan")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_TouchMealPlan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TouchMealPlan'
+type MockStore_TouchMealPlan_Call struct {
+	*mock.Call
+}
+
+// TouchMealPlan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) TouchMealPlan(ctx interface{}, id interface{}) *MockStore_TouchMealPlan_Call {
+	return &MockStore_TouchMealPlan_Call{Call: _e.mock.On("TouchMealPlan", ctx, id)}
+}
+
+func (_c *MockStore_TouchMealPlan_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_TouchMealPlan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_TouchMealPlan_Call) Return(_a0 error) *MockStore_TouchMealPlan_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_TouchMealPlan_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_TouchMealPlan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TouchShoppingList provides a mock function with given fields: ctx, id
+func (_m *MockStore) TouchShoppingList(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TouchShoppingList")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_TouchShoppingList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TouchShoppingList'
+type MockStore_TouchShoppingList_Call struct {
+	*mock.Call
+}
+
+// TouchShoppingList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) TouchShoppingList(ctx interface{}, id interface{}) *MockStore_TouchShoppingList_Call {
+	return &MockStore_TouchShoppingList_Call{Call: _e.mock.On("TouchShoppingList", ctx, id)}
+}
+
+func (_c *MockStore_TouchShoppingList_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_TouchShoppingList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_TouchShoppingList_Call) Return(_a0 error) *MockStore_TouchShoppingList_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_TouchShoppingList_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_TouchShoppingList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAutoMealPlanEntryServings provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateAutoMealPlanEntryServings(ctx context.Context, arg database.UpdateAutoMealPlanEntryServingsParams) ([]database.MealPlanEntry, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAutoMealPlanEntryServings")
+	}
+
+	var r0 []database.MealPlanEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateAutoMealPlanEntryServingsParams) ([]database.MealPlanEntry, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateAutoMealPlanEntryServingsParams) []database.MealPlanEntry); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]database.MealPlanEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.UpdateAutoMealPlanEntryServingsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_UpdateAutoMealPlanEntryServings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAutoMealPlanEntryServings'
+type MockStore_UpdateAutoMealPlanEntryServings_Call struct {
+	*mock.Call
+}
+
+// UpdateAutoMealPlanEntryServings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.UpdateAutoMealPlanEntryServingsParams
+func (_e *MockStore_Expecter) UpdateAutoMealPlanEntryServings(ctx interface{}, arg interface{}) *MockStore_UpdateAutoMealPlanEntryServings_Call {
+	return &MockStore_UpdateAutoMealPlanEntryServings_Call{Call: _e.mock.On("UpdateAutoMealPlanEntryServings", ctx, arg)}
+}
+
+func (_c *MockStore_UpdateAutoMealPlanEntryServings_Call) Run(run func(ctx context.Context, arg database.UpdateAutoMealPlanEntryServingsParams)) *MockStore_UpdateAutoMealPlanEntryServings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.UpdateAutoMealPlanEntryServingsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_UpdateAutoMealPlanEntryServings_Call) Return(_a0 []database.MealPlanEntry, _a1 error) *MockStore_UpdateAutoMealPlanEntryServings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_UpdateAutoMealPlanEntryServings_Call) RunAndReturn(run func(context.Context, database.UpdateAutoMealPlanEntryServingsParams) ([]database.MealPlanEntry, error)) *MockStore_UpdateAutoMealPlanEntryServings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateCollection provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateCollection(ctx context.Context, arg database.UpdateCollectionParams) (database.Collection, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCollection")
+	}
+
+	var r0 database.Collection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateCollectionParams) (database.Collection, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateCollectionParams) database.Collection); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(database.Collection)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.UpdateCollectionParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_UpdateCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCollection'
+type MockStore_UpdateCollection_Call struct {
+	*mock.Call
+}
+
+// UpdateCollection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.UpdateCollectionParams
+func (_e *MockStore_Expecter) UpdateCollection(ctx interface{}, arg interface{}) *MockStore_UpdateCollection_Call {
+	return &MockStore_UpdateCollection_Call{Call: _e.mock.On("UpdateCollection", ctx, arg)}
+}
+
+func (_c *MockStore_UpdateCollection_Call) Run(run func(ctx context.Context, arg database.UpdateCollectionParams)) *MockStore_UpdateCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.UpdateCollectionParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_UpdateCollection_Call) Return(_a0 database.Collection, _a1 error) *MockStore_UpdateCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_UpdateCollection_Call) RunAndReturn(run func(context.Context, database.UpdateCollectionParams) (database.Collection, error)) *MockStore_UpdateCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateCollectionRecipePosition provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateCollectionRecipePosition(ctx context.Context, arg database.UpdateCollectionRecipePositionParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCollectionRecipePosition")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateCollectionRecipePositionParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_UpdateCollectionRecipePosition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCollectionRecipePosition'
+type MockStore_UpdateCollectionRecipePosition_Call struct {
+	*mock.Call
+}
+
+// UpdateCollectionRecipePosition is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.UpdateCollectionRecipePositionParams
+func (_e *MockStore_Expecter) UpdateCollectionRecipePosition(ctx interface{}, arg interface{}) *MockStore_UpdateCollectionRecipePosition_Call {
+	return &MockStore_UpdateCollectionRecipePosition_Call{Call: _e.mock.On("UpdateCollectionRecipePosition", ctx, arg)}
+}
+
+func (_c *MockStore_UpdateCollectionRecipePosition_Call) Run(run func(ctx context.Context, arg database.UpdateCollectionRecipePositionParams)) *MockStore_UpdateCollectionRecipePosition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.UpdateCollectionRecipePositionParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_UpdateCollectionRecipePosition_Call) Return(_a0 error) *MockStore_UpdateCollectionRecipePosition_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_UpdateCollectionRecipePosition_Call) RunAndReturn(run func(context.Context, database.UpdateCollectionRecipePositionParams) error) *MockStore_UpdateCollectionRecipePosition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateEvent provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateEvent(ctx context.Context, arg database.UpdateEventParams) (database.Event, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateEvent")
+	}
+
+	var r0 database.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateEventParams) (database.Event, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateEventParams) database.Event); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(database.Event)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.UpdateEventParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_UpdateEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEvent'
+type MockStore_UpdateEvent_Call struct {
+	*mock.Call
+}
+
+// UpdateEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.UpdateEventParams
+func (_e *MockStore_Expecter) UpdateEvent(ctx interface{}, arg interface{}) *MockStore_UpdateEvent_Call {
+	return &MockStore_UpdateEvent_Call{Call: _e.mock.On("UpdateEvent", ctx, arg)}
+}
+
+func (_c *MockStore_UpdateEvent_Call) Run(run func(ctx context.Context, arg database.UpdateEventParams)) *MockStore_UpdateEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.UpdateEventParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_UpdateEvent_Call) Return(_a0 database.Event, _a1 error) *MockStore_UpdateEvent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_UpdateEvent_Call) RunAndReturn(run func(context.Context, database.UpdateEventParams) (database.Event, error)) *MockStore_UpdateEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateFamily provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateFamily(ctx context.Context, arg database.UpdateFamilyParams) (database.Family, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateFamily")
+	}
+
+	var r0 database.Family
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateFamilyParams) (database.Family, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateFamilyParams) database.Family); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(database.Family)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.UpdateFamilyParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_UpdateFamily_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateFamily'
+type MockStore_UpdateFamily_Call struct {
+	*mock.Call
+}
+
+// UpdateFamily is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.UpdateFamilyParams
+func (_e *MockStore_Expecter) UpdateFamily(ctx interface{}, arg interface{}) *MockStore_UpdateFamily_Call {
+	return &MockStore_UpdateFamily_Call{Call: _e.mock.On("UpdateFamily", ctx, arg)}
+}
+
+func (_c *MockStore_UpdateFamily_Call) Run(run func(ctx context.Context, arg database.UpdateFamilyParams)) *MockStore_UpdateFamily_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(database.UpdateFamilyParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_UpdateFamily_Call) Return(_a0 database.Family, _a1 error) *MockStore_UpdateFamily_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_UpdateFamily_Call) RunAndReturn(run func(context.Context, database.UpdateFamilyParams) (database.Family, error)) *MockStore_UpdateFamily_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateGeneratedShoppingListItem provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateGeneratedShoppingListItem(ctx context.Context, arg database.UpdateGeneratedShoppingListItemParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateGeneratedShoppingListItem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateGeneratedShoppingListItemParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
 }
 
-// MockStore_GetFamilies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFamilies'
-type MockStore_GetFamilies_Call struct {
+// MockStore_UpdateGeneratedShoppingListItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateGeneratedShoppingListItem'
+type MockStore_UpdateGeneratedShoppingListItem_Call struct {
 	*mock.Call
 }
 
-// GetFamilies is a helper method to define mock.On call
+// UpdateGeneratedShoppingListItem is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockStore_Expecter) GetFamilies(ctx interface{}) *MockStore_GetFamilies_Call {
-	return &MockStore_GetFamilies_Call{Call: _e.mock.On("GetFamilies", ctx)}
+//   - arg database.UpdateGeneratedShoppingListItemParams
+func (_e *MockStore_Expecter) UpdateGeneratedShoppingListItem(ctx interface{}, arg interface{}) *MockStore_UpdateGeneratedShoppingListItem_Call {
+	return &MockStore_UpdateGeneratedShoppingListItem_Call{Call: _e.mock.On("UpdateGeneratedShoppingListItem", ctx, arg)}
 }
 
-func (_c *MockStore_GetFamilies_Call) Run(run func(ctx context.Context)) *MockStore_GetFamilies_Call {
+func (_c *MockStore_UpdateGeneratedShoppingListItem_Call) Run(run func(ctx context.Context, arg database.UpdateGeneratedShoppingListItemParams)) *MockStore_UpdateGeneratedShoppingListItem_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(database.UpdateGeneratedShoppingListItemParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetFamilies_Call) Return(_a0 []database.Family, _a1 error) *MockStore_GetFamilies_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockStore_UpdateGeneratedShoppingListItem_Call) Return(_a0 error) *MockStore_UpdateGeneratedShoppingListItem_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockStore_GetFamilies_Call) RunAndReturn(run func(context.Context) ([]database.Family, error)) *MockStore_GetFamilies_Call {
+func (_c *MockStore_UpdateGeneratedShoppingListItem_Call) RunAndReturn(run func(context.Context, database.UpdateGeneratedShoppingListItemParams) error) *MockStore_UpdateGeneratedShoppingListItem_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetFamilyByID provides a mock function with given fields: ctx, id
-func (_m *MockStore) GetFamilyByID(ctx context.Context, id uuid.UUID) (database.Family, error) {
-	ret := _m.Called(ctx, id)
+// UpdateIngredient provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateIngredient(ctx context.Context, arg database.UpdateIngredientParams) (database.Ingredient, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetFamilyByID")
+		panic("no return value specified for UpdateIngredient")
 	}
 
-	var r0 database.Family
+	var r0 database.Ingredient
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (database.Family, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateIngredientParams) (database.Ingredient, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) database.Family); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateIngredientParams) database.Ingredient); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(database.Family)
+		r0 = ret.Get(0).(database.Ingredient)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, database.UpdateIngredientParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -526,56 +8919,56 @@ func (_m *MockStore) GetFamilyByID(ctx context.Context, id uuid.UUID) (database.
 	return r0, r1
 }
 
-// MockStore_GetFamilyByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFamilyByID'
-type MockStore_GetFamilyByID_Call struct {
+// MockStore_UpdateIngredient_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateIngredient'
+type MockStore_UpdateIngredient_Call struct {
 	*mock.Call
 }
 
-// GetFamilyByID is a helper method to define mock.On call
+// UpdateIngredient is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id uuid.UUID
-func (_e *MockStore_Expecter) GetFamilyByID(ctx interface{}, id interface{}) *MockStore_GetFamilyByID_Call {
-	return &MockStore_GetFamilyByID_Call{Call: _e.mock.On("GetFamilyByID", ctx, id)}
+//   - arg database.UpdateIngredientParams
+func (_e *MockStore_Expecter) UpdateIngredient(ctx interface{}, arg interface{}) *MockStore_UpdateIngredient_Call {
+	return &MockStore_UpdateIngredient_Call{Call: _e.mock.On("UpdateIngredient", ctx, arg)}
 }
 
-func (_c *MockStore_GetFamilyByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_GetFamilyByID_Call {
+func (_c *MockStore_UpdateIngredient_Call) Run(run func(ctx context.Context, arg database.UpdateIngredientParams)) *MockStore_UpdateIngredient_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(database.UpdateIngredientParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetFamilyByID_Call) Return(_a0 database.Family, _a1 error) *MockStore_GetFamilyByID_Call {
+func (_c *MockStore_UpdateIngredient_Call) Return(_a0 database.Ingredient, _a1 error) *MockStore_UpdateIngredient_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetFamilyByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (database.Family, error)) *MockStore_GetFamilyByID_Call {
+func (_c *MockStore_UpdateIngredient_Call) RunAndReturn(run func(context.Context, database.UpdateIngredientParams) (database.Ingredient, error)) *MockStore_UpdateIngredient_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetFamilyByUserID provides a mock function with given fields: ctx, createdByUserID
-func (_m *MockStore) GetFamilyByUserID(ctx context.Context, createdByUserID uuid.UUID) (database.Family, error) {
-	ret := _m.Called(ctx, createdByUserID)
+// UpdateInventoryItem provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateInventoryItem(ctx context.Context, arg database.UpdateInventoryItemParams) (database.InventoryItem, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetFamilyByUserID")
+		panic("no return value specified for UpdateInventoryItem")
 	}
 
-	var r0 database.Family
+	var r0 database.InventoryItem
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (database.Family, error)); ok {
-		return rf(ctx, createdByUserID)
+	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateInventoryItemParams) (database.InventoryItem, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) database.Family); ok {
-		r0 = rf(ctx, createdByUserID)
+	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateInventoryItemParams) database.InventoryItem); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(database.Family)
+		r0 = ret.Get(0).(database.InventoryItem)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, createdByUserID)
+	if rf, ok := ret.Get(1).(func(context.Context, database.UpdateInventoryItemParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -583,56 +8976,56 @@ func (_m *MockStore) GetFamilyByUserID(ctx context.Context, createdByUserID uuid
 	return r0, r1
 }
 
-// MockStore_GetFamilyByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFamilyByUserID'
-type MockStore_GetFamilyByUserID_Call struct {
+// MockStore_UpdateInventoryItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateInventoryItem'
+type MockStore_UpdateInventoryItem_Call struct {
 	*mock.Call
 }
 
-// GetFamilyByUserID is a helper method to define mock.On call
+// UpdateInventoryItem is a helper method to define mock.On call
 //   - ctx context.Context
-//   - createdByUserID uuid.UUID
-func (_e *MockStore_Expecter) GetFamilyByUserID(ctx interface{}, createdByUserID interface{}) *MockStore_GetFamilyByUserID_Call {
-	return &MockStore_GetFamilyByUserID_Call{Call: _e.mock.On("GetFamilyByUserID", ctx, createdByUserID)}
+//   - arg database.UpdateInventoryItemParams
+func (_e *MockStore_Expecter) UpdateInventoryItem(ctx interface{}, arg interface{}) *MockStore_UpdateInventoryItem_Call {
+	return &MockStore_UpdateInventoryItem_Call{Call: _e.mock.On("UpdateInventoryItem", ctx, arg)}
 }
 
-func (_c *MockStore_GetFamilyByUserID_Call) Run(run func(ctx context.Context, createdByUserID uuid.UUID)) *MockStore_GetFamilyByUserID_Call {
+func (_c *MockStore_UpdateInventoryItem_Call) Run(run func(ctx context.Context, arg database.UpdateInventoryItemParams)) *MockStore_UpdateInventoryItem_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(database.UpdateInventoryItemParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetFamilyByUserID_Call) Return(_a0 database.Family, _a1 error) *MockStore_GetFamilyByUserID_Call {
+func (_c *MockStore_UpdateInventoryItem_Call) Return(_a0 database.InventoryItem, _a1 error) *MockStore_UpdateInventoryItem_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetFamilyByUserID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (database.Family, error)) *MockStore_GetFamilyByUserID_Call {
+func (_c *MockStore_UpdateInventoryItem_Call) RunAndReturn(run func(context.Context, database.UpdateInventoryItemParams) (database.InventoryItem, error)) *MockStore_UpdateInventoryItem_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetIngredientByID provides a mock function with given fields: ctx, id
-func (_m *MockStore) GetIngredientByID(ctx context.Context, id int32) (database.Ingredient, error) {
-	ret := _m.Called(ctx, id)
+// UpdateMealPlanEntry provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateMealPlanEntry(ctx context.Context, arg database.UpdateMealPlanEntryParams) (database.MealPlanEntry, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetIngredientByID")
+		panic("no return value specified for UpdateMealPlanEntry")
 	}
 
-	var r0 database.Ingredient
+	var r0 database.MealPlanEntry
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int32) (database.Ingredient, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateMealPlanEntryParams) (database.MealPlanEntry, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int32) database.Ingredient); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateMealPlanEntryParams) database.MealPlanEntry); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(database.Ingredient)
+		r0 = ret.Get(0).(database.MealPlanEntry)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, database.UpdateMealPlanEntryParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -640,56 +9033,56 @@ func (_m *MockStore) GetIngredientByID(ctx context.Context, id int32) (database.
 	return r0, r1
 }
 
-// MockStore_GetIngredientByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIngredientByID'
-type MockStore_GetIngredientByID_Call struct {
+// MockStore_UpdateMealPlanEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMealPlanEntry'
+type MockStore_UpdateMealPlanEntry_Call struct {
 	*mock.Call
 }
 
-// GetIngredientByID is a helper method to define mock.On call
+// UpdateMealPlanEntry is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int32
-func (_e *MockStore_Expecter) GetIngredientByID(ctx interface{}, id interface{}) *MockStore_GetIngredientByID_Call {
-	return &MockStore_GetIngredientByID_Call{Call: _e.mock.On("GetIngredientByID", ctx, id)}
+//   - arg database.UpdateMealPlanEntryParams
+func (_e *MockStore_Expecter) UpdateMealPlanEntry(ctx interface{}, arg interface{}) *MockStore_UpdateMealPlanEntry_Call {
+	return &MockStore_UpdateMealPlanEntry_Call{Call: _e.mock.On("UpdateMealPlanEntry", ctx, arg)}
 }
 
-func (_c *MockStore_GetIngredientByID_Call) Run(run func(ctx context.Context, id int32)) *MockStore_GetIngredientByID_Call {
+func (_c *MockStore_UpdateMealPlanEntry_Call) Run(run func(ctx context.Context, arg database.UpdateMealPlanEntryParams)) *MockStore_UpdateMealPlanEntry_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int32))
+		run(args[0].(context.Context), args[1].(database.UpdateMealPlanEntryParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetIngredientByID_Call) Return(_a0 database.Ingredient, _a1 error) *MockStore_GetIngredientByID_Call {
+func (_c *MockStore_UpdateMealPlanEntry_Call) Return(_a0 database.MealPlanEntry, _a1 error) *MockStore_UpdateMealPlanEntry_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetIngredientByID_Call) RunAndReturn(run func(context.Context, int32) (database.Ingredient, error)) *MockStore_GetIngredientByID_Call {
+func (_c *MockStore_UpdateMealPlanEntry_Call) RunAndReturn(run func(context.Context, database.UpdateMealPlanEntryParams) (database.MealPlanEntry, error)) *MockStore_UpdateMealPlanEntry_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetIngredientByName provides a mock function with given fields: ctx, name
-func (_m *MockStore) GetIngredientByName(ctx context.Context, name string) (database.Ingredient, error) {
-	ret := _m.Called(ctx, name)
+// UpdateMealPlanStatus provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateMealPlanStatus(ctx context.Context, arg database.UpdateMealPlanStatusParams) (database.MealPlan, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetIngredientByName")
+		panic("no return value specified for UpdateMealPlanStatus")
 	}
 
-	var r0 database.Ingredient
+	var r0 database.MealPlan
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (database.Ingredient, error)); ok {
-		return rf(ctx, name)
+	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateMealPlanStatusParams) (database.MealPlan, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) database.Ingredient); ok {
-		r0 = rf(ctx, name)
+	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateMealPlanStatusParams) database.MealPlan); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(database.Ingredient)
+		r0 = ret.Get(0).(database.MealPlan)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, name)
+	if rf, ok := ret.Get(1).(func(context.Context, database.UpdateMealPlanStatusParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -697,58 +9090,56 @@ func (_m *MockStore) GetIngredientByName(ctx context.Context, name string) (data
 	return r0, r1
 }
 
-// MockStore_GetIngredientByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIngredientByName'
-type MockStore_GetIngredientByName_Call struct {
+// MockStore_UpdateMealPlanStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMealPlanStatus'
+type MockStore_UpdateMealPlanStatus_Call struct {
 	*mock.Call
 }
 
-// GetIngredientByName is a helper method to define mock.On call
+// UpdateMealPlanStatus is a helper method to define mock.On call
 //   - ctx context.Context
-//   - name string
-func (_e *MockStore_Expecter) GetIngredientByName(ctx interface{}, name interface{}) *MockStore_GetIngredientByName_Call {
-	return &MockStore_GetIngredientByName_Call{Call: _e.mock.On("GetIngredientByName", ctx, name)}
+//   - arg database.UpdateMealPlanStatusParams
+func (_e *MockStore_Expecter) UpdateMealPlanStatus(ctx interface{}, arg interface{}) *MockStore_UpdateMealPlanStatus_Call {
+	return &MockStore_UpdateMealPlanStatus_Call{Call: _e.mock.On("UpdateMealPlanStatus", ctx, arg)}
 }
 
-func (_c *MockStore_GetIngredientByName_Call) Run(run func(ctx context.Context, name string)) *MockStore_GetIngredientByName_Call {
+func (_c *MockStore_UpdateMealPlanStatus_Call) Run(run func(ctx context.Context, arg database.UpdateMealPlanStatusParams)) *MockStore_UpdateMealPlanStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(database.UpdateMealPlanStatusParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetIngredientByName_Call) Return(_a0 database.Ingredient, _a1 error) *MockStore_GetIngredientByName_Call {
+func (_c *MockStore_UpdateMealPlanStatus_Call) Return(_a0 database.MealPlan, _a1 error) *MockStore_UpdateMealPlanStatus_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetIngredientByName_Call) RunAndReturn(run func(context.Context, string) (database.Ingredient, error)) *MockStore_GetIngredientByName_Call {
+func (_c *MockStore_UpdateMealPlanStatus_Call) RunAndReturn(run func(context.Context, database.UpdateMealPlanStatusParams) (database.MealPlan, error)) *MockStore_UpdateMealPlanStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetIngredients provides a mock function with given fields: ctx
-func (_m *MockStore) GetIngredients(ctx context.Context) ([]database.Ingredient, error) {
-	ret := _m.Called(ctx)
+// UpdateRecipe provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateRecipe(ctx context.Context, arg database.UpdateRecipeParams) (database.Recipe, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetIngredients")
+		panic("no return value specified for UpdateRecipe")
 	}
 
-	var r0 []database.Ingredient
+	var r0 database.Recipe
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]database.Ingredient, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateRecipeParams) (database.Recipe, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []database.Ingredient); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateRecipeParams) database.Recipe); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]database.Ingredient)
-		}
+		r0 = ret.Get(0).(database.Recipe)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, database.UpdateRecipeParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -756,55 +9147,56 @@ func (_m *MockStore) GetIngredients(ctx context.Context) ([]database.Ingredient,
 	return r0, r1
 }
 
-// MockStore_GetIngredients_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIngredients'
-type MockStore_GetIngredients_Call struct {
+// MockStore_UpdateRecipe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRecipe'
+type MockStore_UpdateRecipe_Call struct {
 	*mock.Call
 }
 
-// GetIngredients is a helper method to define mock.On call
+// UpdateRecipe is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockStore_Expecter) GetIngredients(ctx interface{}) *MockStore_GetIngredients_Call {
-	return &MockStore_GetIngredients_Call{Call: _e.mock.On("GetIngredients", ctx)}
+//   - arg database.UpdateRecipeParams
+func (_e *MockStore_Expecter) UpdateRecipe(ctx interface{}, arg interface{}) *MockStore_UpdateRecipe_Call {
+	return &MockStore_UpdateRecipe_Call{Call: _e.mock.On("UpdateRecipe", ctx, arg)}
 }
 
-func (_c *MockStore_GetIngredients_Call) Run(run func(ctx context.Context)) *MockStore_GetIngredients_Call {
+func (_c *MockStore_UpdateRecipe_Call) Run(run func(ctx context.Context, arg database.UpdateRecipeParams)) *MockStore_UpdateRecipe_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(database.UpdateRecipeParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetIngredients_Call) Return(_a0 []database.Ingredient, _a1 error) *MockStore_GetIngredients_Call {
+func (_c *MockStore_UpdateRecipe_Call) Return(_a0 database.Recipe, _a1 error) *MockStore_UpdateRecipe_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetIngredients_Call) RunAndReturn(run func(context.Context) ([]database.Ingredient, error)) *MockStore_GetIngredients_Call {
+func (_c *MockStore_UpdateRecipe_Call) RunAndReturn(run func(context.Context, database.UpdateRecipeParams) (database.Recipe, error)) *MockStore_UpdateRecipe_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRecipeByID provides a mock function with given fields: ctx, id
-func (_m *MockStore) GetRecipeByID(ctx context.Context, id uuid.UUID) (database.Recipe, error) {
-	ret := _m.Called(ctx, id)
+// UpdateShoppingListItem provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateShoppingListItem(ctx context.Context, arg database.UpdateShoppingListItemParams) (database.ShoppingListItem, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetRecipeByID")
+		panic("no return value specified for UpdateShoppingListItem")
 	}
 
-	var r0 database.Recipe
+	var r0 database.ShoppingListItem
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (database.Recipe, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateShoppingListItemParams) (database.ShoppingListItem, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) database.Recipe); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateShoppingListItemParams) database.ShoppingListItem); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(database.Recipe)
+		r0 = ret.Get(0).(database.ShoppingListItem)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, database.UpdateShoppingListItemParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -812,58 +9204,56 @@ func (_m *MockStore) GetRecipeByID(ctx context.Context, id uuid.UUID) (database.
 	return r0, r1
 }
 
-// MockStore_GetRecipeByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecipeByID'
-type MockStore_GetRecipeByID_Call struct {
+// MockStore_UpdateShoppingListItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateShoppingListItem'
+type MockStore_UpdateShoppingListItem_Call struct {
 	*mock.Call
 }
 
-// GetRecipeByID is a helper method to define mock.On call
+// UpdateShoppingListItem is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id uuid.UUID
-func (_e *MockStore_Expecter) GetRecipeByID(ctx interface{}, id interface{}) *MockStore_GetRecipeByID_Call {
-	return &MockStore_GetRecipeByID_Call{Call: _e.mock.On("GetRecipeByID", ctx, id)}
+//   - arg database.UpdateShoppingListItemParams
+func (_e *MockStore_Expecter) UpdateShoppingListItem(ctx interface{}, arg interface{}) *MockStore_UpdateShoppingListItem_Call {
+	return &MockStore_UpdateShoppingListItem_Call{Call: _e.mock.On("UpdateShoppingListItem", ctx, arg)}
 }
 
-func (_c *MockStore_GetRecipeByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_GetRecipeByID_Call {
+func (_c *MockStore_UpdateShoppingListItem_Call) Run(run func(ctx context.Context, arg database.UpdateShoppingListItemParams)) *MockStore_UpdateShoppingListItem_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(database.UpdateShoppingListItemParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetRecipeByID_Call) Return(_a0 database.Recipe, _a1 error) *MockStore_GetRecipeByID_Call {
+func (_c *MockStore_UpdateShoppingListItem_Call) Return(_a0 database.ShoppingListItem, _a1 error) *MockStore_UpdateShoppingListItem_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetRecipeByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (database.Recipe, error)) *MockStore_GetRecipeByID_Call {
+func (_c *MockStore_UpdateShoppingListItem_Call) RunAndReturn(run func(context.Context, database.UpdateShoppingListItemParams) (database.ShoppingListItem, error)) *MockStore_UpdateShoppingListItem_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRecipes provides a mock function with given fields: ctx
-func (_m *MockStore) GetRecipes(ctx context.Context) ([]database.Recipe, error) {
-	ret := _m.Called(ctx)
+// UpdateStaple provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateStaple(ctx context.Context, arg database.UpdateStapleParams) (database.Staple, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetRecipes")
+		panic("no return value specified for UpdateStaple")
 	}
 
-	var r0 []database.Recipe
+	var r0 database.Staple
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]database.Recipe, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateStapleParams) (database.Staple, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []database.Recipe); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateStapleParams) database.Staple); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]database.Recipe)
-		}
+		r0 = ret.Get(0).(database.Staple)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, database.UpdateStapleParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -871,57 +9261,56 @@ func (_m *MockStore) GetRecipes(ctx context.Context) ([]database.Recipe, error)
 	return r0, r1
 }
 
-// MockStore_GetRecipes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecipes'
-type MockStore_GetRecipes_Call struct {
+// MockStore_UpdateStaple_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStaple'
+type MockStore_UpdateStaple_Call struct {
 	*mock.Call
 }
 
-// GetRecipes is a helper method to define mock.On call
+// UpdateStaple is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockStore_Expecter) GetRecipes(ctx interface{}) *MockStore_GetRecipes_Call {
-	return &MockStore_GetRecipes_Call{Call: _e.mock.On("GetRecipes", ctx)}
+//   - arg database.UpdateStapleParams
+func (_e *MockStore_Expecter) UpdateStaple(ctx interface{}, arg interface{}) *MockStore_UpdateStaple_Call {
+	return &MockStore_UpdateStaple_Call{Call: _e.mock.On("UpdateStaple", ctx, arg)}
 }
 
-func (_c *MockStore_GetRecipes_Call) Run(run func(ctx context.Context)) *MockStore_GetRecipes_Call {
+func (_c *MockStore_UpdateStaple_Call) Run(run func(ctx context.Context, arg database.UpdateStapleParams)) *MockStore_UpdateStaple_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(database.UpdateStapleParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetRecipes_Call) Return(_a0 []database.Recipe, _a1 error) *MockStore_GetRecipes_Call {
+func (_c *MockStore_UpdateStaple_Call) Return(_a0 database.Staple, _a1 error) *MockStore_UpdateStaple_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetRecipes_Call) RunAndReturn(run func(context.Context) ([]database.Recipe, error)) *MockStore_GetRecipes_Call {
+func (_c *MockStore_UpdateStaple_Call) RunAndReturn(run func(context.Context, database.UpdateStapleParams) (database.Staple, error)) *MockStore_UpdateStaple_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRecipesByFamilyID provides a mock function with given fields: ctx, familyID
-func (_m *MockStore) GetRecipesByFamilyID(ctx context.Context, familyID uuid.UUID) ([]database.Recipe, error) {
-	ret := _m.Called(ctx, familyID)
+// UpdateStoreLayout provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateStoreLayout(ctx context.Context, arg database.UpdateStoreLayoutParams) (database.StoreLayout, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetRecipesByFamilyID")
+		panic("no return value specified for UpdateStoreLayout")
 	}
 
-	var r0 []database.Recipe
+	var r0 database.StoreLayout
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]database.Recipe, error)); ok {
-		return rf(ctx, familyID)
+	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateStoreLayoutParams) (database.StoreLayout, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []database.Recipe); ok {
-		r0 = rf(ctx, familyID)
+	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateStoreLayoutParams) database.StoreLayout); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]database.Recipe)
-		}
+		r0 = ret.Get(0).(database.StoreLayout)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, familyID)
+	if rf, ok := ret.Get(1).(func(context.Context, database.UpdateStoreLayoutParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -929,56 +9318,56 @@ func (_m *MockStore) GetRecipesByFamilyID(ctx context.Context, familyID uuid.UUI
 	return r0, r1
 }
 
-// MockStore_GetRecipesByFamilyID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecipesByFamilyID'
-type MockStore_GetRecipesByFamilyID_Call struct {
+// MockStore_UpdateStoreLayout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStoreLayout'
+type MockStore_UpdateStoreLayout_Call struct {
 	*mock.Call
 }
 
-// GetRecipesByFamilyID is a helper method to define mock.On call
-//   - ctx context.Context
-//   - familyID uuid.UUID
-func (_e *MockStore_Expecter) GetRecipesByFamilyID(ctx interface{}, familyID interface{}) *MockStore_GetRecipesByFamilyID_Call {
-	return &MockStore_GetRecipesByFamilyID_Call{Call: _e.mock.On("GetRecipesByFamilyID", ctx, familyID)}
+// UpdateStoreLayout is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg database.UpdateStoreLayoutParams
+func (_e *MockStore_Expecter) UpdateStoreLayout(ctx interface{}, arg interface{}) *MockStore_UpdateStoreLayout_Call {
+	return &MockStore_UpdateStoreLayout_Call{Call: _e.mock.On("UpdateStoreLayout", ctx, arg)}
 }
 
-func (_c *MockStore_GetRecipesByFamilyID_Call) Run(run func(ctx context.Context, familyID uuid.UUID)) *MockStore_GetRecipesByFamilyID_Call {
+func (_c *MockStore_UpdateStoreLayout_Call) Run(run func(ctx context.Context, arg database.UpdateStoreLayoutParams)) *MockStore_UpdateStoreLayout_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(database.UpdateStoreLayoutParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetRecipesByFamilyID_Call) Return(_a0 []database.Recipe, _a1 error) *MockStore_GetRecipesByFamilyID_Call {
+func (_c *MockStore_UpdateStoreLayout_Call) Return(_a0 database.StoreLayout, _a1 error) *MockStore_UpdateStoreLayout_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetRecipesByFamilyID_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]database.Recipe, error)) *MockStore_GetRecipesByFamilyID_Call {
+func (_c *MockStore_UpdateStoreLayout_Call) RunAndReturn(run func(context.Context, database.UpdateStoreLayoutParams) (database.StoreLayout, error)) *MockStore_UpdateStoreLayout_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserByEmail provides a mock function with given fields: ctx, email
-func (_m *MockStore) GetUserByEmail(ctx context.Context, email string) (database.User, error) {
-	ret := _m.Called(ctx, email)
+// UpdateUserEmail provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateUserEmail(ctx context.Context, arg database.UpdateUserEmailParams) (database.User, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserByEmail")
+		panic("no return value specified for UpdateUserEmail")
 	}
 
 	var r0 database.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (database.User, error)); ok {
-		return rf(ctx, email)
+	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateUserEmailParams) (database.User, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) database.User); ok {
-		r0 = rf(ctx, email)
+	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateUserEmailParams) database.User); ok {
+		r0 = rf(ctx, arg)
 	} else {
 		r0 = ret.Get(0).(database.User)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, email)
+	if rf, ok := ret.Get(1).(func(context.Context, database.UpdateUserEmailParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -986,56 +9375,56 @@ func (_m *MockStore) GetUserByEmail(ctx context.Context, email string) (database
 	return r0, r1
 }
 
-// MockStore_GetUserByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByEmail'
-type MockStore_GetUserByEmail_Call struct {
+// MockStore_UpdateUserEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserEmail'
+type MockStore_UpdateUserEmail_Call struct {
 	*mock.Call
 }
 
-// GetUserByEmail is a helper method to define mock.On call
+// UpdateUserEmail is a helper method to define mock.On call
 //   - ctx context.Context
-//   - email string
-func (_e *MockStore_Expecter) GetUserByEmail(ctx interface{}, email interface{}) *MockStore_GetUserByEmail_Call {
-	return &MockStore_GetUserByEmail_Call{Call: _e.mock.On("GetUserByEmail", ctx, email)}
+//   - arg database.UpdateUserEmailParams
+func (_e *MockStore_Expecter) UpdateUserEmail(ctx interface{}, arg interface{}) *MockStore_UpdateUserEmail_Call {
+	return &MockStore_UpdateUserEmail_Call{Call: _e.mock.On("UpdateUserEmail", ctx, arg)}
 }
 
-func (_c *MockStore_GetUserByEmail_Call) Run(run func(ctx context.Context, email string)) *MockStore_GetUserByEmail_Call {
+func (_c *MockStore_UpdateUserEmail_Call) Run(run func(ctx context.Context, arg database.UpdateUserEmailParams)) *MockStore_UpdateUserEmail_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(database.UpdateUserEmailParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetUserByEmail_Call) Return(_a0 database.User, _a1 error) *MockStore_GetUserByEmail_Call {
+func (_c *MockStore_UpdateUserEmail_Call) Return(_a0 database.User, _a1 error) *MockStore_UpdateUserEmail_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetUserByEmail_Call) RunAndReturn(run func(context.Context, string) (database.User, error)) *MockStore_GetUserByEmail_Call {
+func (_c *MockStore_UpdateUserEmail_Call) RunAndReturn(run func(context.Context, database.UpdateUserEmailParams) (database.User, error)) *MockStore_UpdateUserEmail_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserByID provides a mock function with given fields: ctx, id
-func (_m *MockStore) GetUserByID(ctx context.Context, id uuid.UUID) (database.User, error) {
-	ret := _m.Called(ctx, id)
+// UpdateUserInfo provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateUserInfo(ctx context.Context, arg database.UpdateUserInfoParams) (database.User, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserByID")
+		panic("no return value specified for UpdateUserInfo")
 	}
 
 	var r0 database.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (database.User, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateUserInfoParams) (database.User, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) database.User); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateUserInfoParams) database.User); ok {
+		r0 = rf(ctx, arg)
 	} else {
 		r0 = ret.Get(0).(database.User)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, database.UpdateUserInfoParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1043,58 +9432,56 @@ func (_m *MockStore) GetUserByID(ctx context.Context, id uuid.UUID) (database.Us
 	return r0, r1
 }
 
-// MockStore_GetUserByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByID'
-type MockStore_GetUserByID_Call struct {
+// MockStore_UpdateUserInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserInfo'
+type MockStore_UpdateUserInfo_Call struct {
 	*mock.Call
 }
 
-// GetUserByID is a helper method to define mock.On call
+// UpdateUserInfo is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id uuid.UUID
-func (_e *MockStore_Expecter) GetUserByID(ctx interface{}, id interface{}) *MockStore_GetUserByID_Call {
-	return &MockStore_GetUserByID_Call{Call: _e.mock.On("GetUserByID", ctx, id)}
+//   - arg database.UpdateUserInfoParams
+func (_e *MockStore_Expecter) UpdateUserInfo(ctx interface{}, arg interface{}) *MockStore_UpdateUserInfo_Call {
+	return &MockStore_UpdateUserInfo_Call{Call: _e.mock.On("UpdateUserInfo", ctx, arg)}
 }
 
-func (_c *MockStore_GetUserByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_GetUserByID_Call {
+func (_c *MockStore_UpdateUserInfo_Call) Run(run func(ctx context.Context, arg database.UpdateUserInfoParams)) *MockStore_UpdateUserInfo_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(database.UpdateUserInfoParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetUserByID_Call) Return(_a0 database.User, _a1 error) *MockStore_GetUserByID_Call {
+func (_c *MockStore_UpdateUserInfo_Call) Return(_a0 database.User, _a1 error) *MockStore_UpdateUserInfo_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetUserByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (database.User, error)) *MockStore_GetUserByID_Call {
+func (_c *MockStore_UpdateUserInfo_Call) RunAndReturn(run func(context.Context, database.UpdateUserInfoParams) (database.User, error)) *MockStore_UpdateUserInfo_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUsers provides a mock function with given fields: ctx
-func (_m *MockStore) GetUsers(ctx context.Context) ([]database.User, error) {
-	ret := _m.Called(ctx)
+// UpdateUserPassword provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateUserPassword(ctx context.Context, arg database.UpdateUserPasswordParams) (database.User, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUsers")
+		panic("no return value specified for UpdateUserPassword")
 	}
 
-	var r0 []database.User
+	var r0 database.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]database.User, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateUserPasswordParams) (database.User, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []database.User); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateUserPasswordParams) database.User); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]database.User)
-		}
+		r0 = ret.Get(0).(database.User)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, database.UpdateUserPasswordParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1102,54 +9489,55 @@ func (_m *MockStore) GetUsers(ctx context.Context) ([]database.User, error) {
 	return r0, r1
 }
 
-// MockStore_GetUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsers'
-type MockStore_GetUsers_Call struct {
+// MockStore_UpdateUserPassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserPassword'
+type MockStore_UpdateUserPassword_Call struct {
 	*mock.Call
 }
 
-// GetUsers is a helper method to define mock.On call
+// UpdateUserPassword is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockStore_Expecter) GetUsers(ctx interface{}) *MockStore_GetUsers_Call {
-	return &MockStore_GetUsers_Call{Call: _e.mock.On("GetUsers", ctx)}
+//   - arg database.UpdateUserPasswordParams
+func (_e *MockStore_Expecter) UpdateUserPassword(ctx interface{}, arg interface{}) *MockStore_UpdateUserPassword_Call {
+	return &MockStore_UpdateUserPassword_Call{Call: _e.mock.On("UpdateUserPassword", ctx, arg)}
 }
 
-func (_c *MockStore_GetUsers_Call) Run(run func(ctx context.Context)) *MockStore_GetUsers_Call {
+func (_c *MockStore_UpdateUserPassword_Call) Run(run func(ctx context.Context, arg database.UpdateUserPasswordParams)) *MockStore_UpdateUserPassword_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(database.UpdateUserPasswordParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetUsers_Call) Return(_a0 []database.User, _a1 error) *MockStore_GetUsers_Call {
+func (_c *MockStore_UpdateUserPassword_Call) Return(_a0 database.User, _a1 error) *MockStore_UpdateUserPassword_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetUsers_Call) RunAndReturn(run func(context.Context) ([]database.User, error)) *MockStore_GetUsers_Call {
+func (_c *MockStore_UpdateUserPassword_Call) RunAndReturn(run func(context.Context, database.UpdateUserPasswordParams) (database.User, error)) *MockStore_UpdateUserPassword_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateFamily provides a mock function with given fields: ctx, arg
-func (_m *MockStore) UpdateFamily(ctx context.Context, arg database.UpdateFamilyParams) (database.Family, error) {
+// UpdateUserPortionFactor provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateUserPortionFactor(ctx context.Context, arg database.UpdateUserPortionFactorParams) (database.User, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateFamily")
+		panic("no return value specified for UpdateUserPortionFactor")
 	}
 
-	var r0 database.Family
+	var r0 database.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateFamilyParams) (database.Family, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateUserPortionFactorParams) (database.User, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateFamilyParams) database.Family); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateUserPortionFactorParams) database.User); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(database.Family)
+		r0 = ret.Get(0).(database.User)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, database.UpdateFamilyParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, database.UpdateUserPortionFactorParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -1158,55 +9546,55 @@ func (_m *MockStore) UpdateFamily(ctx context.Context, arg database.UpdateFamily
 	return r0, r1
 }
 
-// MockStore_UpdateFamily_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateFamily'
-type MockStore_UpdateFamily_Call struct {
+// MockStore_UpdateUserPortionFactor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserPortionFactor'
+type MockStore_UpdateUserPortionFactor_Call struct {
 	*mock.Call
 }
 
-// UpdateFamily is a helper method to define mock.On call
+// UpdateUserPortionFactor is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg database.UpdateFamilyParams
-func (_e *MockStore_Expecter) UpdateFamily(ctx interface{}, arg interface{}) *MockStore_UpdateFamily_Call {
-	return &MockStore_UpdateFamily_Call{Call: _e.mock.On("UpdateFamily", ctx, arg)}
+//   - arg database.UpdateUserPortionFactorParams
+func (_e *MockStore_Expecter) UpdateUserPortionFactor(ctx interface{}, arg interface{}) *MockStore_UpdateUserPortionFactor_Call {
+	return &MockStore_UpdateUserPortionFactor_Call{Call: _e.mock.On("UpdateUserPortionFactor", ctx, arg)}
 }
 
-func (_c *MockStore_UpdateFamily_Call) Run(run func(ctx context.Context, arg database.UpdateFamilyParams)) *MockStore_UpdateFamily_Call {
+func (_c *MockStore_UpdateUserPortionFactor_Call) Run(run func(ctx context.Context, arg database.UpdateUserPortionFactorParams)) *MockStore_UpdateUserPortionFactor_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(database.UpdateFamilyParams))
+		run(args[0].(context.Context), args[1].(database.UpdateUserPortionFactorParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_UpdateFamily_Call) Return(_a0 database.Family, _a1 error) *MockStore_UpdateFamily_Call {
+func (_c *MockStore_UpdateUserPortionFactor_Call) Return(_a0 database.User, _a1 error) *MockStore_UpdateUserPortionFactor_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_UpdateFamily_Call) RunAndReturn(run func(context.Context, database.UpdateFamilyParams) (database.Family, error)) *MockStore_UpdateFamily_Call {
+func (_c *MockStore_UpdateUserPortionFactor_Call) RunAndReturn(run func(context.Context, database.UpdateUserPortionFactorParams) (database.User, error)) *MockStore_UpdateUserPortionFactor_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateIngredient provides a mock function with given fields: ctx, arg
-func (_m *MockStore) UpdateIngredient(ctx context.Context, arg database.UpdateIngredientParams) (database.Ingredient, error) {
+// UpdateUserUnitSystem provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateUserUnitSystem(ctx context.Context, arg database.UpdateUserUnitSystemParams) (database.User, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateIngredient")
+		panic("no return value specified for UpdateUserUnitSystem")
 	}
 
-	var r0 database.Ingredient
+	var r0 database.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateIngredientParams) (database.Ingredient, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateUserUnitSystemParams) (database.User, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateIngredientParams) database.Ingredient); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateUserUnitSystemParams) database.User); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(database.Ingredient)
+		r0 = ret.Get(0).(database.User)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, database.UpdateIngredientParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, database.UpdateUserUnitSystemParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -1215,55 +9603,55 @@ func (_m *MockStore) UpdateIngredient(ctx context.Context, arg database.UpdateIn
 	return r0, r1
 }
 
-// MockStore_UpdateIngredient_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateIngredient'
-type MockStore_UpdateIngredient_Call struct {
+// MockStore_UpdateUserUnitSystem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserUnitSystem'
+type MockStore_UpdateUserUnitSystem_Call struct {
 	*mock.Call
 }
 
-// UpdateIngredient is a helper method to define mock.On call
+// UpdateUserUnitSystem is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg database.UpdateIngredientParams
-func (_e *MockStore_Expecter) UpdateIngredient(ctx interface{}, arg interface{}) *MockStore_UpdateIngredient_Call {
-	return &MockStore_UpdateIngredient_Call{Call: _e.mock.On("UpdateIngredient", ctx, arg)}
+//   - arg database.UpdateUserUnitSystemParams
+func (_e *MockStore_Expecter) UpdateUserUnitSystem(ctx interface{}, arg interface{}) *MockStore_UpdateUserUnitSystem_Call {
+	return &MockStore_UpdateUserUnitSystem_Call{Call: _e.mock.On("UpdateUserUnitSystem", ctx, arg)}
 }
 
-func (_c *MockStore_UpdateIngredient_Call) Run(run func(ctx context.Context, arg database.UpdateIngredientParams)) *MockStore_UpdateIngredient_Call {
+func (_c *MockStore_UpdateUserUnitSystem_Call) Run(run func(ctx context.Context, arg database.UpdateUserUnitSystemParams)) *MockStore_UpdateUserUnitSystem_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(database.UpdateIngredientParams))
+		run(args[0].(context.Context), args[1].(database.UpdateUserUnitSystemParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_UpdateIngredient_Call) Return(_a0 database.Ingredient, _a1 error) *MockStore_UpdateIngredient_Call {
+func (_c *MockStore_UpdateUserUnitSystem_Call) Return(_a0 database.User, _a1 error) *MockStore_UpdateUserUnitSystem_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_UpdateIngredient_Call) RunAndReturn(run func(context.Context, database.UpdateIngredientParams) (database.Ingredient, error)) *MockStore_UpdateIngredient_Call {
+func (_c *MockStore_UpdateUserUnitSystem_Call) RunAndReturn(run func(context.Context, database.UpdateUserUnitSystemParams) (database.User, error)) *MockStore_UpdateUserUnitSystem_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateRecipe provides a mock function with given fields: ctx, arg
-func (_m *MockStore) UpdateRecipe(ctx context.Context, arg database.UpdateRecipeParams) (database.Recipe, error) {
+// UpsertCalendarFeed provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpsertCalendarFeed(ctx context.Context, arg database.UpsertCalendarFeedParams) (database.CalendarFeed, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateRecipe")
+		panic("no return value specified for UpsertCalendarFeed")
 	}
 
-	var r0 database.Recipe
+	var r0 database.CalendarFeed
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateRecipeParams) (database.Recipe, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, database.UpsertCalendarFeedParams) (database.CalendarFeed, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateRecipeParams) database.Recipe); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, database.UpsertCalendarFeedParams) database.CalendarFeed); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(database.Recipe)
+		r0 = ret.Get(0).(database.CalendarFeed)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, database.UpdateRecipeParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, database.UpsertCalendarFeedParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -1272,112 +9660,102 @@ func (_m *MockStore) UpdateRecipe(ctx context.Context, arg database.UpdateRecipe
 	return r0, r1
 }
 
-// MockStore_UpdateRecipe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRecipe'
-type MockStore_UpdateRecipe_Call struct {
+// MockStore_UpsertCalendarFeed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertCalendarFeed'
+type MockStore_UpsertCalendarFeed_Call struct {
 	*mock.Call
 }
 
-// UpdateRecipe is a helper method to define mock.On call
+// UpsertCalendarFeed is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg database.UpdateRecipeParams
-func (_e *MockStore_Expecter) UpdateRecipe(ctx interface{}, arg interface{}) *MockStore_UpdateRecipe_Call {
-	return &MockStore_UpdateRecipe_Call{Call: _e.mock.On("UpdateRecipe", ctx, arg)}
+//   - arg database.UpsertCalendarFeedParams
+func (_e *MockStore_Expecter) UpsertCalendarFeed(ctx interface{}, arg interface{}) *MockStore_UpsertCalendarFeed_Call {
+	return &MockStore_UpsertCalendarFeed_Call{Call: _e.mock.On("UpsertCalendarFeed", ctx, arg)}
 }
 
-func (_c *MockStore_UpdateRecipe_Call) Run(run func(ctx context.Context, arg database.UpdateRecipeParams)) *MockStore_UpdateRecipe_Call {
+func (_c *MockStore_UpsertCalendarFeed_Call) Run(run func(ctx context.Context, arg database.UpsertCalendarFeedParams)) *MockStore_UpsertCalendarFeed_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(database.UpdateRecipeParams))
+		run(args[0].(context.Context), args[1].(database.UpsertCalendarFeedParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_UpdateRecipe_Call) Return(_a0 database.Recipe, _a1 error) *MockStore_UpdateRecipe_Call {
+func (_c *MockStore_UpsertCalendarFeed_Call) Return(_a0 database.CalendarFeed, _a1 error) *MockStore_UpsertCalendarFeed_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_UpdateRecipe_Call) RunAndReturn(run func(context.Context, database.UpdateRecipeParams) (database.Recipe, error)) *MockStore_UpdateRecipe_Call {
+func (_c *MockStore_UpsertCalendarFeed_Call) RunAndReturn(run func(context.Context, database.UpsertCalendarFeedParams) (database.CalendarFeed, error)) *MockStore_UpsertCalendarFeed_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateUserEmail provides a mock function with given fields: ctx, arg
-func (_m *MockStore) UpdateUserEmail(ctx context.Context, arg database.UpdateUserEmailParams) (database.User, error) {
+// UpsertMealGuests provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpsertMealGuests(ctx context.Context, arg database.UpsertMealGuestsParams) error {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateUserEmail")
+		panic("no return value specified for UpsertMealGuests")
 	}
 
-	var r0 database.User
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateUserEmailParams) (database.User, error)); ok {
-		return rf(ctx, arg)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateUserEmailParams) database.User); ok {
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.UpsertMealGuestsParams) error); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(database.User)
-	}
-
-	if rf, ok := ret.Get(1).(func(context.Context, database.UpdateUserEmailParams) error); ok {
-		r1 = rf(ctx, arg)
-	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockStore_UpdateUserEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserEmail'
-type MockStore_UpdateUserEmail_Call struct {
+// MockStore_UpsertMealGuests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertMealGuests'
+type MockStore_UpsertMealGuests_Call struct {
 	*mock.Call
 }
 
-// UpdateUserEmail is a helper method to define mock.On call
+// UpsertMealGuests is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg database.UpdateUserEmailParams
-func (_e *MockStore_Expecter) UpdateUserEmail(ctx interface{}, arg interface{}) *MockStore_UpdateUserEmail_Call {
-	return &MockStore_UpdateUserEmail_Call{Call: _e.mock.On("UpdateUserEmail", ctx, arg)}
+//   - arg database.UpsertMealGuestsParams
+func (_e *MockStore_Expecter) UpsertMealGuests(ctx interface{}, arg interface{}) *MockStore_UpsertMealGuests_Call {
+	return &MockStore_UpsertMealGuests_Call{Call: _e.mock.On("UpsertMealGuests", ctx, arg)}
 }
 
-func (_c *MockStore_UpdateUserEmail_Call) Run(run func(ctx context.Context, arg database.UpdateUserEmailParams)) *MockStore_UpdateUserEmail_Call {
+func (_c *MockStore_UpsertMealGuests_Call) Run(run func(ctx context.Context, arg database.UpsertMealGuestsParams)) *MockStore_UpsertMealGuests_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(database.UpdateUserEmailParams))
+		run(args[0].(context.Context), args[1].(database.UpsertMealGuestsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_UpdateUserEmail_Call) Return(_a0 database.User, _a1 error) *MockStore_UpdateUserEmail_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockStore_UpsertMealGuests_Call) Return(_a0 error) *MockStore_UpsertMealGuests_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockStore_UpdateUserEmail_Call) RunAndReturn(run func(context.Context, database.UpdateUserEmailParams) (database.User, error)) *MockStore_UpdateUserEmail_Call {
+func (_c *MockStore_UpsertMealGuests_Call) RunAndReturn(run func(context.Context, database.UpsertMealGuestsParams) error) *MockStore_UpsertMealGuests_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateUserInfo provides a mock function with given fields: ctx, arg
-func (_m *MockStore) UpdateUserInfo(ctx context.Context, arg database.UpdateUserInfoParams) (database.User, error) {
+// UpsertMealPlanRotation provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpsertMealPlanRotation(ctx context.Context, arg database.UpsertMealPlanRotationParams) (database.MealPlanRotation, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateUserInfo")
+		panic("no return value specified for UpsertMealPlanRotation")
 	}
 
-	var r0 database.User
+	var r0 database.MealPlanRotation
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateUserInfoParams) (database.User, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, database.UpsertMealPlanRotationParams) (database.MealPlanRotation, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateUserInfoParams) database.User); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, database.UpsertMealPlanRotationParams) database.MealPlanRotation); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(database.User)
+		r0 = ret.Get(0).(database.MealPlanRotation)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, database.UpdateUserInfoParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, database.UpsertMealPlanRotationParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -1386,55 +9764,55 @@ func (_m *MockStore) UpdateUserInfo(ctx context.Context, arg database.UpdateUser
 	return r0, r1
 }
 
-// MockStore_UpdateUserInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserInfo'
-type MockStore_UpdateUserInfo_Call struct {
+// MockStore_UpsertMealPlanRotation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertMealPlanRotation'
+type MockStore_UpsertMealPlanRotation_Call struct {
 	*mock.Call
 }
 
-// UpdateUserInfo is a helper method to define mock.On call
+// UpsertMealPlanRotation is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg database.UpdateUserInfoParams
-func (_e *MockStore_Expecter) UpdateUserInfo(ctx interface{}, arg interface{}) *MockStore_UpdateUserInfo_Call {
-	return &MockStore_UpdateUserInfo_Call{Call: _e.mock.On("UpdateUserInfo", ctx, arg)}
+//   - arg database.UpsertMealPlanRotationParams
+func (_e *MockStore_Expecter) UpsertMealPlanRotation(ctx interface{}, arg interface{}) *MockStore_UpsertMealPlanRotation_Call {
+	return &MockStore_UpsertMealPlanRotation_Call{Call: _e.mock.On("UpsertMealPlanRotation", ctx, arg)}
 }
 
-func (_c *MockStore_UpdateUserInfo_Call) Run(run func(ctx context.Context, arg database.UpdateUserInfoParams)) *MockStore_UpdateUserInfo_Call {
+func (_c *MockStore_UpsertMealPlanRotation_Call) Run(run func(ctx context.Context, arg database.UpsertMealPlanRotationParams)) *MockStore_UpsertMealPlanRotation_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(database.UpdateUserInfoParams))
+		run(args[0].(context.Context), args[1].(database.UpsertMealPlanRotationParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_UpdateUserInfo_Call) Return(_a0 database.User, _a1 error) *MockStore_UpdateUserInfo_Call {
+func (_c *MockStore_UpsertMealPlanRotation_Call) Return(_a0 database.MealPlanRotation, _a1 error) *MockStore_UpsertMealPlanRotation_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_UpdateUserInfo_Call) RunAndReturn(run func(context.Context, database.UpdateUserInfoParams) (database.User, error)) *MockStore_UpdateUserInfo_Call {
+func (_c *MockStore_UpsertMealPlanRotation_Call) RunAndReturn(run func(context.Context, database.UpsertMealPlanRotationParams) (database.MealPlanRotation, error)) *MockStore_UpsertMealPlanRotation_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateUserPassword provides a mock function with given fields: ctx, arg
-func (_m *MockStore) UpdateUserPassword(ctx context.Context, arg database.UpdateUserPasswordParams) (database.User, error) {
+// UpsertMealPlanVote provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpsertMealPlanVote(ctx context.Context, arg database.UpsertMealPlanVoteParams) (database.MealPlanVote, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateUserPassword")
+		panic("no return value specified for UpsertMealPlanVote")
 	}
 
-	var r0 database.User
+	var r0 database.MealPlanVote
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateUserPasswordParams) (database.User, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, database.UpsertMealPlanVoteParams) (database.MealPlanVote, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, database.UpdateUserPasswordParams) database.User); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, database.UpsertMealPlanVoteParams) database.MealPlanVote); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(database.User)
+		r0 = ret.Get(0).(database.MealPlanVote)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, database.UpdateUserPasswordParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, database.UpsertMealPlanVoteParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -1443,31 +9821,31 @@ func (_m *MockStore) UpdateUserPassword(ctx context.Context, arg database.Update
 	return r0, r1
 }
 
-// MockStore_UpdateUserPassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserPassword'
-type MockStore_UpdateUserPassword_Call struct {
+// MockStore_UpsertMealPlanVote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertMealPlanVote'
+type MockStore_UpsertMealPlanVote_Call struct {
 	*mock.Call
 }
 
-// UpdateUserPassword is a helper method to define mock.On call
+// UpsertMealPlanVote is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg database.UpdateUserPasswordParams
-func (_e *MockStore_Expecter) UpdateUserPassword(ctx interface{}, arg interface{}) *MockStore_UpdateUserPassword_Call {
-	return &MockStore_UpdateUserPassword_Call{Call: _e.mock.On("UpdateUserPassword", ctx, arg)}
+//   - arg database.UpsertMealPlanVoteParams
+func (_e *MockStore_Expecter) UpsertMealPlanVote(ctx interface{}, arg interface{}) *MockStore_UpsertMealPlanVote_Call {
+	return &MockStore_UpsertMealPlanVote_Call{Call: _e.mock.On("UpsertMealPlanVote", ctx, arg)}
 }
 
-func (_c *MockStore_UpdateUserPassword_Call) Run(run func(ctx context.Context, arg database.UpdateUserPasswordParams)) *MockStore_UpdateUserPassword_Call {
+func (_c *MockStore_UpsertMealPlanVote_Call) Run(run func(ctx context.Context, arg database.UpsertMealPlanVoteParams)) *MockStore_UpsertMealPlanVote_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(database.UpdateUserPasswordParams))
+		run(args[0].(context.Context), args[1].(database.UpsertMealPlanVoteParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_UpdateUserPassword_Call) Return(_a0 database.User, _a1 error) *MockStore_UpdateUserPassword_Call {
+func (_c *MockStore_UpsertMealPlanVote_Call) Return(_a0 database.MealPlanVote, _a1 error) *MockStore_UpsertMealPlanVote_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_UpdateUserPassword_Call) RunAndReturn(run func(context.Context, database.UpdateUserPasswordParams) (database.User, error)) *MockStore_UpdateUserPassword_Call {
+func (_c *MockStore_UpsertMealPlanVote_Call) RunAndReturn(run func(context.Context, database.UpsertMealPlanVoteParams) (database.MealPlanVote, error)) *MockStore_UpsertMealPlanVote_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,26 @@
+-- name: UpsertCalendarFeed :one
+INSERT INTO calendar_feeds (
+    family_id,
+    token
+) VALUES ( $1, $2 )
+ON CONFLICT (family_id) DO UPDATE SET
+    created_at = NOW(),
+    token = EXCLUDED.token
+RETURNING *;
+
+-- name: GetCalendarFeedByFamilyID :one
+SELECT * FROM calendar_feeds
+WHERE family_id = $1;
+
+-- name: DeleteCalendarFeed :exec
+DELETE FROM calendar_feeds
+WHERE family_id = $1;
+
+-- name: GetCalendarEntriesByFamilyID :many
+SELECT meal_plan_entries.*, recipes.name AS recipe_name, recipes.total_time_minutes FROM meal_plan_entries
+JOIN meal_plans ON meal_plans.id = meal_plan_entries.meal_plan_id
+JOIN recipes ON recipes.id = meal_plan_entries.recipe_id
+WHERE meal_plans.family_id = sqlc.arg(family_id) AND meal_plan_entries.day >= sqlc.arg(from_day)
+ORDER BY meal_plan_entries.day,
+    CASE meal_plan_entries.slot WHEN 'breakfast' THEN 0 WHEN 'lunch' THEN 1 WHEN 'snack' THEN 2 ELSE 3 END,
+    meal_plan_entries.created_at;
\ No newline at end of file
@@ -0,0 +1,59 @@
+-- name: CreateCollection :one
+INSERT INTO collections (
+    name,
+    user_id,
+    family_id,
+    shared
+) VALUES ( $1, $2, $3, $4 )
+RETURNING *;
+
+-- name: GetCollectionByID :one
+SELECT * FROM collections
+WHERE id = $1;
+
+-- name: GetCollectionsByUserID :many
+SELECT * FROM collections
+WHERE user_id = $1 OR (shared AND family_id = $2)
+ORDER BY name;
+
+-- name: UpdateCollection :one
+UPDATE collections SET
+    updated_at = NOW(),
+    name = $2,
+    shared = $3
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteCollection :exec
+DELETE FROM collections
+WHERE id = $1;
+
+-- name: AddRecipeToCollection :exec
+INSERT INTO collection_recipes (
+    collection_id,
+    recipe_id,
+    position
+) VALUES (
+    $1, $2, (SELECT COALESCE(MAX(position) + 1, 0)::INTEGER FROM collection_recipes WHERE collection_id = $1)
+) ON CONFLICT DO NOTHING;
+
+-- name: RemoveRecipeFromCollection :exec
+DELETE FROM collection_recipes
+WHERE collection_id = $1 AND recipe_id = $2;
+
+-- name: UpdateCollectionRecipePosition :exec
+UPDATE collection_recipes SET
+    position = $3
+WHERE collection_id = $1 AND recipe_id = $2;
+
+-- name: GetCollectionRecipes :many
+SELECT recipes.* FROM recipes
+JOIN collection_recipes ON collection_recipes.recipe_id = recipes.id
+WHERE collection_recipes.collection_id = $1
+ORDER BY collection_recipes.position, collection_recipes.created_at;
+
+-- name: MoveCollectionRecipes :exec
+INSERT INTO collection_recipes (collection_id, recipe_id, position)
+SELECT collection_id, sqlc.arg(target_id)::uuid, position FROM collection_recipes
+WHERE recipe_id = sqlc.arg(source_id)
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,42 @@
+-- name: CreateCookLog :one
+INSERT INTO cook_logs (
+    recipe_id,
+    family_id,
+    cooked_by_user_id,
+    cooked_on,
+    servings,
+    notes,
+    rating
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+) RETURNING *;
+
+-- name: GetCookLogByID :one
+SELECT * FROM cook_logs
+WHERE id = $1;
+
+-- name: GetCookLogsByRecipeID :many
+SELECT * FROM cook_logs
+WHERE recipe_id = $1
+ORDER BY cooked_on DESC, created_at DESC;
+
+-- name: GetCookLogsByFamilyID :many
+SELECT * FROM cook_logs
+WHERE family_id = $1
+ORDER BY cooked_on DESC, created_at DESC
+LIMIT $2
+OFFSET $3;
+
+-- name: GetLastCookedByFamilyID :many
+SELECT recipe_id, MAX(cooked_on)::date AS last_cooked_on, COUNT(*) AS times_cooked FROM cook_logs
+WHERE family_id = $1
+GROUP BY recipe_id;
+
+-- name: DeleteCookLog :exec
+DELETE FROM cook_logs
+WHERE id = $1;
+
+-- name: MoveCookLogs :exec
+UPDATE cook_logs SET
+    recipe_id = sqlc.arg(target_id)
+WHERE recipe_id = sqlc.arg(source_id);
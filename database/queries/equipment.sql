@@ -0,0 +1,55 @@
+-- name: CreateEquipment :one
+INSERT INTO equipment (
+    name
+) VALUES ( $1 )
+RETURNING *;
+
+-- name: GetEquipment :many
+SELECT * FROM equipment
+ORDER BY name;
+
+-- name: AddRecipeEquipment :exec
+INSERT INTO recipe_equipment (
+    recipe_id,
+    equipment_id,
+    heavy
+) VALUES ( $1, $2, $3 );
+
+-- name: DeleteRecipeEquipment :exec
+DELETE FROM recipe_equipment
+WHERE recipe_id = $1;
+
+-- name: GetRecipeEquipment :many
+SELECT recipe_equipment.equipment_id, equipment.name, recipe_equipment.heavy FROM recipe_equipment
+JOIN equipment ON equipment.id = recipe_equipment.equipment_id
+WHERE recipe_equipment.recipe_id = $1
+ORDER BY equipment.name;
+
+-- name: GetRecipeEquipmentByFamilyID :many
+SELECT recipe_equipment.recipe_id, recipe_equipment.equipment_id, equipment.name, recipe_equipment.heavy FROM recipe_equipment
+JOIN equipment ON equipment.id = recipe_equipment.equipment_id
+JOIN recipes ON recipes.id = recipe_equipment.recipe_id
+WHERE recipes.family_id = $1;
+
+-- name: AddFamilyEquipment :exec
+INSERT INTO family_equipment (
+    family_id,
+    equipment_id
+) VALUES ( $1, $2 )
+ON CONFLICT DO NOTHING;
+
+-- name: DeleteFamilyEquipment :exec
+DELETE FROM family_equipment
+WHERE family_id = $1;
+
+-- name: GetFamilyEquipment :many
+SELECT equipment.* FROM equipment
+JOIN family_equipment ON family_equipment.equipment_id = equipment.id
+WHERE family_equipment.family_id = $1
+ORDER BY equipment.name;
+
+-- name: MoveRecipeEquipment :exec
+INSERT INTO recipe_equipment (recipe_id, equipment_id, heavy)
+SELECT sqlc.arg(target_id)::uuid, equipment_id, heavy FROM recipe_equipment
+WHERE recipe_id = sqlc.arg(source_id)
+ON CONFLICT DO NOTHING;
\ No newline at end of file
@@ -0,0 +1,55 @@
+-- name: CreateEvent :one
+INSERT INTO events (
+    family_id,
+    name,
+    day,
+    guests,
+    restrictions
+) VALUES ( $1, $2, $3, $4, $5 )
+RETURNING *;
+
+-- name: GetEventByID :one
+SELECT * FROM events
+WHERE id = $1;
+
+-- name: GetEventsByFamilyID :many
+SELECT * FROM events
+WHERE family_id = $1
+ORDER BY day DESC;
+
+-- name: UpdateEvent :one
+UPDATE events SET
+    updated_at = NOW(),
+    name = $2,
+    day = $3,
+    guests = $4,
+    restrictions = $5
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteEvent :exec
+DELETE FROM events
+WHERE id = $1;
+
+-- name: AddEventRecipe :exec
+INSERT INTO event_recipes (
+    event_id,
+    recipe_id
+) VALUES ( $1, $2 )
+ON CONFLICT (event_id, recipe_id) DO NOTHING;
+
+-- name: RemoveEventRecipe :exec
+DELETE FROM event_recipes
+WHERE event_id = $1 AND recipe_id = $2;
+
+-- name: GetEventRecipes :many
+SELECT recipes.* FROM recipes
+JOIN event_recipes ON event_recipes.recipe_id = recipes.id
+WHERE event_recipes.event_id = $1
+ORDER BY event_recipes.created_at;
+
+-- name: MoveEventRecipes :exec
+INSERT INTO event_recipes (event_id, recipe_id)
+SELECT event_id, sqlc.arg(target_id)::uuid FROM event_recipes
+WHERE recipe_id = sqlc.arg(source_id)
+ON CONFLICT DO NOTHING;
\ No newline at end of file
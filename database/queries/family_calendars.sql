@@ -0,0 +1,47 @@
+-- name: CreateFamilyCalendar :one
+INSERT INTO family_calendars (
+    family_id,
+    name,
+    url,
+    timezone
+) VALUES ( $1, $2, $3, $4 )
+RETURNING *;
+
+-- name: GetFamilyCalendarByID :one
+SELECT * FROM family_calendars
+WHERE id = $1;
+
+-- name: GetFamilyCalendars :many
+SELECT * FROM family_calendars
+WHERE family_id = $1
+ORDER BY created_at;
+
+-- name: TouchFamilyCalendar :one
+UPDATE family_calendars SET
+    synced_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteFamilyCalendar :exec
+DELETE FROM family_calendars
+WHERE id = $1;
+
+-- name: CreateBusySlot :exec
+INSERT INTO busy_slots (
+    calendar_id,
+    day,
+    slot,
+    away,
+    summary
+) VALUES ( $1, $2, $3, $4, $5 );
+
+-- name: DeleteBusySlots :exec
+DELETE FROM busy_slots
+WHERE calendar_id = $1;
+
+-- name: GetBusySlotsByFamilyID :many
+SELECT busy_slots.* FROM busy_slots
+JOIN family_calendars ON family_calendars.id = busy_slots.calendar_id
+WHERE family_calendars.family_id = sqlc.arg(family_id) AND busy_slots.day >= sqlc.arg(from_day) AND busy_slots.day < sqlc.arg(to_day)
+ORDER BY busy_slots.day,
+    CASE busy_slots.slot WHEN 'breakfast' THEN 0 WHEN 'lunch' THEN 1 WHEN 'snack' THEN 2 ELSE 3 END;
\ No newline at end of file
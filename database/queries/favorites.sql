@@ -0,0 +1,32 @@
+-- name: AddFavorite :exec
+INSERT INTO favorites (
+    user_id,
+    recipe_id
+) VALUES ( $1, $2 )
+ON CONFLICT DO NOTHING;
+
+-- name: RemoveFavorite :exec
+DELETE FROM favorites
+WHERE user_id = $1 AND recipe_id = $2;
+
+-- name: IsRecipeFavorited :one
+SELECT EXISTS (
+    SELECT 1 FROM favorites
+    WHERE user_id = $1 AND recipe_id = $2
+);
+
+-- name: GetFavoriteRecipeIDsByUserID :many
+SELECT recipe_id FROM favorites
+WHERE user_id = $1;
+
+-- name: GetFavoriteRecipesByUserID :many
+SELECT recipes.* FROM recipes
+JOIN favorites ON favorites.recipe_id = recipes.id
+WHERE favorites.user_id = $1
+ORDER BY favorites.created_at DESC;
+
+-- name: MoveFavorites :exec
+INSERT INTO favorites (user_id, recipe_id)
+SELECT user_id, sqlc.arg(target_id)::uuid FROM favorites
+WHERE recipe_id = sqlc.arg(source_id)
+ON CONFLICT DO NOTHING;
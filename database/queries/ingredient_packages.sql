@@ -0,0 +1,25 @@
+-- name: CreateIngredientPackage :one
+INSERT INTO ingredient_packages (
+    ingredient_id,
+    quantity,
+    unit,
+    price_cents
+) VALUES ( $1, $2, $3, $4 )
+RETURNING *;
+
+-- name: GetIngredientPackageByID :one
+SELECT * FROM ingredient_packages
+WHERE id = $1;
+
+-- name: GetIngredientPackagesByIngredientID :many
+SELECT * FROM ingredient_packages
+WHERE ingredient_id = $1
+ORDER BY unit, quantity;
+
+-- name: GetIngredientPackages :many
+SELECT * FROM ingredient_packages
+ORDER BY ingredient_id, unit, quantity;
+
+-- name: DeleteIngredientPackage :exec
+DELETE FROM ingredient_packages
+WHERE id = $1;
\ No newline at end of file
@@ -1,8 +1,10 @@
 -- name: CreateIngredient :one
 INSERT INTO ingredients (
     name, 
-    density
-) VALUES ( $1, $2)
+    density,
+    allergens,
+    category
+) VALUES ( $1, $2, $3, $4)
 RETURNING *;
 
 -- name: GetIngredientByID :one
@@ -19,7 +21,9 @@ SELECT * FROM ingredients;
 -- name: UpdateIngredient :one
 UPDATE ingredients SET
     name = $2,
-    density = $3
+    density = $3,
+    allergens = $4,
+    category = $5
 WHERE id = $1
 RETURNING *;
 
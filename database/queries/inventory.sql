@@ -0,0 +1,33 @@
+-- name: CreateInventoryItem :one
+INSERT INTO inventory_items (
+    family_id,
+    ingredient_id,
+    quantity,
+    unit,
+    keep_at_least
+) VALUES ( $1, $2, $3, $4, $5 )
+RETURNING *;
+
+-- name: GetInventoryItemByID :one
+SELECT * FROM inventory_items
+WHERE id = $1;
+
+-- name: GetInventoryByFamilyID :many
+SELECT inventory_items.*, ingredients.name AS ingredient_name
+FROM inventory_items
+JOIN ingredients ON ingredients.id = inventory_items.ingredient_id
+WHERE inventory_items.family_id = $1
+ORDER BY ingredients.name;
+
+-- name: UpdateInventoryItem :one
+UPDATE inventory_items SET
+    updated_at = NOW(),
+    quantity = $2,
+    unit = $3,
+    keep_at_least = $4
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteInventoryItem :exec
+DELETE FROM inventory_items
+WHERE id = $1;
\ No newline at end of file
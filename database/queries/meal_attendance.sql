@@ -0,0 +1,74 @@
+-- name: CreateMemberMealAbsence :exec
+INSERT INTO member_meal_absences (
+    user_id,
+    weekday,
+    slot
+) VALUES ( $1, $2, $3 );
+
+-- name: DeleteMemberMealAbsences :exec
+DELETE FROM member_meal_absences
+WHERE user_id = $1;
+
+-- name: GetMemberMealAbsencesByFamilyID :many
+SELECT member_meal_absences.* FROM member_meal_absences
+JOIN users ON users.id = member_meal_absences.user_id
+WHERE users.family_id = $1
+ORDER BY member_meal_absences.weekday,
+    CASE member_meal_absences.slot WHEN 'breakfast' THEN 0 WHEN 'lunch' THEN 1 WHEN 'snack' THEN 2 ELSE 3 END;
+
+-- name: CreateMealAttendance :exec
+INSERT INTO meal_attendance (
+    meal_plan_id,
+    day,
+    slot,
+    user_id,
+    attending
+) VALUES ( $1, $2, $3, $4, $5 );
+
+-- name: DeleteMealAttendance :exec
+DELETE FROM meal_attendance
+WHERE meal_plan_id = $1 AND day = $2 AND slot = $3;
+
+-- name: GetMealAttendanceByFamilyID :many
+SELECT meal_attendance.* FROM meal_attendance
+JOIN meal_plans ON meal_plans.id = meal_attendance.meal_plan_id
+WHERE meal_plans.family_id = sqlc.arg(family_id)
+    AND meal_attendance.day >= sqlc.arg(from_day)
+    AND meal_attendance.day < sqlc.arg(to_day);
+
+-- name: UpsertMealGuests :exec
+INSERT INTO meal_guests (
+    meal_plan_id,
+    day,
+    slot,
+    guests
+) VALUES ( $1, $2, $3, $4 )
+ON CONFLICT (meal_plan_id, day, slot) DO UPDATE SET
+    guests = EXCLUDED.guests;
+
+-- name: GetMealGuestsByFamilyID :many
+SELECT meal_guests.* FROM meal_guests
+JOIN meal_plans ON meal_plans.id = meal_guests.meal_plan_id
+WHERE meal_plans.family_id = sqlc.arg(family_id)
+    AND meal_guests.day >= sqlc.arg(from_day)
+    AND meal_guests.day < sqlc.arg(to_day);
+
+-- name: GetAutoServingsMealsByFamilyID :many
+SELECT DISTINCT meal_plan_entries.meal_plan_id, meal_plan_entries.day, meal_plan_entries.slot FROM meal_plan_entries
+JOIN meal_plans ON meal_plans.id = meal_plan_entries.meal_plan_id
+WHERE meal_plans.family_id = sqlc.arg(family_id)
+    AND meal_plans.status <> 'final'
+    AND meal_plan_entries.day >= sqlc.arg(from_day)
+    AND meal_plan_entries.auto_servings;
+
+-- name: UpdateAutoMealPlanEntryServings :many
+UPDATE meal_plan_entries SET
+    updated_at = NOW(),
+    sequence = sequence + 1,
+    servings = sqlc.arg(servings)
+WHERE meal_plan_id = sqlc.arg(meal_plan_id)
+    AND day = sqlc.arg(day)
+    AND slot = sqlc.arg(slot)
+    AND auto_servings
+    AND servings <> sqlc.arg(servings)
+RETURNING *;
\ No newline at end of file
@@ -0,0 +1,77 @@
+-- name: CreateMealPlanTemplate :one
+INSERT INTO meal_plan_templates (
+    family_id,
+    name
+) VALUES ( $1, $2 )
+RETURNING *;
+
+-- name: GetMealPlanTemplateByID :one
+SELECT * FROM meal_plan_templates
+WHERE id = $1;
+
+-- name: GetMealPlanTemplatesByFamilyID :many
+SELECT * FROM meal_plan_templates
+WHERE family_id = $1
+ORDER BY name;
+
+-- name: DeleteMealPlanTemplate :exec
+DELETE FROM meal_plan_templates
+WHERE id = $1;
+
+-- name: CreateMealPlanTemplateEntry :one
+INSERT INTO meal_plan_template_entries (
+    template_id,
+    weekday,
+    slot,
+    recipe_id,
+    servings,
+    notes
+) VALUES ( $1, $2, $3, $4, $5, $6 )
+RETURNING *;
+
+-- name: GetMealPlanTemplateEntries :many
+SELECT meal_plan_template_entries.*, recipes.name AS recipe_name FROM meal_plan_template_entries
+JOIN recipes ON recipes.id = meal_plan_template_entries.recipe_id
+WHERE meal_plan_template_entries.template_id = $1
+ORDER BY meal_plan_template_entries.weekday,
+    CASE meal_plan_template_entries.slot WHEN 'breakfast' THEN 0 WHEN 'lunch' THEN 1 WHEN 'snack' THEN 2 ELSE 3 END,
+    recipes.name;
+
+-- name: UpsertMealPlanRotation :one
+INSERT INTO meal_plan_rotations (
+    family_id,
+    start_week
+) VALUES ( $1, $2 )
+ON CONFLICT (family_id) DO UPDATE SET
+    start_week = EXCLUDED.start_week
+RETURNING *;
+
+-- name: GetMealPlanRotation :one
+SELECT * FROM meal_plan_rotations
+WHERE family_id = $1;
+
+-- name: DeleteMealPlanRotation :exec
+DELETE FROM meal_plan_rotations
+WHERE family_id = $1;
+
+-- name: AddMealPlanRotationTemplate :exec
+INSERT INTO meal_plan_rotation_templates (
+    family_id,
+    position,
+    template_id
+) VALUES ( $1, $2, $3 );
+
+-- name: DeleteMealPlanRotationTemplates :exec
+DELETE FROM meal_plan_rotation_templates
+WHERE family_id = $1;
+
+-- name: GetMealPlanRotationTemplates :many
+SELECT meal_plan_rotation_templates.position, meal_plan_templates.id, meal_plan_templates.name FROM meal_plan_rotation_templates
+JOIN meal_plan_templates ON meal_plan_templates.id = meal_plan_rotation_templates.template_id
+WHERE meal_plan_rotation_templates.family_id = $1
+ORDER BY meal_plan_rotation_templates.position;
+
+-- name: MoveMealPlanTemplateEntries :exec
+UPDATE meal_plan_template_entries SET
+    recipe_id = sqlc.arg(target_id)
+WHERE recipe_id = sqlc.arg(source_id);
\ No newline at end of file
@@ -0,0 +1,48 @@
+-- name: UpdateMealPlanStatus :one
+UPDATE meal_plans SET
+    updated_at = NOW(),
+    status = $2
+WHERE id = $1
+RETURNING *;
+
+-- name: FinalizeMealPlan :one
+UPDATE meal_plans SET
+    updated_at = NOW(),
+    status = 'final',
+    finalized_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: LockMealPlanEntries :exec
+UPDATE meal_plan_entries SET
+    locked = TRUE
+WHERE meal_plan_id = $1 AND NOT locked;
+
+-- name: UpsertMealPlanVote :one
+INSERT INTO meal_plan_votes (
+    entry_id,
+    user_id,
+    recipe_id,
+    vote
+) VALUES ( $1, $2, $3, $4 )
+ON CONFLICT (entry_id, user_id) DO UPDATE SET
+    created_at = NOW(),
+    recipe_id = EXCLUDED.recipe_id,
+    vote = EXCLUDED.vote
+RETURNING *;
+
+-- name: DeleteMealPlanVote :exec
+DELETE FROM meal_plan_votes
+WHERE entry_id = $1 AND user_id = $2;
+
+-- name: GetMealPlanVotes :many
+SELECT meal_plan_votes.* FROM meal_plan_votes
+JOIN meal_plan_entries ON meal_plan_entries.id = meal_plan_votes.entry_id
+WHERE meal_plan_entries.meal_plan_id = $1
+    AND meal_plan_votes.recipe_id = meal_plan_entries.recipe_id
+ORDER BY meal_plan_votes.created_at;
+
+-- name: MoveMealPlanVotes :exec
+UPDATE meal_plan_votes SET
+    recipe_id = sqlc.arg(target_id)
+WHERE recipe_id = sqlc.arg(source_id);
\ No newline at end of file
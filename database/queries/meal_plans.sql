@@ -0,0 +1,118 @@
+-- name: CreateMealPlan :one
+INSERT INTO meal_plans (
+    family_id,
+    week_start
+) VALUES ( $1, $2 )
+RETURNING *;
+
+-- name: GetMealPlanByID :one
+SELECT * FROM meal_plans
+WHERE id = $1;
+
+-- name: GetMealPlanByWeek :one
+SELECT * FROM meal_plans
+WHERE family_id = $1 AND week_start = $2;
+
+-- name: GetMealPlansByFamilyID :many
+SELECT * FROM meal_plans
+WHERE family_id = $1
+ORDER BY week_start DESC;
+
+-- name: TouchMealPlan :exec
+UPDATE meal_plans SET
+    updated_at = NOW()
+WHERE id = $1;
+
+-- name: DeleteMealPlan :exec
+DELETE FROM meal_plans
+WHERE id = $1;
+
+-- name: CreateMealPlanEntry :one
+INSERT INTO meal_plan_entries (
+    meal_plan_id,
+    day,
+    slot,
+    recipe_id,
+    servings,
+    notes,
+    locked,
+    leftover_of,
+    batch_servings,
+    auto_servings
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+) RETURNING *;
+
+-- name: GetMealPlanEntryByID :one
+SELECT * FROM meal_plan_entries
+WHERE id = $1;
+
+-- name: GetMealPlanEntries :many
+SELECT meal_plan_entries.*, recipes.name AS recipe_name,
+    (SELECT COALESCE(SUM(leftovers.servings), 0) FROM meal_plan_entries leftovers
+        WHERE leftovers.leftover_of = meal_plan_entries.id)::int AS leftover_servings_eaten,
+    EXISTS (SELECT 1 FROM meal_plan_entries leftovers
+        WHERE leftovers.leftover_of = meal_plan_entries.id
+            AND (leftovers.locked OR leftovers.meal_plan_id <> meal_plan_entries.meal_plan_id))::boolean AS keeps_leftovers
+FROM meal_plan_entries
+JOIN recipes ON recipes.id = meal_plan_entries.recipe_id
+WHERE meal_plan_entries.meal_plan_id = $1
+ORDER BY meal_plan_entries.day,
+    CASE meal_plan_entries.slot WHEN 'breakfast' THEN 0 WHEN 'lunch' THEN 1 WHEN 'snack' THEN 2 ELSE 3 END,
+    meal_plan_entries.created_at;
+
+-- name: UpdateMealPlanEntry :one
+UPDATE meal_plan_entries SET
+    updated_at = NOW(),
+    sequence = sequence + 1,
+    day = $2,
+    slot = $3,
+    recipe_id = $4,
+    servings = $5,
+    notes = $6,
+    locked = $7,
+    leftover_of = $8,
+    batch_servings = $9,
+    auto_servings = $10
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteMealPlanEntry :exec
+DELETE FROM meal_plan_entries
+WHERE id = $1;
+
+-- name: DeleteUnlockedMealPlanEntries :exec
+DELETE FROM meal_plan_entries
+WHERE meal_plan_id = $1 AND NOT locked
+    AND NOT EXISTS (SELECT 1 FROM meal_plan_entries leftovers
+        WHERE leftovers.leftover_of = meal_plan_entries.id
+            AND (leftovers.locked OR leftovers.meal_plan_id <> meal_plan_entries.meal_plan_id));
+
+-- name: GetPlannedRecipesByFamilyID :many
+SELECT meal_plan_entries.recipe_id, meal_plan_entries.day FROM meal_plan_entries
+JOIN meal_plans ON meal_plans.id = meal_plan_entries.meal_plan_id
+WHERE meal_plans.family_id = sqlc.arg(family_id)
+    AND meal_plan_entries.day >= sqlc.arg(from_day)
+    AND meal_plan_entries.day < sqlc.arg(to_day);
+
+-- name: GetLeftovers :many
+SELECT * FROM meal_plan_entries
+WHERE leftover_of = $1
+ORDER BY day;
+
+-- name: GetLeftoversOutsideMealPlan :many
+SELECT leftovers.* FROM meal_plan_entries leftovers
+JOIN meal_plan_entries cooked ON cooked.id = leftovers.leftover_of
+WHERE cooked.meal_plan_id = $1 AND leftovers.meal_plan_id <> $1
+ORDER BY leftovers.day;
+
+-- name: GetLeftoverServingsEaten :one
+SELECT COALESCE(SUM(servings), 0)::int AS servings FROM meal_plan_entries
+WHERE leftover_of = sqlc.arg(entry_id)::uuid AND id <> sqlc.arg(exclude_id);
+
+-- name: MoveMealPlanEntries :exec
+UPDATE meal_plan_entries SET
+    updated_at = NOW(),
+    sequence = sequence + 1,
+    recipe_id = sqlc.arg(target_id)
+WHERE recipe_id = sqlc.arg(source_id);
\ No newline at end of file
@@ -3,9 +3,19 @@ INSERT INTO recipes (
     name,
     cooking_process,
     family_id,
-    items
+    items,
+    prep_time_minutes,
+    cook_time_minutes,
+    active_time_minutes,
+    difficulty,
+    cuisine,
+    tags,
+    calories_per_serving,
+    protein_per_serving,
+    cost_per_serving,
+    servings
 ) VALUES (
-    $1, $2, $3, $4
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
 ) RETURNING *;
 
 -- name: GetRecipes :many
@@ -15,6 +25,24 @@ SELECT * FROM recipes;
 SELECT * FROM recipes
 WHERE family_id = $1;
 
+-- name: FilterRecipesByFamilyID :many
+SELECT recipes.* FROM recipes
+WHERE family_id = sqlc.arg(family_id)
+    AND (sqlc.narg(max_total_time)::int IS NULL OR total_time_minutes <= sqlc.narg(max_total_time))
+    AND (sqlc.narg(difficulty)::varchar IS NULL OR difficulty = sqlc.narg(difficulty))
+    AND (NOT sqlc.arg(only_possible)::boolean OR NOT EXISTS (
+        SELECT 1 FROM recipe_equipment
+        LEFT JOIN family_equipment ON family_equipment.equipment_id = recipe_equipment.equipment_id
+            AND family_equipment.family_id = recipes.family_id
+        WHERE recipe_equipment.recipe_id = recipes.id AND family_equipment.equipment_id IS NULL
+    ))
+ORDER BY
+    CASE WHEN sqlc.arg(sort_by)::text = 'total_time' THEN total_time_minutes END,
+    CASE WHEN sqlc.arg(sort_by)::text = 'prep_time' THEN prep_time_minutes END,
+    CASE WHEN sqlc.arg(sort_by)::text = 'cook_time' THEN cook_time_minutes END,
+    CASE WHEN sqlc.arg(sort_by)::text = 'active_time' THEN active_time_minutes END,
+    name;
+
 -- name: GetRecipeByID :one
 SELECT * FROM recipes
 WHERE id = $1;
@@ -23,7 +51,17 @@ WHERE id = $1;
 UPDATE recipes SET
     name = $2,
     cooking_process = $3,
-    items = $4
+    items = $4,
+    prep_time_minutes = $5,
+    cook_time_minutes = $6,
+    active_time_minutes = $7,
+    difficulty = $8,
+    cuisine = $9,
+    tags = $10,
+    calories_per_serving = $11,
+    protein_per_serving = $12,
+    cost_per_serving = $13,
+    servings = $14
 WHERE id = $1
 RETURNING *;
 
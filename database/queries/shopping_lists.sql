@@ -0,0 +1,101 @@
+-- name: CreateShoppingList :one
+INSERT INTO shopping_lists (
+    family_id,
+    meal_plan_id
+) VALUES ( $1, $2 )
+RETURNING *;
+
+-- name: GetShoppingListByID :one
+SELECT * FROM shopping_lists
+WHERE id = $1;
+
+-- name: GetShoppingListByMealPlanID :one
+SELECT * FROM shopping_lists
+WHERE meal_plan_id = $1;
+
+-- name: GetShoppingListsByFamilyID :many
+SELECT * FROM shopping_lists
+WHERE family_id = $1
+ORDER BY created_at DESC;
+
+-- name: TouchShoppingList :exec
+UPDATE shopping_lists SET
+    updated_at = NOW()
+WHERE id = $1;
+
+-- name: DeleteShoppingList :exec
+DELETE FROM shopping_lists
+WHERE id = $1;
+
+-- name: CreateShoppingListItem :one
+INSERT INTO shopping_list_items (
+    shopping_list_id,
+    ingredient_id,
+    name,
+    quantity,
+    unit,
+    manual,
+    category,
+    packages,
+    surplus,
+    sources,
+    generated_unit
+) VALUES ( $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11 )
+RETURNING *;
+
+-- name: GetShoppingListItemByID :one
+SELECT * FROM shopping_list_items
+WHERE id = $1;
+
+-- name: GetShoppingListItems :many
+SELECT * FROM shopping_list_items
+WHERE shopping_list_id = $1 AND NOT dismissed
+ORDER BY name, unit;
+
+-- name: GetAllShoppingListItems :many
+SELECT * FROM shopping_list_items
+WHERE shopping_list_id = $1
+ORDER BY name, unit;
+
+-- name: UpdateShoppingListItem :one
+UPDATE shopping_list_items SET
+    updated_at = NOW(),
+    name = $2,
+    quantity = $3,
+    unit = $4,
+    category = $5,
+    edited = NOT manual
+WHERE id = $1
+RETURNING *;
+
+-- name: UpdateGeneratedShoppingListItem :exec
+UPDATE shopping_list_items SET
+    updated_at = NOW(),
+    quantity = $2,
+    packages = $3,
+    surplus = $4,
+    sources = $5
+WHERE id = $1 AND NOT manual AND NOT checked AND NOT edited AND NOT dismissed;
+
+-- name: DeleteShoppingListItem :exec
+DELETE FROM shopping_list_items
+WHERE id = $1;
+
+-- name: DeleteGeneratedShoppingListItem :exec
+DELETE FROM shopping_list_items
+WHERE id = $1 AND NOT manual AND NOT checked AND NOT edited AND NOT dismissed;
+
+-- name: DismissShoppingListItem :exec
+UPDATE shopping_list_items SET
+    updated_at = NOW(),
+    dismissed = TRUE
+WHERE id = $1;
+
+-- name: CheckShoppingListItem :one
+UPDATE shopping_list_items SET
+    updated_at = NOW(),
+    checked = $2,
+    checked_at = $3,
+    checked_by = $4
+WHERE id = $1 AND (checked_at IS NULL OR checked_at <= $3)
+RETURNING *;
\ No newline at end of file
@@ -0,0 +1,35 @@
+-- name: CreateStaple :one
+INSERT INTO staples (
+    family_id,
+    ingredient_id,
+    quantity,
+    unit,
+    every_weeks,
+    first_week
+) VALUES ( $1, $2, $3, $4, $5, $6 )
+RETURNING *;
+
+-- name: GetStapleByID :one
+SELECT * FROM staples
+WHERE id = $1;
+
+-- name: GetStaplesByFamilyID :many
+SELECT staples.*, ingredients.name AS ingredient_name
+FROM staples
+JOIN ingredients ON ingredients.id = staples.ingredient_id
+WHERE staples.family_id = $1
+ORDER BY ingredients.name;
+
+-- name: UpdateStaple :one
+UPDATE staples SET
+    updated_at = NOW(),
+    quantity = $2,
+    unit = $3,
+    every_weeks = $4,
+    first_week = $5
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteStaple :exec
+DELETE FROM staples
+WHERE id = $1;
\ No newline at end of file
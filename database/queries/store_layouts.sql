@@ -0,0 +1,28 @@
+-- name: CreateStoreLayout :one
+INSERT INTO store_layouts (
+    family_id,
+    name,
+    categories
+) VALUES ( $1, $2, $3 )
+RETURNING *;
+
+-- name: GetStoreLayoutByID :one
+SELECT * FROM store_layouts
+WHERE id = $1;
+
+-- name: GetStoreLayoutsByFamilyID :many
+SELECT * FROM store_layouts
+WHERE family_id = $1
+ORDER BY name;
+
+-- name: UpdateStoreLayout :one
+UPDATE store_layouts SET
+    updated_at = NOW(),
+    name = $2,
+    categories = $3
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteStoreLayout :exec
+DELETE FROM store_layouts
+WHERE id = $1;
\ No newline at end of file
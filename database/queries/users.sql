@@ -13,6 +13,11 @@ SELECT * FROM users;
 SELECT * FROM users
 WHERE id = $1;
 
+-- name: GetUsersByFamilyID :many
+SELECT * FROM users
+WHERE family_id = $1
+ORDER BY created_at;
+
 -- name: GetUserByEmail :one
 SELECT * FROM users
 WHERE email = $1;
@@ -36,6 +41,19 @@ UPDATE users SET
 WHERE id = $1
 RETURNING *;
 
+-- name: UpdateUserPortionFactor :one
+UPDATE users SET
+    portion_factor = $2
+WHERE id = $1
+RETURNING *;
+
+-- name: UpdateUserUnitSystem :one
+UPDATE users SET
+    updated_at = NOW(),
+    unit_system = $2
+WHERE id = $1
+RETURNING *;
+
 -- name: DeleteUser :exec
 DELETE FROM users
 WHERE id = $1;
\ No newline at end of file
@@ -0,0 +1,286 @@
+package planner
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/andreiz53/cookinator/cooking"
+	"github.com/andreiz53/cookinator/types"
+)
+
+const (
+	TagVegetarian = "vegetarian"
+	TagVegan      = "vegan"
+)
+
+// maxImprovePasses bounds the local search, every pass tries to swap each entry once
+const maxImprovePasses = 20
+
+// MemberTargets are the daily nutrition ranges of a family member, a zero bound is not checked.
+// Every member is counted for one serving of each planned meal.
+type MemberTargets struct {
+	ID          uuid.UUID
+	Name        string
+	MinCalories int32
+	MaxCalories int32
+	MinProtein  int32
+	MaxProtein  int32
+}
+
+// Constraints limit the whole week, a zero value is not checked
+type Constraints struct {
+	// MaxCost is the cost ceiling of the week in cents
+	MaxCost int64
+	Members []MemberTargets
+	// MaxActiveMinutesPerDay limits the hands-on cooking time of each day
+	MaxActiveMinutesPerDay int32
+	// VegetarianDinners is the minimum number of dinners made only of vegetarian or vegan recipes
+	VegetarianDinners int
+}
+
+func (c Constraints) set() bool {
+	return c.MaxCost > 0 || len(c.Members) > 0 || c.MaxActiveMinutesPerDay > 0 || c.VegetarianDinners > 0
+}
+
+// Check tells whether the plan satisfies a constraint and why
+type Check struct {
+	Constraint string `json:"constraint"`
+	Member     string `json:"member,omitempty"`
+	Satisfied  bool   `json:"satisfied"`
+	Detail     string `json:"detail"`
+}
+
+// improve runs a local search over the generated entries, swapping the recipe of one entry at a time
+// for the one that lowers the constraint penalty the most. Swaps never break the slot or no repeat rules.
+func (g *generator) improve(entries []Entry) []Entry {
+	entries = slices.Clone(entries)
+	_, penalty := g.measure(entries)
+
+	for pass := 0; pass < maxImprovePasses && penalty > 0; pass++ {
+		improved := false
+		for _, i := range g.rng.Perm(len(entries)) {
+			current := entries[i]
+			best, bestPenalty := current, penalty
+			for _, recipe := range g.recipes {
+				if recipe.ID == current.RecipeID || !g.swappable(entries, i, recipe) {
+					continue
+				}
+				entries[i].RecipeID = recipe.ID
+				_, p := g.measure(entries)
+				if p < bestPenalty-1e-9 {
+					best, bestPenalty = entries[i], p
+				}
+			}
+			entries[i] = best
+			if best.RecipeID != current.RecipeID {
+				penalty = bestPenalty
+				improved = true
+			}
+		}
+		if !improved {
+			break
+		}
+	}
+	return entries
+}
+
+// swappable reports whether entry i may be given the recipe without breaking the rules against the other entries
+func (g *generator) swappable(entries []Entry, i int, recipe Recipe) bool {
+	entry := entries[i]
+	if !fitsSlot(recipe, entry.Slot) {
+		return false
+	}
+
+	window := time.Duration(g.request.Rules.NoRepeatDays) * 24 * time.Hour
+	near := func(day time.Time) bool {
+		diff := entry.Day.Sub(day)
+		if diff < 0 {
+			diff = -diff
+		}
+		return diff < window
+	}
+	for _, day := range g.request.History[recipe.ID] {
+		if near(day) {
+			return false
+		}
+	}
+
+	others := append(slices.Clone(g.request.Locked), entries[:i]...)
+	others = append(others, entries[i+1:]...)
+	for _, other := range others {
+		if other.RecipeID == recipe.ID && near(other.Day) {
+			return false
+		}
+		if other.Day.Equal(entry.Day) && other.Slot == entry.Slot {
+			if other.RecipeID == recipe.ID {
+				return false
+			}
+			otherRecipe, _ := g.recipe(other.RecipeID)
+			if len(cooking.HeavyConflicts(otherRecipe.Equipment, recipe.Equipment)) > 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// check reports the constraints of the request against the locked and generated entries
+func (g *generator) check(entries []Entry) []Check {
+	checks, _ := g.measure(entries)
+	return checks
+}
+
+// measure checks the constraints and sums how far off the plan is, each violation relative to its bound.
+// Weekday recipes over the quick time rule add a small penalty so swaps keep to it when they can.
+func (g *generator) measure(entries []Entry) ([]Check, float64) {
+	constraints := g.request.Constraints
+	all := append(slices.Clone(g.request.Locked), entries...)
+	checks := []Check{}
+	penalty := 0.0
+
+	var cost int64
+	daily := map[time.Time]*dayTotals{}
+	dinners := map[time.Time]bool{}
+	for i := 0; i < 7; i++ {
+		daily[g.request.WeekStart.AddDate(0, 0, i)] = &dayTotals{}
+	}
+	for _, entry := range all {
+		recipe, _ := g.recipe(entry.RecipeID)
+		cost += int64(recipe.CostPerServing) * int64(entry.Servings)
+
+		totals, ok := daily[entry.Day]
+		if !ok {
+			continue
+		}
+		totals.calories += recipe.CaloriesPerServing
+		totals.protein += recipe.ProteinPerServing
+		totals.active += recipe.ActiveTimeMinutes
+
+		if entry.Slot == types.MealSlotDinner {
+			vegetarian, seen := dinners[entry.Day]
+			dinners[entry.Day] = (vegetarian || !seen) && isVegetarian(recipe)
+		}
+		if !entry.Locked && g.tooSlow(recipe, Slot{Day: entry.Day, Slot: entry.Slot}) {
+			penalty += 0.1
+		}
+	}
+	days := make([]time.Time, 0, len(daily))
+	for day := range daily {
+		days = append(days, day)
+	}
+	slices.SortFunc(days, func(a, b time.Time) int { return a.Compare(b) })
+
+	if constraints.MaxCost > 0 {
+		check := Check{Constraint: "cost", Satisfied: cost <= constraints.MaxCost}
+		if check.Satisfied {
+			check.Detail = fmt.Sprintf("the week costs %s, within %s", money(cost), money(constraints.MaxCost))
+		} else {
+			check.Detail = fmt.Sprintf("the week costs %s, %s over %s", money(cost), money(cost-constraints.MaxCost), money(constraints.MaxCost))
+			penalty += float64(cost-constraints.MaxCost) / float64(constraints.MaxCost)
+		}
+		checks = append(checks, check)
+	}
+
+	for _, member := range constraints.Members {
+		for _, nutrient := range []struct {
+			name, unit string
+			min, max   int32
+			value      func(*dayTotals) int32
+		}{
+			{"calories", "kcal", member.MinCalories, member.MaxCalories, func(d *dayTotals) int32 { return d.calories }},
+			{"protein", "g protein", member.MinProtein, member.MaxProtein, func(d *dayTotals) int32 { return d.protein }},
+		} {
+			if nutrient.min == 0 && nutrient.max == 0 {
+				continue
+			}
+			check := Check{Constraint: nutrient.name, Member: member.Name, Satisfied: true}
+			problems := []string{}
+			for _, day := range days {
+				value := nutrient.value(daily[day])
+				switch {
+				case nutrient.min > 0 && value < nutrient.min:
+					problems = append(problems, fmt.Sprintf("%s has %d %s, below %d", day.Format(dayLayout), value, nutrient.unit, nutrient.min))
+					penalty += float64(nutrient.min-value) / float64(nutrient.min)
+				case nutrient.max > 0 && value > nutrient.max:
+					problems = append(problems, fmt.Sprintf("%s has %d %s, above %d", day.Format(dayLayout), value, nutrient.unit, nutrient.max))
+					penalty += float64(value-nutrient.max) / float64(nutrient.max)
+				}
+			}
+			if len(problems) > 0 {
+				check.Satisfied = false
+				check.Detail = strings.Join(problems, "; ")
+			} else {
+				check.Detail = fmt.Sprintf("every day is within %s %s", bounds(nutrient.min, nutrient.max), nutrient.unit)
+			}
+			checks = append(checks, check)
+		}
+	}
+
+	if constraints.MaxActiveMinutesPerDay > 0 {
+		check := Check{Constraint: "active_time", Satisfied: true}
+		problems := []string{}
+		for _, day := range days {
+			active := daily[day].active
+			if active > constraints.MaxActiveMinutesPerDay {
+				problems = append(problems, fmt.Sprintf("%s needs %d minutes", day.Format(dayLayout), active))
+				penalty += float64(active-constraints.MaxActiveMinutesPerDay) / float64(constraints.MaxActiveMinutesPerDay)
+			}
+		}
+		if len(problems) > 0 {
+			check.Satisfied = false
+			check.Detail = fmt.Sprintf("%s, over %d minutes", strings.Join(problems, "; "), constraints.MaxActiveMinutesPerDay)
+		} else {
+			check.Detail = fmt.Sprintf("every day needs at most %d minutes", constraints.MaxActiveMinutesPerDay)
+		}
+		checks = append(checks, check)
+	}
+
+	if constraints.VegetarianDinners > 0 {
+		count := 0
+		for _, vegetarian := range dinners {
+			if vegetarian {
+				count++
+			}
+		}
+		check := Check{
+			Constraint: "vegetarian_dinners",
+			Satisfied:  count >= constraints.VegetarianDinners,
+			Detail:     fmt.Sprintf("%d of %d required dinners are vegetarian", count, constraints.VegetarianDinners),
+		}
+		if !check.Satisfied {
+			penalty += float64(constraints.VegetarianDinners - count)
+		}
+		checks = append(checks, check)
+	}
+
+	return checks, penalty
+}
+
+type dayTotals struct {
+	calories int32
+	protein  int32
+	active   int32
+}
+
+func isVegetarian(recipe Recipe) bool {
+	return slices.Contains(recipe.Tags, TagVegetarian) || slices.Contains(recipe.Tags, TagVegan)
+}
+
+func money(cents int64) string {
+	return fmt.Sprintf("%d.%02d", cents/100, cents%100)
+}
+
+func bounds(min, max int32) string {
+	switch {
+	case min > 0 && max > 0:
+		return fmt.Sprintf("%d-%d", min, max)
+	case min > 0:
+		return fmt.Sprintf("at least %d", min)
+	default:
+		return fmt.Sprintf("at most %d", max)
+	}
+}
@@ -0,0 +1,125 @@
+package planner
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func checkFor(t *testing.T, checks []Check, constraint string) Check {
+	for _, check := range checks {
+		if check.Constraint == constraint {
+			return check
+		}
+	}
+	t.Fatalf("no check for %s", constraint)
+	return Check{}
+}
+
+func TestGenerateCostCeiling(t *testing.T) {
+	cheap := testRecipes(7, 20)
+	for i := range cheap {
+		cheap[i].CostPerServing = 200
+	}
+	expensive := testRecipes(7, 20)
+	for i := range expensive {
+		expensive[i].CostPerServing = 1500
+	}
+
+	// 7 dinners for 4 at 2.00 a serving
+	result := Generate(Request{
+		WeekStart:   monday,
+		Recipes:     append(cheap, expensive...),
+		Rules:       dinnerRules(11),
+		Constraints: Constraints{MaxCost: 5600},
+	})
+	require.Len(t, result.Entries, 7)
+	check := checkFor(t, result.Checks, "cost")
+	require.True(t, check.Satisfied, check.Detail)
+	require.Equal(t, "the week costs 56.00, within 56.00", check.Detail)
+
+	result = Generate(Request{
+		WeekStart:   monday,
+		Recipes:     append(cheap, expensive...),
+		Rules:       dinnerRules(11),
+		Constraints: Constraints{MaxCost: 5000},
+	})
+	check = checkFor(t, result.Checks, "cost")
+	require.False(t, check.Satisfied)
+	require.Equal(t, "the week costs 56.00, 6.00 over 50.00", check.Detail)
+}
+
+func TestGenerateVegetarianDinners(t *testing.T) {
+	meat := testRecipes(7, 20)
+	vegetarian := testRecipes(4, 20)
+	for i := range vegetarian {
+		vegetarian[i].Tags = []string{TagVegetarian}
+	}
+
+	result := Generate(Request{
+		WeekStart:   monday,
+		Recipes:     append(meat, vegetarian...),
+		Rules:       dinnerRules(2),
+		Constraints: Constraints{VegetarianDinners: 3},
+	})
+	check := checkFor(t, result.Checks, "vegetarian_dinners")
+	require.True(t, check.Satisfied, check.Detail)
+
+	result = Generate(Request{
+		WeekStart:   monday,
+		Recipes:     append(meat, vegetarian...),
+		Rules:       dinnerRules(2),
+		Constraints: Constraints{VegetarianDinners: 5},
+	})
+	check = checkFor(t, result.Checks, "vegetarian_dinners")
+	require.False(t, check.Satisfied)
+	require.Equal(t, "4 of 5 required dinners are vegetarian", check.Detail)
+}
+
+func TestGenerateNutritionAndActiveTime(t *testing.T) {
+	light := testRecipes(7, 20)
+	for i := range light {
+		light[i].CaloriesPerServing = 400
+		light[i].ProteinPerServing = 15
+		light[i].ActiveTimeMinutes = 15
+	}
+	hearty := testRecipes(7, 20)
+	for i := range hearty {
+		hearty[i].CaloriesPerServing = 900
+		hearty[i].ProteinPerServing = 45
+		hearty[i].ActiveTimeMinutes = 25
+	}
+	member := MemberTargets{ID: uuid.New(), Name: "Ana", MinCalories: 800, MaxCalories: 1000, MinProtein: 40}
+
+	result := Generate(Request{
+		WeekStart: monday,
+		Recipes:   append(light, hearty...),
+		Rules:     dinnerRules(9),
+		Constraints: Constraints{
+			Members:                []MemberTargets{member},
+			MaxActiveMinutesPerDay: 30,
+		},
+	})
+	require.Len(t, result.Entries, 7)
+	for _, check := range result.Checks {
+		require.True(t, check.Satisfied, check.Detail)
+	}
+	calories := checkFor(t, result.Checks, "calories")
+	require.Equal(t, "Ana", calories.Member)
+	require.Equal(t, "every day is within 800-1000 kcal", calories.Detail)
+
+	result = Generate(Request{
+		WeekStart:   monday,
+		Recipes:     append(light, hearty...),
+		Rules:       dinnerRules(9),
+		Constraints: Constraints{MaxActiveMinutesPerDay: 20},
+	})
+	check := checkFor(t, result.Checks, "active_time")
+	require.True(t, check.Satisfied, check.Detail)
+}
+
+func TestGenerateWithoutConstraints(t *testing.T) {
+	result := Generate(Request{WeekStart: monday, Recipes: testRecipes(7, 20), Rules: dinnerRules(1)})
+	require.Empty(t, result.Checks)
+}
@@ -0,0 +1,331 @@
+package planner
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/andreiz53/cookinator/cooking"
+	"github.com/andreiz53/cookinator/types"
+)
+
+const (
+	DefaultNoRepeatDays        = 7
+	DefaultWeekdayMaxTotalTime = 30
+	DefaultBusyMaxTotalTime    = 20
+)
+
+// Recipe is a recipe the planner can choose from. Active time is the hands-on time with prep included,
+// protein is in grams and cost in cents.
+type Recipe struct {
+	ID                 uuid.UUID
+	Name               string
+	TotalTimeMinutes   int32
+	Cuisine            string
+	Tags               []string
+	Equipment          []cooking.EquipmentUse
+	ActiveTimeMinutes  int32
+	CaloriesPerServing int32
+	ProteinPerServing  int32
+	CostPerServing     int32
+}
+
+// Entry is a recipe planned for a meal of a day
+type Entry struct {
+	Day      time.Time
+	Slot     types.MealSlot
+	RecipeID uuid.UUID
+	Servings int32
+	Locked   bool
+}
+
+// Slot is a meal of a day
+type Slot struct {
+	Day  time.Time      `json:"day"`
+	Slot types.MealSlot `json:"slot"`
+}
+
+// Rules configure how a week is filled
+type Rules struct {
+	// Slots are the meals to plan every day
+	Slots []types.MealSlot
+	// NoRepeatDays is the minimum number of days between two servings of the same recipe
+	NoRepeatDays int
+	// WeekdayMaxTotalTime limits the total time of recipes planned Monday to Friday, 0 disables it
+	WeekdayMaxTotalTime int32
+	// BusyMaxTotalTime limits the total time of recipes planned for busy slots on any day, 0 disables it
+	BusyMaxTotalTime int32
+	// DishesPerSlot is the number of recipes served together for a meal, like a main and a side
+	DishesPerSlot int
+	Servings      int32
+	Seed          int64
+}
+
+type Request struct {
+	WeekStart time.Time
+	Recipes   []Recipe
+	// Locked entries are kept as they are and count for the rules
+	Locked []Entry
+	// History holds the days each recipe was cooked or planned before the week
+	History map[uuid.UUID][]time.Time
+	// Busy slots get quick recipes and nothing is planned for the Away slots, when nobody is home
+	Busy        []Slot
+	Away        []Slot
+	Rules       Rules
+	Constraints Constraints
+}
+
+type Result struct {
+	// Entries are the generated entries, without the locked ones
+	Entries  []Entry
+	Unfilled []Slot
+	// Skipped are the slots left empty because nobody is home
+	Skipped  []Slot
+	Warnings []string
+	// Checks report how the plan does against each constraint that was set
+	Checks []Check
+}
+
+type generator struct {
+	request  Request
+	rng      *rand.Rand
+	recipes  []Recipe
+	used     map[uuid.UUID][]time.Time
+	cuisines map[string]int
+	tags     map[string]int
+	planned  map[Slot][]Entry
+	busy     map[Slot]bool
+	away     map[Slot]bool
+}
+
+// Generate fills the slots of a week around the locked entries. The same request and seed always give the same plan.
+// When no recipe satisfies every rule for a slot, the quick weekday rule is relaxed first and the no repeat rule second.
+// The greedy plan is then improved against the constraints of the request by swapping recipes.
+func Generate(request Request) Result {
+	g := &generator{
+		request:  request,
+		rng:      rand.New(rand.NewSource(request.Rules.Seed)),
+		used:     map[uuid.UUID][]time.Time{},
+		cuisines: map[string]int{},
+		tags:     map[string]int{},
+		planned:  map[Slot][]Entry{},
+		busy:     map[Slot]bool{},
+		away:     map[Slot]bool{},
+	}
+
+	// the order recipes are loaded in must not change the plan
+	g.recipes = append(g.recipes, request.Recipes...)
+	sort.Slice(g.recipes, func(i, j int) bool {
+		return g.recipes[i].ID.String() < g.recipes[j].ID.String()
+	})
+	for id, days := range request.History {
+		g.used[id] = append(g.used[id], days...)
+	}
+	for _, entry := range request.Locked {
+		g.add(entry)
+	}
+	for _, slot := range request.Busy {
+		g.busy[slot] = true
+	}
+	for _, slot := range request.Away {
+		g.away[slot] = true
+	}
+
+	result := Result{Entries: []Entry{}, Unfilled: []Slot{}, Skipped: []Slot{}, Warnings: []string{}, Checks: []Check{}}
+	for i := 0; i < 7; i++ {
+		day := request.WeekStart.AddDate(0, 0, i)
+		for _, slot := range request.Rules.Slots {
+			key := Slot{Day: day, Slot: slot}
+			if g.away[key] {
+				result.Skipped = append(result.Skipped, key)
+				continue
+			}
+			for len(g.planned[key]) < max(request.Rules.DishesPerSlot, 1) {
+				recipe, ok := g.pick(key)
+				if !ok {
+					result.Unfilled = append(result.Unfilled, key)
+					break
+				}
+				entry := Entry{
+					Day:      day,
+					Slot:     slot,
+					RecipeID: recipe.ID,
+					Servings: request.Rules.Servings,
+				}
+				g.add(entry)
+				result.Entries = append(result.Entries, entry)
+			}
+		}
+	}
+
+	if request.Constraints.set() {
+		result.Entries = g.improve(result.Entries)
+		result.Checks = g.check(result.Entries)
+	}
+	result.Warnings = g.warnings(result.Entries)
+	return result
+}
+
+// warnings replays the entries in order and reports the rules each one breaks
+func (g *generator) warnings(entries []Entry) []string {
+	replay := &generator{request: g.request, recipes: g.recipes, used: map[uuid.UUID][]time.Time{}, busy: g.busy}
+	for id, days := range g.request.History {
+		replay.used[id] = append(replay.used[id], days...)
+	}
+	for _, entry := range g.request.Locked {
+		replay.used[entry.RecipeID] = append(replay.used[entry.RecipeID], entry.Day)
+	}
+
+	warnings := []string{}
+	for _, entry := range entries {
+		recipe, _ := g.recipe(entry.RecipeID)
+		day := entry.Day.Format(dayLayout)
+		if replay.repeats(recipe, entry.Day) {
+			warnings = append(warnings, fmt.Sprintf("%s %s: %s was planned in the last %d days", day, entry.Slot, recipe.Name, g.request.Rules.NoRepeatDays))
+		}
+		if slot := (Slot{Day: entry.Day, Slot: entry.Slot}); replay.tooSlow(recipe, slot) {
+			warnings = append(warnings, fmt.Sprintf("%s %s: %s takes more than %d minutes", day, entry.Slot, recipe.Name, replay.maxTotalTime(slot)))
+		}
+		replay.used[entry.RecipeID] = append(replay.used[entry.RecipeID], entry.Day)
+	}
+	return warnings
+}
+
+const dayLayout = "Mon 2006-01-02"
+
+// pick chooses the recipe for a slot among the ones allowed by the strictest set of rules that leaves any,
+// preferring cuisines and tags used the least this week
+func (g *generator) pick(slot Slot) (Recipe, bool) {
+	levels := []func(Recipe) bool{
+		func(r Recipe) bool { return !g.repeats(r, slot.Day) && !g.tooSlow(r, slot) },
+		func(r Recipe) bool { return !g.repeats(r, slot.Day) },
+		func(r Recipe) bool { return true },
+	}
+
+	for _, allowed := range levels {
+		var best []Recipe
+		bestScore := 0
+		for _, recipe := range g.recipes {
+			if !fitsSlot(recipe, slot.Slot) || g.conflicts(recipe, slot) || !allowed(recipe) {
+				continue
+			}
+			score := g.score(recipe)
+			if len(best) == 0 || score < bestScore {
+				best = []Recipe{recipe}
+				bestScore = score
+			} else if score == bestScore {
+				best = append(best, recipe)
+			}
+		}
+		if len(best) > 0 {
+			return best[g.rng.Intn(len(best))], true
+		}
+	}
+	return Recipe{}, false
+}
+
+// score counts how often the cuisine and tags of a recipe were already used this week
+func (g *generator) score(recipe Recipe) int {
+	score := 0
+	if recipe.Cuisine != "" {
+		score += 2 * g.cuisines[recipe.Cuisine]
+	}
+	for _, tag := range recipe.Tags {
+		score += g.tags[tag]
+	}
+	return score
+}
+
+func (g *generator) repeats(recipe Recipe, day time.Time) bool {
+	window := time.Duration(g.request.Rules.NoRepeatDays) * 24 * time.Hour
+	for _, used := range g.used[recipe.ID] {
+		diff := day.Sub(used)
+		if diff < 0 {
+			diff = -diff
+		}
+		if diff < window {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *generator) tooSlow(recipe Recipe, slot Slot) bool {
+	max := g.maxTotalTime(slot)
+	return max > 0 && recipe.TotalTimeMinutes > max
+}
+
+// maxTotalTime is the longest total time allowed for a slot, 0 when any recipe fits.
+// Busy slots get the busy limit even on weekends.
+func (g *generator) maxTotalTime(slot Slot) int32 {
+	if g.busy[slot] && g.request.Rules.BusyMaxTotalTime > 0 {
+		return g.request.Rules.BusyMaxTotalTime
+	}
+	weekend := slot.Day.Weekday() == time.Saturday || slot.Day.Weekday() == time.Sunday
+	if weekend {
+		return 0
+	}
+	return g.request.Rules.WeekdayMaxTotalTime
+}
+
+// conflicts reports whether the recipe is already part of the meal or needs heavy equipment
+// another recipe of the same meal already keeps busy
+func (g *generator) conflicts(recipe Recipe, slot Slot) bool {
+	for _, entry := range g.planned[slot] {
+		if entry.RecipeID == recipe.ID {
+			return true
+		}
+		other, ok := g.recipe(entry.RecipeID)
+		if ok && len(cooking.HeavyConflicts(other.Equipment, recipe.Equipment)) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *generator) recipe(id uuid.UUID) (Recipe, bool) {
+	i := sort.Search(len(g.recipes), func(i int) bool {
+		return g.recipes[i].ID.String() >= id.String()
+	})
+	if i < len(g.recipes) && g.recipes[i].ID == id {
+		return g.recipes[i], true
+	}
+	return Recipe{}, false
+}
+
+func (g *generator) add(entry Entry) {
+	key := Slot{Day: entry.Day, Slot: entry.Slot}
+	g.planned[key] = append(g.planned[key], entry)
+	g.used[entry.RecipeID] = append(g.used[entry.RecipeID], entry.Day)
+
+	recipe, ok := g.recipe(entry.RecipeID)
+	if !ok {
+		return
+	}
+	if recipe.Cuisine != "" {
+		g.cuisines[recipe.Cuisine]++
+	}
+	for _, tag := range recipe.Tags {
+		g.tags[tag]++
+	}
+}
+
+// fitsSlot reports whether a recipe can be served for a meal. Recipes tagged with meal slots
+// like breakfast are only planned for those, recipes without such tags are planned for lunch and dinner.
+func fitsSlot(recipe Recipe, slot types.MealSlot) bool {
+	tagged := false
+	for _, tag := range recipe.Tags {
+		for _, s := range types.MealSlots {
+			if tag == string(s) {
+				tagged = true
+				if s == slot {
+					return true
+				}
+			}
+		}
+	}
+	return !tagged && (slot == types.MealSlotLunch || slot == types.MealSlotDinner)
+}
@@ -0,0 +1,219 @@
+package planner
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/andreiz53/cookinator/cooking"
+	"github.com/andreiz53/cookinator/types"
+)
+
+var monday = time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)
+
+func testRecipes(n int, totalTime int32) []Recipe {
+	recipes := []Recipe{}
+	for i := 0; i < n; i++ {
+		recipes = append(recipes, Recipe{
+			ID:               uuid.New(),
+			Name:             "recipe",
+			TotalTimeMinutes: totalTime,
+		})
+	}
+	return recipes
+}
+
+func dinnerRules(seed int64) Rules {
+	return Rules{
+		Slots:               []types.MealSlot{types.MealSlotDinner},
+		NoRepeatDays:        DefaultNoRepeatDays,
+		WeekdayMaxTotalTime: DefaultWeekdayMaxTotalTime,
+		Servings:            4,
+		Seed:                seed,
+	}
+}
+
+func TestGenerateIsDeterministic(t *testing.T) {
+	recipes := testRecipes(10, 20)
+	reversed := []Recipe{}
+	for i := len(recipes) - 1; i >= 0; i-- {
+		reversed = append(reversed, recipes[i])
+	}
+
+	result := Generate(Request{WeekStart: monday, Recipes: recipes, Rules: dinnerRules(42)})
+	again := Generate(Request{WeekStart: monday, Recipes: reversed, Rules: dinnerRules(42)})
+
+	require.Len(t, result.Entries, 7)
+	require.Equal(t, result.Entries, again.Entries)
+	require.Empty(t, result.Unfilled)
+	require.Empty(t, result.Warnings)
+}
+
+func TestGenerateNoRepeat(t *testing.T) {
+	recipes := testRecipes(7, 20)
+
+	result := Generate(Request{WeekStart: monday, Recipes: recipes, Rules: dinnerRules(1)})
+
+	seen := map[uuid.UUID]bool{}
+	for _, entry := range result.Entries {
+		require.False(t, seen[entry.RecipeID])
+		seen[entry.RecipeID] = true
+		require.Equal(t, int32(4), entry.Servings)
+	}
+
+	// a recipe cooked yesterday is not planned before next Sunday
+	history := map[uuid.UUID][]time.Time{recipes[0].ID: {monday.AddDate(0, 0, -1)}}
+	result = Generate(Request{WeekStart: monday, Recipes: recipes, History: history, Rules: dinnerRules(1)})
+	for _, entry := range result.Entries[:6] {
+		require.NotEqual(t, recipes[0].ID, entry.RecipeID)
+	}
+	require.Equal(t, recipes[0].ID, result.Entries[6].RecipeID)
+}
+
+func TestGenerateQuickWeekdays(t *testing.T) {
+	quick := testRecipes(5, 25)
+	slow := testRecipes(2, 90)
+
+	result := Generate(Request{WeekStart: monday, Recipes: append(quick, slow...), Rules: dinnerRules(7)})
+	require.Len(t, result.Entries, 7)
+
+	slowIDs := map[uuid.UUID]bool{slow[0].ID: true, slow[1].ID: true}
+	for _, entry := range result.Entries {
+		weekend := entry.Day.Weekday() == time.Saturday || entry.Day.Weekday() == time.Sunday
+		require.Equal(t, weekend, slowIDs[entry.RecipeID])
+	}
+	require.Empty(t, result.Warnings)
+}
+
+func TestGenerateBusyAndAway(t *testing.T) {
+	// enough quick recipes that the free slots before Saturday can't use them all up
+	quick := testRecipes(5, 15)
+	slow := testRecipes(7, 60)
+	rules := dinnerRules(3)
+	rules.BusyMaxTotalTime = DefaultBusyMaxTotalTime
+	rules.WeekdayMaxTotalTime = 0
+
+	saturday := monday.AddDate(0, 0, 5)
+	busy := []Slot{{Day: monday, Slot: types.MealSlotDinner}, {Day: saturday, Slot: types.MealSlotDinner}}
+	away := []Slot{{Day: monday.AddDate(0, 0, 2), Slot: types.MealSlotDinner}}
+
+	result := Generate(Request{WeekStart: monday, Recipes: append(quick, slow...), Busy: busy, Away: away, Rules: rules})
+	require.Len(t, result.Entries, 6)
+	require.Equal(t, away, result.Skipped)
+	require.Empty(t, result.Warnings)
+
+	for _, entry := range result.Entries {
+		require.NotEqual(t, away[0].Day, entry.Day)
+		if entry.Day.Equal(monday) || entry.Day.Equal(saturday) {
+			recipe := findRecipe(t, append(quick, slow...), entry.RecipeID)
+			require.LessOrEqual(t, recipe.TotalTimeMinutes, rules.BusyMaxTotalTime)
+		}
+	}
+}
+
+func findRecipe(t *testing.T, recipes []Recipe, id uuid.UUID) Recipe {
+	for _, recipe := range recipes {
+		if recipe.ID == id {
+			return recipe
+		}
+	}
+	t.Fatalf("no recipe %s", id)
+	return Recipe{}
+}
+
+func TestGenerateRelaxesRules(t *testing.T) {
+	recipes := testRecipes(2, 60)
+
+	result := Generate(Request{WeekStart: monday, Recipes: recipes, Rules: dinnerRules(3)})
+	require.Len(t, result.Entries, 7)
+	require.NotEmpty(t, result.Warnings)
+
+	result = Generate(Request{WeekStart: monday, Rules: dinnerRules(3)})
+	require.Empty(t, result.Entries)
+	require.Len(t, result.Unfilled, 7)
+}
+
+func TestGenerateKeepsLockedSlots(t *testing.T) {
+	recipes := testRecipes(10, 20)
+	locked := Entry{Day: monday.AddDate(0, 0, 2), Slot: types.MealSlotDinner, RecipeID: recipes[3].ID, Servings: 2, Locked: true}
+
+	result := Generate(Request{WeekStart: monday, Recipes: recipes, Locked: []Entry{locked}, Rules: dinnerRules(5)})
+	require.Len(t, result.Entries, 6)
+	for _, entry := range result.Entries {
+		require.NotEqual(t, locked.Day, entry.Day)
+		require.NotEqual(t, locked.RecipeID, entry.RecipeID)
+	}
+}
+
+func TestGenerateVariety(t *testing.T) {
+	recipes := []Recipe{}
+	for _, cuisine := range []string{"italian", "italian", "italian", "mexican", "indian", "thai", "greek", "french", "italian"} {
+		recipe := testRecipes(1, 20)[0]
+		recipe.Cuisine = cuisine
+		recipes = append(recipes, recipe)
+	}
+
+	result := Generate(Request{WeekStart: monday, Recipes: recipes, Rules: dinnerRules(11)})
+
+	byID := map[uuid.UUID]Recipe{}
+	for _, recipe := range recipes {
+		byID[recipe.ID] = recipe
+	}
+	cuisines := map[string]int{}
+	for _, entry := range result.Entries {
+		cuisines[byID[entry.RecipeID].Cuisine]++
+	}
+	require.Len(t, cuisines, 6)
+	require.Equal(t, 2, cuisines["italian"])
+}
+
+func TestGenerateMealSlots(t *testing.T) {
+	breakfast := testRecipes(7, 10)
+	for i := range breakfast {
+		breakfast[i].Tags = []string{types.MealSlotBreakfast}
+	}
+	dinners := testRecipes(7, 20)
+
+	rules := dinnerRules(9)
+	rules.Slots = []types.MealSlot{types.MealSlotBreakfast, types.MealSlotDinner}
+	result := Generate(Request{WeekStart: monday, Recipes: append(breakfast, dinners...), Rules: rules})
+	require.Len(t, result.Entries, 14)
+
+	breakfastIDs := map[uuid.UUID]bool{}
+	for _, recipe := range breakfast {
+		breakfastIDs[recipe.ID] = true
+	}
+	for _, entry := range result.Entries {
+		require.Equal(t, entry.Slot == types.MealSlotBreakfast, breakfastIDs[entry.RecipeID])
+	}
+}
+
+func TestGenerateAvoidsHeavyEquipmentConflicts(t *testing.T) {
+	oven := cooking.EquipmentUse{EquipmentID: 1, Name: "oven", Heavy: true}
+	roasts := testRecipes(7, 20)
+	for i := range roasts {
+		roasts[i].Equipment = []cooking.EquipmentUse{oven}
+	}
+	sides := testRecipes(7, 10)
+
+	rules := dinnerRules(2)
+	rules.DishesPerSlot = 2
+	result := Generate(Request{WeekStart: monday, Recipes: append(roasts, sides...), Rules: rules})
+	require.Len(t, result.Entries, 14)
+
+	roastIDs := map[uuid.UUID]bool{}
+	for _, recipe := range roasts {
+		roastIDs[recipe.ID] = true
+	}
+	ovenDishes := map[time.Time]int{}
+	for _, entry := range result.Entries {
+		if roastIDs[entry.RecipeID] {
+			ovenDishes[entry.Day]++
+		}
+	}
+	for day, count := range ovenDishes {
+		require.Equal(t, 1, count, day)
+	}
+}
@@ -0,0 +1,268 @@
+package server
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/andreiz53/cookinator/calendar"
+	database "github.com/andreiz53/cookinator/database/handlers"
+	"github.com/andreiz53/cookinator/types"
+	"github.com/andreiz53/cookinator/util"
+)
+
+var errInvalidCalendarToken = errors.New("invalid calendar token")
+
+// calendarHistoryDays is how far back the feed still shows meals
+const calendarHistoryDays = 28
+
+// mealTime is when a meal is served and how long it lasts in the calendar
+type mealTime struct {
+	name     string
+	hour     int
+	minute   int
+	duration time.Duration
+}
+
+var mealTimes = map[types.MealSlot]mealTime{
+	types.MealSlotBreakfast: {name: "Breakfast", hour: 8, duration: 30 * time.Minute},
+	types.MealSlotLunch:     {name: "Lunch", hour: 12, minute: 30, duration: time.Hour},
+	types.MealSlotSnack:     {name: "Snack", hour: 16, duration: 30 * time.Minute},
+	types.MealSlotDinner:    {name: "Dinner", hour: 19, duration: time.Hour},
+}
+
+type CalendarFeed struct {
+	FamilyID  uuid.UUID        `json:"family_id"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+	Token     string           `json:"token"`
+	URL       string           `json:"url"`
+}
+
+type FamilyCalendarParams struct {
+	ID string `uri:"id" binding:"required,uuid4_rfc4122"`
+}
+
+type MealPlanCalendarQuery struct {
+	Token string `form:"token" binding:"required"`
+}
+
+func DBCalendarFeedToCalendarFeed(arg database.CalendarFeed) CalendarFeed {
+	return CalendarFeed{
+		FamilyID:  arg.FamilyID,
+		CreatedAt: arg.CreatedAt,
+		Token:     arg.Token,
+		URL:       fmt.Sprintf("/families/%s/meal-plan.ics?token=%s", arg.FamilyID, arg.Token),
+	}
+}
+
+func newCalendarToken() (string, error) {
+	data := make([]byte, 32)
+	_, err := rand.Read(data)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// baseURL is the address the request was made to, used to link back to the API from the feed
+func baseURL(ctx *gin.Context) string {
+	scheme := "http"
+	if ctx.Request.TLS != nil || ctx.GetHeader("X-Forwarded-Proto") == "https" {
+		scheme = "https"
+	}
+	return fmt.Sprintf("%s://%s", scheme, ctx.Request.Host)
+}
+
+// DBCalendarEntriesToEvents turns meal plan entries into events at the time of their meal.
+// Cooked entries remind the family to start cooking the recipe's total time before the meal.
+func DBCalendarEntriesToEvents(arg []database.GetCalendarEntriesByFamilyIDRow, baseURL string) []calendar.Event {
+	events := []calendar.Event{}
+	for _, entry := range arg {
+		meal := mealTimes[types.MealSlot(entry.Slot)]
+		year, month, day := entry.Day.Time.Date()
+		start := time.Date(year, month, day, meal.hour, meal.minute, 0, 0, time.UTC)
+
+		event := calendar.Event{
+			UID:      fmt.Sprintf("%s@cookinator", entry.ID),
+			Sequence: entry.Sequence,
+			Stamp:    entry.UpdatedAt.Time,
+			Start:    start,
+			End:      start.Add(meal.duration),
+			Summary:  fmt.Sprintf("%s: %s", meal.name, entry.RecipeName),
+			URL:      fmt.Sprintf("%s/recipes/%s", baseURL, entry.RecipeID),
+		}
+		if !entry.UpdatedAt.Valid {
+			event.Stamp = entry.CreatedAt.Time
+		}
+
+		description := fmt.Sprintf("%d servings", entry.Servings)
+		if entry.LeftoverOf.Valid {
+			event.Summary = fmt.Sprintf("%s: %s (leftovers)", meal.name, entry.RecipeName)
+		} else if entry.TotalTimeMinutes > 0 {
+			description = fmt.Sprintf("%s, takes %d minutes", description, entry.TotalTimeMinutes)
+			event.Alarm = time.Duration(entry.TotalTimeMinutes) * time.Minute
+			event.AlarmDescription = fmt.Sprintf("Start preparing %s", entry.RecipeName)
+		}
+		if entry.Notes != "" {
+			description = fmt.Sprintf("%s\n%s", description, entry.Notes)
+		}
+		event.Description = description
+
+		events = append(events, event)
+	}
+	return events
+}
+
+func (s *Server) getCalendarFeed(ctx *gin.Context) {
+	var request FamilyCalendarParams
+	err := ctx.ShouldBindUri(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	familyID := uuid.MustParse(request.ID)
+	_, ok := s.authFamilyMember(ctx, familyID)
+	if !ok {
+		return
+	}
+
+	feed, err := s.store.GetCalendarFeedByFamilyID(ctx, familyID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, respondWithErorr(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, DBCalendarFeedToCalendarFeed(feed))
+}
+
+// createCalendarFeed creates the feed token of a family, replacing the previous one
+// so a leaked link can be revoked
+func (s *Server) createCalendarFeed(ctx *gin.Context) {
+	var request FamilyCalendarParams
+	err := ctx.ShouldBindUri(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	familyID := uuid.MustParse(request.ID)
+	_, ok := s.authFamilyMember(ctx, familyID)
+	if !ok {
+		return
+	}
+
+	token, err := newCalendarToken()
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	feed, err := s.store.UpsertCalendarFeed(ctx, database.UpsertCalendarFeedParams{
+		FamilyID: familyID,
+		Token:    token,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, DBCalendarFeedToCalendarFeed(feed))
+}
+
+func (s *Server) deleteCalendarFeed(ctx *gin.Context) {
+	var request FamilyCalendarParams
+	err := ctx.ShouldBindUri(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	familyID := uuid.MustParse(request.ID)
+	_, ok := s.authFamilyMember(ctx, familyID)
+	if !ok {
+		return
+	}
+
+	err = s.store.DeleteCalendarFeed(ctx, familyID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, respondWithMessage(fmt.Sprintf("deleted calendar feed of family with id %s", request.ID)))
+}
+
+// getMealPlanCalendar renders the family meal plans as an iCalendar feed. Calendar apps can't send
+// an authorization header, so the feed is protected by the token in its URL instead.
+func (s *Server) getMealPlanCalendar(ctx *gin.Context) {
+	var uri FamilyCalendarParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	var query MealPlanCalendarQuery
+	err = ctx.ShouldBindQuery(&query)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, respondWithErorr(errInvalidCalendarToken))
+		return
+	}
+
+	familyID := uuid.MustParse(uri.ID)
+	feed, err := s.store.GetCalendarFeedByFamilyID(ctx, familyID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			ctx.JSON(http.StatusUnauthorized, respondWithErorr(errInvalidCalendarToken))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+	if subtle.ConstantTimeCompare([]byte(feed.Token), []byte(query.Token)) != 1 {
+		ctx.JSON(http.StatusUnauthorized, respondWithErorr(errInvalidCalendarToken))
+		return
+	}
+
+	family, err := s.store.GetFamilyByID(ctx, familyID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	entries, err := s.store.GetCalendarEntriesByFamilyID(ctx, database.GetCalendarEntriesByFamilyIDParams{
+		FamilyID: familyID,
+		FromDay:  util.NewDate(time.Now().AddDate(0, 0, -calendarHistoryDays)),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	var body bytes.Buffer
+	err = calendar.Write(&body, calendar.Calendar{
+		Name:   fmt.Sprintf("%s meal plan", family.Name),
+		Events: DBCalendarEntriesToEvents(entries, baseURL(ctx)),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.Data(http.StatusOK, "text/calendar; charset=utf-8", body.Bytes())
+}
@@ -0,0 +1,220 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	database "github.com/andreiz53/cookinator/database/handlers"
+	databaseMock "github.com/andreiz53/cookinator/database/mocks"
+	"github.com/andreiz53/cookinator/types"
+	"github.com/andreiz53/cookinator/util"
+)
+
+func TestCreateCalendarFeed(t *testing.T) {
+	user := randomFamilyUser(t)
+
+	testCases := []struct {
+		name          string
+		familyID      uuid.UUID
+		stubs         func(store *databaseMock.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "OK",
+			familyID: user.FamilyID,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					UpsertCalendarFeed(mock.Anything, mock.MatchedBy(func(arg database.UpsertCalendarFeedParams) bool {
+						return arg.FamilyID == user.FamilyID && len(arg.Token) == 43
+					})).
+					RunAndReturn(func(_ context.Context, arg database.UpsertCalendarFeedParams) (database.CalendarFeed, error) {
+						return database.CalendarFeed{FamilyID: arg.FamilyID, Token: arg.Token}, nil
+					}).
+					Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				feed, err := decodeJSON[CalendarFeed](recorder.Body)
+				require.NoError(t, err)
+				require.Equal(t, user.FamilyID, feed.FamilyID)
+				require.Equal(t, fmt.Sprintf("/families/%s/meal-plan.ics?token=%s", user.FamilyID, feed.Token), feed.URL)
+			},
+		},
+		{
+			name:     "OtherFamily",
+			familyID: uuid.New(),
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					UpsertCalendarFeed(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			server := newTestServer(t, store)
+
+			tc.stubs(store)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/families/%s/calendar-feed", tc.familyID.String())
+			request, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+			setAuth(t, request, server.tokenMaker, authHeaderTypeBearer, user.Email, time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestGetMealPlanCalendar(t *testing.T) {
+	family := randomFamily()
+	feed := database.CalendarFeed{FamilyID: family.ID, Token: util.RandomString(43)}
+	plan := randomMealPlan(family.ID)
+	recipe := randomRecipe(t, family.ID)
+
+	dinner := randomMealPlanEntry(plan, recipe, 0, types.MealSlotDinner)
+	leftovers := randomMealPlanEntry(plan, recipe, 1, types.MealSlotLunch)
+	entries := []database.GetCalendarEntriesByFamilyIDRow{
+		{
+			ID:               dinner.ID,
+			Day:              dinner.Day,
+			Slot:             dinner.Slot,
+			RecipeID:         recipe.ID,
+			Servings:         4,
+			Sequence:         3,
+			UpdatedAt:        util.RandomTime(),
+			RecipeName:       recipe.Name,
+			TotalTimeMinutes: 45,
+		},
+		{
+			ID:               leftovers.ID,
+			Day:              leftovers.Day,
+			Slot:             leftovers.Slot,
+			RecipeID:         recipe.ID,
+			Servings:         2,
+			CreatedAt:        util.RandomTime(),
+			LeftoverOf:       pgtype.UUID{Bytes: dinner.ID, Valid: true},
+			RecipeName:       recipe.Name,
+			TotalTimeMinutes: 45,
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		query         string
+		stubs         func(store *databaseMock.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "OK",
+			query: "token=" + feed.Token,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetCalendarFeedByFamilyID(mock.Anything, family.ID).
+					Times(1).Return(feed, nil)
+				store.EXPECT().
+					GetFamilyByID(mock.Anything, family.ID).
+					Times(1).Return(family, nil)
+				store.EXPECT().
+					GetCalendarEntriesByFamilyID(mock.Anything, mock.MatchedBy(func(arg database.GetCalendarEntriesByFamilyIDParams) bool {
+						return arg.FamilyID == family.ID && arg.FromDay.Time.Before(time.Now())
+					})).
+					Times(1).Return(entries, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, "text/calendar; charset=utf-8", recorder.Header().Get("Content-Type"))
+
+				ics := recorder.Body.String()
+				require.Contains(t, ics, fmt.Sprintf("UID:%s@cookinator\r\nSEQUENCE:3\r\n", dinner.ID))
+				require.Contains(t, ics, "DTSTART:"+dinner.Day.Time.Format("20060102")+"T190000\r\n")
+				require.Contains(t, ics, "TRIGGER:-PT45M\r\n")
+				require.Contains(t, ics, fmt.Sprintf("URL:http://example.com/recipes/%s\r\n", recipe.ID))
+				// leftovers are not cooked, so they don't get a reminder
+				require.Contains(t, ics, "(leftovers)")
+				require.Equal(t, 1, strings.Count(ics, "BEGIN:VALARM"))
+			},
+		},
+		{
+			name:  "InvalidToken",
+			query: "token=" + util.RandomString(43),
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetCalendarFeedByFamilyID(mock.Anything, family.ID).
+					Times(1).Return(feed, nil)
+				store.EXPECT().
+					GetCalendarEntriesByFamilyID(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:  "NoToken",
+			query: "",
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetCalendarFeedByFamilyID(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:  "NoFeed",
+			query: "token=" + feed.Token,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetCalendarFeedByFamilyID(mock.Anything, family.ID).
+					Times(1).Return(database.CalendarFeed{}, pgx.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			server := newTestServer(t, store)
+
+			tc.stubs(store)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("http://example.com/families/%s/meal-plan.ics?%s", family.ID.String(), tc.query)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,365 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	database "github.com/andreiz53/cookinator/database/handlers"
+	"github.com/andreiz53/cookinator/util"
+)
+
+type Collection struct {
+	ID        uuid.UUID        `json:"id"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+	UpdatedAt pgtype.Timestamp `json:"updated_at"`
+	Name      string           `json:"name"`
+	UserID    uuid.UUID        `json:"user_id"`
+	FamilyID  *uuid.UUID       `json:"family_id"`
+	Shared    bool             `json:"shared"`
+	Recipes   []Recipe         `json:"recipes,omitempty"`
+}
+
+type CreateCollectionParams struct {
+	Name   string `json:"name" binding:"required,min=2"`
+	Shared bool   `json:"shared"`
+}
+
+type UpdateCollectionParams struct {
+	ID     string `json:"id" binding:"required,uuid4_rfc4122"`
+	Name   string `json:"name" binding:"required,min=2"`
+	Shared bool   `json:"shared"`
+}
+
+type GetCollectionByIDParams struct {
+	ID string `uri:"id" binding:"required,uuid4_rfc4122"`
+}
+
+type DeleteCollectionParams struct {
+	ID string `uri:"id" binding:"required,uuid4_rfc4122"`
+}
+
+type AddCollectionRecipeParams struct {
+	RecipeID string `json:"recipe_id" binding:"required,uuid4_rfc4122"`
+}
+
+type RemoveCollectionRecipeParams struct {
+	ID       string `uri:"id" binding:"required,uuid4_rfc4122"`
+	RecipeID string `uri:"recipe_id" binding:"required,uuid4_rfc4122"`
+}
+
+type ReorderCollectionRecipesParams struct {
+	RecipeIDs []string `json:"recipe_ids" binding:"required,min=1,dive,uuid4_rfc4122"`
+}
+
+func DBCollectionToCollection(arg database.Collection) Collection {
+	return Collection{
+		ID:        arg.ID,
+		CreatedAt: arg.CreatedAt,
+		UpdatedAt: arg.UpdatedAt,
+		Name:      arg.Name,
+		UserID:    arg.UserID,
+		FamilyID:  util.NullUUID(uuid.UUID(arg.FamilyID.Bytes)),
+		Shared:    arg.Shared,
+	}
+}
+
+func DBCollectionsToCollections(arg []database.Collection) []Collection {
+	collections := []Collection{}
+	for _, collection := range arg {
+		collections = append(collections, DBCollectionToCollection(collection))
+	}
+	return collections
+}
+
+// canViewCollection reports whether the user owns the collection or it is shared with the user's family
+func canViewCollection(user database.User, collection database.Collection) bool {
+	if collection.UserID == user.ID {
+		return true
+	}
+	return collection.Shared && user.FamilyID != uuid.Nil && collection.FamilyID == util.PgUUID(user.FamilyID)
+}
+
+// userCollection loads a collection the user can view, or only one they own when owned is set.
+// It writes the error response itself and returns false on failure.
+func (s *Server) userCollection(ctx *gin.Context, user database.User, id uuid.UUID, owned bool) (database.Collection, bool) {
+	collection, err := s.store.GetCollectionByID(ctx, id)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, respondWithErorr(err))
+			return collection, false
+		}
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return collection, false
+	}
+	if !canViewCollection(user, collection) || (owned && collection.UserID != user.ID) {
+		ctx.JSON(http.StatusForbidden, respondWithErorr(errForbidden))
+		return collection, false
+	}
+	return collection, true
+}
+
+func (s *Server) createCollection(ctx *gin.Context) {
+	var request CreateCollectionParams
+	err := ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authUser(ctx)
+	if !ok {
+		return
+	}
+	if request.Shared && user.FamilyID == uuid.Nil {
+		ctx.JSON(http.StatusForbidden, respondWithErorr(errNoFamily))
+		return
+	}
+
+	collection, err := s.store.CreateCollection(ctx, database.CreateCollectionParams{
+		Name:     request.Name,
+		UserID:   user.ID,
+		FamilyID: util.PgUUID(user.FamilyID),
+		Shared:   request.Shared,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, DBCollectionToCollection(collection))
+}
+
+func (s *Server) getCollections(ctx *gin.Context) {
+	user, ok := s.authUser(ctx)
+	if !ok {
+		return
+	}
+
+	collections, err := s.store.GetCollectionsByUserID(ctx, database.GetCollectionsByUserIDParams{
+		UserID:   user.ID,
+		FamilyID: util.PgUUID(user.FamilyID),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, DBCollectionsToCollections(collections))
+}
+
+func (s *Server) getCollectionByID(ctx *gin.Context) {
+	var request GetCollectionByIDParams
+	err := ctx.ShouldBindUri(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authUser(ctx)
+	if !ok {
+		return
+	}
+
+	collection, ok := s.userCollection(ctx, user, uuid.MustParse(request.ID), false)
+	if !ok {
+		return
+	}
+
+	recipes, err := s.store.GetCollectionRecipes(ctx, collection.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	favoriteIDs, err := s.store.GetFavoriteRecipeIDsByUserID(ctx, user.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	response := DBCollectionToCollection(collection)
+	response.Recipes, err = DBRecipesToRecipes(recipes, favoriteIDs)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (s *Server) updateCollection(ctx *gin.Context) {
+	var request UpdateCollectionParams
+	err := ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authUser(ctx)
+	if !ok {
+		return
+	}
+
+	collection, ok := s.userCollection(ctx, user, uuid.MustParse(request.ID), true)
+	if !ok {
+		return
+	}
+	if request.Shared && !collection.FamilyID.Valid {
+		ctx.JSON(http.StatusForbidden, respondWithErorr(errNoFamily))
+		return
+	}
+
+	collection, err = s.store.UpdateCollection(ctx, database.UpdateCollectionParams{
+		ID:     collection.ID,
+		Name:   request.Name,
+		Shared: request.Shared,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, DBCollectionToCollection(collection))
+}
+
+func (s *Server) deleteCollection(ctx *gin.Context) {
+	var request DeleteCollectionParams
+	err := ctx.ShouldBindUri(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authUser(ctx)
+	if !ok {
+		return
+	}
+
+	collection, ok := s.userCollection(ctx, user, uuid.MustParse(request.ID), true)
+	if !ok {
+		return
+	}
+
+	err = s.store.DeleteCollection(ctx, collection.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, respondWithMessage(fmt.Sprintf("deleted collection with id %s", request.ID)))
+}
+
+func (s *Server) addCollectionRecipe(ctx *gin.Context) {
+	var uri GetCollectionByIDParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	var request AddCollectionRecipeParams
+	err = ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	collection, ok := s.userCollection(ctx, user, uuid.MustParse(uri.ID), true)
+	if !ok {
+		return
+	}
+
+	recipe, ok := s.familyRecipe(ctx, user, uuid.MustParse(request.RecipeID))
+	if !ok {
+		return
+	}
+
+	err = s.store.AddRecipeToCollection(ctx, database.AddRecipeToCollectionParams{
+		CollectionID: collection.ID,
+		RecipeID:     recipe.ID,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, respondWithMessage(fmt.Sprintf("added recipe with id %s to collection", request.RecipeID)))
+}
+
+func (s *Server) removeCollectionRecipe(ctx *gin.Context) {
+	var request RemoveCollectionRecipeParams
+	err := ctx.ShouldBindUri(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authUser(ctx)
+	if !ok {
+		return
+	}
+
+	collection, ok := s.userCollection(ctx, user, uuid.MustParse(request.ID), true)
+	if !ok {
+		return
+	}
+
+	err = s.store.RemoveRecipeFromCollection(ctx, database.RemoveRecipeFromCollectionParams{
+		CollectionID: collection.ID,
+		RecipeID:     uuid.MustParse(request.RecipeID),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, respondWithMessage(fmt.Sprintf("removed recipe with id %s from collection", request.RecipeID)))
+}
+
+// reorderCollectionRecipes stores the position of every recipe in the order it was sent
+func (s *Server) reorderCollectionRecipes(ctx *gin.Context) {
+	var uri GetCollectionByIDParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	var request ReorderCollectionRecipesParams
+	err = ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authUser(ctx)
+	if !ok {
+		return
+	}
+
+	collection, ok := s.userCollection(ctx, user, uuid.MustParse(uri.ID), true)
+	if !ok {
+		return
+	}
+
+	arg := database.ReorderCollectionRecipesTxParams{CollectionID: collection.ID, RecipeIDs: []uuid.UUID{}}
+	for _, recipeID := range request.RecipeIDs {
+		arg.RecipeIDs = append(arg.RecipeIDs, uuid.MustParse(recipeID))
+	}
+	err = s.store.ReorderCollectionRecipesTx(ctx, arg)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, respondWithMessage(fmt.Sprintf("reordered recipes of collection with id %s", uri.ID)))
+}
@@ -0,0 +1,408 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	database "github.com/andreiz53/cookinator/database/handlers"
+	databaseMock "github.com/andreiz53/cookinator/database/mocks"
+	"github.com/andreiz53/cookinator/util"
+)
+
+func randomCollection(user database.User, shared bool) database.Collection {
+	return database.Collection{
+		ID:       uuid.New(),
+		Name:     util.RandomName(),
+		UserID:   user.ID,
+		FamilyID: util.PgUUID(user.FamilyID),
+		Shared:   shared,
+	}
+}
+
+func TestCreateCollection(t *testing.T) {
+	user := randomFamilyUser(t)
+	collection := randomCollection(user, true)
+
+	params := CreateCollectionParams{
+		Name:   collection.Name,
+		Shared: true,
+	}
+
+	testCases := []struct {
+		name          string
+		params        CreateCollectionParams
+		stubs         func(store *databaseMock.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "OK",
+			params: params,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					CreateCollection(mock.Anything, database.CreateCollectionParams{
+						Name:     params.Name,
+						UserID:   user.ID,
+						FamilyID: util.PgUUID(user.FamilyID),
+						Shared:   true,
+					}).
+					Times(1).Return(collection, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+			},
+		},
+		{
+			name:   "BadRequest",
+			params: CreateCollectionParams{},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					CreateCollection(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "PrivateWithoutFamily",
+			params: CreateCollectionParams{Name: collection.Name},
+			stubs: func(store *databaseMock.MockStore) {
+				noFamily := database.User{ID: user.ID, Email: user.Email}
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(noFamily, nil)
+				store.EXPECT().
+					CreateCollection(mock.Anything, database.CreateCollectionParams{
+						Name:     params.Name,
+						UserID:   user.ID,
+						FamilyID: pgtype.UUID{},
+					}).
+					Times(1).Return(randomCollection(noFamily, false), nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				response, err := decodeJSON[Collection](recorder.Body)
+				require.NoError(t, err)
+				require.Nil(t, response.FamilyID)
+			},
+		},
+		{
+			name:   "SharedWithoutFamily",
+			params: params,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(database.User{ID: user.ID, Email: user.Email}, nil)
+				store.EXPECT().
+					CreateCollection(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			server := newTestServer(t, store)
+
+			tc.stubs(store)
+
+			recorder := httptest.NewRecorder()
+			url := "/collections"
+
+			data, err := encodeJSON(tc.params)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+			setAuth(t, request, server.tokenMaker, authHeaderTypeBearer, user.Email, time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestGetCollectionByID(t *testing.T) {
+	user := randomFamilyUser(t)
+	owned := randomCollection(user, false)
+
+	member := randomUser(t)
+	member.FamilyID = user.FamilyID
+	shared := randomCollection(member, true)
+	private := randomCollection(member, false)
+
+	recipes := []database.Recipe{randomRecipe(t, user.FamilyID), randomRecipe(t, user.FamilyID)}
+
+	testCases := []struct {
+		name          string
+		collection    database.Collection
+		stubs         func(store *databaseMock.MockStore, collection database.Collection)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:       "Owned",
+			collection: owned,
+			stubs: func(store *databaseMock.MockStore, collection database.Collection) {
+				store.EXPECT().
+					GetCollectionRecipes(mock.Anything, collection.ID).
+					Times(1).Return(recipes, nil)
+				store.EXPECT().
+					GetFavoriteRecipeIDsByUserID(mock.Anything, user.ID).
+					Times(1).Return([]uuid.UUID{recipes[0].ID}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				collection, err := decodeJSON[Collection](recorder.Body)
+				require.NoError(t, err)
+				require.Equal(t, owned.ID, collection.ID)
+				require.Len(t, collection.Recipes, len(recipes))
+				require.True(t, collection.Recipes[0].Favorited)
+				require.False(t, collection.Recipes[1].Favorited)
+			},
+		},
+		{
+			name:       "SharedWithFamily",
+			collection: shared,
+			stubs: func(store *databaseMock.MockStore, collection database.Collection) {
+				store.EXPECT().
+					GetCollectionRecipes(mock.Anything, collection.ID).
+					Times(1).Return(recipes, nil)
+				store.EXPECT().
+					GetFavoriteRecipeIDsByUserID(mock.Anything, user.ID).
+					Times(1).Return([]uuid.UUID{}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:       "Forbidden",
+			collection: private,
+			stubs: func(store *databaseMock.MockStore, collection database.Collection) {
+				store.EXPECT().
+					GetCollectionRecipes(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			server := newTestServer(t, store)
+
+			store.EXPECT().
+				GetUserByEmail(mock.Anything, user.Email).
+				Times(1).Return(user, nil)
+			store.EXPECT().
+				GetCollectionByID(mock.Anything, tc.collection.ID).
+				Times(1).Return(tc.collection, nil)
+			tc.stubs(store, tc.collection)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/collections/%s", tc.collection.ID.String())
+
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+			setAuth(t, request, server.tokenMaker, authHeaderTypeBearer, user.Email, time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestDeleteCollection(t *testing.T) {
+	user := randomFamilyUser(t)
+	collection := randomCollection(user, false)
+
+	member := randomUser(t)
+	member.FamilyID = user.FamilyID
+	shared := randomCollection(member, true)
+
+	testCases := []struct {
+		name          string
+		collection    database.Collection
+		stubs         func(store *databaseMock.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:       "OK",
+			collection: collection,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetCollectionByID(mock.Anything, collection.ID).
+					Times(1).Return(collection, nil)
+				store.EXPECT().
+					DeleteCollection(mock.Anything, collection.ID).
+					Times(1).Return(nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:       "NotOwner",
+			collection: shared,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetCollectionByID(mock.Anything, shared.ID).
+					Times(1).Return(shared, nil)
+				store.EXPECT().
+					DeleteCollection(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:       "NotFound",
+			collection: collection,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetCollectionByID(mock.Anything, collection.ID).
+					Times(1).Return(database.Collection{}, pgx.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			server := newTestServer(t, store)
+
+			store.EXPECT().
+				GetUserByEmail(mock.Anything, user.Email).
+				Times(1).Return(user, nil)
+			tc.stubs(store)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/collections/%s", tc.collection.ID.String())
+
+			request, err := http.NewRequest(http.MethodDelete, url, nil)
+			require.NoError(t, err)
+			setAuth(t, request, server.tokenMaker, authHeaderTypeBearer, user.Email, time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestReorderCollectionRecipes(t *testing.T) {
+	user := randomFamilyUser(t)
+	collection := randomCollection(user, false)
+	recipeIDs := []uuid.UUID{uuid.New(), uuid.New()}
+
+	member := randomUser(t)
+	member.FamilyID = user.FamilyID
+	shared := randomCollection(member, true)
+
+	testCases := []struct {
+		name          string
+		collection    database.Collection
+		stubs         func(store *databaseMock.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:       "OK",
+			collection: collection,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetCollectionByID(mock.Anything, collection.ID).
+					Times(1).Return(collection, nil)
+				store.EXPECT().
+					ReorderCollectionRecipesTx(mock.Anything, database.ReorderCollectionRecipesTxParams{
+						CollectionID: collection.ID,
+						RecipeIDs:    recipeIDs,
+					}).
+					Times(1).Return(nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:       "NotOwner",
+			collection: shared,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetCollectionByID(mock.Anything, shared.ID).
+					Times(1).Return(shared, nil)
+				store.EXPECT().
+					ReorderCollectionRecipesTx(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:       "InternalError",
+			collection: collection,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetCollectionByID(mock.Anything, collection.ID).
+					Times(1).Return(collection, nil)
+				store.EXPECT().
+					ReorderCollectionRecipesTx(mock.Anything, mock.Anything).
+					Times(1).Return(pgx.ErrTxClosed)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			server := newTestServer(t, store)
+
+			store.EXPECT().
+				GetUserByEmail(mock.Anything, user.Email).
+				Times(1).Return(user, nil)
+			tc.stubs(store)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/collections/%s/recipes", tc.collection.ID.String())
+			data, err := encodeJSON(ReorderCollectionRecipesParams{
+				RecipeIDs: []string{recipeIDs[0].String(), recipeIDs[1].String()},
+			})
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(data))
+			require.NoError(t, err)
+			setAuth(t, request, server.tokenMaker, authHeaderTypeBearer, user.Email, time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,289 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	database "github.com/andreiz53/cookinator/database/handlers"
+	"github.com/andreiz53/cookinator/util"
+)
+
+const defaultCookLogPageSize = 50
+
+var errCookNotInFamily = errors.New("the cook does not belong to the recipe's family")
+
+type CookLog struct {
+	ID             uuid.UUID        `json:"id"`
+	CreatedAt      pgtype.Timestamp `json:"created_at"`
+	RecipeID       uuid.UUID        `json:"recipe_id"`
+	FamilyID       uuid.UUID        `json:"family_id"`
+	CookedByUserID *uuid.UUID       `json:"cooked_by_user_id"`
+	CookedOn       pgtype.Date      `json:"cooked_on"`
+	Servings       int32            `json:"servings"`
+	Notes          string           `json:"notes"`
+	Rating         *int16           `json:"rating"`
+}
+
+type RecipeHistory struct {
+	RecipeID      uuid.UUID    `json:"recipe_id"`
+	TimesCooked   int          `json:"times_cooked"`
+	LastCookedOn  *pgtype.Date `json:"last_cooked_on"`
+	AverageRating *float64     `json:"average_rating"`
+	Entries       []CookLog    `json:"entries"`
+}
+
+type CreateCookLogParams struct {
+	CookedOn       string `json:"cooked_on" binding:"omitempty,datetime=2006-01-02"`
+	CookedByUserID string `json:"cooked_by_user_id" binding:"omitempty,uuid4_rfc4122"`
+	Servings       int32  `json:"servings" binding:"required,min=1"`
+	Notes          string `json:"notes"`
+	Rating         int16  `json:"rating" binding:"omitempty,min=1,max=5"`
+}
+
+type GetRecipeHistoryParams struct {
+	ID string `uri:"id" binding:"required,uuid4_rfc4122"`
+}
+
+type DeleteCookLogParams struct {
+	ID    string `uri:"id" binding:"required,uuid4_rfc4122"`
+	LogID string `uri:"log_id" binding:"required,uuid4_rfc4122"`
+}
+
+type GetFamilyCookLogParams struct {
+	ID string `uri:"id" binding:"required,uuid4_rfc4122"`
+}
+
+type GetFamilyCookLogQuery struct {
+	PageID   int32 `form:"page_id" binding:"omitempty,min=1"`
+	PageSize int32 `form:"page_size" binding:"omitempty,min=1,max=100"`
+}
+
+func DBCookLogToCookLog(arg database.CookLog) CookLog {
+	var rating *int16
+	if arg.Rating.Valid {
+		rating = &arg.Rating.Int16
+	}
+	return CookLog{
+		ID:             arg.ID,
+		CreatedAt:      arg.CreatedAt,
+		RecipeID:       arg.RecipeID,
+		FamilyID:       arg.FamilyID,
+		CookedByUserID: util.NullUUID(arg.CookedByUserID),
+		CookedOn:       arg.CookedOn,
+		Servings:       arg.Servings,
+		Notes:          arg.Notes,
+		Rating:         rating,
+	}
+}
+
+func DBCookLogsToCookLogs(arg []database.CookLog) []CookLog {
+	logs := []CookLog{}
+	for _, log := range arg {
+		logs = append(logs, DBCookLogToCookLog(log))
+	}
+	return logs
+}
+
+// DBCookLogsToRecipeHistory summarizes the cook logs of a recipe, which are expected newest first
+func DBCookLogsToRecipeHistory(recipeID uuid.UUID, arg []database.CookLog) RecipeHistory {
+	history := RecipeHistory{
+		RecipeID:    recipeID,
+		TimesCooked: len(arg),
+		Entries:     DBCookLogsToCookLogs(arg),
+	}
+	if len(arg) > 0 {
+		history.LastCookedOn = &arg[0].CookedOn
+	}
+
+	var ratings, total int
+	for _, log := range arg {
+		if log.Rating.Valid {
+			ratings++
+			total += int(log.Rating.Int16)
+		}
+	}
+	if ratings > 0 {
+		average := float64(total) / float64(ratings)
+		history.AverageRating = &average
+	}
+	return history
+}
+
+func (s *Server) createCookLog(ctx *gin.Context) {
+	var uri GetRecipeHistoryParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	var request CreateCookLogParams
+	err = ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	recipe, ok := s.familyRecipe(ctx, user, uuid.MustParse(uri.ID))
+	if !ok {
+		return
+	}
+
+	cookedOn := util.NewDate(time.Now())
+	if request.CookedOn != "" {
+		cookedOn, err = util.ParseDate(request.CookedOn)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+			return
+		}
+	}
+
+	cookID := user.ID
+	if request.CookedByUserID != "" {
+		cookID = uuid.MustParse(request.CookedByUserID)
+		cook, err := s.store.GetUserByID(ctx, cookID)
+		if err != nil {
+			if err == pgx.ErrNoRows {
+				ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+			return
+		}
+		if cook.FamilyID != recipe.FamilyID {
+			ctx.JSON(http.StatusBadRequest, respondWithErorr(errCookNotInFamily))
+			return
+		}
+	}
+
+	log, err := s.store.CreateCookLog(ctx, database.CreateCookLogParams{
+		RecipeID:       recipe.ID,
+		FamilyID:       recipe.FamilyID,
+		CookedByUserID: cookID,
+		CookedOn:       cookedOn,
+		Servings:       request.Servings,
+		Notes:          request.Notes,
+		Rating:         pgtype.Int2{Int16: request.Rating, Valid: request.Rating != 0},
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, DBCookLogToCookLog(log))
+}
+
+func (s *Server) getRecipeHistory(ctx *gin.Context) {
+	var request GetRecipeHistoryParams
+	err := ctx.ShouldBindUri(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	recipe, ok := s.familyRecipe(ctx, user, uuid.MustParse(request.ID))
+	if !ok {
+		return
+	}
+
+	logs, err := s.store.GetCookLogsByRecipeID(ctx, recipe.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, DBCookLogsToRecipeHistory(recipe.ID, logs))
+}
+
+func (s *Server) deleteCookLog(ctx *gin.Context) {
+	var request DeleteCookLogParams
+	err := ctx.ShouldBindUri(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	log, err := s.store.GetCookLogByID(ctx, uuid.MustParse(request.LogID))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, respondWithErorr(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+	if log.RecipeID.String() != request.ID || log.FamilyID != user.FamilyID {
+		ctx.JSON(http.StatusForbidden, respondWithErorr(errForbidden))
+		return
+	}
+
+	err = s.store.DeleteCookLog(ctx, log.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, respondWithMessage(fmt.Sprintf("deleted cook log with id %s", request.LogID)))
+}
+
+func (s *Server) getFamilyCookLog(ctx *gin.Context) {
+	var uri GetFamilyCookLogParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	var query GetFamilyCookLogQuery
+	err = ctx.ShouldBindQuery(&query)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+	if query.PageID == 0 {
+		query.PageID = 1
+	}
+	if query.PageSize == 0 {
+		query.PageSize = defaultCookLogPageSize
+	}
+
+	familyID := uuid.MustParse(uri.ID)
+	_, ok := s.authFamilyMember(ctx, familyID)
+	if !ok {
+		return
+	}
+
+	logs, err := s.store.GetCookLogsByFamilyID(ctx, database.GetCookLogsByFamilyIDParams{
+		FamilyID: familyID,
+		Limit:    query.PageSize,
+		Offset:   (query.PageID - 1) * query.PageSize,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, DBCookLogsToCookLogs(logs))
+}
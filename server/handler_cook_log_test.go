@@ -0,0 +1,227 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	database "github.com/andreiz53/cookinator/database/handlers"
+	databaseMock "github.com/andreiz53/cookinator/database/mocks"
+	"github.com/andreiz53/cookinator/util"
+)
+
+func randomCookLog(recipe database.Recipe, cookedByUserID uuid.UUID, rating int16) database.CookLog {
+	return database.CookLog{
+		ID:             uuid.New(),
+		RecipeID:       recipe.ID,
+		FamilyID:       recipe.FamilyID,
+		CookedByUserID: cookedByUserID,
+		CookedOn:       util.NewDate(time.Now()),
+		Servings:       int32(util.RandomInt(1, 8)),
+		Notes:          util.RandomString(16),
+		Rating:         pgtype.Int2{Int16: rating, Valid: rating != 0},
+	}
+}
+
+func TestDBCookLogsToRecipeHistory(t *testing.T) {
+	recipe := randomRecipe(t, uuid.New())
+	logs := []database.CookLog{
+		randomCookLog(recipe, uuid.New(), 5),
+		randomCookLog(recipe, uuid.New(), 0),
+		randomCookLog(recipe, uuid.New(), 2),
+	}
+
+	history := DBCookLogsToRecipeHistory(recipe.ID, logs)
+	require.Equal(t, 3, history.TimesCooked)
+	require.Equal(t, logs[0].CookedOn, *history.LastCookedOn)
+	require.NotNil(t, history.AverageRating)
+	require.Equal(t, 3.5, *history.AverageRating)
+	require.Len(t, history.Entries, 3)
+
+	empty := DBCookLogsToRecipeHistory(recipe.ID, nil)
+	require.Zero(t, empty.TimesCooked)
+	require.Nil(t, empty.LastCookedOn)
+	require.Nil(t, empty.AverageRating)
+}
+
+func TestCreateCookLog(t *testing.T) {
+	user := randomFamilyUser(t)
+	recipe := randomRecipe(t, user.FamilyID)
+	log := randomCookLog(recipe, user.ID, 4)
+
+	outsider := randomUser(t)
+
+	testCases := []struct {
+		name          string
+		params        CreateCookLogParams
+		stubs         func(store *databaseMock.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "OK",
+			params: CreateCookLogParams{Servings: log.Servings, Notes: log.Notes, Rating: 4},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetRecipeByID(mock.Anything, recipe.ID).
+					Times(1).Return(recipe, nil)
+				store.EXPECT().
+					CreateCookLog(mock.Anything, mock.MatchedBy(func(arg database.CreateCookLogParams) bool {
+						return arg.RecipeID == recipe.ID &&
+							arg.FamilyID == recipe.FamilyID &&
+							arg.CookedByUserID == user.ID &&
+							arg.CookedOn.Valid &&
+							arg.Rating == pgtype.Int2{Int16: 4, Valid: true}
+					})).
+					Times(1).Return(log, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+			},
+		},
+		{
+			name:   "BadRating",
+			params: CreateCookLogParams{Servings: 2, Rating: 6},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					CreateCookLog(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "CookNotInFamily",
+			params: CreateCookLogParams{Servings: 2, CookedByUserID: outsider.ID.String()},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetRecipeByID(mock.Anything, recipe.ID).
+					Times(1).Return(recipe, nil)
+				store.EXPECT().
+					GetUserByID(mock.Anything, outsider.ID).
+					Times(1).Return(outsider, nil)
+				store.EXPECT().
+					CreateCookLog(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			server := newTestServer(t, store)
+
+			tc.stubs(store)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/recipes/%s/history", recipe.ID.String())
+
+			data, err := encodeJSON(tc.params)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+			setAuth(t, request, server.tokenMaker, authHeaderTypeBearer, user.Email, time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestGetFamilyCookLog(t *testing.T) {
+	user := randomFamilyUser(t)
+	recipe := randomRecipe(t, user.FamilyID)
+	logs := []database.CookLog{randomCookLog(recipe, user.ID, 3)}
+
+	testCases := []struct {
+		name          string
+		familyID      uuid.UUID
+		query         string
+		stubs         func(store *databaseMock.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "OK",
+			familyID: user.FamilyID,
+			query:    "page_id=2&page_size=10",
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetCookLogsByFamilyID(mock.Anything, database.GetCookLogsByFamilyIDParams{
+						FamilyID: user.FamilyID,
+						Limit:    10,
+						Offset:   10,
+					}).
+					Times(1).Return(logs, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:     "OtherFamily",
+			familyID: uuid.New(),
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetCookLogsByFamilyID(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:     "InternalServerError",
+			familyID: user.FamilyID,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetCookLogsByFamilyID(mock.Anything, mock.Anything).
+					Times(1).Return([]database.CookLog{}, pgx.ErrTxClosed)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			server := newTestServer(t, store)
+
+			store.EXPECT().
+				GetUserByEmail(mock.Anything, user.Email).
+				Times(1).Return(user, nil)
+			tc.stubs(store)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/families/%s/cook-log?%s", tc.familyID.String(), tc.query)
+
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+			setAuth(t, request, server.tokenMaker, authHeaderTypeBearer, user.Email, time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
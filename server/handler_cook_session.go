@@ -0,0 +1,146 @@
+package server
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/andreiz53/cookinator/cooking"
+	database "github.com/andreiz53/cookinator/database/handlers"
+)
+
+// CreateCookSessionParams are the dishes cooked for one meal and when it is served
+type CreateCookSessionParams struct {
+	RecipeIDs []string `json:"recipe_ids" binding:"required,min=1,max=6,dive,uuid4_rfc4122"`
+	ServeAt   string   `json:"serve_at" binding:"required,datetime=2006-01-02T15:04:05Z07:00"`
+}
+
+// CookSession is the merged timeline of the dishes of a meal, every dish is ready at ServeAt
+type CookSession struct {
+	ServeAt        time.Time             `json:"serve_at"`
+	StartAt        time.Time             `json:"start_at"`
+	HandsOnMinutes int32                 `json:"hands_on_minutes"`
+	PassiveMinutes int32                 `json:"passive_minutes"`
+	Recipes        []CookSessionRecipe   `json:"recipes"`
+	Steps          []CookSessionStep     `json:"steps"`
+	Conflicts      []CookSessionConflict `json:"conflicts"`
+}
+
+type CookSessionRecipe struct {
+	ID      uuid.UUID `json:"id"`
+	Name    string    `json:"name"`
+	StartAt time.Time `json:"start_at"`
+}
+
+// CookSessionStep is a step of a dish, Temperature is in °C and zero when the step has none
+type CookSessionStep struct {
+	RecipeID    uuid.UUID `json:"recipe_id"`
+	RecipeName  string    `json:"recipe_name"`
+	Text        string    `json:"text"`
+	Start       time.Time `json:"start"`
+	End         time.Time `json:"end"`
+	Passive     bool      `json:"passive"`
+	Equipment   []string  `json:"equipment"`
+	Temperature int       `json:"temperature,omitempty"`
+}
+
+// CookSessionConflict is a piece of equipment two dishes need at the same time
+type CookSessionConflict struct {
+	Equipment string      `json:"equipment"`
+	RecipeIDs []uuid.UUID `json:"recipe_ids"`
+	Start     time.Time   `json:"start"`
+	End       time.Time   `json:"end"`
+	Reason    string      `json:"reason"`
+}
+
+// TimelineToCookSession names the recipes of a timeline, which refers to them by their position
+func TimelineToCookSession(timeline cooking.Timeline, recipes []database.Recipe) CookSession {
+	session := CookSession{
+		ServeAt:        timeline.Serve,
+		StartAt:        timeline.Start,
+		HandsOnMinutes: cooking.Minutes(timeline.HandsOn),
+		PassiveMinutes: cooking.Minutes(timeline.Passive),
+		Recipes:        []CookSessionRecipe{},
+		Steps:          []CookSessionStep{},
+		Conflicts:      []CookSessionConflict{},
+	}
+
+	for _, recipe := range recipes {
+		session.Recipes = append(session.Recipes, CookSessionRecipe{ID: recipe.ID, Name: recipe.Name, StartAt: timeline.Serve})
+	}
+	for _, step := range timeline.Steps {
+		recipe := recipes[step.Recipe]
+		session.Steps = append(session.Steps, CookSessionStep{
+			RecipeID:    recipe.ID,
+			RecipeName:  recipe.Name,
+			Text:        step.Text,
+			Start:       step.Start,
+			End:         step.End,
+			Passive:     step.Passive,
+			Equipment:   step.Equipment,
+			Temperature: step.Temperature,
+		})
+		if step.Start.Before(session.Recipes[step.Recipe].StartAt) {
+			session.Recipes[step.Recipe].StartAt = step.Start
+		}
+	}
+	for _, conflict := range timeline.Conflicts {
+		session.Conflicts = append(session.Conflicts, CookSessionConflict{
+			Equipment: conflict.Equipment,
+			RecipeIDs: []uuid.UUID{recipes[conflict.Recipes[0]].ID, recipes[conflict.Recipes[1]].ID},
+			Start:     conflict.Start,
+			End:       conflict.End,
+			Reason:    conflict.Reason,
+		})
+	}
+	return session
+}
+
+// createCookSession schedules the dishes of a meal backwards from the serve time so they finish together
+func (s *Server) createCookSession(ctx *gin.Context) {
+	var request CreateCookSessionParams
+	err := ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+	serveAt, err := time.Parse(time.RFC3339, request.ServeAt)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	recipes := []database.Recipe{}
+	dishes := []cooking.TimelineRecipe{}
+	for _, id := range request.RecipeIDs {
+		recipe, ok := s.familyRecipe(ctx, user, uuid.MustParse(id))
+		if !ok {
+			return
+		}
+		equipment, err := s.store.GetRecipeEquipment(ctx, recipe.ID)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+			return
+		}
+
+		dish := cooking.TimelineRecipe{Process: recipe.CookingProcess}
+		for _, use := range equipment {
+			dish.Equipment = append(dish.Equipment, cooking.EquipmentUse{
+				EquipmentID: use.EquipmentID,
+				Name:        use.Name,
+				Heavy:       use.Heavy,
+			})
+		}
+		recipes = append(recipes, recipe)
+		dishes = append(dishes, dish)
+	}
+
+	ctx.JSON(http.StatusOK, TimelineToCookSession(cooking.Schedule(dishes, serveAt), recipes))
+}
@@ -0,0 +1,127 @@
+package server
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	database "github.com/andreiz53/cookinator/database/handlers"
+	databaseMock "github.com/andreiz53/cookinator/database/mocks"
+)
+
+func TestCreateCookSession(t *testing.T) {
+	user := randomFamilyUser(t)
+	roast := randomRecipe(t, user.FamilyID)
+	roast.CookingProcess = "Season the chicken. Roast at 220°C for 60 minutes"
+	gratin := randomRecipe(t, user.FamilyID)
+	gratin.CookingProcess = "Slice the potatoes. Bake at 180°C for 40 minutes"
+	otherRecipe := randomRecipe(t, uuid.New())
+	serveAt := time.Date(2026, time.October, 19, 19, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		body          CreateCookSessionParams
+		stubs         func(store *databaseMock.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: CreateCookSessionParams{
+				RecipeIDs: []string{roast.ID.String(), gratin.ID.String()},
+				ServeAt:   serveAt.Format(time.RFC3339),
+			},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetRecipeByID(mock.Anything, roast.ID).
+					Times(1).Return(roast, nil)
+				store.EXPECT().
+					GetRecipeByID(mock.Anything, gratin.ID).
+					Times(1).Return(gratin, nil)
+				store.EXPECT().
+					GetRecipeEquipment(mock.Anything, mock.Anything).
+					Times(2).Return([]database.GetRecipeEquipmentRow{{EquipmentID: 1, Name: "oven", Heavy: true}}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				response, err := decodeJSON[CookSession](recorder.Body)
+				require.NoError(t, err)
+				require.Len(t, response.Steps, 4)
+				require.True(t, serveAt.Equal(response.ServeAt))
+				require.True(t, serveAt.Add(-65*time.Minute).Equal(response.StartAt))
+				require.True(t, serveAt.Add(-65*time.Minute).Equal(response.Recipes[0].StartAt))
+				require.True(t, serveAt.Add(-45*time.Minute).Equal(response.Recipes[1].StartAt))
+				require.Len(t, response.Conflicts, 1)
+				require.Equal(t, "oven", response.Conflicts[0].Equipment)
+				require.ElementsMatch(t, []uuid.UUID{roast.ID, gratin.ID}, response.Conflicts[0].RecipeIDs)
+				require.Equal(t, int32(10), response.HandsOnMinutes)
+				require.Equal(t, int32(55), response.PassiveMinutes)
+			},
+		},
+		{
+			name: "OtherFamilyRecipe",
+			body: CreateCookSessionParams{
+				RecipeIDs: []string{otherRecipe.ID.String()},
+				ServeAt:   serveAt.Format(time.RFC3339),
+			},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetRecipeByID(mock.Anything, otherRecipe.ID).
+					Times(1).Return(otherRecipe, nil)
+				store.EXPECT().
+					GetRecipeEquipment(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidServeAt",
+			body: CreateCookSessionParams{
+				RecipeIDs: []string{roast.ID.String()},
+				ServeAt:   "tonight",
+			},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			server := newTestServer(t, store)
+
+			tc.stubs(store)
+
+			recorder := httptest.NewRecorder()
+			data, err := encodeJSON(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/cook-sessions", bytes.NewReader(data))
+			require.NoError(t, err)
+			setAuth(t, request, server.tokenMaker, authHeaderTypeBearer, user.Email, time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	database "github.com/andreiz53/cookinator/database/handlers"
+)
+
+type Equipment struct {
+	ID   int32  `json:"id"`
+	Name string `json:"name"`
+}
+
+type RecipeEquipment struct {
+	EquipmentID int32  `json:"equipment_id" binding:"required,min=1"`
+	Name        string `json:"name"`
+	Heavy       bool   `json:"heavy"`
+}
+
+type CreateEquipmentParams struct {
+	Name string `json:"name" binding:"required,min=2"`
+}
+
+type SetRecipeEquipmentParams struct {
+	Equipment []RecipeEquipment `json:"equipment" binding:"dive"`
+}
+
+type FamilyEquipmentParams struct {
+	ID string `uri:"id" binding:"required,uuid4_rfc4122"`
+}
+
+type SetFamilyEquipmentParams struct {
+	EquipmentIDs []int32 `json:"equipment_ids" binding:"dive,min=1"`
+}
+
+func DBEquipmentToEquipment(arg []database.Equipment) []Equipment {
+	equipment := []Equipment{}
+	for _, e := range arg {
+		equipment = append(equipment, Equipment{
+			ID:   e.ID,
+			Name: e.Name,
+		})
+	}
+	return equipment
+}
+
+func DBRecipeEquipmentToRecipeEquipment(arg []database.GetRecipeEquipmentRow) []RecipeEquipment {
+	equipment := []RecipeEquipment{}
+	for _, e := range arg {
+		equipment = append(equipment, RecipeEquipment{
+			EquipmentID: e.EquipmentID,
+			Name:        e.Name,
+			Heavy:       e.Heavy,
+		})
+	}
+	return equipment
+}
+
+func (s *Server) createEquipment(ctx *gin.Context) {
+	var request CreateEquipmentParams
+	err := ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	equipment, err := s.store.CreateEquipment(ctx, request.Name)
+	if err != nil {
+		if database.ErrorCode(err) == database.CodeDuplicateKey {
+			ctx.JSON(http.StatusConflict, respondWithErorr(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+	ctx.JSON(http.StatusCreated, Equipment{ID: equipment.ID, Name: equipment.Name})
+}
+
+func (s *Server) getEquipment(ctx *gin.Context) {
+	equipment, err := s.store.GetEquipment(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, DBEquipmentToEquipment(equipment))
+}
+
+func (s *Server) setRecipeEquipment(ctx *gin.Context) {
+	var uri GetRecipeByIDParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	var request SetRecipeEquipmentParams
+	err = ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	recipe, ok := s.familyRecipe(ctx, user, uuid.MustParse(uri.ID))
+	if !ok {
+		return
+	}
+
+	arg := database.SetRecipeEquipmentTxParams{RecipeID: recipe.ID}
+	for _, e := range request.Equipment {
+		arg.Equipment = append(arg.Equipment, database.AddRecipeEquipmentParams{
+			EquipmentID: e.EquipmentID,
+			Heavy:       e.Heavy,
+		})
+	}
+	err = s.store.SetRecipeEquipmentTx(ctx, arg)
+	if err != nil {
+		switch database.ErrorCode(err) {
+		case database.CodeForeignKeyViolation, database.CodeDuplicateKey:
+			ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		default:
+			ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		}
+		return
+	}
+
+	equipment, err := s.store.GetRecipeEquipment(ctx, recipe.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, DBRecipeEquipmentToRecipeEquipment(equipment))
+}
+
+func (s *Server) getFamilyEquipment(ctx *gin.Context) {
+	var request FamilyEquipmentParams
+	err := ctx.ShouldBindUri(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	familyID := uuid.MustParse(request.ID)
+	_, ok := s.authFamilyMember(ctx, familyID)
+	if !ok {
+		return
+	}
+
+	equipment, err := s.store.GetFamilyEquipment(ctx, familyID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, DBEquipmentToEquipment(equipment))
+}
+
+func (s *Server) setFamilyEquipment(ctx *gin.Context) {
+	var uri FamilyEquipmentParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	var request SetFamilyEquipmentParams
+	err = ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	familyID := uuid.MustParse(uri.ID)
+	_, ok := s.authFamilyMember(ctx, familyID)
+	if !ok {
+		return
+	}
+
+	err = s.store.SetFamilyEquipmentTx(ctx, database.SetFamilyEquipmentTxParams{
+		FamilyID:     familyID,
+		EquipmentIDs: request.EquipmentIDs,
+	})
+	if err != nil {
+		if database.ErrorCode(err) == database.CodeForeignKeyViolation {
+			ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, respondWithMessage(fmt.Sprintf("updated equipment of family with id %s", uri.ID)))
+}
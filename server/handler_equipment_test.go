@@ -0,0 +1,184 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	database "github.com/andreiz53/cookinator/database/handlers"
+	databaseMock "github.com/andreiz53/cookinator/database/mocks"
+)
+
+func TestSetRecipeEquipment(t *testing.T) {
+	user := randomFamilyUser(t)
+	recipe := randomRecipe(t, user.FamilyID)
+
+	params := SetRecipeEquipmentParams{
+		Equipment: []RecipeEquipment{{EquipmentID: 1, Heavy: true}, {EquipmentID: 4}},
+	}
+
+	testCases := []struct {
+		name          string
+		params        SetRecipeEquipmentParams
+		stubs         func(store *databaseMock.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "OK",
+			params: params,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetRecipeByID(mock.Anything, recipe.ID).
+					Times(1).Return(recipe, nil)
+				store.EXPECT().
+					SetRecipeEquipmentTx(mock.Anything, database.SetRecipeEquipmentTxParams{
+						RecipeID: recipe.ID,
+						Equipment: []database.AddRecipeEquipmentParams{
+							{EquipmentID: 1, Heavy: true},
+							{EquipmentID: 4},
+						},
+					}).
+					Times(1).Return(nil)
+				store.EXPECT().
+					GetRecipeEquipment(mock.Anything, recipe.ID).
+					Times(1).Return([]database.GetRecipeEquipmentRow{
+					{EquipmentID: 1, Name: "oven", Heavy: true},
+					{EquipmentID: 4, Name: "stand mixer"},
+				}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				equipment, err := decodeJSON[[]RecipeEquipment](recorder.Body)
+				require.NoError(t, err)
+				require.Len(t, equipment, 2)
+			},
+		},
+		{
+			name:   "UnknownEquipment",
+			params: params,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetRecipeByID(mock.Anything, recipe.ID).
+					Times(1).Return(recipe, nil)
+				store.EXPECT().
+					SetRecipeEquipmentTx(mock.Anything, mock.Anything).
+					Times(1).Return(database.ErrForeignKeyViolation)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "InvalidEquipmentID",
+			params: SetRecipeEquipmentParams{Equipment: []RecipeEquipment{{EquipmentID: 0}}},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					SetRecipeEquipmentTx(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			server := newTestServer(t, store)
+
+			tc.stubs(store)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/recipes/%s/equipment", recipe.ID.String())
+
+			data, err := encodeJSON(tc.params)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(data))
+			require.NoError(t, err)
+			setAuth(t, request, server.tokenMaker, authHeaderTypeBearer, user.Email, time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestSetFamilyEquipment(t *testing.T) {
+	user := randomFamilyUser(t)
+
+	testCases := []struct {
+		name          string
+		familyID      uuid.UUID
+		stubs         func(store *databaseMock.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "OK",
+			familyID: user.FamilyID,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					SetFamilyEquipmentTx(mock.Anything, database.SetFamilyEquipmentTxParams{
+						FamilyID:     user.FamilyID,
+						EquipmentIDs: []int32{1, 2, 8},
+					}).
+					Times(1).Return(nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:     "OtherFamily",
+			familyID: uuid.New(),
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					SetFamilyEquipmentTx(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			server := newTestServer(t, store)
+
+			store.EXPECT().
+				GetUserByEmail(mock.Anything, user.Email).
+				Times(1).Return(user, nil)
+			tc.stubs(store)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/families/%s/equipment", tc.familyID.String())
+
+			data, err := encodeJSON(SetFamilyEquipmentParams{EquipmentIDs: []int32{1, 2, 8}})
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(data))
+			require.NoError(t, err)
+			setAuth(t, request, server.tokenMaker, authHeaderTypeBearer, user.Email, time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
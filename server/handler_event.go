@@ -0,0 +1,571 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	database "github.com/andreiz53/cookinator/database/handlers"
+	"github.com/andreiz53/cookinator/types"
+	"github.com/andreiz53/cookinator/util"
+)
+
+// Event is a birthday or holiday meal planned for a number of guests, the menu is scaled to the guest count
+type Event struct {
+	ID           uuid.UUID                  `json:"id"`
+	CreatedAt    pgtype.Timestamp           `json:"created_at"`
+	UpdatedAt    pgtype.Timestamp           `json:"updated_at"`
+	FamilyID     uuid.UUID                  `json:"family_id"`
+	Name         string                     `json:"name"`
+	Day          pgtype.Date                `json:"day"`
+	Guests       int32                      `json:"guests"`
+	Restrictions []types.DietaryRestriction `json:"restrictions"`
+	Menu         []EventRecipe              `json:"menu,omitempty"`
+}
+
+// EventRecipe is a recipe of the menu with its items scaled from the servings of the recipe to the guests
+type EventRecipe struct {
+	ID       uuid.UUID          `json:"id"`
+	Name     string             `json:"name"`
+	Servings int32              `json:"servings"`
+	Scale    float64            `json:"scale"`
+	Items    []types.RecipeItem `json:"items"`
+}
+
+// CreateEventParams lists the restrictions of all guests together, either allergens or diets like vegetarian
+type CreateEventParams struct {
+	Name         string                     `json:"name" binding:"required,min=2,max=255"`
+	Day          string                     `json:"day" binding:"required,datetime=2006-01-02"`
+	Guests       int32                      `json:"guests" binding:"required,min=1,max=500"`
+	Restrictions []types.DietaryRestriction `json:"restrictions" binding:"omitempty,dive,oneof=gluten dairy egg peanuts tree_nuts soy fish shellfish sesame meat vegetarian vegan"`
+}
+
+type UpdateEventParams = CreateEventParams
+
+type EventParams struct {
+	ID string `uri:"id" binding:"required,uuid4_rfc4122"`
+}
+
+type AddEventRecipeParams struct {
+	RecipeID string `json:"recipe_id" binding:"required,uuid4_rfc4122"`
+}
+
+type EventRecipeParams struct {
+	ID       string `uri:"id" binding:"required,uuid4_rfc4122"`
+	RecipeID string `uri:"recipe_id" binding:"required,uuid4_rfc4122"`
+}
+
+type EventShoppingList struct {
+	EventID uuid.UUID      `json:"event_id"`
+	Guests  int32          `json:"guests"`
+	Items   []ShoppingItem `json:"items"`
+}
+
+// AllergyConflict is an ingredient of the menu containing an allergen the guests avoid, Restrictions are the
+// restrictions of the event that leave it out
+type AllergyConflict struct {
+	RecipeID       uuid.UUID                  `json:"recipe_id"`
+	RecipeName     string                     `json:"recipe_name"`
+	IngredientID   int32                      `json:"ingredient_id"`
+	IngredientName string                     `json:"ingredient_name"`
+	Allergen       types.Allergen             `json:"allergen"`
+	Restrictions   []types.DietaryRestriction `json:"restrictions"`
+}
+
+// AllergyReport checks the menu of an event against the restrictions of its guests, SafeRecipes have no conflict.
+// UnverifiedRecipes have no conflict either but items without a known ingredient, which can't be checked.
+type AllergyReport struct {
+	EventID           uuid.UUID                  `json:"event_id"`
+	Restrictions      []types.DietaryRestriction `json:"restrictions"`
+	Conflicts         []AllergyConflict          `json:"conflicts"`
+	SafeRecipes       []uuid.UUID                `json:"safe_recipes"`
+	UnverifiedRecipes []uuid.UUID                `json:"unverified_recipes"`
+}
+
+func DBEventToEvent(arg database.Event) Event {
+	restrictions := []types.DietaryRestriction{}
+	for _, restriction := range arg.Restrictions {
+		restrictions = append(restrictions, types.DietaryRestriction(restriction))
+	}
+	return Event{
+		ID:           arg.ID,
+		CreatedAt:    arg.CreatedAt,
+		UpdatedAt:    arg.UpdatedAt,
+		FamilyID:     arg.FamilyID,
+		Name:         arg.Name,
+		Day:          arg.Day,
+		Guests:       arg.Guests,
+		Restrictions: restrictions,
+	}
+}
+
+func DBEventsToEvents(arg []database.Event) []Event {
+	events := []Event{}
+	for _, event := range arg {
+		events = append(events, DBEventToEvent(event))
+	}
+	return events
+}
+
+// eventRestrictions drops duplicate restrictions, the database needs an empty list rather than null
+func eventRestrictions(arg []types.DietaryRestriction) []string {
+	restrictions := []string{}
+	for _, restriction := range arg {
+		if !slices.Contains(restrictions, string(restriction)) {
+			restrictions = append(restrictions, string(restriction))
+		}
+	}
+	return restrictions
+}
+
+// ScaleRecipeItems multiplies the quantities of the items, pieces are rounded up to whole ones
+func ScaleRecipeItems(items []types.RecipeItem, scale float64) []types.RecipeItem {
+	scaled := []types.RecipeItem{}
+	for _, item := range items {
+		item.Quantity = roundQuantity(item.Quantity*scale, item.Unit)
+		scaled = append(scaled, item)
+	}
+	return scaled
+}
+
+// DBRecipeToEventRecipe scales a recipe from its servings to the guests of an event
+func DBRecipeToEventRecipe(arg database.Recipe, guests int32) (EventRecipe, error) {
+	var items []types.RecipeItem
+	err := json.Unmarshal(arg.Items, &items)
+	if err != nil {
+		return EventRecipe{}, err
+	}
+	scale := float64(guests) / float64(max(arg.Servings, 1))
+	return EventRecipe{
+		ID:       arg.ID,
+		Name:     arg.Name,
+		Servings: arg.Servings,
+		Scale:    math.Round(scale*100) / 100,
+		Items:    ScaleRecipeItems(items, scale),
+	}, nil
+}
+
+// EventToAllergyReport finds the ingredients of the menu containing an allergen one of the restrictions leaves out.
+// A recipe is only safe when every one of its items is a known ingredient.
+func EventToAllergyReport(event Event, ingredients map[int32]database.Ingredient) AllergyReport {
+	avoided := map[types.Allergen][]types.DietaryRestriction{}
+	for _, restriction := range event.Restrictions {
+		for _, allergen := range restriction.Avoids() {
+			avoided[allergen] = append(avoided[allergen], restriction)
+		}
+	}
+
+	report := AllergyReport{
+		EventID:           event.ID,
+		Restrictions:      event.Restrictions,
+		Conflicts:         []AllergyConflict{},
+		SafeRecipes:       []uuid.UUID{},
+		UnverifiedRecipes: []uuid.UUID{},
+	}
+	for _, recipe := range event.Menu {
+		safe, verified := true, true
+		seen := map[int32]bool{}
+		for _, item := range recipe.Items {
+			ingredient, ok := ingredients[item.IngredientID]
+			if !ok {
+				verified = false
+				continue
+			}
+			if seen[ingredient.ID] {
+				continue
+			}
+			seen[ingredient.ID] = true
+			for _, allergen := range ingredient.Allergens {
+				restrictions, ok := avoided[types.Allergen(allergen)]
+				if !ok {
+					continue
+				}
+				safe = false
+				report.Conflicts = append(report.Conflicts, AllergyConflict{
+					RecipeID:       recipe.ID,
+					RecipeName:     recipe.Name,
+					IngredientID:   ingredient.ID,
+					IngredientName: ingredient.Name,
+					Allergen:       types.Allergen(allergen),
+					Restrictions:   restrictions,
+				})
+			}
+		}
+		switch {
+		case safe && (verified || len(avoided) == 0):
+			report.SafeRecipes = append(report.SafeRecipes, recipe.ID)
+		case safe:
+			report.UnverifiedRecipes = append(report.UnverifiedRecipes, recipe.ID)
+		}
+	}
+	return report
+}
+
+// familyEvent loads an event and makes sure it belongs to the user's family.
+// It writes the error response itself and returns false on failure.
+func (s *Server) familyEvent(ctx *gin.Context, user database.User, id uuid.UUID) (database.Event, bool) {
+	event, err := s.store.GetEventByID(ctx, id)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, respondWithErorr(err))
+			return event, false
+		}
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return event, false
+	}
+	if event.FamilyID != user.FamilyID {
+		ctx.JSON(http.StatusForbidden, respondWithErorr(errForbidden))
+		return event, false
+	}
+	return event, true
+}
+
+// eventWithMenu loads the recipes of an event scaled to its guests.
+// It writes the error response itself and returns false on failure.
+func (s *Server) eventWithMenu(ctx *gin.Context, arg database.Event) (Event, bool) {
+	event := DBEventToEvent(arg)
+	recipes, err := s.store.GetEventRecipes(ctx, arg.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return event, false
+	}
+	event.Menu = []EventRecipe{}
+	for _, recipe := range recipes {
+		scaled, err := DBRecipeToEventRecipe(recipe, arg.Guests)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+			return event, false
+		}
+		event.Menu = append(event.Menu, scaled)
+	}
+	return event, true
+}
+
+// ingredientsByID loads every ingredient, keyed by ID.
+// It writes the error response itself and returns false on failure.
+func (s *Server) ingredientsByID(ctx *gin.Context) (map[int32]database.Ingredient, bool) {
+	ingredients, err := s.store.GetIngredients(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return nil, false
+	}
+	byID := map[int32]database.Ingredient{}
+	for _, ingredient := range ingredients {
+		byID[ingredient.ID] = ingredient
+	}
+	return byID, true
+}
+
+func (s *Server) createEvent(ctx *gin.Context) {
+	var uri FamilyMealPlansParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	var request CreateEventParams
+	err = ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+	day, err := util.ParseDate(request.Day)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	familyID := uuid.MustParse(uri.ID)
+	_, ok := s.authFamilyMember(ctx, familyID)
+	if !ok {
+		return
+	}
+
+	event, err := s.store.CreateEvent(ctx, database.CreateEventParams{
+		FamilyID:     familyID,
+		Name:         request.Name,
+		Day:          day,
+		Guests:       request.Guests,
+		Restrictions: eventRestrictions(request.Restrictions),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, DBEventToEvent(event))
+}
+
+func (s *Server) getEvents(ctx *gin.Context) {
+	var uri FamilyMealPlansParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	familyID := uuid.MustParse(uri.ID)
+	_, ok := s.authFamilyMember(ctx, familyID)
+	if !ok {
+		return
+	}
+
+	events, err := s.store.GetEventsByFamilyID(ctx, familyID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, DBEventsToEvents(events))
+}
+
+func (s *Server) getEventByID(ctx *gin.Context) {
+	var uri EventParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	dbEvent, ok := s.familyEvent(ctx, user, uuid.MustParse(uri.ID))
+	if !ok {
+		return
+	}
+
+	event, ok := s.eventWithMenu(ctx, dbEvent)
+	if !ok {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, event)
+}
+
+func (s *Server) updateEvent(ctx *gin.Context) {
+	var uri EventParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	var request UpdateEventParams
+	err = ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+	day, err := util.ParseDate(request.Day)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	event, ok := s.familyEvent(ctx, user, uuid.MustParse(uri.ID))
+	if !ok {
+		return
+	}
+
+	event, err = s.store.UpdateEvent(ctx, database.UpdateEventParams{
+		ID:           event.ID,
+		Name:         request.Name,
+		Day:          day,
+		Guests:       request.Guests,
+		Restrictions: eventRestrictions(request.Restrictions),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, DBEventToEvent(event))
+}
+
+func (s *Server) deleteEvent(ctx *gin.Context) {
+	var uri EventParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	event, ok := s.familyEvent(ctx, user, uuid.MustParse(uri.ID))
+	if !ok {
+		return
+	}
+
+	err = s.store.DeleteEvent(ctx, event.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, respondWithMessage(fmt.Sprintf("deleted event with id %s", uri.ID)))
+}
+
+// addEventRecipe puts a recipe of the family on the menu, adding it again changes nothing
+func (s *Server) addEventRecipe(ctx *gin.Context) {
+	var uri EventParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	var request AddEventRecipeParams
+	err = ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	dbEvent, ok := s.familyEvent(ctx, user, uuid.MustParse(uri.ID))
+	if !ok {
+		return
+	}
+	recipe, ok := s.familyRecipe(ctx, user, uuid.MustParse(request.RecipeID))
+	if !ok {
+		return
+	}
+
+	err = s.store.AddEventRecipe(ctx, database.AddEventRecipeParams{
+		EventID:  dbEvent.ID,
+		RecipeID: recipe.ID,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	event, ok := s.eventWithMenu(ctx, dbEvent)
+	if !ok {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, event)
+}
+
+func (s *Server) removeEventRecipe(ctx *gin.Context) {
+	var uri EventRecipeParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	event, ok := s.familyEvent(ctx, user, uuid.MustParse(uri.ID))
+	if !ok {
+		return
+	}
+
+	err = s.store.RemoveEventRecipe(ctx, database.RemoveEventRecipeParams{
+		EventID:  event.ID,
+		RecipeID: uuid.MustParse(uri.RecipeID),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, respondWithMessage(fmt.Sprintf("removed recipe with id %s from the event", uri.RecipeID)))
+}
+
+// getEventShoppingList adds up the scaled items of the whole menu
+func (s *Server) getEventShoppingList(ctx *gin.Context) {
+	var uri EventParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	dbEvent, ok := s.familyEvent(ctx, user, uuid.MustParse(uri.ID))
+	if !ok {
+		return
+	}
+	event, ok := s.eventWithMenu(ctx, dbEvent)
+	if !ok {
+		return
+	}
+	ingredients, ok := s.ingredientsByID(ctx)
+	if !ok {
+		return
+	}
+
+	items := []types.RecipeItem{}
+	for _, recipe := range event.Menu {
+		items = append(items, recipe.Items...)
+	}
+
+	ctx.JSON(http.StatusOK, EventShoppingList{
+		EventID: event.ID,
+		Guests:  event.Guests,
+		Items:   AggregateShoppingItems(items, ingredients),
+	})
+}
+
+func (s *Server) getEventAllergyReport(ctx *gin.Context) {
+	var uri EventParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	dbEvent, ok := s.familyEvent(ctx, user, uuid.MustParse(uri.ID))
+	if !ok {
+		return
+	}
+	event, ok := s.eventWithMenu(ctx, dbEvent)
+	if !ok {
+		return
+	}
+	ingredients, ok := s.ingredientsByID(ctx)
+	if !ok {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, EventToAllergyReport(event, ingredients))
+}
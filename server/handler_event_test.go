@@ -0,0 +1,218 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	database "github.com/andreiz53/cookinator/database/handlers"
+	databaseMock "github.com/andreiz53/cookinator/database/mocks"
+	"github.com/andreiz53/cookinator/types"
+	"github.com/andreiz53/cookinator/util"
+)
+
+func randomEvent(familyID uuid.UUID) database.Event {
+	return database.Event{
+		ID:           uuid.New(),
+		FamilyID:     familyID,
+		Name:         util.RandomName(),
+		Day:          util.NewDate(time.Date(2026, time.December, 24, 0, 0, 0, 0, time.UTC)),
+		Guests:       int32(util.RandomInt(1, 30)),
+		Restrictions: []string{},
+	}
+}
+
+func TestCreateEvent(t *testing.T) {
+	user := randomFamilyUser(t)
+	event := randomEvent(user.FamilyID)
+	event.Guests = 12
+	event.Restrictions = []string{types.AllergenPeanuts, types.DietVegetarian}
+
+	testCases := []struct {
+		name          string
+		familyID      uuid.UUID
+		params        CreateEventParams
+		stubs         func(store *databaseMock.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "OK",
+			familyID: user.FamilyID,
+			params: CreateEventParams{
+				Name:         event.Name,
+				Day:          "2026-12-24",
+				Guests:       event.Guests,
+				Restrictions: []types.DietaryRestriction{types.AllergenPeanuts, types.DietVegetarian, types.AllergenPeanuts},
+			},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					CreateEvent(mock.Anything, database.CreateEventParams{
+						FamilyID:     user.FamilyID,
+						Name:         event.Name,
+						Day:          event.Day,
+						Guests:       event.Guests,
+						Restrictions: event.Restrictions,
+					}).
+					Times(1).Return(event, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				response, err := decodeJSON[Event](recorder.Body)
+				require.NoError(t, err)
+				require.Equal(t, event.ID, response.ID)
+				require.Equal(t, []types.DietaryRestriction{types.AllergenPeanuts, types.DietVegetarian}, response.Restrictions)
+			},
+		},
+		{
+			name:     "InvalidRestriction",
+			familyID: user.FamilyID,
+			params: CreateEventParams{
+				Name:         event.Name,
+				Day:          "2026-12-24",
+				Guests:       event.Guests,
+				Restrictions: []types.DietaryRestriction{"keto"},
+			},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:     "OtherFamily",
+			familyID: uuid.New(),
+			params:   CreateEventParams{Name: event.Name, Day: "2026-12-24", Guests: event.Guests},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					CreateEvent(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			server := newTestServer(t, store)
+			tc.stubs(store)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/families/%s/events", tc.familyID.String())
+			data, err := encodeJSON(tc.params)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+			setAuth(t, request, server.tokenMaker, authHeaderTypeBearer, user.Email, time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestDBRecipeToEventRecipe(t *testing.T) {
+	recipe := randomRecipe(t, uuid.New())
+	recipe.Servings = 4
+	items := []types.RecipeItem{
+		{ID: uuid.New(), IngredientID: 1, Quantity: 500, Unit: types.MeasureUnitGrams},
+		{ID: uuid.New(), IngredientID: 2, Quantity: 3, Unit: types.MeasureUnitPiece},
+	}
+	raw, err := json.Marshal(items)
+	require.NoError(t, err)
+	recipe.Items = raw
+
+	scaled, err := DBRecipeToEventRecipe(recipe, 10)
+	require.NoError(t, err)
+	require.Equal(t, 2.5, scaled.Scale)
+	require.Equal(t, 1250.0, scaled.Items[0].Quantity)
+	// 7.5 eggs are rounded up to whole ones
+	require.Equal(t, 8.0, scaled.Items[1].Quantity)
+}
+
+func TestGetEventAllergyReport(t *testing.T) {
+	user := randomFamilyUser(t)
+	event := randomEvent(user.FamilyID)
+	event.Restrictions = []string{types.AllergenPeanuts, types.DietVegetarian}
+
+	satay := database.Ingredient{ID: 1, Name: "peanut butter", Allergens: []string{types.AllergenPeanuts}}
+	chicken := database.Ingredient{ID: 2, Name: "chicken", Allergens: []string{types.AllergenMeat}}
+	rice := database.Ingredient{ID: 3, Name: "rice", Allergens: []string{}}
+
+	skewers := randomRecipe(t, user.FamilyID)
+	skewers.Servings = 4
+	raw, err := json.Marshal([]types.RecipeItem{
+		{IngredientID: satay.ID, Quantity: 100, Unit: types.MeasureUnitGrams},
+		{IngredientID: chicken.ID, Quantity: 500, Unit: types.MeasureUnitGrams},
+	})
+	require.NoError(t, err)
+	skewers.Items = raw
+	pilaf := randomRecipe(t, user.FamilyID)
+	pilaf.Servings = 4
+	raw, err = json.Marshal([]types.RecipeItem{{IngredientID: rice.ID, Quantity: 300, Unit: types.MeasureUnitGrams}})
+	require.NoError(t, err)
+	pilaf.Items = raw
+	// the sauce of the dip was typed in without an ingredient, it can't be checked
+	dip := randomRecipe(t, user.FamilyID)
+	dip.Servings = 4
+	raw, err = json.Marshal([]types.RecipeItem{
+		{IngredientID: rice.ID, Quantity: 50, Unit: types.MeasureUnitGrams},
+		{Quantity: 100, Unit: types.MeasureUnitMillilitres},
+	})
+	require.NoError(t, err)
+	dip.Items = raw
+
+	store := new(databaseMock.MockStore)
+	server := newTestServer(t, store)
+	store.EXPECT().
+		GetUserByEmail(mock.Anything, user.Email).
+		Times(1).Return(user, nil)
+	store.EXPECT().
+		GetEventByID(mock.Anything, event.ID).
+		Times(1).Return(event, nil)
+	store.EXPECT().
+		GetEventRecipes(mock.Anything, event.ID).
+		Times(1).Return([]database.Recipe{skewers, pilaf, dip}, nil)
+	store.EXPECT().
+		GetIngredients(mock.Anything).
+		Times(1).Return([]database.Ingredient{satay, chicken, rice}, nil)
+
+	recorder := httptest.NewRecorder()
+	url := fmt.Sprintf("/events/%s/allergy-report", event.ID.String())
+	request, err := http.NewRequest(http.MethodGet, url, nil)
+	require.NoError(t, err)
+	setAuth(t, request, server.tokenMaker, authHeaderTypeBearer, user.Email, time.Minute)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	report, err := decodeJSON[AllergyReport](recorder.Body)
+	require.NoError(t, err)
+	require.Len(t, report.Conflicts, 2)
+	require.Equal(t, types.Allergen(types.AllergenPeanuts), report.Conflicts[0].Allergen)
+	require.Equal(t, types.Allergen(types.AllergenMeat), report.Conflicts[1].Allergen)
+	require.Equal(t, []types.DietaryRestriction{types.DietVegetarian}, report.Conflicts[1].Restrictions)
+	require.Equal(t, []uuid.UUID{pilaf.ID}, report.SafeRecipes)
+	require.Equal(t, []uuid.UUID{dip.ID}, report.UnverifiedRecipes)
+}
@@ -0,0 +1,559 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/andreiz53/cookinator/calendar"
+	database "github.com/andreiz53/cookinator/database/handlers"
+	"github.com/andreiz53/cookinator/planner"
+	"github.com/andreiz53/cookinator/types"
+	"github.com/andreiz53/cookinator/util"
+)
+
+var (
+	errCalendarSource    = errors.New("either url or ics is required")
+	errCalendarURL       = errors.New("the calendar url must be http, https or webcal")
+	errCalendarNotSynced = errors.New("the calendar was uploaded, upload it again to sync it")
+	errCalendarFetch     = errors.New("the calendar could not be downloaded")
+	errCalendarTooLarge  = errors.New("the calendar is too large")
+	errInvalidTimezone   = errors.New("invalid timezone")
+	errCalendarAddress   = errors.New("the calendar url must point to a public address")
+	errCalendarRedirects = errors.New("the calendar url redirects too many times")
+)
+
+const (
+	// calendarImportDays is how far ahead events of imported calendars mark slots as busy
+	calendarImportDays = 90
+	// maxCalendarSize limits the size of uploaded and downloaded calendars
+	maxCalendarSize = 5 << 20
+	// busyPrepTime is how long before a meal an event keeps the family from cooking a long recipe
+	busyPrepTime = time.Hour
+	// maxCalendarRedirects limits how many redirects are followed when downloading a calendar
+	maxCalendarRedirects = 5
+)
+
+// sharedAddressSpace is the carrier-grade NAT range, which isn't reachable from the internet either
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+var calendarClient = newCalendarClient(isPublicAddr)
+
+// newCalendarClient returns the client calendars are downloaded with. The dialer checks every address
+// after the host name is resolved, redirects included, so a calendar URL can't reach the server's own
+// network or the metadata service of its cloud. Proxies from the environment are not used, since the
+// address dialed would then be the proxy's.
+func newCalendarClient(allowed func(netip.Addr) bool) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: 5 * time.Second,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil || !allowed(addrPort.Addr().Unmap()) {
+				return errCalendarAddress
+			}
+			return nil
+		},
+	}
+	return &http.Client{
+		Timeout: 15 * time.Second,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: 5 * time.Second,
+		},
+		CheckRedirect: func(request *http.Request, via []*http.Request) error {
+			if len(via) >= maxCalendarRedirects {
+				return errCalendarRedirects
+			}
+			if request.URL.Scheme != "http" && request.URL.Scheme != "https" {
+				return errCalendarURL
+			}
+			return nil
+		},
+	}
+}
+
+// isPublicAddr reports whether an address is reachable from the internet, which excludes loopback,
+// private, link-local (the metadata services of clouds among them), shared and unspecified addresses
+func isPublicAddr(addr netip.Addr) bool {
+	return addr.IsGlobalUnicast() && !addr.IsPrivate() && !sharedAddressSpace.Contains(addr)
+}
+
+type FamilyCalendar struct {
+	ID        uuid.UUID        `json:"id"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+	SyncedAt  pgtype.Timestamp `json:"synced_at"`
+	FamilyID  uuid.UUID        `json:"family_id"`
+	Name      string           `json:"name"`
+	URL       string           `json:"url"`
+	Timezone  string           `json:"timezone"`
+}
+
+type BusySlot struct {
+	CalendarID uuid.UUID      `json:"calendar_id"`
+	Day        pgtype.Date    `json:"day"`
+	Slot       types.MealSlot `json:"slot"`
+	Away       bool           `json:"away"`
+	Summary    string         `json:"summary"`
+}
+
+// CreateFamilyCalendarParams registers a calendar by its URL, so it can be synced later, or uploads its content.
+// Times without a time zone are read in Timezone, UTC by default.
+type CreateFamilyCalendarParams struct {
+	Name     string `json:"name" binding:"required,max=128"`
+	URL      string `json:"url" binding:"omitempty,url"`
+	ICS      string `json:"ics"`
+	Timezone string `json:"timezone" binding:"omitempty,max=64"`
+}
+
+type FamilyCalendarByIDParams struct {
+	ID         string `uri:"id" binding:"required,uuid4_rfc4122"`
+	CalendarID string `uri:"calendar_id" binding:"required,uuid4_rfc4122"`
+}
+
+// SyncFamilyCalendarParams holds the new content of an uploaded calendar, calendars with a URL are downloaded again
+type SyncFamilyCalendarParams struct {
+	ICS string `json:"ics"`
+}
+
+type GetBusySlotsQuery struct {
+	Week string `form:"week" binding:"required"`
+}
+
+func DBFamilyCalendarToFamilyCalendar(arg database.FamilyCalendar) FamilyCalendar {
+	return FamilyCalendar{
+		ID:        arg.ID,
+		CreatedAt: arg.CreatedAt,
+		SyncedAt:  arg.SyncedAt,
+		FamilyID:  arg.FamilyID,
+		Name:      arg.Name,
+		URL:       arg.Url,
+		Timezone:  arg.Timezone,
+	}
+}
+
+func DBFamilyCalendarsToFamilyCalendars(arg []database.FamilyCalendar) []FamilyCalendar {
+	calendars := []FamilyCalendar{}
+	for _, c := range arg {
+		calendars = append(calendars, DBFamilyCalendarToFamilyCalendar(c))
+	}
+	return calendars
+}
+
+func DBBusySlotsToBusySlots(arg []database.BusySlot) []BusySlot {
+	slots := []BusySlot{}
+	for _, slot := range arg {
+		slots = append(slots, BusySlot{
+			CalendarID: slot.CalendarID,
+			Day:        slot.Day,
+			Slot:       types.MealSlot(slot.Slot),
+			Away:       slot.Away,
+			Summary:    slot.Summary,
+		})
+	}
+	return slots
+}
+
+// fetchCalendar downloads a calendar, webcal links are fetched over https
+func fetchCalendar(ctx context.Context, url string) (string, error) {
+	if strings.HasPrefix(url, "webcal://") {
+		url = "https://" + strings.TrimPrefix(url, "webcal://")
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+	if request.URL.Scheme != "http" && request.URL.Scheme != "https" {
+		return "", errCalendarURL
+	}
+	response, err := calendarClient.Do(request)
+	if err != nil {
+		for _, known := range []error{errCalendarAddress, errCalendarRedirects, errCalendarURL} {
+			if errors.Is(err, known) {
+				return "", known
+			}
+		}
+		return "", errCalendarFetch
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return "", errCalendarFetch
+	}
+	if response.ContentLength > maxCalendarSize {
+		return "", errCalendarTooLarge
+	}
+
+	data, err := io.ReadAll(io.LimitReader(response.Body, maxCalendarSize+1))
+	if err != nil {
+		return "", errCalendarFetch
+	}
+	if len(data) > maxCalendarSize {
+		return "", errCalendarTooLarge
+	}
+	return string(data), nil
+}
+
+// calendarBusySlots parses a calendar and returns the slots its events keep busy from today on
+func calendarBusySlots(ics string, loc *time.Location) ([]database.CreateBusySlotParams, error) {
+	if len(ics) > maxCalendarSize {
+		return nil, errCalendarTooLarge
+	}
+	events, err := calendar.Parse(strings.NewReader(ics), loc)
+	if err != nil {
+		return nil, err
+	}
+
+	year, month, day := time.Now().In(loc).Date()
+	from := time.Date(year, month, day, 0, 0, 0, 0, loc)
+	to := from.AddDate(0, 0, calendarImportDays)
+	return EventsToBusySlots(calendar.Expand(events, from, to), from, to), nil
+}
+
+// EventsToBusySlots marks the meals events overlap on the days from from to to, in the location of from.
+// An event covering the whole meal means nobody is home for it, an event during the meal or the hour
+// before it leaves only time for a quick recipe. All-day events keep the whole day away, transparent
+// ones like birthdays are ignored.
+func EventsToBusySlots(events []calendar.Event, from time.Time, to time.Time) []database.CreateBusySlotParams {
+	loc := from.Location()
+	busy := map[planner.Slot]*database.CreateBusySlotParams{}
+	mark := func(day time.Time, slot types.MealSlot, away bool, summary string) {
+		key := planner.Slot{Day: util.NewDate(day).Time, Slot: slot}
+		current, ok := busy[key]
+		if !ok {
+			current = &database.CreateBusySlotParams{Day: util.NewDate(day), Slot: string(slot)}
+			busy[key] = current
+		}
+		current.Away = current.Away || away
+		if summary != "" && !strings.Contains(current.Summary, summary) {
+			current.Summary = strings.TrimPrefix(current.Summary+", "+summary, ", ")
+		}
+	}
+
+	for _, event := range events {
+		if event.Transparent {
+			continue
+		}
+		start, end := event.Start.In(loc), event.End.In(loc)
+		year, month, day := start.Date()
+		date := time.Date(year, month, day, 0, 0, 0, 0, loc)
+		if date.Before(from) {
+			// events started before the window, like a long trip, are only marked from its first day on
+			date = from
+		}
+		for ; (date.Before(end) || date.Equal(start)) && date.Before(to); date = date.AddDate(0, 0, 1) {
+			for _, slot := range types.MealSlots {
+				if event.AllDay {
+					mark(date, slot, true, event.Summary)
+					continue
+				}
+
+				meal := mealTimes[slot]
+				mealStart := time.Date(date.Year(), date.Month(), date.Day(), meal.hour, meal.minute, 0, 0, loc)
+				mealEnd := mealStart.Add(meal.duration)
+				if !start.After(mealStart) && !end.Before(mealEnd) {
+					mark(date, slot, true, event.Summary)
+				} else if start.Before(mealEnd) && end.After(mealStart.Add(-busyPrepTime)) {
+					mark(date, slot, false, event.Summary)
+				}
+			}
+		}
+	}
+
+	slots := []database.CreateBusySlotParams{}
+	for _, slot := range busy {
+		slots = append(slots, *slot)
+	}
+	sort.Slice(slots, func(i, j int) bool {
+		if !slots[i].Day.Time.Equal(slots[j].Day.Time) {
+			return slots[i].Day.Time.Before(slots[j].Day.Time)
+		}
+		return types.MealSlot(slots[i].Slot).Order() < types.MealSlot(slots[j].Slot).Order()
+	})
+	return slots
+}
+
+// BusySlotsToAvailability merges the busy slots of the family calendars. A slot is busy when any calendar
+// has an event around it and away only when every calendar of the family is away, so one member's
+// trip doesn't skip the meals of everyone else.
+func BusySlotsToAvailability(arg []database.BusySlot, calendars int) ([]planner.Slot, []planner.Slot) {
+	keys := []planner.Slot{}
+	awayCount := map[planner.Slot]int{}
+	for _, slot := range arg {
+		key := planner.Slot{Day: slot.Day.Time, Slot: types.MealSlot(slot.Slot)}
+		count, seen := awayCount[key]
+		if !seen {
+			keys = append(keys, key)
+		}
+		if slot.Away {
+			count++
+		}
+		awayCount[key] = count
+	}
+
+	busy, away := []planner.Slot{}, []planner.Slot{}
+	for _, key := range keys {
+		if awayCount[key] >= calendars {
+			away = append(away, key)
+		} else {
+			busy = append(busy, key)
+		}
+	}
+	return busy, away
+}
+
+// familyCalendar loads a calendar of the family the user is a member of.
+// It writes the error response itself and returns false on failure.
+func (s *Server) familyCalendar(ctx *gin.Context, familyID uuid.UUID, id uuid.UUID) (database.FamilyCalendar, bool) {
+	c, err := s.store.GetFamilyCalendarByID(ctx, id)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, respondWithErorr(err))
+			return c, false
+		}
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return c, false
+	}
+	if c.FamilyID != familyID {
+		ctx.JSON(http.StatusForbidden, respondWithErorr(errForbidden))
+		return c, false
+	}
+	return c, true
+}
+
+// createFamilyCalendar imports a calendar, parsing it right away to mark the slots its events keep busy
+func (s *Server) createFamilyCalendar(ctx *gin.Context) {
+	var uri FamilyCalendarParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	var request CreateFamilyCalendarParams
+	err = ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+	if (request.URL == "") == (request.ICS == "") {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(errCalendarSource))
+		return
+	}
+	scheme, _, _ := strings.Cut(request.URL, "://")
+	if request.URL != "" && scheme != "http" && scheme != "https" && scheme != "webcal" {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(errCalendarURL))
+		return
+	}
+	if request.Timezone == "" {
+		request.Timezone = "UTC"
+	}
+	loc, err := time.LoadLocation(request.Timezone)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(errInvalidTimezone))
+		return
+	}
+
+	familyID := uuid.MustParse(uri.ID)
+	_, ok := s.authFamilyMember(ctx, familyID)
+	if !ok {
+		return
+	}
+
+	ics := request.ICS
+	if request.URL != "" {
+		ics, err = fetchCalendar(ctx, request.URL)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+			return
+		}
+	}
+	slots, err := calendarBusySlots(ics, loc)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	c, err := s.store.CreateFamilyCalendarTx(ctx, database.CreateFamilyCalendarTxParams{
+		Calendar: database.CreateFamilyCalendarParams{
+			FamilyID: familyID,
+			Name:     request.Name,
+			Url:      request.URL,
+			Timezone: request.Timezone,
+		},
+		BusySlots: slots,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, DBFamilyCalendarToFamilyCalendar(c))
+}
+
+func (s *Server) getFamilyCalendars(ctx *gin.Context) {
+	var request FamilyCalendarParams
+	err := ctx.ShouldBindUri(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	familyID := uuid.MustParse(request.ID)
+	_, ok := s.authFamilyMember(ctx, familyID)
+	if !ok {
+		return
+	}
+
+	calendars, err := s.store.GetFamilyCalendars(ctx, familyID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, DBFamilyCalendarsToFamilyCalendars(calendars))
+}
+
+// syncFamilyCalendar replaces the busy slots of a calendar with the ones of its current events
+func (s *Server) syncFamilyCalendar(ctx *gin.Context) {
+	var uri FamilyCalendarByIDParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	var request SyncFamilyCalendarParams
+	if ctx.Request.ContentLength != 0 {
+		err = ctx.ShouldBindJSON(&request)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+			return
+		}
+	}
+
+	familyID := uuid.MustParse(uri.ID)
+	_, ok := s.authFamilyMember(ctx, familyID)
+	if !ok {
+		return
+	}
+
+	c, ok := s.familyCalendar(ctx, familyID, uuid.MustParse(uri.CalendarID))
+	if !ok {
+		return
+	}
+
+	ics := request.ICS
+	if c.Url != "" {
+		ics, err = fetchCalendar(ctx, c.Url)
+		if err != nil {
+			ctx.JSON(http.StatusBadGateway, respondWithErorr(err))
+			return
+		}
+	}
+	if ics == "" {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(errCalendarNotSynced))
+		return
+	}
+
+	loc, err := time.LoadLocation(c.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+	slots, err := calendarBusySlots(ics, loc)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	c, err = s.store.SyncFamilyCalendarTx(ctx, database.SyncFamilyCalendarTxParams{
+		CalendarID: c.ID,
+		BusySlots:  slots,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, DBFamilyCalendarToFamilyCalendar(c))
+}
+
+func (s *Server) deleteFamilyCalendar(ctx *gin.Context) {
+	var request FamilyCalendarByIDParams
+	err := ctx.ShouldBindUri(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	familyID := uuid.MustParse(request.ID)
+	_, ok := s.authFamilyMember(ctx, familyID)
+	if !ok {
+		return
+	}
+
+	c, ok := s.familyCalendar(ctx, familyID, uuid.MustParse(request.CalendarID))
+	if !ok {
+		return
+	}
+
+	err = s.store.DeleteFamilyCalendar(ctx, c.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, respondWithMessage(fmt.Sprintf("deleted calendar with id %s", request.CalendarID)))
+}
+
+// getBusySlots lists the slots of a week the family calendars keep busy
+func (s *Server) getBusySlots(ctx *gin.Context) {
+	var uri FamilyCalendarParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	var query GetBusySlotsQuery
+	err = ctx.ShouldBindQuery(&query)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	weekStart, err := util.ParseISOWeek(query.Week)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	familyID := uuid.MustParse(uri.ID)
+	_, ok := s.authFamilyMember(ctx, familyID)
+	if !ok {
+		return
+	}
+
+	slots, err := s.store.GetBusySlotsByFamilyID(ctx, database.GetBusySlotsByFamilyIDParams{
+		FamilyID: familyID,
+		FromDay:  weekStart,
+		ToDay:    util.NewDate(weekStart.Time.AddDate(0, 0, 7)),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, DBBusySlotsToBusySlots(slots))
+}
@@ -0,0 +1,400 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/andreiz53/cookinator/calendar"
+	database "github.com/andreiz53/cookinator/database/handlers"
+	databaseMock "github.com/andreiz53/cookinator/database/mocks"
+	"github.com/andreiz53/cookinator/types"
+	"github.com/andreiz53/cookinator/util"
+)
+
+// testICS has a weekly late meeting from the day after tomorrow on
+func testICS() string {
+	start := time.Now().UTC().AddDate(0, 0, 2)
+	return fmt.Sprintf("BEGIN:VCALENDAR\r\nVERSION:2.0\r\nBEGIN:VEVENT\r\nUID:meeting\r\n"+
+		"DTSTART:%sT180000\r\nDTEND:%sT193000\r\nRRULE:FREQ=WEEKLY;COUNT=2\r\nSUMMARY:Late meeting\r\n"+
+		"END:VEVENT\r\nEND:VCALENDAR\r\n", start.Format("20060102"), start.Format("20060102"))
+}
+
+func TestIsPublicAddr(t *testing.T) {
+	testCases := []struct {
+		addr   string
+		public bool
+	}{
+		{addr: "93.184.215.14", public: true},
+		{addr: "2606:2800:21f:cb07:6820:80da:af6b:8b2c", public: true},
+		{addr: "127.0.0.1"},
+		{addr: "::1"},
+		{addr: "10.0.0.8"},
+		{addr: "172.16.4.2"},
+		{addr: "192.168.1.1"},
+		{addr: "fd00:ec2::254"},
+		{addr: "169.254.169.254"},
+		{addr: "fe80::1"},
+		{addr: "100.100.100.200"},
+		{addr: "0.0.0.0"},
+		{addr: "224.0.0.1"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.addr, func(t *testing.T) {
+			require.Equal(t, tc.public, isPublicAddr(netip.MustParseAddr(tc.addr)))
+		})
+	}
+}
+
+func TestFetchCalendar(t *testing.T) {
+	published := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/loop.ics":
+			http.Redirect(w, r, "/loop.ics", http.StatusFound)
+		case "/file.ics":
+			http.Redirect(w, r, "file:///etc/passwd", http.StatusFound)
+		case "/large.ics":
+			w.Header().Set("Content-Length", fmt.Sprint(maxCalendarSize+1))
+			w.Write([]byte(strings.Repeat("X", maxCalendarSize+1)))
+		default:
+			w.Write([]byte(testICS()))
+		}
+	}))
+	defer published.Close()
+
+	// the server listens on loopback
+	_, err := fetchCalendar(context.Background(), published.URL+"/kids.ics")
+	require.ErrorIs(t, err, errCalendarAddress)
+	_, err = fetchCalendar(context.Background(), "ftp://example.com/kids.ics")
+	require.ErrorIs(t, err, errCalendarURL)
+
+	defer func(client *http.Client) { calendarClient = client }(calendarClient)
+	calendarClient = newCalendarClient(func(netip.Addr) bool { return true })
+
+	ics, err := fetchCalendar(context.Background(), published.URL+"/kids.ics")
+	require.NoError(t, err)
+	require.Equal(t, testICS(), ics)
+	_, err = fetchCalendar(context.Background(), published.URL+"/loop.ics")
+	require.ErrorIs(t, err, errCalendarRedirects)
+	_, err = fetchCalendar(context.Background(), published.URL+"/file.ics")
+	require.ErrorIs(t, err, errCalendarURL)
+	_, err = fetchCalendar(context.Background(), published.URL+"/large.ics")
+	require.ErrorIs(t, err, errCalendarTooLarge)
+}
+
+func TestEventsToBusySlots(t *testing.T) {
+	day := time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)
+	events := []calendar.Event{
+		// keeps the cook out until just before dinner
+		{Summary: "Football", Start: day.Add(17 * time.Hour), End: day.Add(18*time.Hour + 30*time.Minute)},
+		{Summary: "Piano", Start: day.Add(18 * time.Hour), End: day.Add(19 * time.Hour)},
+		// nobody is home for lunch
+		{Summary: "School trip", Start: day.Add(9 * time.Hour), End: day.Add(15 * time.Hour)},
+		{Summary: "Birthday", Start: day, End: day.AddDate(0, 0, 1), AllDay: true, Transparent: true},
+		{Summary: "Vacation", Start: day.AddDate(0, 0, 3), End: day.AddDate(0, 0, 4), AllDay: true},
+		// a stay abroad only keeps the days of the window away
+		{Summary: "Erasmus", Start: day.AddDate(0, -3, 0), End: day.AddDate(1, 0, 0), AllDay: true},
+	}
+
+	slots := EventsToBusySlots(events[:5], day, day.AddDate(0, 0, calendarImportDays))
+	require.Len(t, slots, 6)
+
+	require.Equal(t, string(types.MealSlotLunch), slots[0].Slot)
+	require.True(t, slots[0].Away)
+	require.Equal(t, "School trip", slots[0].Summary)
+	require.Equal(t, string(types.MealSlotDinner), slots[1].Slot)
+	require.False(t, slots[1].Away)
+	require.Equal(t, "Football, Piano", slots[1].Summary)
+
+	for _, slot := range slots[2:] {
+		require.Equal(t, day.AddDate(0, 0, 3), slot.Day.Time)
+		require.True(t, slot.Away)
+	}
+
+	slots = EventsToBusySlots(events[5:], day, day.AddDate(0, 0, 7))
+	require.Len(t, slots, 7*len(types.MealSlots))
+	require.Equal(t, day, slots[0].Day.Time)
+	require.Equal(t, day.AddDate(0, 0, 6), slots[len(slots)-1].Day.Time)
+}
+
+func TestBusySlotsToAvailability(t *testing.T) {
+	day := util.NewDate(time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC))
+	mine, theirs := uuid.New(), uuid.New()
+	slots := []database.BusySlot{
+		{CalendarID: mine, Day: day, Slot: "lunch", Away: true},
+		{CalendarID: theirs, Day: day, Slot: "lunch", Away: true},
+		{CalendarID: mine, Day: day, Slot: "dinner", Away: true},
+		{CalendarID: theirs, Day: day, Slot: "dinner", Away: false},
+	}
+
+	busy, away := BusySlotsToAvailability(slots, 2)
+	require.Len(t, away, 1)
+	require.Equal(t, types.MealSlot(types.MealSlotLunch), away[0].Slot)
+	// only one of the two calendars is away for dinner
+	require.Len(t, busy, 1)
+	require.Equal(t, types.MealSlot(types.MealSlotDinner), busy[0].Slot)
+}
+
+func TestCreateFamilyCalendar(t *testing.T) {
+	user := randomFamilyUser(t)
+	created := database.FamilyCalendar{ID: uuid.New(), FamilyID: user.FamilyID, Name: "Kids", Timezone: "Europe/Bucharest"}
+
+	// the test server listens on loopback, which calendars can't be downloaded from otherwise
+	defer func(client *http.Client) { calendarClient = client }(calendarClient)
+	calendarClient = newCalendarClient(func(netip.Addr) bool { return true })
+
+	published := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/kids.ics" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "text/calendar")
+		w.Write([]byte(testICS()))
+	}))
+	defer published.Close()
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		stubs         func(store *databaseMock.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Upload",
+			body: gin.H{"name": "Kids", "ics": testICS(), "timezone": "Europe/Bucharest"},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					CreateFamilyCalendarTx(mock.Anything, mock.MatchedBy(func(arg database.CreateFamilyCalendarTxParams) bool {
+						return arg.Calendar.FamilyID == user.FamilyID && arg.Calendar.Url == "" &&
+							arg.Calendar.Timezone == "Europe/Bucharest" && len(arg.BusySlots) == 2
+					})).
+					Times(1).Return(created, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				got, err := decodeJSON[FamilyCalendar](recorder.Body)
+				require.NoError(t, err)
+				require.Equal(t, created.ID, got.ID)
+			},
+		},
+		{
+			name: "Register",
+			body: gin.H{"name": "Kids", "url": published.URL + "/kids.ics"},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					CreateFamilyCalendarTx(mock.Anything, mock.MatchedBy(func(arg database.CreateFamilyCalendarTxParams) bool {
+						return arg.Calendar.Url == published.URL+"/kids.ics" && arg.Calendar.Timezone == "UTC" && len(arg.BusySlots) == 2
+					})).
+					Times(1).Return(created, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+			},
+		},
+		{
+			name: "NotFound",
+			body: gin.H{"name": "Kids", "url": published.URL + "/missing.ics"},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					CreateFamilyCalendarTx(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidCalendar",
+			body: gin.H{"name": "Kids", "ics": "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\n"},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					CreateFamilyCalendarTx(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "BothSources",
+			body: gin.H{"name": "Kids", "ics": testICS(), "url": published.URL + "/kids.ics"},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					CreateFamilyCalendarTx(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidScheme",
+			body: gin.H{"name": "Kids", "url": "file:///etc/passwd"},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					CreateFamilyCalendarTx(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidTimezone",
+			body: gin.H{"name": "Kids", "ics": testICS(), "timezone": "Mars/Olympus"},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					CreateFamilyCalendarTx(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			server := newTestServer(t, store)
+
+			tc.stubs(store)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/families/%s/calendars", user.FamilyID.String())
+			data, err := encodeJSON(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+			setAuth(t, request, server.tokenMaker, authHeaderTypeBearer, user.Email, time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestSyncFamilyCalendar(t *testing.T) {
+	user := randomFamilyUser(t)
+	uploaded := database.FamilyCalendar{ID: uuid.New(), FamilyID: user.FamilyID, Name: "School", Timezone: "UTC"}
+	other := database.FamilyCalendar{ID: uuid.New(), FamilyID: uuid.New(), Name: "Other", Timezone: "UTC"}
+
+	testCases := []struct {
+		name          string
+		calendar      database.FamilyCalendar
+		body          gin.H
+		stubs         func(store *databaseMock.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "OK",
+			calendar: uploaded,
+			body:     gin.H{"ics": testICS()},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetFamilyCalendarByID(mock.Anything, uploaded.ID).
+					Times(1).Return(uploaded, nil)
+				store.EXPECT().
+					SyncFamilyCalendarTx(mock.Anything, mock.MatchedBy(func(arg database.SyncFamilyCalendarTxParams) bool {
+						return arg.CalendarID == uploaded.ID && len(arg.BusySlots) == 2
+					})).
+					Times(1).Return(uploaded, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:     "UploadedWithoutContent",
+			calendar: uploaded,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetFamilyCalendarByID(mock.Anything, uploaded.ID).
+					Times(1).Return(uploaded, nil)
+				store.EXPECT().
+					SyncFamilyCalendarTx(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:     "OtherFamily",
+			calendar: other,
+			body:     gin.H{"ics": testICS()},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetFamilyCalendarByID(mock.Anything, other.ID).
+					Times(1).Return(other, nil)
+				store.EXPECT().
+					SyncFamilyCalendarTx(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			server := newTestServer(t, store)
+
+			tc.stubs(store)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/families/%s/calendars/%s/sync", user.FamilyID.String(), tc.calendar.ID.String())
+
+			var body []byte
+			if tc.body != nil {
+				data, err := encodeJSON(tc.body)
+				require.NoError(t, err)
+				body = data
+			}
+
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+			require.NoError(t, err)
+			setAuth(t, request, server.tokenMaker, authHeaderTypeBearer, user.Email, time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
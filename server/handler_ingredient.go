@@ -3,29 +3,37 @@ package server
 import (
 	"fmt"
 	"net/http"
+	"slices"
 
 	"github.com/gin-gonic/gin"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 
 	database "github.com/andreiz53/cookinator/database/handlers"
+	"github.com/andreiz53/cookinator/types"
 )
 
 type Ingredient struct {
-	ID      int32          `json:"id"`
-	Name    string         `json:"name"`
-	Density pgtype.Numeric `json:"density"`
+	ID        int32                 `json:"id"`
+	Name      string                `json:"name"`
+	Density   pgtype.Numeric        `json:"density"`
+	Allergens []types.Allergen      `json:"allergens"`
+	Category  types.GroceryCategory `json:"category"`
 }
 
 type CreateIngredientParams struct {
-	Name    string  `json:"name" binding:"required,min=2"`
-	Density float64 `json:"density" binding:"required,gt=0"`
+	Name      string                `json:"name" binding:"required,min=2"`
+	Density   float64               `json:"density" binding:"required,gt=0"`
+	Allergens []types.Allergen      `json:"allergens" binding:"omitempty,dive,oneof=gluten dairy egg peanuts tree_nuts soy fish shellfish sesame meat"`
+	Category  types.GroceryCategory `json:"category" binding:"omitempty,oneof=produce bakery meat seafood dairy pantry spices beverages frozen other"`
 }
 
 type UpdateIngredientParams struct {
-	ID      int32   `json:"id" binding:"required,min=1"`
-	Name    string  `json:"name" binding:"required,min=2"`
-	Density float64 `json:"density" binding:"required,gt=0"`
+	ID        int32                 `json:"id" binding:"required,min=1"`
+	Name      string                `json:"name" binding:"required,min=2"`
+	Density   float64               `json:"density" binding:"required,gt=0"`
+	Allergens []types.Allergen      `json:"allergens" binding:"omitempty,dive,oneof=gluten dairy egg peanuts tree_nuts soy fish shellfish sesame meat"`
+	Category  types.GroceryCategory `json:"category" binding:"omitempty,oneof=produce bakery meat seafood dairy pantry spices beverages frozen other"`
 }
 
 type DeleteIngredientParams struct {
@@ -44,8 +52,10 @@ func createIngredientToDBCreateIngredient(arg CreateIngredientParams) database.C
 		density.NaN = true
 	}
 	return database.CreateIngredientParams{
-		Name:    arg.Name,
-		Density: density,
+		Name:      arg.Name,
+		Density:   density,
+		Allergens: ingredientAllergens(arg.Allergens),
+		Category:  ingredientCategory(arg.Category),
 	}
 }
 
@@ -57,17 +67,44 @@ func updateIngredientToDBUpdateIngredient(arg UpdateIngredientParams) database.U
 		density.NaN = true
 	}
 	return database.UpdateIngredientParams{
-		Name:    arg.Name,
-		Density: density,
-		ID:      arg.ID,
+		Name:      arg.Name,
+		Density:   density,
+		ID:        arg.ID,
+		Allergens: ingredientAllergens(arg.Allergens),
+		Category:  ingredientCategory(arg.Category),
 	}
 }
 
+// ingredientAllergens drops duplicate allergens, the database needs an empty list rather than null
+func ingredientAllergens(arg []types.Allergen) []string {
+	allergens := []string{}
+	for _, allergen := range arg {
+		if !slices.Contains(allergens, string(allergen)) {
+			allergens = append(allergens, string(allergen))
+		}
+	}
+	return allergens
+}
+
+// ingredientCategory puts ingredients without a category in the other aisle
+func ingredientCategory(arg types.GroceryCategory) string {
+	if arg == "" {
+		return types.GroceryCategoryOther
+	}
+	return string(arg)
+}
+
 func dbIngredientToIngredient(arg database.Ingredient) Ingredient {
+	allergens := []types.Allergen{}
+	for _, allergen := range arg.Allergens {
+		allergens = append(allergens, types.Allergen(allergen))
+	}
 	return Ingredient{
-		ID:      arg.ID,
-		Name:    arg.Name,
-		Density: arg.Density,
+		ID:        arg.ID,
+		Name:      arg.Name,
+		Density:   arg.Density,
+		Allergens: allergens,
+		Category:  types.GroceryCategory(arg.Category),
 	}
 }
 
@@ -0,0 +1,310 @@
+package server
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	database "github.com/andreiz53/cookinator/database/handlers"
+	"github.com/andreiz53/cookinator/types"
+)
+
+const (
+	// maxPackageSteps bounds the quantities tried when picking packages, bigger quantities are bought in the
+	// biggest package only
+	maxPackageSteps = 100_000
+	// minPackageSteps is how many steps the smallest package is counted in at least, so small packages
+	// of fractional sizes are told apart
+	minPackageSteps = 100
+)
+
+// IngredientPackage is a size an ingredient is sold in
+type IngredientPackage struct {
+	ID           uuid.UUID         `json:"id"`
+	CreatedAt    pgtype.Timestamp  `json:"created_at"`
+	IngredientID int32             `json:"ingredient_id"`
+	Quantity     float64           `json:"quantity"`
+	Unit         types.MeasureUnit `json:"unit"`
+	PriceCents   pgtype.Int4       `json:"price_cents"`
+}
+
+type CreateIngredientPackageParams struct {
+	Quantity   float64           `json:"quantity" binding:"required,gt=0"`
+	Unit       types.MeasureUnit `json:"unit" binding:"required,oneof=g mL pc"`
+	PriceCents *int32            `json:"price_cents" binding:"omitempty,min=0"`
+}
+
+type IngredientPackageParams struct {
+	ID        int32  `uri:"id" binding:"required,min=1"`
+	PackageID string `uri:"package_id" binding:"required,uuid4_rfc4122"`
+}
+
+// PackageCount is how many packages of a size are bought
+type PackageCount struct {
+	PackageID  uuid.UUID         `json:"package_id"`
+	Quantity   float64           `json:"quantity"`
+	Unit       types.MeasureUnit `json:"unit"`
+	Count      int               `json:"count"`
+	PriceCents int32             `json:"price_cents,omitempty"`
+}
+
+func DBIngredientPackageToIngredientPackage(arg database.IngredientPackage) IngredientPackage {
+	return IngredientPackage{
+		ID:           arg.ID,
+		CreatedAt:    arg.CreatedAt,
+		IngredientID: arg.IngredientID,
+		Quantity:     arg.Quantity,
+		Unit:         types.MeasureUnit(arg.Unit),
+		PriceCents:   arg.PriceCents,
+	}
+}
+
+func DBIngredientPackagesToIngredientPackages(arg []database.IngredientPackage) []IngredientPackage {
+	packages := []IngredientPackage{}
+	for _, pkg := range arg {
+		packages = append(packages, DBIngredientPackageToIngredientPackage(pkg))
+	}
+	return packages
+}
+
+// PackagesToBuy picks whole packages holding at least the quantity and returns them with how much they hold
+// in the unit of the quantity. By default the packages leaving the least surplus are picked, the fewest of them
+// on a tie. Cheapest picks the packages costing the least out of the ones with a price, and falls back to the
+// default when none has one. Packages in a unit the quantity can't be converted to are skipped, false is
+// returned when none is left.
+func PackagesToBuy(quantity float64, unit types.MeasureUnit, packages []database.IngredientPackage, density float64, cheapest bool) ([]PackageCount, float64, bool) {
+	// volumes are compared in millilitres so packages in any volume unit can be combined
+	base := unit
+	if _, ok := unit.Millilitres(quantity); ok {
+		base = types.MeasureUnitMillilitres
+	}
+	need, ok := types.ConvertQuantity(quantity, unit, base, density)
+	if !ok {
+		return nil, 0, false
+	}
+
+	type candidate struct {
+		pkg   database.IngredientPackage
+		size  float64
+		steps int
+	}
+	candidates := []candidate{}
+	for _, pkg := range packages {
+		size, ok := types.ConvertQuantity(pkg.Quantity, types.MeasureUnit(pkg.Unit), base, density)
+		if !ok || (cheapest && !pkg.PriceCents.Valid) {
+			continue
+		}
+		candidates = append(candidates, candidate{pkg: pkg, size: size})
+	}
+	if len(candidates) == 0 {
+		if cheapest {
+			return PackagesToBuy(quantity, unit, packages, density, false)
+		}
+		return nil, 0, false
+	}
+
+	biggest, smallest := 0, 0
+	for i, c := range candidates {
+		if c.size > candidates[biggest].size {
+			biggest = i
+		}
+		if c.size < candidates[smallest].size {
+			smallest = i
+		}
+	}
+	// quantities are counted in steps, and packages in the whole steps they hold, so the packages picked always
+	// hold at least the need. A step is small enough for the smallest package to be minPackageSteps of them,
+	// unless the need is too big to try every quantity.
+	scale := max(minPackageSteps/candidates[smallest].size, 1)
+	scale = min(scale, maxPackageSteps/(need+candidates[biggest].size))
+	for i := range candidates {
+		candidates[i].steps = int(math.Floor(candidates[i].size*scale + 1e-9))
+	}
+
+	counts := make([]int, len(candidates))
+	if scale < 1 || candidates[smallest].steps < 1 {
+		counts[biggest] = int(math.Ceil(need/candidates[biggest].size - 1e-9))
+	} else {
+		target := int(math.Ceil(need*scale - 1e-9))
+		limit := target + candidates[biggest].steps
+		// cost[a] is the least cost of packages holding exactly a, last[a] the package added last to get there
+		cost := make([]int64, limit+1)
+		last := make([]int, limit+1)
+		for a := 1; a <= limit; a++ {
+			cost[a] = -1
+			for i, c := range candidates {
+				if c.steps > a || cost[a-c.steps] < 0 {
+					continue
+				}
+				price := int64(1)
+				if cheapest {
+					price = int64(c.pkg.PriceCents.Int32)
+				}
+				if total := cost[a-c.steps] + price; cost[a] < 0 || total < cost[a] {
+					cost[a] = total
+					last[a] = i
+				}
+			}
+		}
+		best := -1
+		for a := target; a <= limit; a++ {
+			if cost[a] < 0 {
+				continue
+			}
+			if best < 0 || (cheapest && cost[a] < cost[best]) {
+				best = a
+			}
+			if !cheapest {
+				break
+			}
+		}
+		for a := best; a > 0; a -= candidates[last[a]].steps {
+			counts[last[a]]++
+		}
+	}
+
+	result := []PackageCount{}
+	held := 0.0
+	for i, c := range candidates {
+		if counts[i] == 0 {
+			continue
+		}
+		held += float64(counts[i]) * c.size
+		result = append(result, PackageCount{
+			PackageID:  c.pkg.ID,
+			Quantity:   c.pkg.Quantity,
+			Unit:       types.MeasureUnit(c.pkg.Unit),
+			Count:      counts[i],
+			PriceCents: c.pkg.PriceCents.Int32 * int32(counts[i]),
+		})
+	}
+	purchased, _ := types.ConvertQuantity(held, base, unit, density)
+	return result, purchased, true
+}
+
+// PackageNeeds rounds what is bought of every need up to whole packages of its ingredient, the surplus is what
+// goes into the inventory after shopping. Needs of ingredients without packages are bought as they are.
+func PackageNeeds(needs []ShoppingNeed, packages map[int32][]database.IngredientPackage, ingredients map[int32]database.Ingredient, cheapest bool) []ShoppingNeed {
+	result := []ShoppingNeed{}
+	for _, need := range needs {
+		need.Purchased = need.ToBuy
+		if need.ToBuy > 0 {
+			density := ingredientDensity(ingredients[need.IngredientID])
+			counts, purchased, ok := PackagesToBuy(need.ToBuy, need.Unit, packages[need.IngredientID], density, cheapest)
+			if ok {
+				need.Packages = counts
+				need.Purchased = roundQuantity(purchased, need.Unit)
+				need.Surplus = math.Round((purchased-need.ToBuy)*100) / 100
+				for _, count := range counts {
+					need.PriceCents += count.PriceCents
+				}
+			}
+		}
+		result = append(result, need)
+	}
+	return result
+}
+
+// ingredientPackages loads the packages of every ingredient, keyed by ingredient.
+// It writes the error response itself and returns false on failure.
+func (s *Server) ingredientPackages(ctx *gin.Context) (map[int32][]database.IngredientPackage, bool) {
+	packages, err := s.store.GetIngredientPackages(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return nil, false
+	}
+	byIngredient := map[int32][]database.IngredientPackage{}
+	for _, pkg := range packages {
+		byIngredient[pkg.IngredientID] = append(byIngredient[pkg.IngredientID], pkg)
+	}
+	return byIngredient, true
+}
+
+func (s *Server) getIngredientPackages(ctx *gin.Context) {
+	var request GetIngredientByIDParams
+	err := ctx.ShouldBindUri(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	packages, err := s.store.GetIngredientPackagesByIngredientID(ctx, request.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, DBIngredientPackagesToIngredientPackages(packages))
+}
+
+func (s *Server) createIngredientPackage(ctx *gin.Context) {
+	var uri GetIngredientByIDParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	var request CreateIngredientPackageParams
+	err = ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	arg := database.CreateIngredientPackageParams{
+		IngredientID: uri.ID,
+		Quantity:     request.Quantity,
+		Unit:         string(request.Unit),
+	}
+	if request.PriceCents != nil {
+		arg.PriceCents = pgtype.Int4{Int32: *request.PriceCents, Valid: true}
+	}
+	pkg, err := s.store.CreateIngredientPackage(ctx, arg)
+	if err != nil {
+		switch database.ErrorCode(err) {
+		case database.CodeDuplicateKey:
+			ctx.JSON(http.StatusConflict, respondWithErorr(err))
+		case database.CodeForeignKeyViolation:
+			ctx.JSON(http.StatusNotFound, respondWithErorr(err))
+		default:
+			ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		}
+		return
+	}
+	ctx.JSON(http.StatusCreated, DBIngredientPackageToIngredientPackage(pkg))
+}
+
+func (s *Server) deleteIngredientPackage(ctx *gin.Context) {
+	var request IngredientPackageParams
+	err := ctx.ShouldBindUri(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	pkg, err := s.store.GetIngredientPackageByID(ctx, uuid.MustParse(request.PackageID))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, respondWithErorr(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+	if pkg.IngredientID != request.ID {
+		ctx.JSON(http.StatusNotFound, respondWithErorr(pgx.ErrNoRows))
+		return
+	}
+
+	err = s.store.DeleteIngredientPackage(ctx, pkg.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, respondWithMessage(fmt.Sprintf("deleted package with id %s", request.PackageID)))
+}
@@ -0,0 +1,226 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	database "github.com/andreiz53/cookinator/database/handlers"
+	databaseMock "github.com/andreiz53/cookinator/database/mocks"
+	"github.com/andreiz53/cookinator/types"
+)
+
+func randomIngredientPackage(ingredientID int32, quantity float64, unit string, priceCents int32) database.IngredientPackage {
+	return database.IngredientPackage{
+		ID:           uuid.New(),
+		IngredientID: ingredientID,
+		Quantity:     quantity,
+		Unit:         unit,
+		PriceCents:   pgtype.Int4{Int32: priceCents, Valid: priceCents > 0},
+	}
+}
+
+func TestPackagesToBuy(t *testing.T) {
+	kilo := randomIngredientPackage(1, 1000, types.MeasureUnitGrams, 450)
+	half := randomIngredientPackage(1, 500, types.MeasureUnitGrams, 200)
+	flour := []database.IngredientPackage{half, kilo}
+
+	// 350 g fit in the smallest bag
+	counts, purchased, ok := PackagesToBuy(350, types.MeasureUnitGrams, flour, 0, false)
+	require.True(t, ok)
+	require.Equal(t, []PackageCount{{PackageID: half.ID, Quantity: 500, Unit: types.MeasureUnitGrams, Count: 1, PriceCents: 200}}, counts)
+	require.Equal(t, 500.0, purchased)
+
+	// one bag of a kilo leaves as much as two of half a kilo, but two of half a kilo are cheaper
+	counts, purchased, ok = PackagesToBuy(900, types.MeasureUnitGrams, flour, 0, false)
+	require.True(t, ok)
+	require.Len(t, counts, 1)
+	require.Equal(t, kilo.ID, counts[0].PackageID)
+	require.Equal(t, 1000.0, purchased)
+
+	counts, purchased, ok = PackagesToBuy(900, types.MeasureUnitGrams, flour, 0, true)
+	require.True(t, ok)
+	require.Equal(t, []PackageCount{{PackageID: half.ID, Quantity: 500, Unit: types.MeasureUnitGrams, Count: 2, PriceCents: 400}}, counts)
+	require.Equal(t, 1000.0, purchased)
+
+	// without prices the cheapest packages are the ones leaving the least
+	eggs := []database.IngredientPackage{
+		randomIngredientPackage(2, 6, types.MeasureUnitPiece, 0),
+		randomIngredientPackage(2, 10, types.MeasureUnitPiece, 0),
+	}
+	counts, purchased, ok = PackagesToBuy(8, types.MeasureUnitPiece, eggs, 0, true)
+	require.True(t, ok)
+	require.Len(t, counts, 1)
+	require.Equal(t, 10.0, counts[0].Quantity)
+	require.Equal(t, 10.0, purchased)
+
+	// a carton of milk in millilitres holds cups, and grams only with the density
+	milk := []database.IngredientPackage{randomIngredientPackage(3, 1000, types.MeasureUnitMillilitres, 0)}
+	counts, purchased, ok = PackagesToBuy(2, types.MeasureUnitCup, milk, 0, false)
+	require.True(t, ok)
+	require.Equal(t, 1, counts[0].Count)
+	require.InDelta(t, 4.17, purchased, 0.01)
+
+	_, _, ok = PackagesToBuy(300, types.MeasureUnitGrams, milk, 0, false)
+	require.False(t, ok)
+	counts, purchased, ok = PackagesToBuy(1500, types.MeasureUnitGrams, milk, 1.03, false)
+	require.True(t, ok)
+	require.Equal(t, 2, counts[0].Count)
+	require.InDelta(t, 2060, purchased, 0.01)
+
+	// sachets of 1.6 g hold 5 g in four of them, not three
+	yeast := []database.IngredientPackage{randomIngredientPackage(4, 1.6, types.MeasureUnitGrams, 0)}
+	counts, purchased, ok = PackagesToBuy(5, types.MeasureUnitGrams, yeast, 0, false)
+	require.True(t, ok)
+	require.Equal(t, 4, counts[0].Count)
+	require.InDelta(t, 6.4, purchased, 0.01)
+
+	// too much to try every quantity is bought in the biggest package
+	counts, purchased, ok = PackagesToBuy(250_000, types.MeasureUnitGrams, flour, 0, false)
+	require.True(t, ok)
+	require.Equal(t, []PackageCount{{PackageID: kilo.ID, Quantity: 1000, Unit: types.MeasureUnitGrams, Count: 250, PriceCents: 450 * 250}}, counts)
+	require.Equal(t, 250_000.0, purchased)
+}
+
+func TestPackageNeeds(t *testing.T) {
+	flour := database.Ingredient{ID: 1, Name: "flour"}
+	salt := database.Ingredient{ID: 2, Name: "salt"}
+	ingredients := map[int32]database.Ingredient{flour.ID: flour, salt.ID: salt}
+	bag := randomIngredientPackage(flour.ID, 1000, types.MeasureUnitGrams, 150)
+	packages := map[int32][]database.IngredientPackage{flour.ID: {bag}}
+
+	needs := PackageNeeds([]ShoppingNeed{
+		{IngredientID: flour.ID, Name: flour.Name, Unit: types.MeasureUnitGrams, Required: 350, ToBuy: 350},
+		{IngredientID: salt.ID, Name: salt.Name, Unit: types.MeasureUnitGrams, Required: 5, ToBuy: 5},
+		{IngredientID: flour.ID, Name: flour.Name, Unit: types.MeasureUnitPiece, Required: 1, OnHand: 1},
+	}, packages, ingredients, false)
+
+	require.Len(t, needs, 3)
+	require.Equal(t, 1000.0, needs[0].Purchased)
+	require.Equal(t, 650.0, needs[0].Surplus)
+	require.Equal(t, int32(150), needs[0].PriceCents)
+	require.Len(t, needs[0].Packages, 1)
+
+	// salt isn't sold in packages and the last need is already on hand
+	require.Equal(t, 5.0, needs[1].Purchased)
+	require.Zero(t, needs[1].Surplus)
+	require.Empty(t, needs[1].Packages)
+	require.Zero(t, needs[2].Purchased)
+	require.Empty(t, needs[2].Packages)
+}
+
+func TestCreateIngredientPackage(t *testing.T) {
+	ingredient := randomIngredient()
+	price := int32(249)
+	params := CreateIngredientPackageParams{Quantity: 1000, Unit: types.MeasureUnitGrams, PriceCents: &price}
+	pkg := randomIngredientPackage(ingredient.ID, params.Quantity, types.MeasureUnitGrams, price)
+	arg := database.CreateIngredientPackageParams{
+		IngredientID: ingredient.ID,
+		Quantity:     params.Quantity,
+		Unit:         types.MeasureUnitGrams,
+		PriceCents:   pgtype.Int4{Int32: price, Valid: true},
+	}
+
+	testCases := []struct {
+		name          string
+		params        CreateIngredientPackageParams
+		stubs         func(store *databaseMock.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "OK",
+			params: params,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					CreateIngredientPackage(mock.Anything, arg).
+					Times(1).Return(pkg, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				response, err := decodeJSON[IngredientPackage](recorder.Body)
+				require.NoError(t, err)
+				require.Equal(t, pkg.ID, response.ID)
+				require.Equal(t, pkg.PriceCents, response.PriceCents)
+			},
+		},
+		{
+			name:   "WithoutPrice",
+			params: CreateIngredientPackageParams{Quantity: 6, Unit: types.MeasureUnitPiece},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					CreateIngredientPackage(mock.Anything, database.CreateIngredientPackageParams{
+						IngredientID: ingredient.ID,
+						Quantity:     6,
+						Unit:         types.MeasureUnitPiece,
+					}).
+					Times(1).Return(randomIngredientPackage(ingredient.ID, 6, types.MeasureUnitPiece, 0), nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+			},
+		},
+		{
+			name:   "SameSize",
+			params: params,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					CreateIngredientPackage(mock.Anything, arg).
+					Times(1).Return(database.IngredientPackage{}, database.ErrDuplicateKey)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name:   "UnknownIngredient",
+			params: params,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					CreateIngredientPackage(mock.Anything, arg).
+					Times(1).Return(database.IngredientPackage{}, database.ErrForeignKeyViolation)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:   "SpoonUnit",
+			params: CreateIngredientPackageParams{Quantity: 1, Unit: types.MeasureUnitTablespoon},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					CreateIngredientPackage(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			server := newTestServer(t, store)
+			tc.stubs(store)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/ingredients/%d/packages", ingredient.ID)
+			data, err := encodeJSON(tc.params)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
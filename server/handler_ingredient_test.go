@@ -13,13 +13,16 @@ import (
 
 	database "github.com/andreiz53/cookinator/database/handlers"
 	databaseMock "github.com/andreiz53/cookinator/database/mocks"
+	"github.com/andreiz53/cookinator/types"
 	"github.com/andreiz53/cookinator/util"
 )
 
 func TestCreateIngredient(t *testing.T) {
 	ingredientParams := database.CreateIngredientParams{
-		Name:    util.RandomName(),
-		Density: util.RandomPGNumeric(),
+		Name:      util.RandomName(),
+		Density:   util.RandomPGNumeric(),
+		Allergens: []string{types.AllergenGluten},
+		Category:  types.GroceryCategoryBakery,
 	}
 
 	testCases := []struct {
@@ -414,9 +417,10 @@ func TestDeleteIngredient(t *testing.T) {
 
 func randomIngredient() database.Ingredient {
 	return database.Ingredient{
-		ID:      int32(util.RandomInt(1, 1000)),
-		Name:    util.RandomName(),
-		Density: util.RandomPGNumeric(),
+		ID:       int32(util.RandomInt(1, 1000)),
+		Name:     util.RandomName(),
+		Density:  util.RandomPGNumeric(),
+		Category: types.GroceryCategoryOther,
 	}
 }
 
@@ -0,0 +1,310 @@
+package server
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	database "github.com/andreiz53/cookinator/database/handlers"
+	"github.com/andreiz53/cookinator/types"
+)
+
+// InventoryItem is how much of an ingredient the family has in the kitchen. KeepAtLeast is the safety
+// margin of a staple, in the same unit, which shopping lists never plan to cook into.
+type InventoryItem struct {
+	ID             uuid.UUID         `json:"id"`
+	CreatedAt      pgtype.Timestamp  `json:"created_at"`
+	UpdatedAt      pgtype.Timestamp  `json:"updated_at"`
+	FamilyID       uuid.UUID         `json:"family_id"`
+	IngredientID   int32             `json:"ingredient_id"`
+	IngredientName string            `json:"ingredient_name,omitempty"`
+	Quantity       float64           `json:"quantity"`
+	Unit           types.MeasureUnit `json:"unit"`
+	KeepAtLeast    float64           `json:"keep_at_least"`
+}
+
+type CreateInventoryItemParams struct {
+	IngredientID int32             `json:"ingredient_id" binding:"required,min=1"`
+	Quantity     float64           `json:"quantity" binding:"gte=0"`
+	Unit         types.MeasureUnit `json:"unit" binding:"required,oneof=g mL tsp tbsp pc cup"`
+	KeepAtLeast  float64           `json:"keep_at_least" binding:"gte=0"`
+}
+
+type UpdateInventoryItemParams struct {
+	Quantity    float64           `json:"quantity" binding:"gte=0"`
+	Unit        types.MeasureUnit `json:"unit" binding:"required,oneof=g mL tsp tbsp pc cup"`
+	KeepAtLeast float64           `json:"keep_at_least" binding:"gte=0"`
+}
+
+type InventoryItemParams struct {
+	ID string `uri:"id" binding:"required,uuid4_rfc4122"`
+}
+
+// ShoppingNeed compares what the meals of a week need of an ingredient with what is on hand, in the unit of the need.
+// Purchased is what is bought rounded up to whole Packages, the Surplus is left over for the inventory.
+type ShoppingNeed struct {
+	IngredientID int32             `json:"ingredient_id"`
+	Name         string            `json:"name"`
+	Unit         types.MeasureUnit `json:"unit"`
+	Required     float64           `json:"required"`
+	OnHand       float64           `json:"on_hand"`
+	KeepAtLeast  float64           `json:"keep_at_least"`
+	ToBuy        float64           `json:"to_buy"`
+	Packages     []PackageCount    `json:"packages,omitempty"`
+	Purchased    float64           `json:"purchased"`
+	Surplus      float64           `json:"surplus"`
+	PriceCents   int32             `json:"price_cents,omitempty"`
+}
+
+func DBInventoryItemToInventoryItem(arg database.InventoryItem) InventoryItem {
+	return InventoryItem{
+		ID:           arg.ID,
+		CreatedAt:    arg.CreatedAt,
+		UpdatedAt:    arg.UpdatedAt,
+		FamilyID:     arg.FamilyID,
+		IngredientID: arg.IngredientID,
+		Quantity:     arg.Quantity,
+		Unit:         types.MeasureUnit(arg.Unit),
+		KeepAtLeast:  arg.KeepAtLeast,
+	}
+}
+
+func DBInventoryToInventory(arg []database.GetInventoryByFamilyIDRow) []InventoryItem {
+	inventory := []InventoryItem{}
+	for _, row := range arg {
+		item := DBInventoryItemToInventoryItem(database.InventoryItem{
+			ID:           row.ID,
+			CreatedAt:    row.CreatedAt,
+			UpdatedAt:    row.UpdatedAt,
+			FamilyID:     row.FamilyID,
+			IngredientID: row.IngredientID,
+			Quantity:     row.Quantity,
+			Unit:         row.Unit,
+			KeepAtLeast:  row.KeepAtLeast,
+		})
+		item.IngredientName = row.IngredientName
+		inventory = append(inventory, item)
+	}
+	return inventory
+}
+
+// roundQuantity rounds to two decimals, pieces are rounded up to whole ones
+func roundQuantity(quantity float64, unit types.MeasureUnit) float64 {
+	if unit == types.MeasureUnitPiece {
+		return math.Ceil(quantity - 1e-9)
+	}
+	return math.Round(quantity*100) / 100
+}
+
+// SubtractInventory works out what to buy of each item so the meals can be cooked and the safety margin of
+// every staple is still on hand after. The inventory is converted to the unit of the item, an ingredient on
+// hand in a unit that can't be converted counts as missing. Staples already below their margin are restocked
+// even when no meal needs them.
+func SubtractInventory(items []ShoppingItem, inventory []database.GetInventoryByFamilyIDRow, ingredients map[int32]database.Ingredient) []ShoppingNeed {
+	stock := map[int32]database.GetInventoryByFamilyIDRow{}
+	for _, row := range inventory {
+		stock[row.IngredientID] = row
+	}
+
+	needs := []ShoppingNeed{}
+	for _, item := range items {
+		need := ShoppingNeed{
+			IngredientID: item.IngredientID,
+			Name:         item.Name,
+			Unit:         item.Unit,
+			Required:     item.Quantity,
+		}
+		if row, ok := stock[item.IngredientID]; ok {
+			density := ingredientDensity(ingredients[item.IngredientID])
+			onHand, ok := types.ConvertQuantity(row.Quantity, types.MeasureUnit(row.Unit), item.Unit, density)
+			if ok {
+				keep, _ := types.ConvertQuantity(row.KeepAtLeast, types.MeasureUnit(row.Unit), item.Unit, density)
+				need.OnHand = math.Round(onHand*100) / 100
+				need.KeepAtLeast = math.Round(keep*100) / 100
+				delete(stock, item.IngredientID)
+			}
+		}
+		need.ToBuy = roundQuantity(max(need.Required+need.KeepAtLeast-need.OnHand, 0), need.Unit)
+		needs = append(needs, need)
+	}
+
+	for _, row := range stock {
+		if row.Quantity >= row.KeepAtLeast {
+			continue
+		}
+		unit := types.MeasureUnit(row.Unit)
+		needs = append(needs, ShoppingNeed{
+			IngredientID: row.IngredientID,
+			Name:         row.IngredientName,
+			Unit:         unit,
+			OnHand:       row.Quantity,
+			KeepAtLeast:  row.KeepAtLeast,
+			ToBuy:        roundQuantity(row.KeepAtLeast-row.Quantity, unit),
+		})
+	}
+	sort.Slice(needs, func(i, j int) bool {
+		if needs[i].Name != needs[j].Name {
+			return needs[i].Name < needs[j].Name
+		}
+		return needs[i].Unit < needs[j].Unit
+	})
+	return needs
+}
+
+// familyInventoryItem loads an inventory item and makes sure it belongs to the user's family.
+// It writes the error response itself and returns false on failure.
+func (s *Server) familyInventoryItem(ctx *gin.Context, user database.User, id uuid.UUID) (database.InventoryItem, bool) {
+	item, err := s.store.GetInventoryItemByID(ctx, id)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, respondWithErorr(err))
+			return item, false
+		}
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return item, false
+	}
+	if item.FamilyID != user.FamilyID {
+		ctx.JSON(http.StatusForbidden, respondWithErorr(errForbidden))
+		return item, false
+	}
+	return item, true
+}
+
+func (s *Server) getInventory(ctx *gin.Context) {
+	var uri FamilyMealPlansParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	familyID := uuid.MustParse(uri.ID)
+	_, ok := s.authFamilyMember(ctx, familyID)
+	if !ok {
+		return
+	}
+
+	inventory, err := s.store.GetInventoryByFamilyID(ctx, familyID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, DBInventoryToInventory(inventory))
+}
+
+// createInventoryItem stocks an ingredient, each ingredient is kept once and updated after
+func (s *Server) createInventoryItem(ctx *gin.Context) {
+	var uri FamilyMealPlansParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	var request CreateInventoryItemParams
+	err = ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	familyID := uuid.MustParse(uri.ID)
+	_, ok := s.authFamilyMember(ctx, familyID)
+	if !ok {
+		return
+	}
+
+	item, err := s.store.CreateInventoryItem(ctx, database.CreateInventoryItemParams{
+		FamilyID:     familyID,
+		IngredientID: request.IngredientID,
+		Quantity:     request.Quantity,
+		Unit:         string(request.Unit),
+		KeepAtLeast:  request.KeepAtLeast,
+	})
+	if err != nil {
+		switch database.ErrorCode(err) {
+		case database.CodeDuplicateKey:
+			ctx.JSON(http.StatusConflict, respondWithErorr(err))
+		case database.CodeForeignKeyViolation:
+			ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		default:
+			ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, DBInventoryItemToInventoryItem(item))
+}
+
+func (s *Server) updateInventoryItem(ctx *gin.Context) {
+	var uri InventoryItemParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	var request UpdateInventoryItemParams
+	err = ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	item, ok := s.familyInventoryItem(ctx, user, uuid.MustParse(uri.ID))
+	if !ok {
+		return
+	}
+
+	item, err = s.store.UpdateInventoryItem(ctx, database.UpdateInventoryItemParams{
+		ID:          item.ID,
+		Quantity:    request.Quantity,
+		Unit:        string(request.Unit),
+		KeepAtLeast: request.KeepAtLeast,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, DBInventoryItemToInventoryItem(item))
+}
+
+func (s *Server) deleteInventoryItem(ctx *gin.Context) {
+	var uri InventoryItemParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	item, ok := s.familyInventoryItem(ctx, user, uuid.MustParse(uri.ID))
+	if !ok {
+		return
+	}
+
+	err = s.store.DeleteInventoryItem(ctx, item.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, respondWithMessage(fmt.Sprintf("deleted inventory item with id %s", uri.ID)))
+}
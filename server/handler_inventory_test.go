@@ -0,0 +1,160 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	database "github.com/andreiz53/cookinator/database/handlers"
+	databaseMock "github.com/andreiz53/cookinator/database/mocks"
+	"github.com/andreiz53/cookinator/types"
+)
+
+func TestSubtractInventory(t *testing.T) {
+	flour := database.Ingredient{ID: 1, Name: "flour"}
+	err := flour.Density.Scan("0.5")
+	require.NoError(t, err)
+	eggs := database.Ingredient{ID: 2, Name: "eggs"}
+	milk := database.Ingredient{ID: 3, Name: "milk"}
+	salt := database.Ingredient{ID: 4, Name: "salt"}
+	ingredients := map[int32]database.Ingredient{flour.ID: flour, eggs.ID: eggs, milk.ID: milk, salt.ID: salt}
+
+	items := []ShoppingItem{
+		{IngredientID: eggs.ID, Name: eggs.Name, Quantity: 6, Unit: types.MeasureUnitPiece},
+		{IngredientID: flour.ID, Name: flour.Name, Quantity: 500, Unit: types.MeasureUnitGrams},
+		{IngredientID: milk.ID, Name: milk.Name, Quantity: 250, Unit: types.MeasureUnitMillilitres},
+	}
+	inventory := []database.GetInventoryByFamilyIDRow{
+		// 2 cups of flour weigh 240 g
+		{IngredientID: flour.ID, IngredientName: flour.Name, Quantity: 2, Unit: types.MeasureUnitCup},
+		{IngredientID: eggs.ID, IngredientName: eggs.Name, Quantity: 10, Unit: types.MeasureUnitPiece, KeepAtLeast: 2},
+		// grams of milk can't be converted without its density
+		{IngredientID: milk.ID, IngredientName: milk.Name, Quantity: 1000, Unit: types.MeasureUnitGrams},
+		{IngredientID: salt.ID, IngredientName: salt.Name, Quantity: 100, Unit: types.MeasureUnitGrams, KeepAtLeast: 250},
+	}
+
+	needs := SubtractInventory(items, inventory, ingredients)
+	require.Equal(t, []ShoppingNeed{
+		{IngredientID: eggs.ID, Name: eggs.Name, Unit: types.MeasureUnitPiece, Required: 6, OnHand: 10, KeepAtLeast: 2, ToBuy: 0},
+		{IngredientID: flour.ID, Name: flour.Name, Unit: types.MeasureUnitGrams, Required: 500, OnHand: 240, ToBuy: 260},
+		{IngredientID: milk.ID, Name: milk.Name, Unit: types.MeasureUnitMillilitres, Required: 250, ToBuy: 250},
+		{IngredientID: salt.ID, Name: salt.Name, Unit: types.MeasureUnitGrams, OnHand: 100, KeepAtLeast: 250, ToBuy: 150},
+	}, needs)
+}
+
+func TestCreateInventoryItem(t *testing.T) {
+	user := randomFamilyUser(t)
+	params := CreateInventoryItemParams{IngredientID: 3, Quantity: 500, Unit: types.MeasureUnitGrams, KeepAtLeast: 100}
+	item := database.InventoryItem{
+		ID:           uuid.New(),
+		FamilyID:     user.FamilyID,
+		IngredientID: params.IngredientID,
+		Quantity:     params.Quantity,
+		Unit:         string(params.Unit),
+		KeepAtLeast:  params.KeepAtLeast,
+	}
+	arg := database.CreateInventoryItemParams{
+		FamilyID:     user.FamilyID,
+		IngredientID: params.IngredientID,
+		Quantity:     params.Quantity,
+		Unit:         string(params.Unit),
+		KeepAtLeast:  params.KeepAtLeast,
+	}
+
+	testCases := []struct {
+		name          string
+		params        CreateInventoryItemParams
+		stubs         func(store *databaseMock.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "OK",
+			params: params,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					CreateInventoryItem(mock.Anything, arg).
+					Times(1).Return(item, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				response, err := decodeJSON[InventoryItem](recorder.Body)
+				require.NoError(t, err)
+				require.Equal(t, item.ID, response.ID)
+				require.Equal(t, item.KeepAtLeast, response.KeepAtLeast)
+			},
+		},
+		{
+			name:   "AlreadyStocked",
+			params: params,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					CreateInventoryItem(mock.Anything, arg).
+					Times(1).Return(database.InventoryItem{}, database.ErrDuplicateKey)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name:   "UnknownIngredient",
+			params: params,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					CreateInventoryItem(mock.Anything, arg).
+					Times(1).Return(database.InventoryItem{}, database.ErrForeignKeyViolation)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "NegativeQuantity",
+			params: CreateInventoryItemParams{IngredientID: 3, Quantity: -1, Unit: types.MeasureUnitGrams},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			server := newTestServer(t, store)
+			tc.stubs(store)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/families/%s/inventory", user.FamilyID.String())
+			data, err := encodeJSON(tc.params)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+			setAuth(t, request, server.tokenMaker, authHeaderTypeBearer, user.Email, time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
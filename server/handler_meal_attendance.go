@@ -0,0 +1,460 @@
+package server
+
+import (
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	database "github.com/andreiz53/cookinator/database/handlers"
+	"github.com/andreiz53/cookinator/types"
+	"github.com/andreiz53/cookinator/util"
+)
+
+// MemberAttendance is the share of a serving a member eats and the meals they usually skip
+type MemberAttendance struct {
+	UserID        uuid.UUID     `json:"user_id"`
+	FirstName     string        `json:"first_name"`
+	PortionFactor float64       `json:"portion_factor"`
+	Absences      []MealAbsence `json:"absences"`
+}
+
+// MealAbsence is a meal of the week a member usually skips, weekday 0 is Monday
+type MealAbsence struct {
+	Weekday int32          `json:"weekday" binding:"min=0,max=6"`
+	Slot    types.MealSlot `json:"slot" binding:"required,oneof=breakfast lunch dinner snack"`
+}
+
+// MealAttendance is who eats a meal of a plan and the servings it needs
+type MealAttendance struct {
+	Day      pgtype.Date       `json:"day"`
+	Slot     types.MealSlot    `json:"slot"`
+	Members  []AttendingMember `json:"members"`
+	Guests   int32             `json:"guests"`
+	Servings int32             `json:"servings"`
+}
+
+type AttendingMember struct {
+	UserID    uuid.UUID `json:"user_id"`
+	FirstName string    `json:"first_name"`
+	Attending bool      `json:"attending"`
+}
+
+type MemberAttendanceParams struct {
+	ID     string `uri:"id" binding:"required,uuid4_rfc4122"`
+	UserID string `uri:"user_id" binding:"required,uuid4_rfc4122"`
+}
+
+// SetMemberAttendanceParams sets how much a member eats, kids usually eat half a serving
+type SetMemberAttendanceParams struct {
+	PortionFactor float64       `json:"portion_factor" binding:"required,gt=0,lte=4"`
+	Absences      []MealAbsence `json:"absences" binding:"max=28,dive"`
+}
+
+// SetMealAttendanceParams sets who eats a meal, members left out follow their usual pattern
+type SetMealAttendanceParams struct {
+	Day     string             `json:"day" binding:"required,datetime=2006-01-02"`
+	Slot    types.MealSlot     `json:"slot" binding:"required,oneof=breakfast lunch dinner snack"`
+	Guests  int32              `json:"guests" binding:"min=0,max=100"`
+	Members []MealMemberParams `json:"members" binding:"dive"`
+}
+
+type MealMemberParams struct {
+	UserID    string `json:"user_id" binding:"required,uuid4_rfc4122"`
+	Attending bool   `json:"attending"`
+}
+
+type plannedMeal struct {
+	day  time.Time
+	slot string
+}
+
+type memberMeal struct {
+	userID  uuid.UUID
+	weekday int
+	slot    string
+}
+
+type memberDay struct {
+	userID uuid.UUID
+	meal   plannedMeal
+}
+
+// attendance knows who eats the meals of a family. Members eat every meal except the ones they
+// usually skip, and the attendance set for a single meal overrides both.
+type attendance struct {
+	members  []database.User
+	absent   map[memberMeal]bool
+	override map[memberDay]bool
+	guests   map[plannedMeal]int32
+}
+
+func newAttendance(members []database.User, absences []database.MemberMealAbsence, meals []database.MealAttendance, guests []database.MealGuest) attendance {
+	a := attendance{
+		members:  members,
+		absent:   map[memberMeal]bool{},
+		override: map[memberDay]bool{},
+		guests:   map[plannedMeal]int32{},
+	}
+	for _, absence := range absences {
+		a.absent[memberMeal{absence.UserID, int(absence.Weekday), absence.Slot}] = true
+	}
+	for _, meal := range meals {
+		a.override[memberDay{meal.UserID, plannedMeal{meal.Day.Time, meal.Slot}}] = meal.Attending
+	}
+	for _, meal := range guests {
+		a.guests[plannedMeal{meal.Day.Time, meal.Slot}] = meal.Guests
+	}
+	return a
+}
+
+func (a attendance) attending(userID uuid.UUID, day time.Time, slot string) bool {
+	attending, ok := a.override[memberDay{userID, plannedMeal{day, slot}}]
+	if ok {
+		return attending
+	}
+	return !a.absent[memberMeal{userID, util.Weekday(day), slot}]
+}
+
+func (a attendance) member(userID uuid.UUID) bool {
+	for _, member := range a.members {
+		if member.ID == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// servings adds up the portions of the members eating a meal and a serving per guest, rounded up.
+// A meal is never planned for less than a serving, even when nobody is home.
+func (a attendance) servings(day time.Time, slot string) int32 {
+	total := float64(a.guests[plannedMeal{day, slot}])
+	for _, member := range a.members {
+		if a.attending(member.ID, day, slot) {
+			total += member.PortionFactor
+		}
+	}
+	return max(int32(math.Ceil(total-1e-9)), 1)
+}
+
+func (a attendance) meal(day time.Time, slot string) MealAttendance {
+	meal := MealAttendance{
+		Day:      util.NewDate(day),
+		Slot:     types.MealSlot(slot),
+		Members:  []AttendingMember{},
+		Guests:   a.guests[plannedMeal{day, slot}],
+		Servings: a.servings(day, slot),
+	}
+	for _, member := range a.members {
+		meal.Members = append(meal.Members, AttendingMember{
+			UserID:    member.ID,
+			FirstName: member.FirstName,
+			Attending: a.attending(member.ID, day, slot),
+		})
+	}
+	return meal
+}
+
+func DBMembersToMemberAttendance(members []database.User, absences []database.MemberMealAbsence) []MemberAttendance {
+	result := []MemberAttendance{}
+	for _, member := range members {
+		attendance := MemberAttendance{
+			UserID:        member.ID,
+			FirstName:     member.FirstName,
+			PortionFactor: member.PortionFactor,
+			Absences:      []MealAbsence{},
+		}
+		for _, absence := range absences {
+			if absence.UserID == member.ID {
+				attendance.Absences = append(attendance.Absences, MealAbsence{
+					Weekday: absence.Weekday,
+					Slot:    types.MealSlot(absence.Slot),
+				})
+			}
+		}
+		result = append(result, attendance)
+	}
+	return result
+}
+
+// familyAttendance loads who eats the meals of a family between from and to.
+// It writes the error response itself and returns false on failure.
+func (s *Server) familyAttendance(ctx *gin.Context, familyID uuid.UUID, from, to time.Time) (attendance, bool) {
+	members, err := s.store.GetUsersByFamilyID(ctx, familyID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return attendance{}, false
+	}
+	absences, err := s.store.GetMemberMealAbsencesByFamilyID(ctx, familyID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return attendance{}, false
+	}
+	meals, err := s.store.GetMealAttendanceByFamilyID(ctx, database.GetMealAttendanceByFamilyIDParams{
+		FamilyID: familyID,
+		FromDay:  util.NewDate(from),
+		ToDay:    util.NewDate(to),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return attendance{}, false
+	}
+	guests, err := s.store.GetMealGuestsByFamilyID(ctx, database.GetMealGuestsByFamilyIDParams{
+		FamilyID: familyID,
+		FromDay:  util.NewDate(from),
+		ToDay:    util.NewDate(to),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return attendance{}, false
+	}
+	return newAttendance(members, absences, meals, guests), true
+}
+
+// weekAttendance loads who eats the meals of a plan's week.
+// It writes the error response itself and returns false on failure.
+func (s *Server) weekAttendance(ctx *gin.Context, plan database.MealPlan) (attendance, bool) {
+	return s.familyAttendance(ctx, plan.FamilyID, plan.WeekStart.Time, plan.WeekStart.Time.AddDate(0, 0, 7))
+}
+
+func (s *Server) getFamilyAttendance(ctx *gin.Context) {
+	var request FamilyMealPlansParams
+	err := ctx.ShouldBindUri(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	familyID := uuid.MustParse(request.ID)
+	_, ok := s.authFamilyMember(ctx, familyID)
+	if !ok {
+		return
+	}
+
+	members, err := s.store.GetUsersByFamilyID(ctx, familyID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+	absences, err := s.store.GetMemberMealAbsencesByFamilyID(ctx, familyID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, DBMembersToMemberAttendance(members, absences))
+}
+
+// setMemberAttendance sets the portion and usual absences of a family member.
+// The servings of the upcoming meals that follow attendance are computed again.
+func (s *Server) setMemberAttendance(ctx *gin.Context) {
+	var uri MemberAttendanceParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	var request SetMemberAttendanceParams
+	err = ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	familyID := uuid.MustParse(uri.ID)
+	_, ok := s.authFamilyMember(ctx, familyID)
+	if !ok {
+		return
+	}
+
+	today := util.NewDate(time.Now())
+	meals, err := s.store.GetAutoServingsMealsByFamilyID(ctx, database.GetAutoServingsMealsByFamilyIDParams{
+		FamilyID: familyID,
+		FromDay:  today,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+	to := today.Time
+	for _, meal := range meals {
+		if !meal.Day.Time.Before(to) {
+			to = meal.Day.Time.AddDate(0, 0, 1)
+		}
+	}
+
+	attendance, ok := s.familyAttendance(ctx, familyID, today.Time, to)
+	if !ok {
+		return
+	}
+
+	userID := uuid.MustParse(uri.UserID)
+	if !attendance.member(userID) {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(errNotFamilyMember))
+		return
+	}
+
+	arg := database.SetMemberAttendanceTxParams{
+		UserID:        userID,
+		PortionFactor: request.PortionFactor,
+	}
+	for i := range attendance.members {
+		if attendance.members[i].ID == userID {
+			attendance.members[i].PortionFactor = request.PortionFactor
+		}
+	}
+	for key := range attendance.absent {
+		if key.userID == userID {
+			delete(attendance.absent, key)
+		}
+	}
+	for _, absence := range request.Absences {
+		arg.Absences = append(arg.Absences, database.CreateMemberMealAbsenceParams{
+			Weekday: absence.Weekday,
+			Slot:    string(absence.Slot),
+		})
+		attendance.absent[memberMeal{userID, int(absence.Weekday), string(absence.Slot)}] = true
+	}
+
+	for _, meal := range meals {
+		arg.Servings = append(arg.Servings, database.UpdateAutoMealPlanEntryServingsParams{
+			Servings:   attendance.servings(meal.Day.Time, meal.Slot),
+			MealPlanID: meal.MealPlanID,
+			Day:        meal.Day,
+			Slot:       meal.Slot,
+		})
+	}
+
+	user, err := s.store.SetMemberAttendanceTx(ctx, arg)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, MemberAttendance{
+		UserID:        user.ID,
+		FirstName:     user.FirstName,
+		PortionFactor: user.PortionFactor,
+		Absences:      append([]MealAbsence{}, request.Absences...),
+	})
+}
+
+// getMealPlanAttendance lists who eats each meal of the week and the servings it needs
+func (s *Server) getMealPlanAttendance(ctx *gin.Context) {
+	var request GetMealPlanByIDParams
+	err := ctx.ShouldBindUri(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	plan, ok := s.familyMealPlan(ctx, user, uuid.MustParse(request.ID))
+	if !ok {
+		return
+	}
+
+	attendance, ok := s.weekAttendance(ctx, plan)
+	if !ok {
+		return
+	}
+
+	meals := []MealAttendance{}
+	for i := 0; i < 7; i++ {
+		day := plan.WeekStart.Time.AddDate(0, 0, i)
+		for _, slot := range types.MealSlots {
+			meals = append(meals, attendance.meal(day, string(slot)))
+		}
+	}
+
+	ctx.JSON(http.StatusOK, meals)
+}
+
+// setMealAttendance sets who eats a meal of the week and how many guests join.
+// Entries of the meal whose servings follow attendance get the new servings.
+func (s *Server) setMealAttendance(ctx *gin.Context) {
+	var uri GetMealPlanByIDParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	var request SetMealAttendanceParams
+	err = ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	day, err := util.ParseDate(request.Day)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	plan, ok := s.familyMealPlan(ctx, user, uuid.MustParse(uri.ID))
+	if !ok {
+		return
+	}
+	if !util.InWeek(day, plan.WeekStart) {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(errDayNotInWeek))
+		return
+	}
+
+	attendance, ok := s.weekAttendance(ctx, plan)
+	if !ok {
+		return
+	}
+
+	slot := string(request.Slot)
+	meal := plannedMeal{day.Time, slot}
+	arg := database.SetMealAttendanceTxParams{
+		Meal: database.UpsertMealGuestsParams{
+			MealPlanID: plan.ID,
+			Day:        day,
+			Slot:       slot,
+			Guests:     request.Guests,
+		},
+	}
+	for key := range attendance.override {
+		if key.meal == meal {
+			delete(attendance.override, key)
+		}
+	}
+	for _, member := range request.Members {
+		userID := uuid.MustParse(member.UserID)
+		if !attendance.member(userID) {
+			ctx.JSON(http.StatusBadRequest, respondWithErorr(errNotFamilyMember))
+			return
+		}
+		arg.Members = append(arg.Members, database.CreateMealAttendanceParams{
+			UserID:    userID,
+			Attending: member.Attending,
+		})
+		attendance.override[memberDay{userID, meal}] = member.Attending
+	}
+	attendance.guests[meal] = request.Guests
+	arg.Servings = attendance.servings(day.Time, slot)
+
+	_, err = s.store.SetMealAttendanceTx(ctx, arg)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, attendance.meal(day.Time, slot))
+}
@@ -0,0 +1,334 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	database "github.com/andreiz53/cookinator/database/handlers"
+	databaseMock "github.com/andreiz53/cookinator/database/mocks"
+	"github.com/andreiz53/cookinator/types"
+	"github.com/andreiz53/cookinator/util"
+)
+
+// attendanceStubs loads a family where nobody changed who eats a meal and no guests are invited
+func attendanceStubs(store *databaseMock.MockStore, familyID uuid.UUID, members []database.User, absences []database.MemberMealAbsence) {
+	store.EXPECT().
+		GetUsersByFamilyID(mock.Anything, familyID).
+		Times(1).Return(members, nil)
+	store.EXPECT().
+		GetMemberMealAbsencesByFamilyID(mock.Anything, familyID).
+		Times(1).Return(absences, nil)
+	store.EXPECT().
+		GetMealAttendanceByFamilyID(mock.Anything, mock.MatchedBy(func(arg database.GetMealAttendanceByFamilyIDParams) bool {
+			return arg.FamilyID == familyID
+		})).
+		Times(1).Return([]database.MealAttendance{}, nil)
+	store.EXPECT().
+		GetMealGuestsByFamilyID(mock.Anything, mock.MatchedBy(func(arg database.GetMealGuestsByFamilyIDParams) bool {
+			return arg.FamilyID == familyID
+		})).
+		Times(1).Return([]database.MealGuest{}, nil)
+}
+
+func TestAttendanceServings(t *testing.T) {
+	monday := util.WeekStart(time.Now()).Time
+	tuesday := monday.AddDate(0, 0, 1)
+
+	parent, kid, other := randomUser(t), randomUser(t), randomUser(t)
+	kid.PortionFactor = 0.5
+	attendance := newAttendance(
+		[]database.User{parent, kid, other},
+		[]database.MemberMealAbsence{{UserID: other.ID, Weekday: 0, Slot: types.MealSlotDinner}},
+		[]database.MealAttendance{
+			{Day: util.NewDate(tuesday), Slot: types.MealSlotLunch, UserID: kid.ID, Attending: false},
+			{Day: util.NewDate(tuesday), Slot: types.MealSlotLunch, UserID: other.ID, Attending: true},
+		},
+		[]database.MealGuest{{Day: util.NewDate(tuesday), Slot: types.MealSlotLunch, Guests: 2}},
+	)
+
+	require.Equal(t, int32(2), attendance.servings(monday, types.MealSlotDinner))
+	require.Equal(t, int32(3), attendance.servings(monday, types.MealSlotLunch))
+	require.Equal(t, int32(4), attendance.servings(tuesday, types.MealSlotLunch))
+	require.False(t, attendance.attending(kid.ID, tuesday, types.MealSlotLunch))
+	require.True(t, attendance.attending(kid.ID, tuesday, types.MealSlotDinner))
+
+	// the usual absence only applies to Mondays
+	require.True(t, attendance.attending(other.ID, monday.AddDate(0, 0, 7), types.MealSlotLunch))
+	require.False(t, attendance.attending(other.ID, monday.AddDate(0, 0, 7), types.MealSlotDinner))
+
+	nobody := newAttendance([]database.User{parent}, []database.MemberMealAbsence{{UserID: parent.ID, Weekday: 0, Slot: types.MealSlotLunch}}, nil, nil)
+	require.Equal(t, int32(1), nobody.servings(monday, types.MealSlotLunch))
+}
+
+func TestSetMealAttendance(t *testing.T) {
+	user := randomFamilyUser(t)
+	kid := randomUser(t)
+	kid.PortionFactor = 0.5
+	members := []database.User{user, kid}
+	plan := randomMealPlan(user.FamilyID)
+	otherPlan := randomMealPlan(uuid.New())
+	day := plan.WeekStart.Time.AddDate(0, 0, 4)
+
+	params := SetMealAttendanceParams{
+		Day:     day.Format(util.DateLayout),
+		Slot:    types.MealSlotDinner,
+		Guests:  3,
+		Members: []MealMemberParams{{UserID: user.ID.String(), Attending: false}},
+	}
+	stranger := params
+	stranger.Members = []MealMemberParams{{UserID: uuid.NewString(), Attending: true}}
+	nextWeek := params
+	nextWeek.Day = plan.WeekStart.Time.AddDate(0, 0, 7).Format(util.DateLayout)
+
+	testCases := []struct {
+		name          string
+		planID        uuid.UUID
+		params        SetMealAttendanceParams
+		stubs         func(store *databaseMock.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "OK",
+			planID: plan.ID,
+			params: params,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetMealPlanByID(mock.Anything, plan.ID).
+					Times(1).Return(plan, nil)
+				attendanceStubs(store, user.FamilyID, members, []database.MemberMealAbsence{})
+				store.EXPECT().
+					SetMealAttendanceTx(mock.Anything, mock.MatchedBy(func(arg database.SetMealAttendanceTxParams) bool {
+						return arg.Meal.MealPlanID == plan.ID && arg.Meal.Guests == 3 && arg.Meal.Slot == types.MealSlotDinner &&
+							len(arg.Members) == 1 && !arg.Members[0].Attending && arg.Servings == 4
+					})).
+					Times(1).Return([]database.MealPlanEntry{}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				meal, err := decodeJSON[MealAttendance](recorder.Body)
+				require.NoError(t, err)
+				require.Equal(t, int32(4), meal.Servings)
+				require.Equal(t, int32(3), meal.Guests)
+				require.Len(t, meal.Members, 2)
+				require.False(t, meal.Members[0].Attending)
+				require.True(t, meal.Members[1].Attending)
+			},
+		},
+		{
+			name:   "NotFamilyMember",
+			planID: plan.ID,
+			params: stranger,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetMealPlanByID(mock.Anything, plan.ID).
+					Times(1).Return(plan, nil)
+				attendanceStubs(store, user.FamilyID, members, []database.MemberMealAbsence{})
+				store.EXPECT().
+					SetMealAttendanceTx(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "DayNotInWeek",
+			planID: plan.ID,
+			params: nextWeek,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetMealPlanByID(mock.Anything, plan.ID).
+					Times(1).Return(plan, nil)
+				store.EXPECT().
+					SetMealAttendanceTx(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "OtherFamily",
+			planID: otherPlan.ID,
+			params: params,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetMealPlanByID(mock.Anything, otherPlan.ID).
+					Times(1).Return(otherPlan, nil)
+				store.EXPECT().
+					SetMealAttendanceTx(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			server := newTestServer(t, store)
+
+			tc.stubs(store)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/meal-plans/%s/attendance", tc.planID.String())
+			data, err := encodeJSON(tc.params)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(data))
+			require.NoError(t, err)
+			setAuth(t, request, server.tokenMaker, authHeaderTypeBearer, user.Email, time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestSetMemberAttendance(t *testing.T) {
+	user := randomFamilyUser(t)
+	kid := randomUser(t)
+	kid.FamilyID = user.FamilyID
+	members := []database.User{user, kid}
+	tomorrow := util.NewDate(time.Now().AddDate(0, 0, 1))
+	meal := database.GetAutoServingsMealsByFamilyIDRow{
+		MealPlanID: uuid.New(),
+		Day:        tomorrow,
+		Slot:       types.MealSlotLunch,
+	}
+
+	params := SetMemberAttendanceParams{
+		PortionFactor: 0.5,
+		Absences:      []MealAbsence{{Weekday: int32(util.Weekday(tomorrow.Time)), Slot: types.MealSlotDinner}},
+	}
+
+	testCases := []struct {
+		name          string
+		userID        uuid.UUID
+		params        SetMemberAttendanceParams
+		stubs         func(store *databaseMock.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "OK",
+			userID: kid.ID,
+			params: params,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetAutoServingsMealsByFamilyID(mock.Anything, mock.Anything).
+					Times(1).Return([]database.GetAutoServingsMealsByFamilyIDRow{meal}, nil)
+				attendanceStubs(store, user.FamilyID, members, []database.MemberMealAbsence{})
+				updated := kid
+				updated.PortionFactor = 0.5
+				store.EXPECT().
+					SetMemberAttendanceTx(mock.Anything, mock.MatchedBy(func(arg database.SetMemberAttendanceTxParams) bool {
+						return arg.UserID == kid.ID && arg.PortionFactor == 0.5 && len(arg.Absences) == 1 &&
+							len(arg.Servings) == 1 && arg.Servings[0].Servings == 2 && arg.Servings[0].MealPlanID == meal.MealPlanID
+					})).
+					Times(1).Return(updated, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				member, err := decodeJSON[MemberAttendance](recorder.Body)
+				require.NoError(t, err)
+				require.Equal(t, kid.ID, member.UserID)
+				require.Equal(t, 0.5, member.PortionFactor)
+				require.Len(t, member.Absences, 1)
+			},
+		},
+		{
+			name:   "NotFamilyMember",
+			userID: uuid.New(),
+			params: params,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetAutoServingsMealsByFamilyID(mock.Anything, mock.Anything).
+					Times(1).Return([]database.GetAutoServingsMealsByFamilyIDRow{}, nil)
+				attendanceStubs(store, user.FamilyID, members, []database.MemberMealAbsence{})
+				store.EXPECT().
+					SetMemberAttendanceTx(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "InvalidPortion",
+			userID: kid.ID,
+			params: SetMemberAttendanceParams{PortionFactor: 5},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					SetMemberAttendanceTx(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "InvalidSlot",
+			userID: kid.ID,
+			params: SetMemberAttendanceParams{PortionFactor: 1, Absences: []MealAbsence{{Weekday: 1, Slot: "brunch"}}},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					SetMemberAttendanceTx(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			server := newTestServer(t, store)
+
+			tc.stubs(store)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/families/%s/attendance/%s", user.FamilyID.String(), tc.userID.String())
+			data, err := encodeJSON(tc.params)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(data))
+			require.NoError(t, err)
+			setAuth(t, request, server.tokenMaker, authHeaderTypeBearer, user.Email, time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
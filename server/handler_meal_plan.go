@@ -0,0 +1,792 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	database "github.com/andreiz53/cookinator/database/handlers"
+	"github.com/andreiz53/cookinator/types"
+	"github.com/andreiz53/cookinator/util"
+)
+
+var (
+	errDayNotInWeek          = errors.New("the day is not in the week of the meal plan")
+	errLeftoverOfLeftover    = errors.New("leftovers can only come from an entry that was cooked")
+	errLeftoverBeforeCooking = errors.New("leftovers must be eaten after the meal they come from")
+	errNotEnoughLeftovers    = errors.New("not enough servings are left over")
+	errBatchTooSmall         = errors.New("the batch must be at least as large as the servings eaten at the meal")
+	errMealPlanFinal         = errors.New("the meal plan is final and can no longer be changed")
+	errMealHasLeftovers      = errors.New("leftovers of the meal are planned, delete them first")
+	errPlanHasLeftovers      = errors.New("leftovers of meals of the plan are planned in other weeks, delete them first")
+)
+
+type MealPlan struct {
+	ID        uuid.UUID        `json:"id"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+	UpdatedAt pgtype.Timestamp `json:"updated_at"`
+	FamilyID  uuid.UUID        `json:"family_id"`
+	WeekStart pgtype.Date      `json:"week_start"`
+	// Status goes from draft to proposed while the family votes, final plans can't be changed
+	Status      types.MealPlanStatus `json:"status"`
+	FinalizedAt pgtype.Timestamp     `json:"finalized_at"`
+	Entries     []MealPlanEntry      `json:"entries,omitempty"`
+}
+
+type MealPlanEntry struct {
+	ID         uuid.UUID      `json:"id"`
+	MealPlanID uuid.UUID      `json:"meal_plan_id"`
+	Day        pgtype.Date    `json:"day"`
+	Slot       types.MealSlot `json:"slot"`
+	RecipeID   uuid.UUID      `json:"recipe_id"`
+	RecipeName string         `json:"recipe_name"`
+	Servings   int32          `json:"servings"`
+	Notes      string         `json:"notes"`
+	Locked     bool           `json:"locked"`
+	LeftoverOf *uuid.UUID     `json:"leftover_of,omitempty"`
+	// AutoServings entries follow the attendance of their meal
+	AutoServings bool `json:"auto_servings"`
+	// CookedServings and LeftoverServings are only set on entries that are cooked
+	CookedServings   int32 `json:"cooked_servings"`
+	LeftoverServings int32 `json:"leftover_servings"`
+}
+
+type FamilyMealPlansParams struct {
+	ID string `uri:"id" binding:"required,uuid4_rfc4122"`
+}
+
+// CreateMealPlanParams accepts any day of the week, the plan always starts on that week's Monday
+type CreateMealPlanParams struct {
+	WeekStart string `json:"week_start" binding:"required,datetime=2006-01-02"`
+}
+
+type GetMealPlansQuery struct {
+	WeekStart string `form:"week_start" binding:"omitempty,datetime=2006-01-02"`
+}
+
+type GetMealPlanByIDParams struct {
+	ID string `uri:"id" binding:"required,uuid4_rfc4122"`
+}
+
+type MealPlanEntryParams struct {
+	ID      string `uri:"id" binding:"required,uuid4_rfc4122"`
+	EntryID string `uri:"entry_id" binding:"required,uuid4_rfc4122"`
+}
+
+// CreateMealPlanEntryParams either cooks a recipe or eats the leftovers of another entry, possibly from another week.
+// BatchServings cooks more than the servings eaten at the meal so the rest can be planned as leftovers.
+// Without servings they are computed from who attends the meal, and entries cooked just for the meal
+// keep following its attendance.
+type CreateMealPlanEntryParams struct {
+	Day           string         `json:"day" binding:"required,datetime=2006-01-02"`
+	Slot          types.MealSlot `json:"slot" binding:"required,oneof=breakfast lunch dinner snack"`
+	RecipeID      string         `json:"recipe_id" binding:"required_without=LeftoverOf,omitempty,uuid4_rfc4122"`
+	Servings      int32          `json:"servings" binding:"omitempty,min=1"`
+	Notes         string         `json:"notes"`
+	LeftoverOf    string         `json:"leftover_of" binding:"omitempty,uuid4_rfc4122"`
+	BatchServings int32          `json:"batch_servings" binding:"omitempty,excluded_with=LeftoverOf,gtefield=Servings"`
+	// Locked entries are kept when the plan is generated again
+	Locked bool `json:"locked"`
+}
+
+type UpdateMealPlanEntryParams = CreateMealPlanEntryParams
+
+// BatchCookParams cooks a recipe once and spreads the rest over later meals of the week.
+// Leftovers without servings eat as many as the cooked meal.
+type BatchCookParams struct {
+	Day       string                `json:"day" binding:"required,datetime=2006-01-02"`
+	Slot      types.MealSlot        `json:"slot" binding:"required,oneof=breakfast lunch dinner snack"`
+	RecipeID  string                `json:"recipe_id" binding:"required,uuid4_rfc4122"`
+	Servings  int32                 `json:"servings" binding:"required,min=1"`
+	Notes     string                `json:"notes"`
+	Leftovers []BatchLeftoverParams `json:"leftovers" binding:"required,min=1,dive"`
+}
+
+type BatchLeftoverParams struct {
+	Day      string         `json:"day" binding:"required,datetime=2006-01-02"`
+	Slot     types.MealSlot `json:"slot" binding:"required,oneof=breakfast lunch dinner snack"`
+	Servings int32          `json:"servings" binding:"omitempty,min=1"`
+}
+
+func DBMealPlanToMealPlan(arg database.MealPlan) MealPlan {
+	return MealPlan{
+		ID:          arg.ID,
+		CreatedAt:   arg.CreatedAt,
+		UpdatedAt:   arg.UpdatedAt,
+		FamilyID:    arg.FamilyID,
+		WeekStart:   arg.WeekStart,
+		Status:      types.MealPlanStatus(arg.Status),
+		FinalizedAt: arg.FinalizedAt,
+	}
+}
+
+func DBMealPlansToMealPlans(arg []database.MealPlan) []MealPlan {
+	plans := []MealPlan{}
+	for _, plan := range arg {
+		plans = append(plans, DBMealPlanToMealPlan(plan))
+	}
+	return plans
+}
+
+// cookedServings is the number of servings cooked for an entry, more than the ones eaten when batch cooking
+func cookedServings(servings int32, batchServings pgtype.Int4) int32 {
+	if batchServings.Valid {
+		return batchServings.Int32
+	}
+	return servings
+}
+
+// eatenAfter reports whether a meal on day at slot comes after the meal on cookedDay at cookedSlot
+func eatenAfter(day pgtype.Date, slot string, cookedDay pgtype.Date, cookedSlot string) bool {
+	if day.Time.Equal(cookedDay.Time) {
+		return types.MealSlot(slot).Order() > types.MealSlot(cookedSlot).Order()
+	}
+	return day.Time.After(cookedDay.Time)
+}
+
+// keptOnReplace reports whether generating a plan or applying a template keeps an entry: it is locked, or
+// its leftovers are eaten at a locked meal or in another week, which deleting it would delete
+func keptOnReplace(entry database.GetMealPlanEntriesRow) bool {
+	return entry.Locked || entry.KeepsLeftovers
+}
+
+// DBMealPlanEntryToMealPlanEntry converts an entry, leftoversEaten are the servings other entries eat of its leftovers
+func DBMealPlanEntryToMealPlanEntry(arg database.MealPlanEntry, recipeName string, leftoversEaten int32) MealPlanEntry {
+	entry := MealPlanEntry{
+		ID:           arg.ID,
+		MealPlanID:   arg.MealPlanID,
+		Day:          arg.Day,
+		Slot:         types.MealSlot(arg.Slot),
+		RecipeID:     arg.RecipeID,
+		RecipeName:   recipeName,
+		Servings:     arg.Servings,
+		Notes:        arg.Notes,
+		Locked:       arg.Locked,
+		AutoServings: arg.AutoServings,
+	}
+	if arg.LeftoverOf.Valid {
+		leftoverOf := uuid.UUID(arg.LeftoverOf.Bytes)
+		entry.LeftoverOf = &leftoverOf
+	} else {
+		entry.CookedServings = cookedServings(arg.Servings, arg.BatchServings)
+		entry.LeftoverServings = entry.CookedServings - arg.Servings - leftoversEaten
+	}
+	return entry
+}
+
+func DBMealPlanEntriesToMealPlanEntries(arg []database.GetMealPlanEntriesRow) []MealPlanEntry {
+	entries := []MealPlanEntry{}
+	for _, entry := range arg {
+		entries = append(entries, DBMealPlanEntryToMealPlanEntry(database.MealPlanEntry{
+			ID:            entry.ID,
+			MealPlanID:    entry.MealPlanID,
+			Day:           entry.Day,
+			Slot:          entry.Slot,
+			RecipeID:      entry.RecipeID,
+			Servings:      entry.Servings,
+			Notes:         entry.Notes,
+			Locked:        entry.Locked,
+			LeftoverOf:    entry.LeftoverOf,
+			BatchServings: entry.BatchServings,
+			AutoServings:  entry.AutoServings,
+		}, entry.RecipeName, entry.LeftoverServingsEaten))
+	}
+	return entries
+}
+
+// familyMealPlan loads a meal plan and makes sure it belongs to the user's family.
+// It writes the error response itself and returns false on failure.
+func (s *Server) familyMealPlan(ctx *gin.Context, user database.User, id uuid.UUID) (database.MealPlan, bool) {
+	plan, err := s.store.GetMealPlanByID(ctx, id)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, respondWithErorr(err))
+			return plan, false
+		}
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return plan, false
+	}
+	if plan.FamilyID != user.FamilyID {
+		ctx.JSON(http.StatusForbidden, respondWithErorr(errForbidden))
+		return plan, false
+	}
+	return plan, true
+}
+
+// editableMealPlan rejects changes to a final plan, its week is settled and shopped for.
+// It writes the error response itself and returns false on failure.
+func editableMealPlan(ctx *gin.Context, plan database.MealPlan) bool {
+	if plan.Status == types.MealPlanStatusFinal {
+		ctx.JSON(http.StatusConflict, respondWithErorr(errMealPlanFinal))
+		return false
+	}
+	return true
+}
+
+// mealPlanEntry loads an entry and makes sure it belongs to the plan.
+// It writes the error response itself and returns false on failure.
+func (s *Server) mealPlanEntry(ctx *gin.Context, plan database.MealPlan, id uuid.UUID) (database.MealPlanEntry, bool) {
+	entry, err := s.store.GetMealPlanEntryByID(ctx, id)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, respondWithErorr(err))
+			return entry, false
+		}
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return entry, false
+	}
+	if entry.MealPlanID != plan.ID {
+		ctx.JSON(http.StatusNotFound, respondWithErorr(pgx.ErrNoRows))
+		return entry, false
+	}
+	return entry, true
+}
+
+// mealPlanEntryToDB validates an entry against its plan and family and converts it for the store.
+// entryID is the entry being updated, uuid.Nil for new entries.
+// It writes the error response itself and returns false on failure.
+func (s *Server) mealPlanEntryToDB(ctx *gin.Context, user database.User, plan database.MealPlan, entryID uuid.UUID, arg CreateMealPlanEntryParams) (database.CreateMealPlanEntryParams, database.Recipe, bool) {
+	day, err := util.ParseDate(arg.Day)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return database.CreateMealPlanEntryParams{}, database.Recipe{}, false
+	}
+	if !util.InWeek(day, plan.WeekStart) {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(errDayNotInWeek))
+		return database.CreateMealPlanEntryParams{}, database.Recipe{}, false
+	}
+
+	params := database.CreateMealPlanEntryParams{
+		MealPlanID:    plan.ID,
+		Day:           day,
+		Slot:          string(arg.Slot),
+		Servings:      arg.Servings,
+		Notes:         arg.Notes,
+		Locked:        arg.Locked,
+		BatchServings: pgtype.Int4{Int32: arg.BatchServings, Valid: arg.BatchServings > 0},
+	}
+	if params.Servings == 0 {
+		attendance, ok := s.weekAttendance(ctx, plan)
+		if !ok {
+			return params, database.Recipe{}, false
+		}
+		params.Servings = attendance.servings(day.Time, params.Slot)
+		// batches and leftovers are planned for a number of servings, they don't follow attendance
+		params.AutoServings = arg.LeftoverOf == "" && arg.BatchServings == 0
+	}
+	if params.BatchServings.Valid && params.BatchServings.Int32 < params.Servings {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(errBatchTooSmall))
+		return params, database.Recipe{}, false
+	}
+
+	var recipeID uuid.UUID
+	if arg.LeftoverOf != "" {
+		source, ok := s.leftoverSource(ctx, user, uuid.MustParse(arg.LeftoverOf), entryID, params)
+		if !ok {
+			return params, database.Recipe{}, false
+		}
+		params.LeftoverOf = pgtype.UUID{Bytes: source.ID, Valid: true}
+		recipeID = source.RecipeID
+	} else {
+		recipeID = uuid.MustParse(arg.RecipeID)
+	}
+
+	recipe, ok := s.familyRecipe(ctx, user, recipeID)
+	if !ok {
+		return params, recipe, false
+	}
+	params.RecipeID = recipe.ID
+
+	return params, recipe, true
+}
+
+// leftoverSource loads the entry whose leftovers are eaten and checks that it was cooked by the family
+// before the meal and that enough servings are left, not counting the entry being updated.
+// It writes the error response itself and returns false on failure.
+func (s *Server) leftoverSource(ctx *gin.Context, user database.User, id, entryID uuid.UUID, leftover database.CreateMealPlanEntryParams) (database.MealPlanEntry, bool) {
+	source, err := s.store.GetMealPlanEntryByID(ctx, id)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, respondWithErorr(err))
+			return source, false
+		}
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return source, false
+	}
+	if _, ok := s.familyMealPlan(ctx, user, source.MealPlanID); !ok {
+		return source, false
+	}
+
+	if source.LeftoverOf.Valid || source.ID == entryID {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(errLeftoverOfLeftover))
+		return source, false
+	}
+	if !eatenAfter(leftover.Day, leftover.Slot, source.Day, source.Slot) {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(errLeftoverBeforeCooking))
+		return source, false
+	}
+
+	eaten, err := s.store.GetLeftoverServingsEaten(ctx, database.GetLeftoverServingsEatenParams{
+		EntryID:   source.ID,
+		ExcludeID: entryID,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return source, false
+	}
+	if cookedServings(source.Servings, source.BatchServings)-source.Servings-eaten < leftover.Servings {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(errNotEnoughLeftovers))
+		return source, false
+	}
+	return source, true
+}
+
+func (s *Server) createMealPlan(ctx *gin.Context) {
+	var uri FamilyMealPlansParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	var request CreateMealPlanParams
+	err = ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	familyID := uuid.MustParse(uri.ID)
+	_, ok := s.authFamilyMember(ctx, familyID)
+	if !ok {
+		return
+	}
+
+	day, err := util.ParseDate(request.WeekStart)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	plan, err := s.store.CreateMealPlan(ctx, database.CreateMealPlanParams{
+		FamilyID:  familyID,
+		WeekStart: util.WeekStart(day.Time),
+	})
+	if err != nil {
+		if database.ErrorCode(err) == database.CodeDuplicateKey {
+			ctx.JSON(http.StatusConflict, respondWithErorr(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, DBMealPlanToMealPlan(plan))
+}
+
+// getMealPlans lists the plans of a family, or only the plan of the week containing week_start
+func (s *Server) getMealPlans(ctx *gin.Context) {
+	var uri FamilyMealPlansParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	var query GetMealPlansQuery
+	err = ctx.ShouldBindQuery(&query)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	familyID := uuid.MustParse(uri.ID)
+	_, ok := s.authFamilyMember(ctx, familyID)
+	if !ok {
+		return
+	}
+
+	if query.WeekStart == "" {
+		plans, err := s.store.GetMealPlansByFamilyID(ctx, familyID)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+			return
+		}
+		ctx.JSON(http.StatusOK, DBMealPlansToMealPlans(plans))
+		return
+	}
+
+	day, err := util.ParseDate(query.WeekStart)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+	plan, err := s.store.GetMealPlanByWeek(ctx, database.GetMealPlanByWeekParams{
+		FamilyID:  familyID,
+		WeekStart: util.WeekStart(day.Time),
+	})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			ctx.JSON(http.StatusOK, []MealPlan{})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, []MealPlan{DBMealPlanToMealPlan(plan)})
+}
+
+func (s *Server) getMealPlanByID(ctx *gin.Context) {
+	var request GetMealPlanByIDParams
+	err := ctx.ShouldBindUri(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	plan, ok := s.familyMealPlan(ctx, user, uuid.MustParse(request.ID))
+	if !ok {
+		return
+	}
+
+	entries, err := s.store.GetMealPlanEntries(ctx, plan.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	response := DBMealPlanToMealPlan(plan)
+	response.Entries = DBMealPlanEntriesToMealPlanEntries(entries)
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (s *Server) deleteMealPlan(ctx *gin.Context) {
+	var request GetMealPlanByIDParams
+	err := ctx.ShouldBindUri(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	plan, ok := s.familyMealPlan(ctx, user, uuid.MustParse(request.ID))
+	if !ok {
+		return
+	}
+	if !editableMealPlan(ctx, plan) {
+		return
+	}
+
+	// deleting the plan deletes the leftovers of its meals too, even the ones eaten in another week
+	leftovers, err := s.store.GetLeftoversOutsideMealPlan(ctx, plan.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+	if len(leftovers) > 0 {
+		ctx.JSON(http.StatusConflict, respondWithErorr(errPlanHasLeftovers))
+		return
+	}
+
+	err = s.store.DeleteMealPlan(ctx, plan.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, respondWithMessage(fmt.Sprintf("deleted meal plan with id %s", request.ID)))
+}
+
+func (s *Server) createMealPlanEntry(ctx *gin.Context) {
+	var uri GetMealPlanByIDParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	var request CreateMealPlanEntryParams
+	err = ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	plan, ok := s.familyMealPlan(ctx, user, uuid.MustParse(uri.ID))
+	if !ok {
+		return
+	}
+	if !editableMealPlan(ctx, plan) {
+		return
+	}
+
+	dbParams, recipe, ok := s.mealPlanEntryToDB(ctx, user, plan, uuid.Nil, request)
+	if !ok {
+		return
+	}
+
+	entry, err := s.store.CreateMealPlanEntry(ctx, dbParams)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	err = s.store.TouchMealPlan(ctx, plan.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, DBMealPlanEntryToMealPlanEntry(entry, recipe.Name, 0))
+}
+
+func (s *Server) updateMealPlanEntry(ctx *gin.Context) {
+	var uri MealPlanEntryParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	var request UpdateMealPlanEntryParams
+	err = ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	plan, ok := s.familyMealPlan(ctx, user, uuid.MustParse(uri.ID))
+	if !ok {
+		return
+	}
+	if !editableMealPlan(ctx, plan) {
+		return
+	}
+
+	entry, ok := s.mealPlanEntry(ctx, plan, uuid.MustParse(uri.EntryID))
+	if !ok {
+		return
+	}
+
+	dbParams, recipe, ok := s.mealPlanEntryToDB(ctx, user, plan, entry.ID, request)
+	if !ok {
+		return
+	}
+
+	// the leftovers already planned from this entry must still be cooked
+	eaten, err := s.store.GetLeftoverServingsEaten(ctx, database.GetLeftoverServingsEatenParams{
+		EntryID:   entry.ID,
+		ExcludeID: uuid.Nil,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+	if eaten > 0 && (dbParams.LeftoverOf.Valid || cookedServings(dbParams.Servings, dbParams.BatchServings)-dbParams.Servings < eaten) {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(errNotEnoughLeftovers))
+		return
+	}
+	if eaten > 0 && (!dbParams.Day.Time.Equal(entry.Day.Time) || dbParams.Slot != entry.Slot) {
+		// a meal moved later must still be cooked before its leftovers are eaten
+		leftovers, err := s.store.GetLeftovers(ctx, pgtype.UUID{Bytes: entry.ID, Valid: true})
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+			return
+		}
+		for _, leftover := range leftovers {
+			if !eatenAfter(leftover.Day, leftover.Slot, dbParams.Day, dbParams.Slot) {
+				ctx.JSON(http.StatusBadRequest, respondWithErorr(errLeftoverBeforeCooking))
+				return
+			}
+		}
+	}
+
+	entry, err = s.store.UpdateMealPlanEntry(ctx, database.UpdateMealPlanEntryParams{
+		ID:            entry.ID,
+		Day:           dbParams.Day,
+		Slot:          dbParams.Slot,
+		RecipeID:      dbParams.RecipeID,
+		Servings:      dbParams.Servings,
+		Notes:         dbParams.Notes,
+		Locked:        dbParams.Locked,
+		LeftoverOf:    dbParams.LeftoverOf,
+		BatchServings: dbParams.BatchServings,
+		AutoServings:  dbParams.AutoServings,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	err = s.store.TouchMealPlan(ctx, plan.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, DBMealPlanEntryToMealPlanEntry(entry, recipe.Name, eaten))
+}
+
+func (s *Server) deleteMealPlanEntry(ctx *gin.Context) {
+	var request MealPlanEntryParams
+	err := ctx.ShouldBindUri(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	plan, ok := s.familyMealPlan(ctx, user, uuid.MustParse(request.ID))
+	if !ok {
+		return
+	}
+	if !editableMealPlan(ctx, plan) {
+		return
+	}
+
+	entry, ok := s.mealPlanEntry(ctx, plan, uuid.MustParse(request.EntryID))
+	if !ok {
+		return
+	}
+
+	// deleting the meal would delete its leftovers with it
+	eaten, err := s.store.GetLeftoverServingsEaten(ctx, database.GetLeftoverServingsEatenParams{
+		EntryID:   entry.ID,
+		ExcludeID: uuid.Nil,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+	if eaten > 0 {
+		ctx.JSON(http.StatusConflict, respondWithErorr(errMealHasLeftovers))
+		return
+	}
+
+	err = s.store.DeleteMealPlanEntry(ctx, entry.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	err = s.store.TouchMealPlan(ctx, plan.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, respondWithMessage(fmt.Sprintf("deleted meal plan entry with id %s", request.EntryID)))
+}
+
+// batchCook plans a recipe cooked in a batch big enough for the meal and all of its leftovers
+func (s *Server) batchCook(ctx *gin.Context) {
+	var uri GetMealPlanByIDParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	var request BatchCookParams
+	err = ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	plan, ok := s.familyMealPlan(ctx, user, uuid.MustParse(uri.ID))
+	if !ok {
+		return
+	}
+	if !editableMealPlan(ctx, plan) {
+		return
+	}
+
+	entry, recipe, ok := s.mealPlanEntryToDB(ctx, user, plan, uuid.Nil, CreateMealPlanEntryParams{
+		Day:      request.Day,
+		Slot:     request.Slot,
+		RecipeID: request.RecipeID,
+		Servings: request.Servings,
+		Notes:    request.Notes,
+	})
+	if !ok {
+		return
+	}
+
+	arg := database.BatchCookTxParams{Leftovers: []database.CreateMealPlanEntryParams{}}
+	batch := entry.Servings
+	for _, leftover := range request.Leftovers {
+		day, err := util.ParseDate(leftover.Day)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+			return
+		}
+		if !util.InWeek(day, plan.WeekStart) {
+			ctx.JSON(http.StatusBadRequest, respondWithErorr(errDayNotInWeek))
+			return
+		}
+		if day.Time.Before(entry.Day.Time) ||
+			day.Time.Equal(entry.Day.Time) && leftover.Slot.Order() <= request.Slot.Order() {
+			ctx.JSON(http.StatusBadRequest, respondWithErorr(errLeftoverBeforeCooking))
+			return
+		}
+
+		servings := leftover.Servings
+		if servings == 0 {
+			servings = entry.Servings
+		}
+		batch += servings
+		arg.Leftovers = append(arg.Leftovers, database.CreateMealPlanEntryParams{
+			Day:      day,
+			Slot:     string(leftover.Slot),
+			Servings: servings,
+		})
+	}
+	entry.BatchServings = pgtype.Int4{Int32: batch, Valid: true}
+	arg.Entry = entry
+
+	result, err := s.store.BatchCookTx(ctx, arg)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	entries := []MealPlanEntry{DBMealPlanEntryToMealPlanEntry(result.Entry, recipe.Name, batch-entry.Servings)}
+	for _, leftover := range result.Leftovers {
+		entries = append(entries, DBMealPlanEntryToMealPlanEntry(leftover, recipe.Name, 0))
+	}
+	ctx.JSON(http.StatusCreated, entries)
+}
@@ -0,0 +1,403 @@
+package server
+
+import (
+	"errors"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/andreiz53/cookinator/cooking"
+	database "github.com/andreiz53/cookinator/database/handlers"
+	"github.com/andreiz53/cookinator/planner"
+	"github.com/andreiz53/cookinator/types"
+	"github.com/andreiz53/cookinator/util"
+)
+
+var errNotFamilyMember = errors.New("the user is not a member of the family")
+
+// GenerateMealPlanQuery configures the generated week. Seed makes the result reproducible,
+// when it is missing a random one is used and returned with the plan.
+type GenerateMealPlanQuery struct {
+	Week           string           `form:"week" binding:"required"`
+	Seed           *int64           `form:"seed"`
+	Slots          []types.MealSlot `form:"slot" binding:"omitempty,dive,oneof=breakfast lunch dinner snack"`
+	NoRepeatDays   *int             `form:"no_repeat_days" binding:"omitempty,min=0,max=60"`
+	WeekdayMaxTime string           `form:"weekday_max_time"`
+	BusyMaxTime    string           `form:"busy_max_time"`
+	DishesPerSlot  int              `form:"dishes_per_slot" binding:"omitempty,min=1,max=4"`
+	Servings       int32            `form:"servings" binding:"omitempty,min=1"`
+}
+
+// GenerateMealPlanConstraints are the optional limits the generated week is optimized for,
+// a zero value is not checked. MaxCost is in cents.
+type GenerateMealPlanConstraints struct {
+	MaxCost                int64                   `json:"max_cost" binding:"min=0"`
+	MaxActiveMinutesPerDay int32                   `json:"max_active_minutes_per_day" binding:"min=0"`
+	VegetarianDinners      int                     `json:"vegetarian_dinners" binding:"min=0,max=7"`
+	Members                []MemberNutritionParams `json:"members" binding:"omitempty,dive"`
+}
+
+// MemberNutritionParams are the daily calorie and protein ranges of a family member
+type MemberNutritionParams struct {
+	UserID      string `json:"user_id" binding:"required,uuid4_rfc4122"`
+	MinCalories int32  `json:"min_calories" binding:"min=0"`
+	MaxCalories int32  `json:"max_calories" binding:"omitempty,gtefield=MinCalories"`
+	MinProtein  int32  `json:"min_protein" binding:"min=0"`
+	MaxProtein  int32  `json:"max_protein" binding:"omitempty,gtefield=MinProtein"`
+}
+
+type GeneratedMealPlan struct {
+	MealPlan MealPlan        `json:"meal_plan"`
+	Seed     int64           `json:"seed"`
+	Unfilled []planner.Slot  `json:"unfilled"`
+	Skipped  []planner.Slot  `json:"skipped"`
+	Warnings []string        `json:"warnings"`
+	Checks   []planner.Check `json:"checks"`
+}
+
+func generateMealPlanToRules(arg GenerateMealPlanQuery) (planner.Rules, error) {
+	rules := planner.Rules{
+		Slots:               arg.Slots,
+		NoRepeatDays:        planner.DefaultNoRepeatDays,
+		WeekdayMaxTotalTime: planner.DefaultWeekdayMaxTotalTime,
+		BusyMaxTotalTime:    planner.DefaultBusyMaxTotalTime,
+		DishesPerSlot:       max(arg.DishesPerSlot, 1),
+		Servings:            arg.Servings,
+	}
+	if len(rules.Slots) == 0 {
+		rules.Slots = []types.MealSlot{types.MealSlotDinner}
+	}
+	if arg.NoRepeatDays != nil {
+		rules.NoRepeatDays = *arg.NoRepeatDays
+	}
+	if arg.WeekdayMaxTime != "" {
+		minutes, err := cooking.ParseMinutes(arg.WeekdayMaxTime)
+		if err != nil {
+			return rules, err
+		}
+		rules.WeekdayMaxTotalTime = minutes
+	}
+	if arg.BusyMaxTime != "" {
+		minutes, err := cooking.ParseMinutes(arg.BusyMaxTime)
+		if err != nil {
+			return rules, err
+		}
+		rules.BusyMaxTotalTime = minutes
+	}
+	if arg.Seed != nil {
+		rules.Seed = *arg.Seed
+	} else {
+		rules.Seed = rand.Int63()
+	}
+	return rules, nil
+}
+
+// generateMealPlanToConstraints matches the member targets with the family members, naming them in the checks
+func generateMealPlanToConstraints(arg GenerateMealPlanConstraints, members []database.User) (planner.Constraints, error) {
+	constraints := planner.Constraints{
+		MaxCost:                arg.MaxCost,
+		MaxActiveMinutesPerDay: arg.MaxActiveMinutesPerDay,
+		VegetarianDinners:      arg.VegetarianDinners,
+	}
+
+	names := map[uuid.UUID]string{}
+	for _, member := range members {
+		names[member.ID] = member.FirstName
+	}
+	for _, targets := range arg.Members {
+		id := uuid.MustParse(targets.UserID)
+		name, ok := names[id]
+		if !ok {
+			return constraints, errNotFamilyMember
+		}
+		constraints.Members = append(constraints.Members, planner.MemberTargets{
+			ID:          id,
+			Name:        name,
+			MinCalories: targets.MinCalories,
+			MaxCalories: targets.MaxCalories,
+			MinProtein:  targets.MinProtein,
+			MaxProtein:  targets.MaxProtein,
+		})
+	}
+	return constraints, nil
+}
+
+// DBRecipesToPlannerRecipes converts recipes together with the equipment they need
+func DBRecipesToPlannerRecipes(arg []database.Recipe, equipment []database.GetRecipeEquipmentByFamilyIDRow) []planner.Recipe {
+	uses := map[uuid.UUID][]cooking.EquipmentUse{}
+	for _, row := range equipment {
+		uses[row.RecipeID] = append(uses[row.RecipeID], cooking.EquipmentUse{
+			EquipmentID: row.EquipmentID,
+			Name:        row.Name,
+			Heavy:       row.Heavy,
+		})
+	}
+
+	recipes := []planner.Recipe{}
+	for _, recipe := range arg {
+		recipes = append(recipes, planner.Recipe{
+			ID:                 recipe.ID,
+			Name:               recipe.Name,
+			TotalTimeMinutes:   recipe.TotalTimeMinutes,
+			Cuisine:            recipe.Cuisine,
+			Tags:               recipe.Tags,
+			Equipment:          uses[recipe.ID],
+			ActiveTimeMinutes:  recipe.ActiveTimeMinutes,
+			CaloriesPerServing: recipe.CaloriesPerServing,
+			ProteinPerServing:  recipe.ProteinPerServing,
+			CostPerServing:     recipe.CostPerServing,
+		})
+	}
+	return recipes
+}
+
+// familyMealPlanByWeek loads the plan of a week, creating it when the family has none yet.
+// It writes the error response itself and returns false on failure.
+func (s *Server) familyMealPlanByWeek(ctx *gin.Context, arg database.GetMealPlanByWeekParams) (database.MealPlan, bool) {
+	plan, err := s.store.GetMealPlanByWeek(ctx, arg)
+	if err == pgx.ErrNoRows {
+		plan, err = s.store.CreateMealPlan(ctx, database.CreateMealPlanParams(arg))
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return plan, false
+	}
+	return plan, true
+}
+
+// generateMealPlan fills the week of a family plan with its recipes, keeping the locked entries.
+// Recipes planned or cooked close to the week are not repeated, weekdays and slots the family
+// calendars keep busy get quick recipes and slots where nobody is home are left empty.
+// The optional JSON body sets constraints, the response reports which of them the plan satisfies.
+func (s *Server) generateMealPlan(ctx *gin.Context) {
+	var uri FamilyMealPlansParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	var query GenerateMealPlanQuery
+	err = ctx.ShouldBindQuery(&query)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	// the body is optional, without one no constraints are set
+	var body GenerateMealPlanConstraints
+	if ctx.Request.ContentLength != 0 {
+		err = ctx.ShouldBindJSON(&body)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+			return
+		}
+	}
+
+	weekStart, err := util.ParseISOWeek(query.Week)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	rules, err := generateMealPlanToRules(query)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	familyID := uuid.MustParse(uri.ID)
+	_, ok := s.authFamilyMember(ctx, familyID)
+	if !ok {
+		return
+	}
+
+	members, err := s.store.GetUsersByFamilyID(ctx, familyID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+	// without servings each meal gets the servings of who attends it
+	autoServings := rules.Servings == 0
+	if autoServings {
+		rules.Servings = max(int32(len(members)), 1)
+	}
+
+	constraints, err := generateMealPlanToConstraints(body, members)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	plan, ok := s.familyMealPlanByWeek(ctx, database.GetMealPlanByWeekParams{
+		FamilyID:  familyID,
+		WeekStart: weekStart,
+	})
+	if !ok {
+		return
+	}
+	if !editableMealPlan(ctx, plan) {
+		return
+	}
+
+	entries, err := s.store.GetMealPlanEntries(ctx, plan.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+	locked := []planner.Entry{}
+	for _, entry := range entries {
+		if keptOnReplace(entry) {
+			locked = append(locked, planner.Entry{
+				Day:      entry.Day.Time,
+				Slot:     types.MealSlot(entry.Slot),
+				RecipeID: entry.RecipeID,
+				Servings: entry.Servings,
+				Locked:   true,
+			})
+		}
+	}
+
+	recipes, err := s.store.GetRecipesByFamilyID(ctx, familyID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+	equipment, err := s.store.GetRecipeEquipmentByFamilyID(ctx, familyID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	history, ok := s.recipeHistory(ctx, plan, rules.NoRepeatDays)
+	if !ok {
+		return
+	}
+
+	busy, away, ok := s.familyAvailability(ctx, plan)
+	if !ok {
+		return
+	}
+
+	result := planner.Generate(planner.Request{
+		WeekStart:   weekStart.Time,
+		Recipes:     DBRecipesToPlannerRecipes(recipes, equipment),
+		Locked:      locked,
+		History:     history,
+		Busy:        busy,
+		Away:        away,
+		Rules:       rules,
+		Constraints: constraints,
+	})
+
+	var attendance attendance
+	if autoServings {
+		attendance, ok = s.weekAttendance(ctx, plan)
+		if !ok {
+			return
+		}
+	}
+
+	arg := database.ReplaceMealPlanEntriesTxParams{MealPlanID: plan.ID}
+	for _, entry := range result.Entries {
+		params := database.CreateMealPlanEntryParams{
+			Day:      util.NewDate(entry.Day),
+			Slot:     string(entry.Slot),
+			RecipeID: entry.RecipeID,
+			Servings: entry.Servings,
+		}
+		if autoServings {
+			params.Servings = attendance.servings(entry.Day, params.Slot)
+			params.AutoServings = true
+		}
+		arg.Entries = append(arg.Entries, params)
+	}
+	_, err = s.store.ReplaceMealPlanEntriesTx(ctx, arg)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	entries, err = s.store.GetMealPlanEntries(ctx, plan.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	response := GeneratedMealPlan{
+		MealPlan: DBMealPlanToMealPlan(plan),
+		Seed:     rules.Seed,
+		Unfilled: result.Unfilled,
+		Skipped:  result.Skipped,
+		Warnings: result.Warnings,
+		Checks:   result.Checks,
+	}
+	response.MealPlan.Entries = DBMealPlanEntriesToMealPlanEntries(entries)
+	ctx.JSON(http.StatusOK, response)
+}
+
+// recipeHistory collects the days recipes were planned in other weeks or cooked within noRepeatDays of the plan.
+// It writes the error response itself and returns false on failure.
+func (s *Server) recipeHistory(ctx *gin.Context, plan database.MealPlan, noRepeatDays int) (map[uuid.UUID][]time.Time, bool) {
+	history := map[uuid.UUID][]time.Time{}
+	if noRepeatDays == 0 {
+		return history, true
+	}
+
+	planned, err := s.store.GetPlannedRecipesByFamilyID(ctx, database.GetPlannedRecipesByFamilyIDParams{
+		FamilyID: plan.FamilyID,
+		FromDay:  util.NewDate(plan.WeekStart.Time.AddDate(0, 0, -noRepeatDays)),
+		ToDay:    util.NewDate(plan.WeekStart.Time.AddDate(0, 0, 7+noRepeatDays)),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return nil, false
+	}
+	for _, row := range planned {
+		// the entries of the plan itself are either locked or about to be replaced
+		if util.InWeek(row.Day, plan.WeekStart) {
+			continue
+		}
+		history[row.RecipeID] = append(history[row.RecipeID], row.Day.Time)
+	}
+
+	cooked, err := s.store.GetLastCookedByFamilyID(ctx, plan.FamilyID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return nil, false
+	}
+	for _, row := range cooked {
+		history[row.RecipeID] = append(history[row.RecipeID], row.LastCookedOn.Time)
+	}
+	return history, true
+}
+
+// familyAvailability loads the slots of the plan's week the imported family calendars keep busy or away.
+// It writes the error response itself and returns false on failure.
+func (s *Server) familyAvailability(ctx *gin.Context, plan database.MealPlan) ([]planner.Slot, []planner.Slot, bool) {
+	calendars, err := s.store.GetFamilyCalendars(ctx, plan.FamilyID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return nil, nil, false
+	}
+	if len(calendars) == 0 {
+		return []planner.Slot{}, []planner.Slot{}, true
+	}
+
+	slots, err := s.store.GetBusySlotsByFamilyID(ctx, database.GetBusySlotsByFamilyIDParams{
+		FamilyID: plan.FamilyID,
+		FromDay:  plan.WeekStart,
+		ToDay:    util.NewDate(plan.WeekStart.Time.AddDate(0, 0, 7)),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return nil, nil, false
+	}
+
+	busy, away := BusySlotsToAvailability(slots, len(calendars))
+	return busy, away, true
+}
@@ -0,0 +1,335 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	database "github.com/andreiz53/cookinator/database/handlers"
+	databaseMock "github.com/andreiz53/cookinator/database/mocks"
+	"github.com/andreiz53/cookinator/types"
+	"github.com/andreiz53/cookinator/util"
+)
+
+func TestGenerateMealPlan(t *testing.T) {
+	user := randomFamilyUser(t)
+	members := []database.User{user, randomUser(t)}
+	plan := randomMealPlan(user.FamilyID)
+
+	recipes := []database.Recipe{}
+	for i := 0; i < 8; i++ {
+		recipe := randomRecipe(t, user.FamilyID)
+		recipe.TotalTimeMinutes = 20
+		recipes = append(recipes, recipe)
+	}
+	locked := randomMealPlanEntry(plan, recipes[0], 0, types.MealSlotDinner)
+	locked.Locked = true
+	lockedRow := database.GetMealPlanEntriesRow{
+		ID:         locked.ID,
+		MealPlanID: plan.ID,
+		Day:        locked.Day,
+		Slot:       locked.Slot,
+		RecipeID:   locked.RecipeID,
+		Servings:   locked.Servings,
+		Locked:     true,
+		RecipeName: recipes[0].Name,
+	}
+	week := util.ISOWeek(plan.WeekStart.Time)
+
+	generateStubs := func(store *databaseMock.MockStore, calendars []database.FamilyCalendar) {
+		store.EXPECT().
+			GetMealPlanEntries(mock.Anything, plan.ID).
+			Times(2).Return([]database.GetMealPlanEntriesRow{lockedRow}, nil)
+		store.EXPECT().
+			GetRecipesByFamilyID(mock.Anything, user.FamilyID).
+			Times(1).Return(recipes, nil)
+		store.EXPECT().
+			GetRecipeEquipmentByFamilyID(mock.Anything, user.FamilyID).
+			Times(1).Return([]database.GetRecipeEquipmentByFamilyIDRow{}, nil)
+		store.EXPECT().
+			GetPlannedRecipesByFamilyID(mock.Anything, mock.Anything).
+			Times(1).Return([]database.GetPlannedRecipesByFamilyIDRow{}, nil)
+		store.EXPECT().
+			GetLastCookedByFamilyID(mock.Anything, user.FamilyID).
+			Times(1).Return([]database.GetLastCookedByFamilyIDRow{}, nil)
+		store.EXPECT().
+			GetFamilyCalendars(mock.Anything, user.FamilyID).
+			Times(1).Return(calendars, nil)
+	}
+
+	testCases := []struct {
+		name          string
+		familyID      uuid.UUID
+		query         string
+		body          *GenerateMealPlanConstraints
+		stubs         func(store *databaseMock.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "OK",
+			familyID: user.FamilyID,
+			query:    fmt.Sprintf("week=%s&seed=42", week),
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetUsersByFamilyID(mock.Anything, user.FamilyID).
+					Times(1).Return(members, nil)
+				store.EXPECT().
+					GetMealPlanByWeek(mock.Anything, database.GetMealPlanByWeekParams{
+						FamilyID:  user.FamilyID,
+						WeekStart: plan.WeekStart,
+					}).
+					Times(1).Return(plan, nil)
+				generateStubs(store, []database.FamilyCalendar{})
+				attendanceStubs(store, user.FamilyID, members, []database.MemberMealAbsence{})
+				store.EXPECT().
+					ReplaceMealPlanEntriesTx(mock.Anything, mock.MatchedBy(func(arg database.ReplaceMealPlanEntriesTxParams) bool {
+						if arg.MealPlanID != plan.ID || len(arg.Entries) != 6 {
+							return false
+						}
+						for _, entry := range arg.Entries {
+							if entry.Day == locked.Day || entry.Servings != int32(len(members)) || !entry.AutoServings || entry.Slot != locked.Slot {
+								return false
+							}
+						}
+						return true
+					})).
+					Times(1).Return([]database.MealPlanEntry{}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				generated, err := decodeJSON[GeneratedMealPlan](recorder.Body)
+				require.NoError(t, err)
+				require.Equal(t, plan.ID, generated.MealPlan.ID)
+				require.Equal(t, int64(42), generated.Seed)
+				require.Empty(t, generated.Unfilled)
+				require.Empty(t, generated.Warnings)
+			},
+		},
+		{
+			name:     "CreatesPlan",
+			familyID: user.FamilyID,
+			query:    fmt.Sprintf("week=%s&servings=3", week),
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetUsersByFamilyID(mock.Anything, user.FamilyID).
+					Times(1).Return(members, nil)
+				store.EXPECT().
+					GetMealPlanByWeek(mock.Anything, mock.Anything).
+					Times(1).Return(database.MealPlan{}, pgx.ErrNoRows)
+				store.EXPECT().
+					CreateMealPlan(mock.Anything, database.CreateMealPlanParams{
+						FamilyID:  user.FamilyID,
+						WeekStart: plan.WeekStart,
+					}).
+					Times(1).Return(plan, nil)
+				generateStubs(store, []database.FamilyCalendar{})
+				store.EXPECT().
+					ReplaceMealPlanEntriesTx(mock.Anything, mock.MatchedBy(func(arg database.ReplaceMealPlanEntriesTxParams) bool {
+						return len(arg.Entries) == 6 && arg.Entries[0].Servings == 3
+					})).
+					Times(1).Return([]database.MealPlanEntry{}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:     "Constraints",
+			familyID: user.FamilyID,
+			query:    fmt.Sprintf("week=%s&seed=7", week),
+			body: &GenerateMealPlanConstraints{
+				MaxCost: 100,
+				Members: []MemberNutritionParams{{UserID: user.ID.String(), MinCalories: 1800, MaxCalories: 2400}},
+			},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetUsersByFamilyID(mock.Anything, user.FamilyID).
+					Times(1).Return(members, nil)
+				store.EXPECT().
+					GetMealPlanByWeek(mock.Anything, mock.Anything).
+					Times(1).Return(plan, nil)
+				generateStubs(store, []database.FamilyCalendar{})
+				attendanceStubs(store, user.FamilyID, members, []database.MemberMealAbsence{})
+				store.EXPECT().
+					ReplaceMealPlanEntriesTx(mock.Anything, mock.Anything).
+					Times(1).Return([]database.MealPlanEntry{}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				generated, err := decodeJSON[GeneratedMealPlan](recorder.Body)
+				require.NoError(t, err)
+				require.Len(t, generated.Checks, 2)
+				// the test recipes have no cost or nutrition data
+				require.True(t, generated.Checks[0].Satisfied)
+				require.False(t, generated.Checks[1].Satisfied)
+				require.Equal(t, user.FirstName, generated.Checks[1].Member)
+			},
+		},
+		{
+			name:     "BusyCalendar",
+			familyID: user.FamilyID,
+			query:    fmt.Sprintf("week=%s&seed=3", week),
+			stubs: func(store *databaseMock.MockStore) {
+				calendar := database.FamilyCalendar{ID: uuid.New(), FamilyID: user.FamilyID}
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetUsersByFamilyID(mock.Anything, user.FamilyID).
+					Times(1).Return(members, nil)
+				store.EXPECT().
+					GetMealPlanByWeek(mock.Anything, mock.Anything).
+					Times(1).Return(plan, nil)
+				generateStubs(store, []database.FamilyCalendar{calendar})
+				attendanceStubs(store, user.FamilyID, members, []database.MemberMealAbsence{})
+				store.EXPECT().
+					GetBusySlotsByFamilyID(mock.Anything, database.GetBusySlotsByFamilyIDParams{
+						FamilyID: user.FamilyID,
+						FromDay:  plan.WeekStart,
+						ToDay:    util.NewDate(plan.WeekStart.Time.AddDate(0, 0, 7)),
+					}).
+					Times(1).Return([]database.BusySlot{
+					{CalendarID: calendar.ID, Day: util.NewDate(plan.WeekStart.Time.AddDate(0, 0, 1)), Slot: string(types.MealSlotDinner), Away: false},
+					{CalendarID: calendar.ID, Day: util.NewDate(plan.WeekStart.Time.AddDate(0, 0, 2)), Slot: string(types.MealSlotDinner), Away: true},
+				}, nil)
+				store.EXPECT().
+					ReplaceMealPlanEntriesTx(mock.Anything, mock.MatchedBy(func(arg database.ReplaceMealPlanEntriesTxParams) bool {
+						return len(arg.Entries) == 5
+					})).
+					Times(1).Return([]database.MealPlanEntry{}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				generated, err := decodeJSON[GeneratedMealPlan](recorder.Body)
+				require.NoError(t, err)
+				require.Len(t, generated.Skipped, 1)
+				require.Equal(t, plan.WeekStart.Time.AddDate(0, 0, 2), generated.Skipped[0].Day)
+			},
+		},
+		{
+			name:     "NotFamilyMember",
+			familyID: user.FamilyID,
+			query:    fmt.Sprintf("week=%s", week),
+			body: &GenerateMealPlanConstraints{
+				Members: []MemberNutritionParams{{UserID: uuid.NewString(), MinProtein: 50}},
+			},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetUsersByFamilyID(mock.Anything, user.FamilyID).
+					Times(1).Return(members, nil)
+				store.EXPECT().
+					ReplaceMealPlanEntriesTx(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:     "InvalidConstraints",
+			familyID: user.FamilyID,
+			query:    fmt.Sprintf("week=%s", week),
+			body:     &GenerateMealPlanConstraints{VegetarianDinners: 8},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					ReplaceMealPlanEntriesTx(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:     "OtherFamily",
+			familyID: uuid.New(),
+			query:    fmt.Sprintf("week=%s", week),
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					ReplaceMealPlanEntriesTx(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:     "InvalidWeek",
+			familyID: user.FamilyID,
+			query:    "week=2026-W60",
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					ReplaceMealPlanEntriesTx(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:     "InvalidSlot",
+			familyID: user.FamilyID,
+			query:    fmt.Sprintf("week=%s&slot=brunch", week),
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					ReplaceMealPlanEntriesTx(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			server := newTestServer(t, store)
+
+			tc.stubs(store)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/families/%s/meal-plans/generate?%s", tc.familyID.String(), tc.query)
+
+			var body io.Reader
+			if tc.body != nil {
+				data, err := encodeJSON(tc.body)
+				require.NoError(t, err)
+				body = bytes.NewReader(data)
+			}
+
+			request, err := http.NewRequest(http.MethodPost, url, body)
+			require.NoError(t, err)
+			setAuth(t, request, server.tokenMaker, authHeaderTypeBearer, user.Email, time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,639 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	database "github.com/andreiz53/cookinator/database/handlers"
+	"github.com/andreiz53/cookinator/types"
+	"github.com/andreiz53/cookinator/util"
+)
+
+var (
+	errEmptyWeek           = errors.New("the meal plan has no entries to save")
+	errWeekInPast          = errors.New("templates can only be applied to the current or a future week")
+	errTemplateNotInFamily = errors.New("the template does not belong to the family")
+)
+
+const (
+	defaultPopulateWeeks = 4
+)
+
+type MealPlanTemplate struct {
+	ID        uuid.UUID               `json:"id"`
+	CreatedAt pgtype.Timestamp        `json:"created_at"`
+	FamilyID  uuid.UUID               `json:"family_id"`
+	Name      string                  `json:"name"`
+	Entries   []MealPlanTemplateEntry `json:"entries,omitempty"`
+}
+
+// MealPlanTemplateEntry is a recipe planned for a meal of a week day, 0 is Monday
+type MealPlanTemplateEntry struct {
+	ID         uuid.UUID      `json:"id"`
+	Weekday    int32          `json:"weekday"`
+	Slot       types.MealSlot `json:"slot"`
+	RecipeID   uuid.UUID      `json:"recipe_id"`
+	RecipeName string         `json:"recipe_name"`
+	Servings   int32          `json:"servings"`
+	Notes      string         `json:"notes"`
+}
+
+type MealPlanRotation struct {
+	FamilyID  uuid.UUID          `json:"family_id"`
+	StartWeek string             `json:"start_week"`
+	Templates []MealPlanTemplate `json:"templates"`
+	// Upcoming are the next weeks with the template the rotation gives them
+	Upcoming []RotationWeek `json:"upcoming"`
+}
+
+type RotationWeek struct {
+	Week         string      `json:"week"`
+	WeekStart    pgtype.Date `json:"week_start"`
+	TemplateID   uuid.UUID   `json:"template_id"`
+	TemplateName string      `json:"template_name"`
+	// Populated is only set by populate, false when the week was already planned and left as it was
+	Populated  bool       `json:"populated"`
+	MealPlanID *uuid.UUID `json:"meal_plan_id,omitempty"`
+}
+
+type CreateMealPlanTemplateParams struct {
+	Name string `json:"name" binding:"required,max=128"`
+}
+
+type MealPlanTemplateParams struct {
+	ID string `uri:"id" binding:"required,uuid4_rfc4122"`
+}
+
+type ApplyMealPlanTemplateParams struct {
+	Week string `json:"week" binding:"required"`
+}
+
+// SetMealPlanRotationParams sets the templates the family cycles through, the first one is used for StartWeek
+type SetMealPlanRotationParams struct {
+	StartWeek   string   `json:"start_week" binding:"required"`
+	TemplateIDs []string `json:"template_ids" binding:"required,min=1,max=12,dive,uuid4_rfc4122"`
+}
+
+type PopulateMealPlanRotationQuery struct {
+	Weeks int `form:"weeks" binding:"omitempty,min=1,max=12"`
+}
+
+func DBMealPlanTemplateToMealPlanTemplate(arg database.MealPlanTemplate) MealPlanTemplate {
+	return MealPlanTemplate{
+		ID:        arg.ID,
+		CreatedAt: arg.CreatedAt,
+		FamilyID:  arg.FamilyID,
+		Name:      arg.Name,
+	}
+}
+
+func DBMealPlanTemplatesToMealPlanTemplates(arg []database.MealPlanTemplate) []MealPlanTemplate {
+	templates := []MealPlanTemplate{}
+	for _, template := range arg {
+		templates = append(templates, DBMealPlanTemplateToMealPlanTemplate(template))
+	}
+	return templates
+}
+
+func DBMealPlanTemplateEntriesToMealPlanTemplateEntries(arg []database.GetMealPlanTemplateEntriesRow) []MealPlanTemplateEntry {
+	entries := []MealPlanTemplateEntry{}
+	for _, entry := range arg {
+		entries = append(entries, MealPlanTemplateEntry{
+			ID:         entry.ID,
+			Weekday:    entry.Weekday,
+			Slot:       types.MealSlot(entry.Slot),
+			RecipeID:   entry.RecipeID,
+			RecipeName: entry.RecipeName,
+			Servings:   entry.Servings,
+			Notes:      entry.Notes,
+		})
+	}
+	return entries
+}
+
+// MealPlanEntriesToTemplateEntries keeps the week day of each entry instead of its date.
+// Leftovers are left out, they only make sense next to the batch they come from.
+func MealPlanEntriesToTemplateEntries(plan database.MealPlan, arg []database.GetMealPlanEntriesRow) []database.CreateMealPlanTemplateEntryParams {
+	entries := []database.CreateMealPlanTemplateEntryParams{}
+	for _, entry := range arg {
+		if entry.LeftoverOf.Valid {
+			continue
+		}
+		entries = append(entries, database.CreateMealPlanTemplateEntryParams{
+			Weekday:  int32(entry.Day.Time.Sub(plan.WeekStart.Time).Hours() / 24),
+			Slot:     entry.Slot,
+			RecipeID: entry.RecipeID,
+			Servings: entry.Servings,
+			Notes:    entry.Notes,
+		})
+	}
+	return entries
+}
+
+// TemplateEntriesToMealPlanEntries shifts the entries of a template to the days of a week,
+// leaving out the meals an entry the plan keeps already takes
+func TemplateEntriesToMealPlanEntries(arg []database.GetMealPlanTemplateEntriesRow, weekStart time.Time, planned []database.GetMealPlanEntriesRow) []database.CreateMealPlanEntryParams {
+	type meal struct {
+		day  time.Time
+		slot string
+	}
+	locked := map[meal]bool{}
+	for _, entry := range planned {
+		if keptOnReplace(entry) {
+			locked[meal{entry.Day.Time, entry.Slot}] = true
+		}
+	}
+
+	entries := []database.CreateMealPlanEntryParams{}
+	for _, entry := range arg {
+		day := util.NewDate(weekStart.AddDate(0, 0, int(entry.Weekday)))
+		if locked[meal{day.Time, entry.Slot}] {
+			continue
+		}
+		entries = append(entries, database.CreateMealPlanEntryParams{
+			Day:      day,
+			Slot:     entry.Slot,
+			RecipeID: entry.RecipeID,
+			Servings: entry.Servings,
+			Notes:    entry.Notes,
+		})
+	}
+	return entries
+}
+
+// RotationTemplate returns the template a rotation gives a week, the rotation repeats from its start week on
+func RotationTemplate(rotation database.MealPlanRotation, templates []database.GetMealPlanRotationTemplatesRow, weekStart time.Time) (database.GetMealPlanRotationTemplatesRow, bool) {
+	if len(templates) == 0 || weekStart.Before(rotation.StartWeek.Time) {
+		return database.GetMealPlanRotationTemplatesRow{}, false
+	}
+	weeks := int(weekStart.Sub(rotation.StartWeek.Time).Hours() / 24 / 7)
+	return templates[weeks%len(templates)], true
+}
+
+// familyMealPlanTemplate loads a template and makes sure it belongs to the user's family.
+// It writes the error response itself and returns false on failure.
+func (s *Server) familyMealPlanTemplate(ctx *gin.Context, user database.User, id uuid.UUID) (database.MealPlanTemplate, bool) {
+	template, err := s.store.GetMealPlanTemplateByID(ctx, id)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, respondWithErorr(err))
+			return template, false
+		}
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return template, false
+	}
+	if template.FamilyID != user.FamilyID {
+		ctx.JSON(http.StatusForbidden, respondWithErorr(errForbidden))
+		return template, false
+	}
+	return template, true
+}
+
+// applyMealPlanTemplate replaces the unlocked entries of a plan with the ones of a template.
+// It writes the error response itself and returns false on failure.
+func (s *Server) applyMealPlanTemplate(ctx *gin.Context, plan database.MealPlan, templateID uuid.UUID) bool {
+	templateEntries, err := s.store.GetMealPlanTemplateEntries(ctx, templateID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return false
+	}
+	planned, err := s.store.GetMealPlanEntries(ctx, plan.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return false
+	}
+
+	_, err = s.store.ReplaceMealPlanEntriesTx(ctx, database.ReplaceMealPlanEntriesTxParams{
+		MealPlanID: plan.ID,
+		Entries:    TemplateEntriesToMealPlanEntries(templateEntries, plan.WeekStart.Time, planned),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return false
+	}
+	return true
+}
+
+// createMealPlanTemplate saves the week of a meal plan as a named template
+func (s *Server) createMealPlanTemplate(ctx *gin.Context) {
+	var uri GetMealPlanByIDParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	var request CreateMealPlanTemplateParams
+	err = ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	plan, ok := s.familyMealPlan(ctx, user, uuid.MustParse(uri.ID))
+	if !ok {
+		return
+	}
+
+	planned, err := s.store.GetMealPlanEntries(ctx, plan.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+	entries := MealPlanEntriesToTemplateEntries(plan, planned)
+	if len(entries) == 0 {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(errEmptyWeek))
+		return
+	}
+
+	template, err := s.store.CreateMealPlanTemplateTx(ctx, database.CreateMealPlanTemplateTxParams{
+		Template: database.CreateMealPlanTemplateParams{
+			FamilyID: plan.FamilyID,
+			Name:     request.Name,
+		},
+		Entries: entries,
+	})
+	if err != nil {
+		if database.ErrorCode(err) == database.CodeDuplicateKey {
+			ctx.JSON(http.StatusConflict, respondWithErorr(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, DBMealPlanTemplateToMealPlanTemplate(template))
+}
+
+func (s *Server) getMealPlanTemplates(ctx *gin.Context) {
+	var request FamilyMealPlansParams
+	err := ctx.ShouldBindUri(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	familyID := uuid.MustParse(request.ID)
+	_, ok := s.authFamilyMember(ctx, familyID)
+	if !ok {
+		return
+	}
+
+	templates, err := s.store.GetMealPlanTemplatesByFamilyID(ctx, familyID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, DBMealPlanTemplatesToMealPlanTemplates(templates))
+}
+
+func (s *Server) getMealPlanTemplateByID(ctx *gin.Context) {
+	var request MealPlanTemplateParams
+	err := ctx.ShouldBindUri(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	template, ok := s.familyMealPlanTemplate(ctx, user, uuid.MustParse(request.ID))
+	if !ok {
+		return
+	}
+
+	entries, err := s.store.GetMealPlanTemplateEntries(ctx, template.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	response := DBMealPlanTemplateToMealPlanTemplate(template)
+	response.Entries = DBMealPlanTemplateEntriesToMealPlanTemplateEntries(entries)
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (s *Server) deleteMealPlanTemplate(ctx *gin.Context) {
+	var request MealPlanTemplateParams
+	err := ctx.ShouldBindUri(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	template, ok := s.familyMealPlanTemplate(ctx, user, uuid.MustParse(request.ID))
+	if !ok {
+		return
+	}
+
+	err = s.store.DeleteMealPlanTemplate(ctx, template.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, respondWithMessage(fmt.Sprintf("deleted meal plan template with id %s", request.ID)))
+}
+
+// applyMealPlanTemplateToWeek fills a week with the recipes of a template, shifted to the days of that week.
+// Locked entries of the week are kept and the template's meals they take are left out.
+func (s *Server) applyMealPlanTemplateToWeek(ctx *gin.Context) {
+	var uri MealPlanTemplateParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	var request ApplyMealPlanTemplateParams
+	err = ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	weekStart, err := util.ParseISOWeek(request.Week)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+	if weekStart.Time.Before(util.WeekStart(time.Now()).Time) {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(errWeekInPast))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	template, ok := s.familyMealPlanTemplate(ctx, user, uuid.MustParse(uri.ID))
+	if !ok {
+		return
+	}
+
+	plan, ok := s.familyMealPlanByWeek(ctx, database.GetMealPlanByWeekParams{
+		FamilyID:  template.FamilyID,
+		WeekStart: weekStart,
+	})
+	if !ok {
+		return
+	}
+	if !editableMealPlan(ctx, plan) {
+		return
+	}
+
+	ok = s.applyMealPlanTemplate(ctx, plan, template.ID)
+	if !ok {
+		return
+	}
+
+	entries, err := s.store.GetMealPlanEntries(ctx, plan.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	response := DBMealPlanToMealPlan(plan)
+	response.Entries = DBMealPlanEntriesToMealPlanEntries(entries)
+	ctx.JSON(http.StatusOK, response)
+}
+
+// mealPlanRotation loads the rotation of a family with its templates in order, upcoming lists the next weeks.
+// It writes the error response itself and returns false on failure.
+func (s *Server) mealPlanRotation(ctx *gin.Context, familyID uuid.UUID, upcoming int) (MealPlanRotation, bool) {
+	rotation, err := s.store.GetMealPlanRotation(ctx, familyID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, respondWithErorr(err))
+			return MealPlanRotation{}, false
+		}
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return MealPlanRotation{}, false
+	}
+
+	templates, err := s.store.GetMealPlanRotationTemplates(ctx, familyID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return MealPlanRotation{}, false
+	}
+
+	response := MealPlanRotation{
+		FamilyID:  rotation.FamilyID,
+		StartWeek: util.ISOWeek(rotation.StartWeek.Time),
+		Templates: []MealPlanTemplate{},
+		Upcoming:  []RotationWeek{},
+	}
+	for _, template := range templates {
+		response.Templates = append(response.Templates, MealPlanTemplate{
+			ID:       template.ID,
+			FamilyID: familyID,
+			Name:     template.Name,
+		})
+	}
+
+	next := util.WeekStart(time.Now()).Time.AddDate(0, 0, 7)
+	for i := 0; i < upcoming; i++ {
+		weekStart := next.AddDate(0, 0, 7*i)
+		template, ok := RotationTemplate(rotation, templates, weekStart)
+		if !ok {
+			continue
+		}
+		response.Upcoming = append(response.Upcoming, RotationWeek{
+			Week:         util.ISOWeek(weekStart),
+			WeekStart:    util.NewDate(weekStart),
+			TemplateID:   template.ID,
+			TemplateName: template.Name,
+		})
+	}
+	return response, true
+}
+
+// setMealPlanRotation sets the templates a family cycles through week after week
+func (s *Server) setMealPlanRotation(ctx *gin.Context) {
+	var uri FamilyMealPlansParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	var request SetMealPlanRotationParams
+	err = ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	startWeek, err := util.ParseISOWeek(request.StartWeek)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	familyID := uuid.MustParse(uri.ID)
+	_, ok := s.authFamilyMember(ctx, familyID)
+	if !ok {
+		return
+	}
+
+	templates, err := s.store.GetMealPlanTemplatesByFamilyID(ctx, familyID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+	owned := map[uuid.UUID]bool{}
+	for _, template := range templates {
+		owned[template.ID] = true
+	}
+
+	arg := database.SetMealPlanRotationTxParams{
+		FamilyID:  familyID,
+		StartWeek: startWeek,
+	}
+	for _, id := range request.TemplateIDs {
+		templateID := uuid.MustParse(id)
+		if !owned[templateID] {
+			ctx.JSON(http.StatusBadRequest, respondWithErorr(errTemplateNotInFamily))
+			return
+		}
+		arg.TemplateIDs = append(arg.TemplateIDs, templateID)
+	}
+
+	_, err = s.store.SetMealPlanRotationTx(ctx, arg)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	rotation, ok := s.mealPlanRotation(ctx, familyID, defaultPopulateWeeks)
+	if !ok {
+		return
+	}
+	ctx.JSON(http.StatusOK, rotation)
+}
+
+func (s *Server) getMealPlanRotation(ctx *gin.Context) {
+	var request FamilyMealPlansParams
+	err := ctx.ShouldBindUri(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	familyID := uuid.MustParse(request.ID)
+	_, ok := s.authFamilyMember(ctx, familyID)
+	if !ok {
+		return
+	}
+
+	rotation, ok := s.mealPlanRotation(ctx, familyID, defaultPopulateWeeks)
+	if !ok {
+		return
+	}
+	ctx.JSON(http.StatusOK, rotation)
+}
+
+func (s *Server) deleteMealPlanRotation(ctx *gin.Context) {
+	var request FamilyMealPlansParams
+	err := ctx.ShouldBindUri(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	familyID := uuid.MustParse(request.ID)
+	_, ok := s.authFamilyMember(ctx, familyID)
+	if !ok {
+		return
+	}
+
+	err = s.store.DeleteMealPlanRotation(ctx, familyID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, respondWithMessage(fmt.Sprintf("deleted meal plan rotation of family with id %s", request.ID)))
+}
+
+// populateMealPlanRotation applies the rotation to the next weeks. Weeks that already have entries
+// were planned by hand or populated before, so they are left as they are.
+func (s *Server) populateMealPlanRotation(ctx *gin.Context) {
+	var uri FamilyMealPlansParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	var query PopulateMealPlanRotationQuery
+	err = ctx.ShouldBindQuery(&query)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+	if query.Weeks == 0 {
+		query.Weeks = defaultPopulateWeeks
+	}
+
+	familyID := uuid.MustParse(uri.ID)
+	_, ok := s.authFamilyMember(ctx, familyID)
+	if !ok {
+		return
+	}
+
+	rotation, ok := s.mealPlanRotation(ctx, familyID, query.Weeks)
+	if !ok {
+		return
+	}
+
+	weeks := []RotationWeek{}
+	for _, week := range rotation.Upcoming {
+		plan, ok := s.familyMealPlanByWeek(ctx, database.GetMealPlanByWeekParams{
+			FamilyID:  familyID,
+			WeekStart: week.WeekStart,
+		})
+		if !ok {
+			return
+		}
+		week.MealPlanID = &plan.ID
+
+		entries, err := s.store.GetMealPlanEntries(ctx, plan.ID)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+			return
+		}
+		if len(entries) == 0 && plan.Status != types.MealPlanStatusFinal {
+			ok = s.applyMealPlanTemplate(ctx, plan, week.TemplateID)
+			if !ok {
+				return
+			}
+			week.Populated = true
+		}
+		weeks = append(weeks, week)
+	}
+
+	ctx.JSON(http.StatusOK, weeks)
+}
@@ -0,0 +1,467 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	database "github.com/andreiz53/cookinator/database/handlers"
+	databaseMock "github.com/andreiz53/cookinator/database/mocks"
+	"github.com/andreiz53/cookinator/types"
+	"github.com/andreiz53/cookinator/util"
+)
+
+func randomMealPlanTemplate(familyID uuid.UUID) database.MealPlanTemplate {
+	return database.MealPlanTemplate{
+		ID:       uuid.New(),
+		FamilyID: familyID,
+		Name:     util.RandomName(),
+	}
+}
+
+func TestCreateMealPlanTemplate(t *testing.T) {
+	user := randomFamilyUser(t)
+	plan := randomMealPlan(user.FamilyID)
+	otherPlan := randomMealPlan(uuid.New())
+	recipe := randomRecipe(t, user.FamilyID)
+	template := randomMealPlanTemplate(user.FamilyID)
+
+	cooked := randomMealPlanEntry(plan, recipe, 2, types.MealSlotDinner)
+	entries := []database.GetMealPlanEntriesRow{
+		{ID: cooked.ID, Day: cooked.Day, Slot: cooked.Slot, RecipeID: recipe.ID, Servings: 4},
+		{ID: uuid.New(), Day: util.NewDate(cooked.Day.Time.AddDate(0, 0, 1)), Slot: types.MealSlotLunch, RecipeID: recipe.ID, Servings: 2,
+			LeftoverOf: pgtype.UUID{Bytes: cooked.ID, Valid: true}},
+	}
+
+	testCases := []struct {
+		name          string
+		planID        uuid.UUID
+		stubs         func(store *databaseMock.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "OK",
+			planID: plan.ID,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetMealPlanByID(mock.Anything, plan.ID).
+					Times(1).Return(plan, nil)
+				store.EXPECT().
+					GetMealPlanEntries(mock.Anything, plan.ID).
+					Times(1).Return(entries, nil)
+				store.EXPECT().
+					CreateMealPlanTemplateTx(mock.Anything, mock.MatchedBy(func(arg database.CreateMealPlanTemplateTxParams) bool {
+						return arg.Template.Name == template.Name && len(arg.Entries) == 1 &&
+							arg.Entries[0].Weekday == 2 && arg.Entries[0].Servings == 4
+					})).
+					Times(1).Return(template, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				response, err := decodeJSON[MealPlanTemplate](recorder.Body)
+				require.NoError(t, err)
+				require.Equal(t, template.ID, response.ID)
+			},
+		},
+		{
+			name:   "EmptyWeek",
+			planID: plan.ID,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetMealPlanByID(mock.Anything, plan.ID).
+					Times(1).Return(plan, nil)
+				store.EXPECT().
+					GetMealPlanEntries(mock.Anything, plan.ID).
+					Times(1).Return(entries[1:], nil)
+				store.EXPECT().
+					CreateMealPlanTemplateTx(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "DuplicateName",
+			planID: plan.ID,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetMealPlanByID(mock.Anything, plan.ID).
+					Times(1).Return(plan, nil)
+				store.EXPECT().
+					GetMealPlanEntries(mock.Anything, plan.ID).
+					Times(1).Return(entries, nil)
+				store.EXPECT().
+					CreateMealPlanTemplateTx(mock.Anything, mock.Anything).
+					Times(1).Return(database.MealPlanTemplate{}, database.ErrDuplicateKey)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name:   "OtherFamily",
+			planID: otherPlan.ID,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetMealPlanByID(mock.Anything, otherPlan.ID).
+					Times(1).Return(otherPlan, nil)
+				store.EXPECT().
+					GetMealPlanEntries(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			server := newTestServer(t, store)
+
+			tc.stubs(store)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/meal-plans/%s/template", tc.planID.String())
+			data, err := encodeJSON(CreateMealPlanTemplateParams{Name: template.Name})
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+			setAuth(t, request, server.tokenMaker, authHeaderTypeBearer, user.Email, time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestTemplateEntriesToMealPlanEntries(t *testing.T) {
+	recipe := randomRecipe(t, uuid.New())
+	plan := randomMealPlan(uuid.New())
+	templateEntries := []database.GetMealPlanTemplateEntriesRow{
+		{ID: uuid.New(), Weekday: 0, Slot: types.MealSlotDinner, RecipeID: recipe.ID, Servings: 4},
+		{ID: uuid.New(), Weekday: 3, Slot: types.MealSlotLunch, RecipeID: recipe.ID, Servings: 2},
+	}
+	// Monday's dinner isn't locked, but its leftovers are eaten at a locked meal
+	cooked := randomMealPlanEntry(plan, recipe, 0, types.MealSlotDinner)
+	unlocked := randomMealPlanEntry(plan, recipe, 3, types.MealSlotLunch)
+	planned := []database.GetMealPlanEntriesRow{
+		{ID: cooked.ID, Day: cooked.Day, Slot: cooked.Slot, RecipeID: recipe.ID, Servings: 2, KeepsLeftovers: true},
+		{ID: unlocked.ID, Day: unlocked.Day, Slot: unlocked.Slot, RecipeID: recipe.ID, Servings: 2},
+	}
+
+	entries := TemplateEntriesToMealPlanEntries(templateEntries, plan.WeekStart.Time, planned)
+	require.Len(t, entries, 1)
+	require.Equal(t, unlocked.Day, entries[0].Day)
+	require.Equal(t, string(types.MealSlotLunch), entries[0].Slot)
+}
+
+func TestApplyMealPlanTemplate(t *testing.T) {
+	user := randomFamilyUser(t)
+	recipe := randomRecipe(t, user.FamilyID)
+	template := randomMealPlanTemplate(user.FamilyID)
+	plan := randomMealPlan(user.FamilyID)
+	plan.WeekStart = util.NewDate(plan.WeekStart.Time.AddDate(0, 0, 7))
+	week := util.ISOWeek(plan.WeekStart.Time)
+
+	templateEntries := []database.GetMealPlanTemplateEntriesRow{
+		{ID: uuid.New(), Weekday: 0, Slot: types.MealSlotDinner, RecipeID: recipe.ID, Servings: 4},
+		{ID: uuid.New(), Weekday: 3, Slot: types.MealSlotLunch, RecipeID: recipe.ID, Servings: 2},
+	}
+	locked := randomMealPlanEntry(plan, recipe, 3, types.MealSlotLunch)
+	planned := []database.GetMealPlanEntriesRow{
+		{ID: locked.ID, Day: locked.Day, Slot: locked.Slot, RecipeID: recipe.ID, Servings: 1, Locked: true},
+	}
+
+	testCases := []struct {
+		name          string
+		week          string
+		stubs         func(store *databaseMock.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			week: week,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetMealPlanTemplateByID(mock.Anything, template.ID).
+					Times(1).Return(template, nil)
+				store.EXPECT().
+					GetMealPlanByWeek(mock.Anything, database.GetMealPlanByWeekParams{FamilyID: user.FamilyID, WeekStart: plan.WeekStart}).
+					Times(1).Return(database.MealPlan{}, pgx.ErrNoRows)
+				store.EXPECT().
+					CreateMealPlan(mock.Anything, database.CreateMealPlanParams{FamilyID: user.FamilyID, WeekStart: plan.WeekStart}).
+					Times(1).Return(plan, nil)
+				store.EXPECT().
+					GetMealPlanTemplateEntries(mock.Anything, template.ID).
+					Times(1).Return(templateEntries, nil)
+				store.EXPECT().
+					GetMealPlanEntries(mock.Anything, plan.ID).
+					Times(2).Return(planned, nil)
+				store.EXPECT().
+					ReplaceMealPlanEntriesTx(mock.Anything, mock.MatchedBy(func(arg database.ReplaceMealPlanEntriesTxParams) bool {
+						return arg.MealPlanID == plan.ID && len(arg.Entries) == 1 &&
+							arg.Entries[0].Day == plan.WeekStart && arg.Entries[0].Slot == types.MealSlotDinner
+					})).
+					Times(1).Return([]database.MealPlanEntry{}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				response, err := decodeJSON[MealPlan](recorder.Body)
+				require.NoError(t, err)
+				require.Equal(t, plan.ID, response.ID)
+			},
+		},
+		{
+			name: "WeekInPast",
+			week: util.ISOWeek(time.Now().AddDate(0, 0, -7)),
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetMealPlanTemplateByID(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidWeek",
+			week: "next week",
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetMealPlanTemplateByID(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "OtherFamily",
+			week: week,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetMealPlanTemplateByID(mock.Anything, template.ID).
+					Times(1).Return(randomMealPlanTemplate(uuid.New()), nil)
+				store.EXPECT().
+					ReplaceMealPlanEntriesTx(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			server := newTestServer(t, store)
+
+			tc.stubs(store)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/meal-plan-templates/%s/apply", template.ID.String())
+			data, err := encodeJSON(ApplyMealPlanTemplateParams{Week: tc.week})
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+			setAuth(t, request, server.tokenMaker, authHeaderTypeBearer, user.Email, time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestPopulateMealPlanRotation(t *testing.T) {
+	user := randomFamilyUser(t)
+	recipe := randomRecipe(t, user.FamilyID)
+	nextWeek := util.NewDate(util.WeekStart(time.Now()).Time.AddDate(0, 0, 7))
+	rotation := database.MealPlanRotation{FamilyID: user.FamilyID, StartWeek: nextWeek}
+	templates := []database.GetMealPlanRotationTemplatesRow{
+		{Position: 0, ID: uuid.New(), Name: "A"},
+		{Position: 1, ID: uuid.New(), Name: "B"},
+	}
+
+	planned := randomMealPlan(user.FamilyID)
+	planned.WeekStart = nextWeek
+	empty := randomMealPlan(user.FamilyID)
+	empty.WeekStart = util.NewDate(nextWeek.Time.AddDate(0, 0, 7))
+	entry := randomMealPlanEntry(planned, recipe, 0, types.MealSlotDinner)
+
+	testCases := []struct {
+		name          string
+		familyID      uuid.UUID
+		query         string
+		stubs         func(store *databaseMock.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "OK",
+			familyID: user.FamilyID,
+			query:    "?weeks=2",
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetMealPlanRotation(mock.Anything, user.FamilyID).
+					Times(1).Return(rotation, nil)
+				store.EXPECT().
+					GetMealPlanRotationTemplates(mock.Anything, user.FamilyID).
+					Times(1).Return(templates, nil)
+				store.EXPECT().
+					GetMealPlanByWeek(mock.Anything, database.GetMealPlanByWeekParams{FamilyID: user.FamilyID, WeekStart: planned.WeekStart}).
+					Times(1).Return(planned, nil)
+				store.EXPECT().
+					GetMealPlanByWeek(mock.Anything, database.GetMealPlanByWeekParams{FamilyID: user.FamilyID, WeekStart: empty.WeekStart}).
+					Times(1).Return(empty, nil)
+				store.EXPECT().
+					GetMealPlanEntries(mock.Anything, planned.ID).
+					Times(1).Return([]database.GetMealPlanEntriesRow{{ID: entry.ID, Day: entry.Day, Slot: entry.Slot, RecipeID: recipe.ID}}, nil)
+				store.EXPECT().
+					GetMealPlanEntries(mock.Anything, empty.ID).
+					Times(2).Return([]database.GetMealPlanEntriesRow{}, nil)
+				store.EXPECT().
+					GetMealPlanTemplateEntries(mock.Anything, templates[1].ID).
+					Times(1).Return([]database.GetMealPlanTemplateEntriesRow{
+					{ID: uuid.New(), Weekday: 4, Slot: types.MealSlotDinner, RecipeID: recipe.ID, Servings: 4},
+				}, nil)
+				store.EXPECT().
+					ReplaceMealPlanEntriesTx(mock.Anything, mock.MatchedBy(func(arg database.ReplaceMealPlanEntriesTxParams) bool {
+						return arg.MealPlanID == empty.ID && len(arg.Entries) == 1
+					})).
+					Times(1).Return([]database.MealPlanEntry{}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				weeks, err := decodeJSON[[]RotationWeek](recorder.Body)
+				require.NoError(t, err)
+				require.Len(t, weeks, 2)
+				require.Equal(t, templates[0].ID, weeks[0].TemplateID)
+				require.False(t, weeks[0].Populated)
+				require.Equal(t, templates[1].ID, weeks[1].TemplateID)
+				require.True(t, weeks[1].Populated)
+				require.Equal(t, empty.ID, *weeks[1].MealPlanID)
+			},
+		},
+		{
+			name:     "NoRotation",
+			familyID: user.FamilyID,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetMealPlanRotation(mock.Anything, user.FamilyID).
+					Times(1).Return(database.MealPlanRotation{}, pgx.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:     "InvalidWeeks",
+			familyID: user.FamilyID,
+			query:    "?weeks=20",
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetMealPlanRotation(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:     "OtherFamily",
+			familyID: uuid.New(),
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetMealPlanRotation(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			server := newTestServer(t, store)
+
+			tc.stubs(store)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/families/%s/meal-plan-rotation/populate%s", tc.familyID.String(), tc.query)
+			request, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+			setAuth(t, request, server.tokenMaker, authHeaderTypeBearer, user.Email, time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestRotationTemplate(t *testing.T) {
+	start := util.WeekStart(time.Now())
+	rotation := database.MealPlanRotation{StartWeek: start}
+	templates := []database.GetMealPlanRotationTemplatesRow{
+		{Position: 0, ID: uuid.New()},
+		{Position: 1, ID: uuid.New()},
+		{Position: 2, ID: uuid.New()},
+	}
+
+	_, ok := RotationTemplate(rotation, templates, start.Time.AddDate(0, 0, -7))
+	require.False(t, ok)
+
+	for week, position := range []int{0, 1, 2, 0, 1} {
+		template, ok := RotationTemplate(rotation, templates, start.Time.AddDate(0, 0, 7*week))
+		require.True(t, ok)
+		require.Equal(t, templates[position].ID, template.ID)
+	}
+
+	_, ok = RotationTemplate(rotation, nil, start.Time)
+	require.False(t, ok)
+}
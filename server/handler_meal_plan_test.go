@@ -0,0 +1,893 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	database "github.com/andreiz53/cookinator/database/handlers"
+	databaseMock "github.com/andreiz53/cookinator/database/mocks"
+	"github.com/andreiz53/cookinator/types"
+	"github.com/andreiz53/cookinator/util"
+)
+
+func randomMealPlan(familyID uuid.UUID) database.MealPlan {
+	return database.MealPlan{
+		ID:        uuid.New(),
+		FamilyID:  familyID,
+		WeekStart: util.WeekStart(time.Now()),
+	}
+}
+
+func randomMealPlanEntry(plan database.MealPlan, recipe database.Recipe, day int, slot types.MealSlot) database.MealPlanEntry {
+	return database.MealPlanEntry{
+		ID:         uuid.New(),
+		MealPlanID: plan.ID,
+		Day:        util.NewDate(plan.WeekStart.Time.AddDate(0, 0, day)),
+		Slot:       string(slot),
+		RecipeID:   recipe.ID,
+		Servings:   int32(util.RandomInt(1, 6)),
+		Notes:      util.RandomString(12),
+	}
+}
+
+func TestCreateMealPlan(t *testing.T) {
+	user := randomFamilyUser(t)
+	plan := randomMealPlan(user.FamilyID)
+
+	testCases := []struct {
+		name          string
+		familyID      uuid.UUID
+		params        CreateMealPlanParams
+		stubs         func(store *databaseMock.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "OK",
+			familyID: user.FamilyID,
+			// a Thursday is moved back to the Monday of its week
+			params: CreateMealPlanParams{WeekStart: plan.WeekStart.Time.AddDate(0, 0, 3).Format(util.DateLayout)},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					CreateMealPlan(mock.Anything, database.CreateMealPlanParams{
+						FamilyID:  user.FamilyID,
+						WeekStart: plan.WeekStart,
+					}).
+					Times(1).Return(plan, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+			},
+		},
+		{
+			name:     "Duplicate",
+			familyID: user.FamilyID,
+			params:   CreateMealPlanParams{WeekStart: plan.WeekStart.Time.Format(util.DateLayout)},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					CreateMealPlan(mock.Anything, mock.Anything).
+					Times(1).Return(database.MealPlan{}, database.ErrDuplicateKey)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name:     "OtherFamily",
+			familyID: uuid.New(),
+			params:   CreateMealPlanParams{WeekStart: plan.WeekStart.Time.Format(util.DateLayout)},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					CreateMealPlan(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:     "BadRequest",
+			familyID: user.FamilyID,
+			params:   CreateMealPlanParams{WeekStart: "next week"},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					CreateMealPlan(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			server := newTestServer(t, store)
+
+			tc.stubs(store)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/families/%s/meal-plans", tc.familyID.String())
+
+			data, err := encodeJSON(tc.params)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+			setAuth(t, request, server.tokenMaker, authHeaderTypeBearer, user.Email, time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestGetMealPlanByID(t *testing.T) {
+	user := randomFamilyUser(t)
+	plan := randomMealPlan(user.FamilyID)
+	otherPlan := randomMealPlan(uuid.New())
+	recipe := randomRecipe(t, user.FamilyID)
+	entry := randomMealPlanEntry(plan, recipe, 0, types.MealSlotDinner)
+
+	testCases := []struct {
+		name          string
+		plan          database.MealPlan
+		stubs         func(store *databaseMock.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			plan: plan,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetMealPlanByID(mock.Anything, plan.ID).
+					Times(1).Return(plan, nil)
+				store.EXPECT().
+					GetMealPlanEntries(mock.Anything, plan.ID).
+					Times(1).Return([]database.GetMealPlanEntriesRow{{
+					ID:         entry.ID,
+					MealPlanID: plan.ID,
+					Day:        entry.Day,
+					Slot:       entry.Slot,
+					RecipeID:   recipe.ID,
+					Servings:   entry.Servings,
+					RecipeName: recipe.Name,
+				}}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				gotPlan, err := decodeJSON[MealPlan](recorder.Body)
+				require.NoError(t, err)
+				require.Equal(t, plan.ID, gotPlan.ID)
+				require.Len(t, gotPlan.Entries, 1)
+				require.Equal(t, recipe.Name, gotPlan.Entries[0].RecipeName)
+				require.Equal(t, entry.Day.Time, gotPlan.Entries[0].Day.Time)
+			},
+		},
+		{
+			name: "OtherFamily",
+			plan: otherPlan,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetMealPlanByID(mock.Anything, otherPlan.ID).
+					Times(1).Return(otherPlan, nil)
+				store.EXPECT().
+					GetMealPlanEntries(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "NotFound",
+			plan: plan,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetMealPlanByID(mock.Anything, plan.ID).
+					Times(1).Return(database.MealPlan{}, pgx.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			server := newTestServer(t, store)
+
+			store.EXPECT().
+				GetUserByEmail(mock.Anything, user.Email).
+				Times(1).Return(user, nil)
+			tc.stubs(store)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/meal-plans/%s", tc.plan.ID.String())
+
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+			setAuth(t, request, server.tokenMaker, authHeaderTypeBearer, user.Email, time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestCreateMealPlanEntry(t *testing.T) {
+	user := randomFamilyUser(t)
+	plan := randomMealPlan(user.FamilyID)
+	recipe := randomRecipe(t, user.FamilyID)
+	entry := randomMealPlanEntry(plan, recipe, 2, types.MealSlotLunch)
+
+	params := CreateMealPlanEntryParams{
+		Day:      entry.Day.Time.Format(util.DateLayout),
+		Slot:     types.MealSlotLunch,
+		RecipeID: recipe.ID.String(),
+		Servings: entry.Servings,
+		Notes:    entry.Notes,
+	}
+	nextWeek := params
+	nextWeek.Day = plan.WeekStart.Time.AddDate(0, 0, 7).Format(util.DateLayout)
+	badSlot := params
+	badSlot.Slot = "brunch"
+	autoServings := params
+	autoServings.Servings = 0
+	kid := randomUser(t)
+	kid.PortionFactor = 0.5
+
+	cooked := randomMealPlanEntry(plan, recipe, 0, types.MealSlotDinner)
+	cooked.BatchServings = pgtype.Int4{Int32: cooked.Servings + 4, Valid: true}
+	leftover := CreateMealPlanEntryParams{
+		Day:        plan.WeekStart.Time.AddDate(0, 0, 1).Format(util.DateLayout),
+		Slot:       types.MealSlotLunch,
+		Servings:   2,
+		LeftoverOf: cooked.ID.String(),
+	}
+	leftoverBefore := leftover
+	leftoverBefore.Day = plan.WeekStart.Time.Format(util.DateLayout)
+
+	testCases := []struct {
+		name          string
+		params        CreateMealPlanEntryParams
+		stubs         func(store *databaseMock.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "OK",
+			params: params,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetMealPlanByID(mock.Anything, plan.ID).
+					Times(1).Return(plan, nil)
+				store.EXPECT().
+					GetRecipeByID(mock.Anything, recipe.ID).
+					Times(1).Return(recipe, nil)
+				store.EXPECT().
+					CreateMealPlanEntry(mock.Anything, database.CreateMealPlanEntryParams{
+						MealPlanID: plan.ID,
+						Day:        entry.Day,
+						Slot:       entry.Slot,
+						RecipeID:   recipe.ID,
+						Servings:   entry.Servings,
+						Notes:      entry.Notes,
+					}).
+					Times(1).Return(entry, nil)
+				store.EXPECT().
+					TouchMealPlan(mock.Anything, plan.ID).
+					Times(1).Return(nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				gotEntry, err := decodeJSON[MealPlanEntry](recorder.Body)
+				require.NoError(t, err)
+				require.Equal(t, entry.ID, gotEntry.ID)
+				require.Equal(t, recipe.Name, gotEntry.RecipeName)
+			},
+		},
+		{
+			name:   "AutoServings",
+			params: autoServings,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetMealPlanByID(mock.Anything, plan.ID).
+					Times(1).Return(plan, nil)
+				attendanceStubs(store, user.FamilyID, []database.User{user, kid}, []database.MemberMealAbsence{})
+				store.EXPECT().
+					GetRecipeByID(mock.Anything, recipe.ID).
+					Times(1).Return(recipe, nil)
+				store.EXPECT().
+					CreateMealPlanEntry(mock.Anything, mock.MatchedBy(func(arg database.CreateMealPlanEntryParams) bool {
+						return arg.Servings == 2 && arg.AutoServings
+					})).
+					Times(1).Return(entry, nil)
+				store.EXPECT().
+					TouchMealPlan(mock.Anything, plan.ID).
+					Times(1).Return(nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+			},
+		},
+		{
+			name:   "Leftovers",
+			params: leftover,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetMealPlanByID(mock.Anything, plan.ID).
+					Times(2).Return(plan, nil)
+				store.EXPECT().
+					GetMealPlanEntryByID(mock.Anything, cooked.ID).
+					Times(1).Return(cooked, nil)
+				store.EXPECT().
+					GetLeftoverServingsEaten(mock.Anything, database.GetLeftoverServingsEatenParams{
+						EntryID:   cooked.ID,
+						ExcludeID: uuid.Nil,
+					}).
+					Times(1).Return(int32(2), nil)
+				store.EXPECT().
+					GetRecipeByID(mock.Anything, recipe.ID).
+					Times(1).Return(recipe, nil)
+				store.EXPECT().
+					CreateMealPlanEntry(mock.Anything, mock.MatchedBy(func(arg database.CreateMealPlanEntryParams) bool {
+						return arg.RecipeID == recipe.ID && arg.LeftoverOf.Valid && uuid.UUID(arg.LeftoverOf.Bytes) == cooked.ID
+					})).
+					Times(1).Return(database.MealPlanEntry{
+					ID:         uuid.New(),
+					MealPlanID: plan.ID,
+					RecipeID:   recipe.ID,
+					Servings:   2,
+					LeftoverOf: pgtype.UUID{Bytes: cooked.ID, Valid: true},
+				}, nil)
+				store.EXPECT().
+					TouchMealPlan(mock.Anything, plan.ID).
+					Times(1).Return(nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				gotEntry, err := decodeJSON[MealPlanEntry](recorder.Body)
+				require.NoError(t, err)
+				require.NotNil(t, gotEntry.LeftoverOf)
+				require.Equal(t, cooked.ID, *gotEntry.LeftoverOf)
+				require.Zero(t, gotEntry.CookedServings)
+			},
+		},
+		{
+			name:   "NotEnoughLeftovers",
+			params: leftover,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetMealPlanByID(mock.Anything, plan.ID).
+					Times(2).Return(plan, nil)
+				store.EXPECT().
+					GetMealPlanEntryByID(mock.Anything, cooked.ID).
+					Times(1).Return(cooked, nil)
+				store.EXPECT().
+					GetLeftoverServingsEaten(mock.Anything, mock.Anything).
+					Times(1).Return(int32(3), nil)
+				store.EXPECT().
+					CreateMealPlanEntry(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "LeftoversBeforeCooking",
+			params: leftoverBefore,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetMealPlanByID(mock.Anything, plan.ID).
+					Times(2).Return(plan, nil)
+				store.EXPECT().
+					GetMealPlanEntryByID(mock.Anything, cooked.ID).
+					Times(1).Return(cooked, nil)
+				store.EXPECT().
+					CreateMealPlanEntry(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "DayNotInWeek",
+			params: nextWeek,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetMealPlanByID(mock.Anything, plan.ID).
+					Times(1).Return(plan, nil)
+				store.EXPECT().
+					CreateMealPlanEntry(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "BadSlot",
+			params: badSlot,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					CreateMealPlanEntry(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			server := newTestServer(t, store)
+
+			tc.stubs(store)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/meal-plans/%s/entries", plan.ID.String())
+
+			data, err := encodeJSON(tc.params)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+			setAuth(t, request, server.tokenMaker, authHeaderTypeBearer, user.Email, time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestUpdateMealPlanEntry(t *testing.T) {
+	user := randomFamilyUser(t)
+	plan := randomMealPlan(user.FamilyID)
+	recipe := randomRecipe(t, user.FamilyID)
+	// Tuesday's dinner is cooked for 4 and 2 servings are left over for Thursday's lunch
+	entry := randomMealPlanEntry(plan, recipe, 1, types.MealSlotDinner)
+	entry.Servings = 2
+	entry.BatchServings = pgtype.Int4{Int32: 4, Valid: true}
+	leftover := randomMealPlanEntry(plan, recipe, 3, types.MealSlotLunch)
+	leftover.Servings = 2
+	leftover.LeftoverOf = pgtype.UUID{Bytes: entry.ID, Valid: true}
+
+	params := func(day int, slot types.MealSlot) UpdateMealPlanEntryParams {
+		return UpdateMealPlanEntryParams{
+			Day:           plan.WeekStart.Time.AddDate(0, 0, day).Format(util.DateLayout),
+			Slot:          slot,
+			RecipeID:      recipe.ID.String(),
+			Servings:      2,
+			BatchServings: 4,
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		params        UpdateMealPlanEntryParams
+		stubs         func(store *databaseMock.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "MovedBeforeLeftovers",
+			params: params(2, types.MealSlotDinner),
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetLeftovers(mock.Anything, pgtype.UUID{Bytes: entry.ID, Valid: true}).
+					Times(1).Return([]database.MealPlanEntry{leftover}, nil)
+				store.EXPECT().
+					UpdateMealPlanEntry(mock.Anything, mock.MatchedBy(func(arg database.UpdateMealPlanEntryParams) bool {
+						return arg.ID == entry.ID && arg.Day.Time.Equal(plan.WeekStart.Time.AddDate(0, 0, 2))
+					})).
+					Times(1).Return(entry, nil)
+				store.EXPECT().
+					TouchMealPlan(mock.Anything, plan.ID).
+					Times(1).Return(nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:   "MovedAfterLeftovers",
+			params: params(3, types.MealSlotLunch),
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetLeftovers(mock.Anything, pgtype.UUID{Bytes: entry.ID, Valid: true}).
+					Times(1).Return([]database.MealPlanEntry{leftover}, nil)
+				store.EXPECT().
+					UpdateMealPlanEntry(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			server := newTestServer(t, store)
+
+			store.EXPECT().
+				GetUserByEmail(mock.Anything, user.Email).
+				Times(1).Return(user, nil)
+			store.EXPECT().
+				GetMealPlanByID(mock.Anything, plan.ID).
+				Times(1).Return(plan, nil)
+			store.EXPECT().
+				GetMealPlanEntryByID(mock.Anything, entry.ID).
+				Times(1).Return(entry, nil)
+			store.EXPECT().
+				GetRecipeByID(mock.Anything, recipe.ID).
+				Times(1).Return(recipe, nil)
+			store.EXPECT().
+				GetLeftoverServingsEaten(mock.Anything, database.GetLeftoverServingsEatenParams{EntryID: entry.ID}).
+				Times(1).Return(int32(2), nil)
+			tc.stubs(store)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/meal-plans/%s/entries/%s", plan.ID.String(), entry.ID.String())
+
+			data, err := encodeJSON(tc.params)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(data))
+			require.NoError(t, err)
+			setAuth(t, request, server.tokenMaker, authHeaderTypeBearer, user.Email, time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestDeleteMealPlan(t *testing.T) {
+	user := randomFamilyUser(t)
+	plan := randomMealPlan(user.FamilyID)
+	final := randomMealPlan(user.FamilyID)
+	final.Status = types.MealPlanStatusFinal
+	recipe := randomRecipe(t, user.FamilyID)
+	// Sunday's dinner is eaten again for Monday's lunch of the next week
+	cooked := randomMealPlanEntry(plan, recipe, 6, types.MealSlotDinner)
+	leftover := randomMealPlanEntry(randomMealPlan(user.FamilyID), recipe, 0, types.MealSlotLunch)
+	leftover.LeftoverOf = pgtype.UUID{Bytes: cooked.ID, Valid: true}
+
+	testCases := []struct {
+		name          string
+		plan          database.MealPlan
+		stubs         func(store *databaseMock.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			plan: plan,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetLeftoversOutsideMealPlan(mock.Anything, plan.ID).
+					Times(1).Return([]database.MealPlanEntry{}, nil)
+				store.EXPECT().
+					DeleteMealPlan(mock.Anything, plan.ID).
+					Times(1).Return(nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "LeftoversInOtherWeek",
+			plan: plan,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetLeftoversOutsideMealPlan(mock.Anything, plan.ID).
+					Times(1).Return([]database.MealPlanEntry{leftover}, nil)
+				store.EXPECT().
+					DeleteMealPlan(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "Final",
+			plan: final,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetLeftoversOutsideMealPlan(mock.Anything, mock.Anything).
+					Times(0)
+				store.EXPECT().
+					DeleteMealPlan(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			server := newTestServer(t, store)
+
+			store.EXPECT().
+				GetUserByEmail(mock.Anything, user.Email).
+				Times(1).Return(user, nil)
+			store.EXPECT().
+				GetMealPlanByID(mock.Anything, tc.plan.ID).
+				Times(1).Return(tc.plan, nil)
+			tc.stubs(store)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/meal-plans/%s", tc.plan.ID.String())
+			request, err := http.NewRequest(http.MethodDelete, url, nil)
+			require.NoError(t, err)
+			setAuth(t, request, server.tokenMaker, authHeaderTypeBearer, user.Email, time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestDeleteMealPlanEntry(t *testing.T) {
+	user := randomFamilyUser(t)
+	plan := randomMealPlan(user.FamilyID)
+	recipe := randomRecipe(t, user.FamilyID)
+	entry := randomMealPlanEntry(plan, recipe, 4, types.MealSlotDinner)
+	otherEntry := randomMealPlanEntry(randomMealPlan(user.FamilyID), recipe, 4, types.MealSlotDinner)
+
+	testCases := []struct {
+		name          string
+		entry         database.MealPlanEntry
+		stubs         func(store *databaseMock.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "OK",
+			entry: entry,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetLeftoverServingsEaten(mock.Anything, database.GetLeftoverServingsEatenParams{EntryID: entry.ID}).
+					Times(1).Return(int32(0), nil)
+				store.EXPECT().
+					DeleteMealPlanEntry(mock.Anything, entry.ID).
+					Times(1).Return(nil)
+				store.EXPECT().
+					TouchMealPlan(mock.Anything, plan.ID).
+					Times(1).Return(nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:  "HasLeftovers",
+			entry: entry,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetLeftoverServingsEaten(mock.Anything, database.GetLeftoverServingsEatenParams{EntryID: entry.ID}).
+					Times(1).Return(int32(2), nil)
+				store.EXPECT().
+					DeleteMealPlanEntry(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name:  "EntryOfOtherPlan",
+			entry: otherEntry,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					DeleteMealPlanEntry(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			server := newTestServer(t, store)
+
+			store.EXPECT().
+				GetUserByEmail(mock.Anything, user.Email).
+				Times(1).Return(user, nil)
+			store.EXPECT().
+				GetMealPlanByID(mock.Anything, plan.ID).
+				Times(1).Return(plan, nil)
+			store.EXPECT().
+				GetMealPlanEntryByID(mock.Anything, tc.entry.ID).
+				Times(1).Return(tc.entry, nil)
+			tc.stubs(store)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/meal-plans/%s/entries/%s", plan.ID.String(), tc.entry.ID.String())
+
+			request, err := http.NewRequest(http.MethodDelete, url, nil)
+			require.NoError(t, err)
+			setAuth(t, request, server.tokenMaker, authHeaderTypeBearer, user.Email, time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestBatchCook(t *testing.T) {
+	user := randomFamilyUser(t)
+	plan := randomMealPlan(user.FamilyID)
+	recipe := randomRecipe(t, user.FamilyID)
+	entry := randomMealPlanEntry(plan, recipe, 6, types.MealSlotDinner)
+
+	params := BatchCookParams{
+		Day:      plan.WeekStart.Time.Format(util.DateLayout),
+		Slot:     types.MealSlotDinner,
+		RecipeID: recipe.ID.String(),
+		Servings: 4,
+		Leftovers: []BatchLeftoverParams{
+			{Day: plan.WeekStart.Time.AddDate(0, 0, 1).Format(util.DateLayout), Slot: types.MealSlotLunch, Servings: 2},
+			{Day: plan.WeekStart.Time.AddDate(0, 0, 2).Format(util.DateLayout), Slot: types.MealSlotDinner},
+		},
+	}
+	sameMeal := params
+	sameMeal.Leftovers = []BatchLeftoverParams{{Day: params.Day, Slot: types.MealSlotDinner}}
+
+	testCases := []struct {
+		name          string
+		params        BatchCookParams
+		stubs         func(store *databaseMock.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "OK",
+			params: params,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetMealPlanByID(mock.Anything, plan.ID).
+					Times(1).Return(plan, nil)
+				store.EXPECT().
+					GetRecipeByID(mock.Anything, recipe.ID).
+					Times(1).Return(recipe, nil)
+				store.EXPECT().
+					BatchCookTx(mock.Anything, mock.MatchedBy(func(arg database.BatchCookTxParams) bool {
+						return arg.Entry.BatchServings.Int32 == 10 && len(arg.Leftovers) == 2 &&
+							arg.Leftovers[0].Servings == 2 && arg.Leftovers[1].Servings == 4
+					})).
+					Times(1).Return(database.BatchCookTxResult{
+					Entry: database.MealPlanEntry{
+						ID:            entry.ID,
+						MealPlanID:    plan.ID,
+						RecipeID:      recipe.ID,
+						Servings:      4,
+						BatchServings: pgtype.Int4{Int32: 10, Valid: true},
+					},
+					Leftovers: []database.MealPlanEntry{
+						{ID: uuid.New(), RecipeID: recipe.ID, Servings: 2, LeftoverOf: pgtype.UUID{Bytes: entry.ID, Valid: true}},
+						{ID: uuid.New(), RecipeID: recipe.ID, Servings: 4, LeftoverOf: pgtype.UUID{Bytes: entry.ID, Valid: true}},
+					},
+				}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				entries, err := decodeJSON[[]MealPlanEntry](recorder.Body)
+				require.NoError(t, err)
+				require.Len(t, entries, 3)
+				require.Equal(t, int32(10), entries[0].CookedServings)
+				require.Zero(t, entries[0].LeftoverServings)
+				require.Equal(t, entry.ID, *entries[2].LeftoverOf)
+			},
+		},
+		{
+			name:   "LeftoversBeforeCooking",
+			params: sameMeal,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetMealPlanByID(mock.Anything, plan.ID).
+					Times(1).Return(plan, nil)
+				store.EXPECT().
+					GetRecipeByID(mock.Anything, recipe.ID).
+					Times(1).Return(recipe, nil)
+				store.EXPECT().
+					BatchCookTx(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "NoLeftovers",
+			params: BatchCookParams{Day: params.Day, Slot: params.Slot, RecipeID: params.RecipeID, Servings: 4},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					BatchCookTx(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			server := newTestServer(t, store)
+
+			tc.stubs(store)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/meal-plans/%s/batch", plan.ID.String())
+
+			data, err := encodeJSON(tc.params)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+			setAuth(t, request, server.tokenMaker, authHeaderTypeBearer, user.Email, time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
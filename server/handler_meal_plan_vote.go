@@ -0,0 +1,481 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	database "github.com/andreiz53/cookinator/database/handlers"
+	"github.com/andreiz53/cookinator/planner"
+	"github.com/andreiz53/cookinator/types"
+	"github.com/andreiz53/cookinator/util"
+)
+
+var (
+	errMealPlanNotDraft    = errors.New("only a draft meal plan can be proposed")
+	errMealPlanNotProposed = errors.New("votes are only taken while the meal plan is proposed")
+	errVoteOnLeftovers     = errors.New("leftovers can't be voted on, vote on the meal they come from")
+	errFinalizeNotProposed = errors.New("only a proposed meal plan can be finalized")
+	errVetoedHasLeftovers  = errors.New("leftovers of a vetoed meal are planned in other weeks, delete them first")
+)
+
+// MealPlanVoteParams is a member's vote on a proposed entry
+type MealPlanVoteParams struct {
+	Vote types.Vote `json:"vote" binding:"required,oneof=up down veto"`
+}
+
+// MealPlanVotes are the results of the family vote on a plan. Voters is how many of the members voted.
+type MealPlanVotes struct {
+	MealPlanID uuid.UUID            `json:"meal_plan_id"`
+	Status     types.MealPlanStatus `json:"status"`
+	Members    int                  `json:"members"`
+	Voters     int                  `json:"voters"`
+	Entries    []EntryVotes         `json:"entries"`
+}
+
+// EntryVotes counts the votes on the current recipe of an entry, Score is up votes minus down votes
+type EntryVotes struct {
+	EntryID    uuid.UUID      `json:"entry_id"`
+	Day        string         `json:"day"`
+	Slot       types.MealSlot `json:"slot"`
+	RecipeID   uuid.UUID      `json:"recipe_id"`
+	RecipeName string         `json:"recipe_name"`
+	Up         int            `json:"up"`
+	Down       int            `json:"down"`
+	Veto       int            `json:"veto"`
+	Score      int            `json:"score"`
+	Vetoed     bool           `json:"vetoed"`
+	// MyVote is the vote of the user asking, if they voted
+	MyVote types.Vote `json:"my_vote,omitempty"`
+}
+
+// FinalizedMealPlan is the final week with the vetoed entries that were replaced.
+// Unfilled are the vetoed slots no other recipe could take.
+type FinalizedMealPlan struct {
+	MealPlan MealPlan        `json:"meal_plan"`
+	Replaced []ReplacedEntry `json:"replaced"`
+	Unfilled []planner.Slot  `json:"unfilled"`
+}
+
+// ReplacedEntry is a vetoed entry, the replacement is missing when the slot was left unfilled
+type ReplacedEntry struct {
+	Day                   string         `json:"day"`
+	Slot                  types.MealSlot `json:"slot"`
+	RecipeID              uuid.UUID      `json:"recipe_id"`
+	RecipeName            string         `json:"recipe_name"`
+	ReplacementRecipeID   *uuid.UUID     `json:"replacement_recipe_id,omitempty"`
+	ReplacementRecipeName string         `json:"replacement_recipe_name,omitempty"`
+}
+
+// MealPlanVotesToEntryVotes tallies the votes of each entry that was cooked for its meal, leftovers are left out.
+// The votes are expected to be on the current recipe of their entry.
+func MealPlanVotesToEntryVotes(entries []database.GetMealPlanEntriesRow, votes []database.MealPlanVote, userID uuid.UUID) []EntryVotes {
+	byEntry := map[uuid.UUID][]database.MealPlanVote{}
+	for _, vote := range votes {
+		byEntry[vote.EntryID] = append(byEntry[vote.EntryID], vote)
+	}
+
+	result := []EntryVotes{}
+	for _, entry := range entries {
+		if entry.LeftoverOf.Valid {
+			continue
+		}
+		tally := EntryVotes{
+			EntryID:    entry.ID,
+			Day:        entry.Day.Time.Format(util.DateLayout),
+			Slot:       types.MealSlot(entry.Slot),
+			RecipeID:   entry.RecipeID,
+			RecipeName: entry.RecipeName,
+		}
+		for _, vote := range byEntry[entry.ID] {
+			switch vote.Vote {
+			case types.VoteUp:
+				tally.Up++
+			case types.VoteDown:
+				tally.Down++
+			case types.VoteVeto:
+				tally.Veto++
+			}
+			if vote.UserID == userID {
+				tally.MyVote = types.Vote(vote.Vote)
+			}
+		}
+		tally.Score = tally.Up - tally.Down
+		tally.Vetoed = tally.Veto > 0
+		result = append(result, tally)
+	}
+	return result
+}
+
+// proposeMealPlan opens the vote on a draft plan
+func (s *Server) proposeMealPlan(ctx *gin.Context) {
+	var uri GetMealPlanByIDParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	plan, ok := s.familyMealPlan(ctx, user, uuid.MustParse(uri.ID))
+	if !ok {
+		return
+	}
+	if plan.Status != types.MealPlanStatusDraft {
+		ctx.JSON(http.StatusConflict, respondWithErorr(errMealPlanNotDraft))
+		return
+	}
+
+	plan, err = s.store.UpdateMealPlanStatus(ctx, database.UpdateMealPlanStatusParams{
+		ID:     plan.ID,
+		Status: types.MealPlanStatusProposed,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, DBMealPlanToMealPlan(plan))
+}
+
+// votableMealPlanEntry loads an entry of a proposed plan that can be voted on.
+// It writes the error response itself and returns false on failure.
+func (s *Server) votableMealPlanEntry(ctx *gin.Context, user database.User, arg MealPlanEntryParams) (database.MealPlanEntry, bool) {
+	plan, ok := s.familyMealPlan(ctx, user, uuid.MustParse(arg.ID))
+	if !ok {
+		return database.MealPlanEntry{}, false
+	}
+	if plan.Status != types.MealPlanStatusProposed {
+		ctx.JSON(http.StatusConflict, respondWithErorr(errMealPlanNotProposed))
+		return database.MealPlanEntry{}, false
+	}
+
+	entry, ok := s.mealPlanEntry(ctx, plan, uuid.MustParse(arg.EntryID))
+	if !ok {
+		return database.MealPlanEntry{}, false
+	}
+	if entry.LeftoverOf.Valid {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(errVoteOnLeftovers))
+		return database.MealPlanEntry{}, false
+	}
+	return entry, true
+}
+
+// voteMealPlanEntry sets the vote of the user on an entry. The vote is on the entry's recipe,
+// it stops counting when the recipe of the entry is changed.
+func (s *Server) voteMealPlanEntry(ctx *gin.Context) {
+	var uri MealPlanEntryParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	var request MealPlanVoteParams
+	err = ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	entry, ok := s.votableMealPlanEntry(ctx, user, uri)
+	if !ok {
+		return
+	}
+
+	vote, err := s.store.UpsertMealPlanVote(ctx, database.UpsertMealPlanVoteParams{
+		EntryID:  entry.ID,
+		UserID:   user.ID,
+		RecipeID: entry.RecipeID,
+		Vote:     string(request.Vote),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, vote)
+}
+
+func (s *Server) deleteMealPlanVote(ctx *gin.Context) {
+	var uri MealPlanEntryParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	entry, ok := s.votableMealPlanEntry(ctx, user, uri)
+	if !ok {
+		return
+	}
+
+	err = s.store.DeleteMealPlanVote(ctx, database.DeleteMealPlanVoteParams{
+		EntryID: entry.ID,
+		UserID:  user.ID,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, respondWithMessage(fmt.Sprintf("deleted vote on meal plan entry with id %s", uri.EntryID)))
+}
+
+func (s *Server) getMealPlanVotes(ctx *gin.Context) {
+	var uri GetMealPlanByIDParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	plan, ok := s.familyMealPlan(ctx, user, uuid.MustParse(uri.ID))
+	if !ok {
+		return
+	}
+
+	entries, err := s.store.GetMealPlanEntries(ctx, plan.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+	votes, err := s.store.GetMealPlanVotes(ctx, plan.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+	members, err := s.store.GetUsersByFamilyID(ctx, plan.FamilyID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	voters := map[uuid.UUID]bool{}
+	for _, vote := range votes {
+		voters[vote.UserID] = true
+	}
+
+	ctx.JSON(http.StatusOK, MealPlanVotes{
+		MealPlanID: plan.ID,
+		Status:     types.MealPlanStatus(plan.Status),
+		Members:    len(members),
+		Voters:     len(voters),
+		Entries:    MealPlanVotesToEntryVotes(entries, votes, user.ID),
+	})
+}
+
+// finalizeMealPlan closes the vote and settles the week. Every vetoed entry is replaced by a generated one
+// that follows the usual rules and none of the vetoed recipes, leftovers of a vetoed batch are dropped with it.
+// Leftovers planned in other weeks would be dropped too, so the plan can't be finalized while a vetoed meal has any.
+// All entries of the final plan are locked and the plan can't be changed anymore.
+func (s *Server) finalizeMealPlan(ctx *gin.Context) {
+	var uri GetMealPlanByIDParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	plan, ok := s.familyMealPlan(ctx, user, uuid.MustParse(uri.ID))
+	if !ok {
+		return
+	}
+	if plan.Status != types.MealPlanStatusProposed {
+		ctx.JSON(http.StatusConflict, respondWithErorr(errFinalizeNotProposed))
+		return
+	}
+
+	entries, err := s.store.GetMealPlanEntries(ctx, plan.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+	votes, err := s.store.GetMealPlanVotes(ctx, plan.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	vetoed := map[uuid.UUID]bool{}
+	vetoedRecipes := map[uuid.UUID]bool{}
+	for _, tally := range MealPlanVotesToEntryVotes(entries, votes, user.ID) {
+		if tally.Vetoed {
+			vetoed[tally.EntryID] = true
+			vetoedRecipes[tally.RecipeID] = true
+		}
+	}
+
+	if len(vetoed) > 0 {
+		leftovers, err := s.store.GetLeftoversOutsideMealPlan(ctx, plan.ID)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+			return
+		}
+		for _, leftover := range leftovers {
+			if vetoed[leftover.LeftoverOf.Bytes] {
+				ctx.JSON(http.StatusConflict, respondWithErorr(errVetoedHasLeftovers))
+				return
+			}
+		}
+	}
+
+	response := FinalizedMealPlan{Replaced: []ReplacedEntry{}, Unfilled: []planner.Slot{}}
+	arg := database.FinalizeMealPlanTxParams{MealPlanID: plan.ID}
+	if len(vetoed) > 0 {
+		locked := []planner.Entry{}
+		for _, entry := range entries {
+			if vetoed[entry.ID] || (entry.LeftoverOf.Valid && vetoed[entry.LeftoverOf.Bytes]) {
+				continue
+			}
+			locked = append(locked, planner.Entry{
+				Day:      entry.Day.Time,
+				Slot:     types.MealSlot(entry.Slot),
+				RecipeID: entry.RecipeID,
+				Servings: entry.Servings,
+				Locked:   true,
+			})
+		}
+
+		recipes, err := s.store.GetRecipesByFamilyID(ctx, plan.FamilyID)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+			return
+		}
+		equipment, err := s.store.GetRecipeEquipmentByFamilyID(ctx, plan.FamilyID)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+			return
+		}
+		candidates := []planner.Recipe{}
+		names := map[uuid.UUID]string{}
+		for _, recipe := range DBRecipesToPlannerRecipes(recipes, equipment) {
+			names[recipe.ID] = recipe.Name
+			if !vetoedRecipes[recipe.ID] {
+				candidates = append(candidates, recipe)
+			}
+		}
+
+		history, ok := s.recipeHistory(ctx, plan, planner.DefaultNoRepeatDays)
+		if !ok {
+			return
+		}
+		busy, _, ok := s.familyAvailability(ctx, plan)
+		if !ok {
+			return
+		}
+
+		seed := rand.Int63()
+		for _, entry := range entries {
+			if !vetoed[entry.ID] {
+				continue
+			}
+			arg.Vetoed = append(arg.Vetoed, entry.ID)
+			replaced := ReplacedEntry{
+				Day:        entry.Day.Time.Format(util.DateLayout),
+				Slot:       types.MealSlot(entry.Slot),
+				RecipeID:   entry.RecipeID,
+				RecipeName: entry.RecipeName,
+			}
+
+			// only the vetoed meal is planned, every other day of its slot is taken out of the week
+			slot := planner.Slot{Day: entry.Day.Time, Slot: types.MealSlot(entry.Slot)}
+			away := []planner.Slot{}
+			dishes := 1
+			for i := 0; i < 7; i++ {
+				day := plan.WeekStart.Time.AddDate(0, 0, i)
+				if !day.Equal(slot.Day) {
+					away = append(away, planner.Slot{Day: day, Slot: slot.Slot})
+				}
+			}
+			for _, kept := range locked {
+				if kept.Day.Equal(slot.Day) && kept.Slot == slot.Slot {
+					dishes++
+				}
+			}
+
+			result := planner.Generate(planner.Request{
+				WeekStart: plan.WeekStart.Time,
+				Recipes:   candidates,
+				Locked:    locked,
+				History:   history,
+				Busy:      busy,
+				Away:      away,
+				Rules: planner.Rules{
+					Slots:               []types.MealSlot{slot.Slot},
+					NoRepeatDays:        planner.DefaultNoRepeatDays,
+					WeekdayMaxTotalTime: planner.DefaultWeekdayMaxTotalTime,
+					BusyMaxTotalTime:    planner.DefaultBusyMaxTotalTime,
+					DishesPerSlot:       dishes,
+					Servings:            entry.Servings,
+					Seed:                seed,
+				},
+			})
+			if len(result.Entries) == 0 {
+				response.Unfilled = append(response.Unfilled, slot)
+				response.Replaced = append(response.Replaced, replaced)
+				continue
+			}
+
+			replacement := result.Entries[0]
+			replacement.Locked = true
+			locked = append(locked, replacement)
+			arg.Replacements = append(arg.Replacements, database.CreateMealPlanEntryParams{
+				Day:          entry.Day,
+				Slot:         entry.Slot,
+				RecipeID:     replacement.RecipeID,
+				Servings:     entry.Servings,
+				Notes:        entry.Notes,
+				Locked:       true,
+				AutoServings: entry.AutoServings,
+			})
+			replaced.ReplacementRecipeID = &replacement.RecipeID
+			replaced.ReplacementRecipeName = names[replacement.RecipeID]
+			response.Replaced = append(response.Replaced, replaced)
+		}
+	}
+
+	plan, err = s.store.FinalizeMealPlanTx(ctx, arg)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	entries, err = s.store.GetMealPlanEntries(ctx, plan.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	response.MealPlan = DBMealPlanToMealPlan(plan)
+	response.MealPlan.Entries = DBMealPlanEntriesToMealPlanEntries(entries)
+	ctx.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,434 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	database "github.com/andreiz53/cookinator/database/handlers"
+	databaseMock "github.com/andreiz53/cookinator/database/mocks"
+	"github.com/andreiz53/cookinator/types"
+)
+
+func TestProposeMealPlan(t *testing.T) {
+	user := randomFamilyUser(t)
+	plan := randomMealPlan(user.FamilyID)
+	plan.Status = types.MealPlanStatusDraft
+	proposed := plan
+	proposed.Status = types.MealPlanStatusProposed
+
+	testCases := []struct {
+		name          string
+		plan          database.MealPlan
+		stubs         func(store *databaseMock.MockStore, plan database.MealPlan)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			plan: plan,
+			stubs: func(store *databaseMock.MockStore, plan database.MealPlan) {
+				store.EXPECT().
+					UpdateMealPlanStatus(mock.Anything, database.UpdateMealPlanStatusParams{ID: plan.ID, Status: types.MealPlanStatusProposed}).
+					Times(1).Return(proposed, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				response, err := decodeJSON[MealPlan](recorder.Body)
+				require.NoError(t, err)
+				require.Equal(t, types.MealPlanStatus(types.MealPlanStatusProposed), response.Status)
+			},
+		},
+		{
+			name: "AlreadyProposed",
+			plan: proposed,
+			stubs: func(store *databaseMock.MockStore, plan database.MealPlan) {
+				store.EXPECT().
+					UpdateMealPlanStatus(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			server := newTestServer(t, store)
+
+			store.EXPECT().
+				GetUserByEmail(mock.Anything, user.Email).
+				Times(1).Return(user, nil)
+			store.EXPECT().
+				GetMealPlanByID(mock.Anything, tc.plan.ID).
+				Times(1).Return(tc.plan, nil)
+			tc.stubs(store, tc.plan)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/meal-plans/%s/propose", tc.plan.ID.String())
+			request, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+			setAuth(t, request, server.tokenMaker, authHeaderTypeBearer, user.Email, time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestVoteMealPlanEntry(t *testing.T) {
+	user := randomFamilyUser(t)
+	recipe := randomRecipe(t, user.FamilyID)
+	plan := randomMealPlan(user.FamilyID)
+	plan.Status = types.MealPlanStatusProposed
+	draft := plan
+	draft.Status = types.MealPlanStatusDraft
+
+	entry := randomMealPlanEntry(plan, recipe, 1, types.MealSlotDinner)
+	leftover := randomMealPlanEntry(plan, recipe, 2, types.MealSlotLunch)
+	leftover.LeftoverOf = pgtype.UUID{Bytes: entry.ID, Valid: true}
+
+	testCases := []struct {
+		name          string
+		plan          database.MealPlan
+		entry         database.MealPlanEntry
+		body          MealPlanVoteParams
+		stubs         func(store *databaseMock.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "OK",
+			plan:  plan,
+			entry: entry,
+			body:  MealPlanVoteParams{Vote: types.VoteVeto},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetMealPlanByID(mock.Anything, plan.ID).
+					Times(1).Return(plan, nil)
+				store.EXPECT().
+					GetMealPlanEntryByID(mock.Anything, entry.ID).
+					Times(1).Return(entry, nil)
+				store.EXPECT().
+					UpsertMealPlanVote(mock.Anything, database.UpsertMealPlanVoteParams{
+						EntryID:  entry.ID,
+						UserID:   user.ID,
+						RecipeID: recipe.ID,
+						Vote:     types.VoteVeto,
+					}).
+					Times(1).Return(database.MealPlanVote{EntryID: entry.ID, UserID: user.ID, RecipeID: recipe.ID, Vote: types.VoteVeto}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:  "NotProposed",
+			plan:  draft,
+			entry: entry,
+			body:  MealPlanVoteParams{Vote: types.VoteUp},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetMealPlanByID(mock.Anything, draft.ID).
+					Times(1).Return(draft, nil)
+				store.EXPECT().
+					UpsertMealPlanVote(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name:  "Leftovers",
+			plan:  plan,
+			entry: leftover,
+			body:  MealPlanVoteParams{Vote: types.VoteDown},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetMealPlanByID(mock.Anything, plan.ID).
+					Times(1).Return(plan, nil)
+				store.EXPECT().
+					GetMealPlanEntryByID(mock.Anything, leftover.ID).
+					Times(1).Return(leftover, nil)
+				store.EXPECT().
+					UpsertMealPlanVote(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "InvalidVote",
+			plan:  plan,
+			entry: entry,
+			body:  MealPlanVoteParams{Vote: "maybe"},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(0).Return(user, nil)
+				store.EXPECT().
+					GetMealPlanByID(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			server := newTestServer(t, store)
+
+			tc.stubs(store)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/meal-plans/%s/entries/%s/vote", tc.plan.ID.String(), tc.entry.ID.String())
+			data, err := encodeJSON(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(data))
+			require.NoError(t, err)
+			setAuth(t, request, server.tokenMaker, authHeaderTypeBearer, user.Email, time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestFinalizeMealPlan(t *testing.T) {
+	user := randomFamilyUser(t)
+	plan := randomMealPlan(user.FamilyID)
+	plan.Status = types.MealPlanStatusProposed
+	final := plan
+	final.Status = types.MealPlanStatusFinal
+	draft := plan
+	draft.Status = types.MealPlanStatusDraft
+
+	vetoedRecipe := randomRecipe(t, user.FamilyID)
+	keptRecipe := randomRecipe(t, user.FamilyID)
+	replacement := randomRecipe(t, user.FamilyID)
+	recipes := []database.Recipe{vetoedRecipe, keptRecipe, replacement}
+
+	vetoed := randomMealPlanEntry(plan, vetoedRecipe, 0, types.MealSlotDinner)
+	kept := randomMealPlanEntry(plan, keptRecipe, 1, types.MealSlotDinner)
+	entries := []database.GetMealPlanEntriesRow{
+		{ID: vetoed.ID, Day: vetoed.Day, Slot: vetoed.Slot, RecipeID: vetoedRecipe.ID, RecipeName: vetoedRecipe.Name, Servings: 3, AutoServings: true},
+		{ID: kept.ID, Day: kept.Day, Slot: kept.Slot, RecipeID: keptRecipe.ID, RecipeName: keptRecipe.Name, Servings: 2},
+	}
+	votes := []database.MealPlanVote{
+		{EntryID: vetoed.ID, UserID: user.ID, RecipeID: vetoedRecipe.ID, Vote: types.VoteVeto},
+		{EntryID: kept.ID, UserID: uuid.New(), RecipeID: keptRecipe.ID, Vote: types.VoteUp},
+	}
+	// the vetoed meal is cooked in a batch eaten again next week
+	nextWeek := randomMealPlan(user.FamilyID)
+	leftover := randomMealPlanEntry(nextWeek, vetoedRecipe, 0, types.MealSlotLunch)
+	leftover.LeftoverOf = pgtype.UUID{Bytes: vetoed.ID, Valid: true}
+
+	testCases := []struct {
+		name          string
+		plan          database.MealPlan
+		stubs         func(store *databaseMock.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			plan: plan,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetMealPlanEntries(mock.Anything, plan.ID).
+					Times(2).Return(entries, nil)
+				store.EXPECT().
+					GetMealPlanVotes(mock.Anything, plan.ID).
+					Times(1).Return(votes, nil)
+				store.EXPECT().
+					GetLeftoversOutsideMealPlan(mock.Anything, plan.ID).
+					Times(1).Return([]database.MealPlanEntry{}, nil)
+				store.EXPECT().
+					GetRecipesByFamilyID(mock.Anything, user.FamilyID).
+					Times(1).Return(recipes, nil)
+				store.EXPECT().
+					GetRecipeEquipmentByFamilyID(mock.Anything, user.FamilyID).
+					Times(1).Return([]database.GetRecipeEquipmentByFamilyIDRow{}, nil)
+				store.EXPECT().
+					GetPlannedRecipesByFamilyID(mock.Anything, mock.Anything).
+					Times(1).Return([]database.GetPlannedRecipesByFamilyIDRow{}, nil)
+				store.EXPECT().
+					GetLastCookedByFamilyID(mock.Anything, user.FamilyID).
+					Times(1).Return([]database.GetLastCookedByFamilyIDRow{}, nil)
+				store.EXPECT().
+					GetFamilyCalendars(mock.Anything, user.FamilyID).
+					Times(1).Return([]database.FamilyCalendar{}, nil)
+				store.EXPECT().
+					FinalizeMealPlanTx(mock.Anything, mock.MatchedBy(func(arg database.FinalizeMealPlanTxParams) bool {
+						// the kept recipe is planned the next day, so only the replacement is left
+						return arg.MealPlanID == plan.ID && len(arg.Vetoed) == 1 && arg.Vetoed[0] == vetoed.ID &&
+							len(arg.Replacements) == 1 && arg.Replacements[0].RecipeID == replacement.ID &&
+							arg.Replacements[0].Day == vetoed.Day && arg.Replacements[0].Servings == 3 &&
+							arg.Replacements[0].AutoServings && arg.Replacements[0].Locked
+					})).
+					Times(1).Return(final, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				response, err := decodeJSON[FinalizedMealPlan](recorder.Body)
+				require.NoError(t, err)
+				require.Equal(t, types.MealPlanStatus(types.MealPlanStatusFinal), response.MealPlan.Status)
+				require.Len(t, response.Replaced, 1)
+				require.Equal(t, vetoedRecipe.ID, response.Replaced[0].RecipeID)
+				require.Equal(t, replacement.ID, *response.Replaced[0].ReplacementRecipeID)
+				require.Empty(t, response.Unfilled)
+			},
+		},
+		{
+			name: "NoVetoes",
+			plan: plan,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetMealPlanEntries(mock.Anything, plan.ID).
+					Times(2).Return(entries, nil)
+				store.EXPECT().
+					GetMealPlanVotes(mock.Anything, plan.ID).
+					Times(1).Return(votes[1:], nil)
+				store.EXPECT().
+					GetRecipesByFamilyID(mock.Anything, mock.Anything).
+					Times(0)
+				store.EXPECT().
+					FinalizeMealPlanTx(mock.Anything, database.FinalizeMealPlanTxParams{MealPlanID: plan.ID}).
+					Times(1).Return(final, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				response, err := decodeJSON[FinalizedMealPlan](recorder.Body)
+				require.NoError(t, err)
+				require.Empty(t, response.Replaced)
+			},
+		},
+		{
+			name: "VetoedLeftoversInOtherWeek",
+			plan: plan,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetMealPlanEntries(mock.Anything, plan.ID).
+					Times(1).Return(entries, nil)
+				store.EXPECT().
+					GetMealPlanVotes(mock.Anything, plan.ID).
+					Times(1).Return(votes, nil)
+				store.EXPECT().
+					GetLeftoversOutsideMealPlan(mock.Anything, plan.ID).
+					Times(1).Return([]database.MealPlanEntry{leftover}, nil)
+				store.EXPECT().
+					FinalizeMealPlanTx(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "Draft",
+			plan: draft,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetMealPlanEntries(mock.Anything, mock.Anything).
+					Times(0)
+				store.EXPECT().
+					FinalizeMealPlanTx(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "AlreadyFinal",
+			plan: final,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					FinalizeMealPlanTx(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			server := newTestServer(t, store)
+
+			store.EXPECT().
+				GetUserByEmail(mock.Anything, user.Email).
+				Times(1).Return(user, nil)
+			store.EXPECT().
+				GetMealPlanByID(mock.Anything, tc.plan.ID).
+				Times(1).Return(tc.plan, nil)
+			tc.stubs(store)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/meal-plans/%s/finalize", tc.plan.ID.String())
+			request, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+			setAuth(t, request, server.tokenMaker, authHeaderTypeBearer, user.Email, time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestMealPlanVotesToEntryVotes(t *testing.T) {
+	user := randomFamilyUser(t)
+	recipe := randomRecipe(t, user.FamilyID)
+	plan := randomMealPlan(user.FamilyID)
+	entry := randomMealPlanEntry(plan, recipe, 0, types.MealSlotDinner)
+
+	entries := []database.GetMealPlanEntriesRow{
+		{ID: entry.ID, Day: entry.Day, Slot: entry.Slot, RecipeID: recipe.ID},
+		{ID: uuid.New(), Day: entry.Day, Slot: types.MealSlotLunch, RecipeID: recipe.ID, LeftoverOf: pgtype.UUID{Bytes: entry.ID, Valid: true}},
+	}
+	votes := []database.MealPlanVote{
+		{EntryID: entry.ID, UserID: user.ID, RecipeID: recipe.ID, Vote: types.VoteUp},
+		{EntryID: entry.ID, UserID: uuid.New(), RecipeID: recipe.ID, Vote: types.VoteUp},
+		{EntryID: entry.ID, UserID: uuid.New(), RecipeID: recipe.ID, Vote: types.VoteDown},
+	}
+
+	tallies := MealPlanVotesToEntryVotes(entries, votes, user.ID)
+	require.Len(t, tallies, 1)
+	require.Equal(t, 2, tallies[0].Up)
+	require.Equal(t, 1, tallies[0].Down)
+	require.Equal(t, 1, tallies[0].Score)
+	require.False(t, tallies[0].Vetoed)
+	require.Equal(t, types.Vote(types.VoteUp), tallies[0].MyVote)
+
+	votes = append(votes, database.MealPlanVote{EntryID: entry.ID, UserID: uuid.New(), RecipeID: recipe.ID, Vote: types.VoteVeto})
+	tallies = MealPlanVotesToEntryVotes(entries, votes, uuid.New())
+	require.True(t, tallies[0].Vetoed)
+	require.Empty(t, tallies[0].MyVote)
+}
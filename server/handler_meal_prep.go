@@ -0,0 +1,195 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/andreiz53/cookinator/cooking"
+	database "github.com/andreiz53/cookinator/database/handlers"
+	"github.com/andreiz53/cookinator/types"
+	"github.com/andreiz53/cookinator/util"
+)
+
+var errMealPlanNotFinal = errors.New("the prep schedule is only made for a final meal plan")
+
+// the make-ahead tasks of a week are done on a Sunday afternoon before it starts, the mornings and the evenings
+const (
+	prepDayHour     = 15
+	prepMorningHour = 8
+	prepEveningHour = 20
+	// choppedKeepsDays is how long chopped produce keeps in the fridge, later meals are chopped for the night before
+	choppedKeepsDays = 3
+)
+
+// PrepSchedule is the timeline of the make-ahead tasks of a week, grouped in the sessions they are done in
+type PrepSchedule struct {
+	MealPlanID uuid.UUID     `json:"meal_plan_id"`
+	WeekStart  string        `json:"week_start"`
+	Sessions   []PrepSession `json:"sessions"`
+}
+
+// PrepSession is a time the family sits down to prepare, like the Sunday afternoon before the week
+type PrepSession struct {
+	At    time.Time          `json:"at"`
+	Label string             `json:"label"`
+	Tasks []PrepScheduleTask `json:"tasks"`
+}
+
+// PrepScheduleTask is a make-ahead task of a meal. StartBy is the latest time the task can start
+// and HoursBeforeMeal is how long that is before the meal is served.
+type PrepScheduleTask struct {
+	Kind            cooking.PrepKind `json:"kind"`
+	Text            string           `json:"text"`
+	EntryID         uuid.UUID        `json:"entry_id"`
+	RecipeID        uuid.UUID        `json:"recipe_id"`
+	RecipeName      string           `json:"recipe_name"`
+	Day             string           `json:"day"`
+	Slot            types.MealSlot   `json:"slot"`
+	Meal            time.Time        `json:"meal"`
+	StartBy         time.Time        `json:"start_by"`
+	HoursBeforeMeal float64          `json:"hours_before_meal"`
+}
+
+// prepSessionAt is the latest session a task can start in before its deadline, not earlier than the prep day.
+// Tasks due before the prep day get a session of their own at the deadline.
+func prepSessionAt(prepDay time.Time, startBy time.Time) time.Time {
+	at := startBy
+	if !startBy.Before(prepDay) {
+		at = prepDay
+	}
+	for day := prepDay.Truncate(24 * time.Hour); !day.After(startBy); day = day.AddDate(0, 0, 1) {
+		for _, hour := range []int{prepMorningHour, prepEveningHour} {
+			session := day.Add(time.Duration(hour) * time.Hour)
+			if !session.Before(prepDay) && !session.After(startBy) {
+				at = session
+			}
+		}
+	}
+	return at
+}
+
+func prepSessionLabel(prepDay time.Time, at time.Time) string {
+	switch {
+	case at.Equal(prepDay):
+		return "Sunday prep"
+	case at.Hour() == prepMorningHour && at.Minute() == 0:
+		return at.Weekday().String() + " morning"
+	case at.Hour() == prepEveningHour && at.Minute() == 0:
+		return at.Weekday().String() + " evening"
+	default:
+		return at.Weekday().String() + " " + at.Format("15:04")
+	}
+}
+
+// MealPlanToPrepSchedule finds the make-ahead tasks of the recipes cooked in a week and schedules them.
+// Waits like marinating start in the latest session that leaves them enough time before cooking starts,
+// chopping is batched on the prep day for the first days of the week and done the evening before for the rest.
+func MealPlanToPrepSchedule(plan database.MealPlan, entries []database.GetMealPlanEntriesRow, recipes map[uuid.UUID]database.Recipe) PrepSchedule {
+	weekStart := plan.WeekStart.Time
+	prepDay := weekStart.AddDate(0, 0, -1).Add(prepDayHour * time.Hour)
+	sessions := map[time.Time][]PrepScheduleTask{}
+	for _, entry := range entries {
+		recipe, ok := recipes[entry.RecipeID]
+		if entry.LeftoverOf.Valid || !ok {
+			continue
+		}
+
+		slot := types.MealSlot(entry.Slot)
+		meal := mealTimes[slot]
+		year, month, day := entry.Day.Time.Date()
+		served := time.Date(year, month, day, meal.hour, meal.minute, 0, 0, time.UTC)
+		cookStart := served.Add(-time.Duration(recipe.TotalTimeMinutes) * time.Minute)
+
+		for _, prep := range cooking.PrepTasks(recipe.CookingProcess) {
+			task := PrepScheduleTask{
+				Kind:       prep.Kind,
+				Text:       prep.Text,
+				EntryID:    entry.ID,
+				RecipeID:   recipe.ID,
+				RecipeName: recipe.Name,
+				Day:        entry.Day.Time.Format(util.DateLayout),
+				Slot:       slot,
+				Meal:       served,
+				StartBy:    cookStart.Add(-prep.Lead),
+			}
+			task.HoursBeforeMeal = served.Sub(task.StartBy).Hours()
+
+			at := prepSessionAt(prepDay, task.StartBy)
+			if prep.Kind == cooking.PrepChop {
+				if at.After(prepDay) {
+					at = prepDay
+				}
+				if !entry.Day.Time.Before(weekStart.AddDate(0, 0, choppedKeepsDays)) {
+					at = time.Date(year, month, day-1, prepEveningHour, 0, 0, 0, time.UTC)
+				}
+			}
+			sessions[at] = append(sessions[at], task)
+		}
+	}
+
+	schedule := PrepSchedule{
+		MealPlanID: plan.ID,
+		WeekStart:  weekStart.Format(util.DateLayout),
+		Sessions:   []PrepSession{},
+	}
+	for at, tasks := range sessions {
+		sort.SliceStable(tasks, func(i, j int) bool {
+			return tasks[i].StartBy.Before(tasks[j].StartBy)
+		})
+		schedule.Sessions = append(schedule.Sessions, PrepSession{
+			At:    at,
+			Label: prepSessionLabel(prepDay, at),
+			Tasks: tasks,
+		})
+	}
+	sort.Slice(schedule.Sessions, func(i, j int) bool {
+		return schedule.Sessions[i].At.Before(schedule.Sessions[j].At)
+	})
+	return schedule
+}
+
+// getMealPrepSchedule tells the family what to prepare ahead for the meals of a final week
+func (s *Server) getMealPrepSchedule(ctx *gin.Context) {
+	var uri GetMealPlanByIDParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	plan, ok := s.familyMealPlan(ctx, user, uuid.MustParse(uri.ID))
+	if !ok {
+		return
+	}
+	if plan.Status != types.MealPlanStatusFinal {
+		ctx.JSON(http.StatusConflict, respondWithErorr(errMealPlanNotFinal))
+		return
+	}
+
+	entries, err := s.store.GetMealPlanEntries(ctx, plan.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+	recipes, err := s.store.GetRecipesByFamilyID(ctx, plan.FamilyID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+	byID := map[uuid.UUID]database.Recipe{}
+	for _, recipe := range recipes {
+		byID[recipe.ID] = recipe
+	}
+
+	ctx.JSON(http.StatusOK, MealPlanToPrepSchedule(plan, entries, byID))
+}
@@ -0,0 +1,148 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/andreiz53/cookinator/cooking"
+	database "github.com/andreiz53/cookinator/database/handlers"
+	databaseMock "github.com/andreiz53/cookinator/database/mocks"
+	"github.com/andreiz53/cookinator/types"
+	"github.com/andreiz53/cookinator/util"
+)
+
+func TestMealPlanToPrepSchedule(t *testing.T) {
+	familyID := uuid.New()
+	plan := randomMealPlan(familyID)
+	plan.WeekStart = util.NewDate(time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC))
+
+	beans := randomRecipe(t, familyID)
+	beans.CookingProcess = "Soak the beans overnight. Chop the onion. Simmer for 30 minutes"
+	beans.TotalTimeMinutes = 30
+	chicken := randomRecipe(t, familyID)
+	chicken.CookingProcess = "Marinate the chicken for 2 hours. Slice the peppers"
+	recipes := map[uuid.UUID]database.Recipe{beans.ID: beans, chicken.ID: chicken}
+
+	monday := randomMealPlanEntry(plan, beans, 0, types.MealSlotDinner)
+	thursday := randomMealPlanEntry(plan, chicken, 3, types.MealSlotDinner)
+	entries := []database.GetMealPlanEntriesRow{
+		{ID: monday.ID, Day: monday.Day, Slot: monday.Slot, RecipeID: beans.ID},
+		{ID: thursday.ID, Day: thursday.Day, Slot: thursday.Slot, RecipeID: chicken.ID},
+		{ID: uuid.New(), Day: thursday.Day, Slot: types.MealSlotLunch, RecipeID: beans.ID,
+			LeftoverOf: pgtype.UUID{Bytes: monday.ID, Valid: true}},
+	}
+
+	schedule := MealPlanToPrepSchedule(plan, entries, recipes)
+	require.Equal(t, "2026-10-19", schedule.WeekStart)
+	require.Len(t, schedule.Sessions, 4)
+
+	expected := []struct {
+		label string
+		kind  cooking.PrepKind
+		entry uuid.UUID
+	}{
+		{"Sunday prep", cooking.PrepChop, monday.ID},
+		{"Sunday evening", cooking.PrepSoak, monday.ID},
+		{"Wednesday evening", cooking.PrepChop, thursday.ID},
+		{"Thursday morning", cooking.PrepMarinate, thursday.ID},
+	}
+	for i, session := range schedule.Sessions {
+		require.Equal(t, expected[i].label, session.Label)
+		require.Len(t, session.Tasks, 1)
+		require.Equal(t, expected[i].kind, session.Tasks[0].Kind)
+		require.Equal(t, expected[i].entry, session.Tasks[0].EntryID)
+		require.False(t, session.At.After(session.Tasks[0].StartBy))
+	}
+
+	soak := schedule.Sessions[1].Tasks[0]
+	require.Equal(t, time.Date(2026, time.October, 19, 6, 30, 0, 0, time.UTC), soak.StartBy)
+	require.Equal(t, 12.5, soak.HoursBeforeMeal)
+}
+
+func TestGetMealPrepSchedule(t *testing.T) {
+	user := randomFamilyUser(t)
+	plan := randomMealPlan(user.FamilyID)
+	plan.Status = types.MealPlanStatusFinal
+	draft := plan
+	draft.Status = types.MealPlanStatusDraft
+
+	recipe := randomRecipe(t, user.FamilyID)
+	recipe.CookingProcess = "Thaw the salmon. Bake for 15 minutes"
+	entry := randomMealPlanEntry(plan, recipe, 2, types.MealSlotDinner)
+	entries := []database.GetMealPlanEntriesRow{
+		{ID: entry.ID, Day: entry.Day, Slot: entry.Slot, RecipeID: recipe.ID, RecipeName: recipe.Name},
+	}
+
+	testCases := []struct {
+		name          string
+		plan          database.MealPlan
+		stubs         func(store *databaseMock.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			plan: plan,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetMealPlanEntries(mock.Anything, plan.ID).
+					Times(1).Return(entries, nil)
+				store.EXPECT().
+					GetRecipesByFamilyID(mock.Anything, user.FamilyID).
+					Times(1).Return([]database.Recipe{recipe}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				response, err := decodeJSON[PrepSchedule](recorder.Body)
+				require.NoError(t, err)
+				require.Len(t, response.Sessions, 1)
+				require.Equal(t, cooking.PrepKind(cooking.PrepThaw), response.Sessions[0].Tasks[0].Kind)
+				require.Equal(t, recipe.Name, response.Sessions[0].Tasks[0].RecipeName)
+			},
+		},
+		{
+			name: "NotFinal",
+			plan: draft,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetMealPlanEntries(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			server := newTestServer(t, store)
+
+			store.EXPECT().
+				GetUserByEmail(mock.Anything, user.Email).
+				Times(1).Return(user, nil)
+			store.EXPECT().
+				GetMealPlanByID(mock.Anything, tc.plan.ID).
+				Times(1).Return(tc.plan, nil)
+			tc.stubs(store)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/meal-plans/%s/prep-schedule", tc.plan.ID.String())
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+			setAuth(t, request, server.tokenMaker, authHeaderTypeBearer, user.Email, time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -1 +1,553 @@
 package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/andreiz53/cookinator/cooking"
+	database "github.com/andreiz53/cookinator/database/handlers"
+	"github.com/andreiz53/cookinator/types"
+)
+
+// defaultRecipeServings is how many a recipe feeds when it doesn't say
+const defaultRecipeServings = 4
+
+type Recipe struct {
+	ID                 uuid.UUID            `json:"id"`
+	CreatedAt          pgtype.Timestamp     `json:"created_at"`
+	UpdatedAt          pgtype.Timestamp     `json:"updated_at"`
+	Name               string               `json:"name"`
+	CookingProcess     string               `json:"cooking_process"`
+	FamilyID           uuid.UUID            `json:"family_id"`
+	Items              []types.RecipeItem   `json:"items"`
+	PrepTimeMinutes    int32                `json:"prep_time_minutes"`
+	CookTimeMinutes    int32                `json:"cook_time_minutes"`
+	TotalTimeMinutes   int32                `json:"total_time_minutes"`
+	ActiveTimeMinutes  int32                `json:"active_time_minutes"`
+	Difficulty         types.Difficulty     `json:"difficulty"`
+	Cuisine            string               `json:"cuisine"`
+	Tags               []string             `json:"tags"`
+	CaloriesPerServing int32                `json:"calories_per_serving"`
+	ProteinPerServing  int32                `json:"protein_per_serving"`
+	CostPerServing     int32                `json:"cost_per_serving"`
+	Servings           int32                `json:"servings"`
+	Favorited          bool                 `json:"favorited"`
+	Equipment          []RecipeEquipment    `json:"equipment,omitempty"`
+	Duplicates         []DuplicateCandidate `json:"duplicates,omitempty"`
+}
+
+// CreateRecipeParams leaves cook and active time optional, they are then computed from the timers in the cooking process.
+// Protein is in grams and cost in cents per serving. Servings is how many the items feed, 4 when it is not sent.
+type CreateRecipeParams struct {
+	Name               string             `json:"name" binding:"required,min=2"`
+	CookingProcess     string             `json:"cooking_process" binding:"required"`
+	Items              []types.RecipeItem `json:"items" binding:"required,min=1,dive"`
+	PrepTimeMinutes    int32              `json:"prep_time_minutes" binding:"min=0"`
+	CookTimeMinutes    *int32             `json:"cook_time_minutes" binding:"omitempty,min=0"`
+	ActiveTimeMinutes  *int32             `json:"active_time_minutes" binding:"omitempty,min=0"`
+	Difficulty         types.Difficulty   `json:"difficulty" binding:"omitempty,oneof=easy medium hard"`
+	Cuisine            string             `json:"cuisine" binding:"max=64"`
+	Tags               []string           `json:"tags" binding:"omitempty,dive,min=1,max=32"`
+	CaloriesPerServing int32              `json:"calories_per_serving" binding:"min=0"`
+	ProteinPerServing  int32              `json:"protein_per_serving" binding:"min=0"`
+	CostPerServing     int32              `json:"cost_per_serving" binding:"min=0"`
+	Servings           int32              `json:"servings" binding:"omitempty,min=1,max=100"`
+}
+
+type UpdateRecipeParams struct {
+	ID                 string             `json:"id" binding:"required,uuid4_rfc4122"`
+	Name               string             `json:"name" binding:"required,min=2"`
+	CookingProcess     string             `json:"cooking_process" binding:"required"`
+	Items              []types.RecipeItem `json:"items" binding:"required,min=1,dive"`
+	PrepTimeMinutes    int32              `json:"prep_time_minutes" binding:"min=0"`
+	CookTimeMinutes    *int32             `json:"cook_time_minutes" binding:"omitempty,min=0"`
+	ActiveTimeMinutes  *int32             `json:"active_time_minutes" binding:"omitempty,min=0"`
+	Difficulty         types.Difficulty   `json:"difficulty" binding:"omitempty,oneof=easy medium hard"`
+	Cuisine            string             `json:"cuisine" binding:"max=64"`
+	Tags               []string           `json:"tags" binding:"omitempty,dive,min=1,max=32"`
+	CaloriesPerServing int32              `json:"calories_per_serving" binding:"min=0"`
+	ProteinPerServing  int32              `json:"protein_per_serving" binding:"min=0"`
+	CostPerServing     int32              `json:"cost_per_serving" binding:"min=0"`
+	Servings           int32              `json:"servings" binding:"omitempty,min=1,max=100"`
+}
+
+type GetRecipesQuery struct {
+	MaxTotalTime string           `form:"max_total_time"`
+	Difficulty   types.Difficulty `form:"difficulty" binding:"omitempty,oneof=easy medium hard"`
+	Sort         string           `form:"sort" binding:"omitempty,oneof=name total_time prep_time cook_time active_time"`
+	OnlyPossible bool             `form:"only_possible"`
+}
+
+type GetRecipeByIDParams struct {
+	ID string `uri:"id" binding:"required,uuid4_rfc4122"`
+}
+
+type DeleteRecipeParams struct {
+	ID string `uri:"id" binding:"required,uuid4_rfc4122"`
+}
+
+type FavoriteRecipeParams struct {
+	ID string `uri:"id" binding:"required,uuid4_rfc4122"`
+}
+
+// recipeTimes fills in the cook and active time that were not sent from the timers of the cooking process.
+// Prep work is always counted as active time.
+func recipeTimes(process string, prep int32, cook, active *int32) (int32, int32) {
+	parsedCook, parsedActive := cooking.Times(process)
+
+	cookMinutes := cooking.Minutes(parsedCook)
+	if cook != nil {
+		cookMinutes = *cook
+	}
+	activeMinutes := prep + cooking.Minutes(parsedActive)
+	if active != nil {
+		activeMinutes = *active
+	}
+	return cookMinutes, activeMinutes
+}
+
+func recipeDifficulty(arg types.Difficulty) string {
+	if arg == "" {
+		return types.DifficultyMedium
+	}
+	return string(arg)
+}
+
+func recipeServings(arg int32) int32 {
+	if arg == 0 {
+		return defaultRecipeServings
+	}
+	return arg
+}
+
+// recipeTags lowercases the tags and drops duplicates, the database needs an empty list rather than null
+func recipeTags(arg []string) []string {
+	tags := []string{}
+	for _, tag := range arg {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag != "" && !slices.Contains(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+func createRecipeToDBCreateRecipe(arg CreateRecipeParams, familyID uuid.UUID) (database.CreateRecipeParams, error) {
+	items, err := json.Marshal(arg.Items)
+	if err != nil {
+		return database.CreateRecipeParams{}, err
+	}
+	cook, active := recipeTimes(arg.CookingProcess, arg.PrepTimeMinutes, arg.CookTimeMinutes, arg.ActiveTimeMinutes)
+	return database.CreateRecipeParams{
+		Name:               arg.Name,
+		CookingProcess:     arg.CookingProcess,
+		FamilyID:           familyID,
+		Items:              items,
+		PrepTimeMinutes:    arg.PrepTimeMinutes,
+		CookTimeMinutes:    cook,
+		ActiveTimeMinutes:  active,
+		Difficulty:         recipeDifficulty(arg.Difficulty),
+		Cuisine:            strings.ToLower(strings.TrimSpace(arg.Cuisine)),
+		Tags:               recipeTags(arg.Tags),
+		CaloriesPerServing: arg.CaloriesPerServing,
+		ProteinPerServing:  arg.ProteinPerServing,
+		CostPerServing:     arg.CostPerServing,
+		Servings:           recipeServings(arg.Servings),
+	}, nil
+}
+
+func updateRecipeToDBUpdateRecipe(arg UpdateRecipeParams) (database.UpdateRecipeParams, error) {
+	items, err := json.Marshal(arg.Items)
+	if err != nil {
+		return database.UpdateRecipeParams{}, err
+	}
+	cook, active := recipeTimes(arg.CookingProcess, arg.PrepTimeMinutes, arg.CookTimeMinutes, arg.ActiveTimeMinutes)
+	return database.UpdateRecipeParams{
+		ID:                 uuid.MustParse(arg.ID),
+		Name:               arg.Name,
+		CookingProcess:     arg.CookingProcess,
+		Items:              items,
+		PrepTimeMinutes:    arg.PrepTimeMinutes,
+		CookTimeMinutes:    cook,
+		ActiveTimeMinutes:  active,
+		Difficulty:         recipeDifficulty(arg.Difficulty),
+		Cuisine:            strings.ToLower(strings.TrimSpace(arg.Cuisine)),
+		Tags:               recipeTags(arg.Tags),
+		CaloriesPerServing: arg.CaloriesPerServing,
+		ProteinPerServing:  arg.ProteinPerServing,
+		CostPerServing:     arg.CostPerServing,
+		Servings:           recipeServings(arg.Servings),
+	}, nil
+}
+
+// getRecipesToDBFilterRecipes converts the recipe list query, max_total_time accepts minutes or values like 30m
+func getRecipesToDBFilterRecipes(arg GetRecipesQuery, familyID uuid.UUID) (database.FilterRecipesByFamilyIDParams, error) {
+	params := database.FilterRecipesByFamilyIDParams{
+		FamilyID:     familyID,
+		Difficulty:   pgtype.Text{String: string(arg.Difficulty), Valid: arg.Difficulty != ""},
+		OnlyPossible: arg.OnlyPossible,
+		SortBy:       arg.Sort,
+	}
+	if arg.MaxTotalTime != "" {
+		minutes, err := cooking.ParseMinutes(arg.MaxTotalTime)
+		if err != nil {
+			return params, err
+		}
+		params.MaxTotalTime = pgtype.Int4{Int32: minutes, Valid: true}
+	}
+	return params, nil
+}
+
+func DBRecipeToRecipe(arg database.Recipe, favorited bool) (Recipe, error) {
+	var items []types.RecipeItem
+	err := json.Unmarshal(arg.Items, &items)
+	if err != nil {
+		return Recipe{}, err
+	}
+	return Recipe{
+		ID:                 arg.ID,
+		CreatedAt:          arg.CreatedAt,
+		UpdatedAt:          arg.UpdatedAt,
+		Name:               arg.Name,
+		CookingProcess:     arg.CookingProcess,
+		FamilyID:           arg.FamilyID,
+		Items:              items,
+		PrepTimeMinutes:    arg.PrepTimeMinutes,
+		CookTimeMinutes:    arg.CookTimeMinutes,
+		TotalTimeMinutes:   arg.TotalTimeMinutes,
+		ActiveTimeMinutes:  arg.ActiveTimeMinutes,
+		Difficulty:         types.Difficulty(arg.Difficulty),
+		Cuisine:            arg.Cuisine,
+		Tags:               arg.Tags,
+		CaloriesPerServing: arg.CaloriesPerServing,
+		ProteinPerServing:  arg.ProteinPerServing,
+		CostPerServing:     arg.CostPerServing,
+		Servings:           arg.Servings,
+		Favorited:          favorited,
+	}, nil
+}
+
+// DBRecipesToRecipes converts recipes and flags the ones found in favoriteIDs
+func DBRecipesToRecipes(arg []database.Recipe, favoriteIDs []uuid.UUID) ([]Recipe, error) {
+	favorites := make(map[uuid.UUID]bool, len(favoriteIDs))
+	for _, id := range favoriteIDs {
+		favorites[id] = true
+	}
+
+	recipes := []Recipe{}
+	for _, recipe := range arg {
+		r, err := DBRecipeToRecipe(recipe, favorites[recipe.ID])
+		if err != nil {
+			return nil, err
+		}
+		recipes = append(recipes, r)
+	}
+	return recipes, nil
+}
+
+// familyRecipe loads a recipe and makes sure it belongs to the user's family.
+// It writes the error response itself and returns false on failure.
+func (s *Server) familyRecipe(ctx *gin.Context, user database.User, id uuid.UUID) (database.Recipe, bool) {
+	recipe, err := s.store.GetRecipeByID(ctx, id)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, respondWithErorr(err))
+			return recipe, false
+		}
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return recipe, false
+	}
+	if recipe.FamilyID != user.FamilyID {
+		ctx.JSON(http.StatusForbidden, respondWithErorr(errForbidden))
+		return recipe, false
+	}
+	return recipe, true
+}
+
+func (s *Server) createRecipe(ctx *gin.Context) {
+	var request CreateRecipeParams
+	err := ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	dbParams, err := createRecipeToDBCreateRecipe(request, user.FamilyID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	existing, err := s.store.GetRecipesByFamilyID(ctx, user.FamilyID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+	duplicates, err := findDuplicates(request.Name, request.Items, existing)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	recipe, err := s.store.CreateRecipe(ctx, dbParams)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	response, err := DBRecipeToRecipe(recipe, false)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+	// the recipe is still created, the client decides whether to merge it with a duplicate
+	response.Duplicates = duplicates
+	ctx.JSON(http.StatusCreated, response)
+}
+
+func (s *Server) getRecipes(ctx *gin.Context) {
+	var query GetRecipesQuery
+	err := ctx.ShouldBindQuery(&query)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	dbParams, err := getRecipesToDBFilterRecipes(query, user.FamilyID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	recipes, err := s.store.FilterRecipesByFamilyID(ctx, dbParams)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	favoriteIDs, err := s.store.GetFavoriteRecipeIDsByUserID(ctx, user.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	response, err := DBRecipesToRecipes(recipes, favoriteIDs)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (s *Server) getRecipeByID(ctx *gin.Context) {
+	var request GetRecipeByIDParams
+	err := ctx.ShouldBindUri(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	recipe, ok := s.familyRecipe(ctx, user, uuid.MustParse(request.ID))
+	if !ok {
+		return
+	}
+
+	favorited, err := s.store.IsRecipeFavorited(ctx, database.IsRecipeFavoritedParams{
+		UserID:   user.ID,
+		RecipeID: recipe.ID,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	equipment, err := s.store.GetRecipeEquipment(ctx, recipe.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	response, err := DBRecipeToRecipe(recipe, favorited)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+	response.Equipment = DBRecipeEquipmentToRecipeEquipment(equipment)
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (s *Server) updateRecipe(ctx *gin.Context) {
+	var request UpdateRecipeParams
+	err := ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	_, ok = s.familyRecipe(ctx, user, uuid.MustParse(request.ID))
+	if !ok {
+		return
+	}
+
+	dbParams, err := updateRecipeToDBUpdateRecipe(request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	recipe, err := s.store.UpdateRecipe(ctx, dbParams)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	favorited, err := s.store.IsRecipeFavorited(ctx, database.IsRecipeFavoritedParams{
+		UserID:   user.ID,
+		RecipeID: recipe.ID,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	response, err := DBRecipeToRecipe(recipe, favorited)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (s *Server) deleteRecipe(ctx *gin.Context) {
+	var request DeleteRecipeParams
+	err := ctx.ShouldBindUri(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	recipe, ok := s.familyRecipe(ctx, user, uuid.MustParse(request.ID))
+	if !ok {
+		return
+	}
+
+	err = s.store.DeleteRecipe(ctx, recipe.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, respondWithMessage(fmt.Sprintf("deleted recipe with id %s", request.ID)))
+}
+
+func (s *Server) addFavorite(ctx *gin.Context) {
+	var request FavoriteRecipeParams
+	err := ctx.ShouldBindUri(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	recipe, ok := s.familyRecipe(ctx, user, uuid.MustParse(request.ID))
+	if !ok {
+		return
+	}
+
+	err = s.store.AddFavorite(ctx, database.AddFavoriteParams{
+		UserID:   user.ID,
+		RecipeID: recipe.ID,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, respondWithMessage(fmt.Sprintf("added recipe with id %s to favorites", request.ID)))
+}
+
+func (s *Server) removeFavorite(ctx *gin.Context) {
+	var request FavoriteRecipeParams
+	err := ctx.ShouldBindUri(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authUser(ctx)
+	if !ok {
+		return
+	}
+
+	err = s.store.RemoveFavorite(ctx, database.RemoveFavoriteParams{
+		UserID:   user.ID,
+		RecipeID: uuid.MustParse(request.ID),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, respondWithMessage(fmt.Sprintf("removed recipe with id %s from favorites", request.ID)))
+}
+
+func (s *Server) getFavorites(ctx *gin.Context) {
+	user, ok := s.authUser(ctx)
+	if !ok {
+		return
+	}
+
+	recipes, err := s.store.GetFavoriteRecipesByUserID(ctx, user.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	response := []Recipe{}
+	for _, recipe := range recipes {
+		r, err := DBRecipeToRecipe(recipe, true)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+			return
+		}
+		response = append(response, r)
+	}
+	ctx.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,173 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/andreiz53/cookinator/cooking"
+	database "github.com/andreiz53/cookinator/database/handlers"
+	"github.com/andreiz53/cookinator/types"
+)
+
+// duplicateThreshold is the minimum similarity score for a recipe to be reported as a possible duplicate
+const duplicateThreshold = 0.5
+
+var errMergeSameRecipe = errors.New("a recipe cannot be merged into itself")
+
+type DuplicateCandidate struct {
+	RecipeID             uuid.UUID `json:"recipe_id"`
+	Name                 string    `json:"name"`
+	Score                float64   `json:"score"`
+	NameSimilarity       float64   `json:"name_similarity"`
+	IngredientSimilarity float64   `json:"ingredient_similarity"`
+}
+
+type CheckRecipeDuplicatesParams struct {
+	Name  string             `json:"name" binding:"required,min=2"`
+	Items []types.RecipeItem `json:"items" binding:"required,min=1,dive"`
+}
+
+type MergeRecipesParams struct {
+	SourceID string `json:"source_id" binding:"required,uuid4_rfc4122"`
+}
+
+// ingredientKeys identifies the ingredients of a recipe. Items without an ingredient can't be compared
+// with the items of another recipe and are left out.
+func ingredientKeys(items []types.RecipeItem) []int32 {
+	keys := []int32{}
+	for _, item := range items {
+		if item.IngredientID != 0 {
+			keys = append(keys, item.IngredientID)
+		}
+	}
+	return keys
+}
+
+// findDuplicates scores every recipe against the given name and items and returns the ones
+// above duplicateThreshold, best match first
+func findDuplicates(name string, items []types.RecipeItem, recipes []database.Recipe) ([]DuplicateCandidate, error) {
+	keys := ingredientKeys(items)
+
+	candidates := []DuplicateCandidate{}
+	for _, recipe := range recipes {
+		var recipeItems []types.RecipeItem
+		err := json.Unmarshal(recipe.Items, &recipeItems)
+		if err != nil {
+			return nil, err
+		}
+
+		nameSimilarity := cooking.NameSimilarity(name, recipe.Name)
+		ingredientSimilarity := cooking.Jaccard(keys, ingredientKeys(recipeItems))
+		score := cooking.Similarity(nameSimilarity, ingredientSimilarity)
+		if score < duplicateThreshold {
+			continue
+		}
+		candidates = append(candidates, DuplicateCandidate{
+			RecipeID:             recipe.ID,
+			Name:                 recipe.Name,
+			Score:                score,
+			NameSimilarity:       nameSimilarity,
+			IngredientSimilarity: ingredientSimilarity,
+		})
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Score > candidates[j].Score
+	})
+	return candidates, nil
+}
+
+// checkRecipeDuplicates reports the possible duplicates of a recipe before it is created or imported
+func (s *Server) checkRecipeDuplicates(ctx *gin.Context) {
+	var request CheckRecipeDuplicatesParams
+	err := ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	recipes, err := s.store.GetRecipesByFamilyID(ctx, user.FamilyID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	duplicates, err := findDuplicates(request.Name, request.Items, recipes)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, duplicates)
+}
+
+// mergeRecipes merges the source recipe into the recipe from the uri. The target keeps its
+// content and takes over the history, ratings, favorites, collections, planned meals, templates, event menus
+// and equipment of the source, which is deleted.
+func (s *Server) mergeRecipes(ctx *gin.Context) {
+	var uri GetRecipeByIDParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	var request MergeRecipesParams
+	err = ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+	if request.SourceID == uri.ID {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(errMergeSameRecipe))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	target, ok := s.familyRecipe(ctx, user, uuid.MustParse(uri.ID))
+	if !ok {
+		return
+	}
+	source, ok := s.familyRecipe(ctx, user, uuid.MustParse(request.SourceID))
+	if !ok {
+		return
+	}
+
+	recipe, err := s.store.MergeRecipesTx(ctx, database.MergeRecipesTxParams{
+		TargetID: target.ID,
+		SourceID: source.ID,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	favorited, err := s.store.IsRecipeFavorited(ctx, database.IsRecipeFavoritedParams{
+		UserID:   user.ID,
+		RecipeID: recipe.ID,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	response, err := DBRecipeToRecipe(recipe, favorited)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, response)
+}
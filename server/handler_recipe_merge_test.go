@@ -0,0 +1,164 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	database "github.com/andreiz53/cookinator/database/handlers"
+	databaseMock "github.com/andreiz53/cookinator/database/mocks"
+	"github.com/andreiz53/cookinator/types"
+)
+
+func TestFindDuplicates(t *testing.T) {
+	familyID := uuid.New()
+	items := []types.RecipeItem{
+		{ID: uuid.New(), IngredientID: 1, Quantity: 200, Unit: types.MeasureUnitGrams},
+		{ID: uuid.New(), IngredientID: 2, Quantity: 2, Unit: types.MeasureUnitPiece},
+		{ID: uuid.New(), IngredientID: 3, Quantity: 1, Unit: types.MeasureUnitTablespoon},
+	}
+
+	same := randomRecipe(t, familyID)
+	same.Name = "Chicken Curry"
+	sameItems, err := json.Marshal(items)
+	require.NoError(t, err)
+	same.Items = sameItems
+
+	similar := randomRecipe(t, familyID)
+	similar.Name = "chicken curry (mom's)"
+	similarItems, err := json.Marshal(items[:2])
+	require.NoError(t, err)
+	similar.Items = similarItems
+
+	other := randomRecipe(t, familyID)
+	other.Name = "Apple Pie"
+
+	duplicates, err := findDuplicates("Chicken  Curry!", items, []database.Recipe{other, similar, same})
+	require.NoError(t, err)
+	require.Len(t, duplicates, 2)
+	require.Equal(t, same.ID, duplicates[0].RecipeID)
+	require.Equal(t, 1.0, duplicates[0].Score)
+	require.Equal(t, similar.ID, duplicates[1].RecipeID)
+	require.Less(t, duplicates[1].Score, duplicates[0].Score)
+
+	// items typed in without an ingredient don't make two recipes alike
+	keyless := []types.RecipeItem{{ID: uuid.New(), Quantity: 1, Unit: types.MeasureUnitPiece}}
+	soup := randomRecipe(t, familyID)
+	soup.Name = "Chicken Soup"
+	soupItems, err := json.Marshal([]types.RecipeItem{{ID: uuid.New(), Quantity: 2, Unit: types.MeasureUnitCup}})
+	require.NoError(t, err)
+	soup.Items = soupItems
+
+	duplicates, err = findDuplicates("Chicken Curry", keyless, []database.Recipe{soup})
+	require.NoError(t, err)
+	require.Empty(t, duplicates)
+}
+
+func TestMergeRecipes(t *testing.T) {
+	user := randomFamilyUser(t)
+	target := randomRecipe(t, user.FamilyID)
+	source := randomRecipe(t, user.FamilyID)
+	otherSource := randomRecipe(t, uuid.New())
+
+	testCases := []struct {
+		name          string
+		sourceID      uuid.UUID
+		stubs         func(store *databaseMock.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "OK",
+			sourceID: source.ID,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetRecipeByID(mock.Anything, target.ID).
+					Times(1).Return(target, nil)
+				store.EXPECT().
+					GetRecipeByID(mock.Anything, source.ID).
+					Times(1).Return(source, nil)
+				store.EXPECT().
+					MergeRecipesTx(mock.Anything, database.MergeRecipesTxParams{
+						TargetID: target.ID,
+						SourceID: source.ID,
+					}).
+					Times(1).Return(target, nil)
+				store.EXPECT().
+					IsRecipeFavorited(mock.Anything, database.IsRecipeFavoritedParams{
+						UserID:   user.ID,
+						RecipeID: target.ID,
+					}).
+					Times(1).Return(true, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchRecipe(t, recorder.Body, target, true)
+			},
+		},
+		{
+			name:     "SameRecipe",
+			sourceID: target.ID,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					MergeRecipesTx(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:     "SourceOfOtherFamily",
+			sourceID: otherSource.ID,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetRecipeByID(mock.Anything, target.ID).
+					Times(1).Return(target, nil)
+				store.EXPECT().
+					GetRecipeByID(mock.Anything, otherSource.ID).
+					Times(1).Return(otherSource, nil)
+				store.EXPECT().
+					MergeRecipesTx(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			server := newTestServer(t, store)
+
+			tc.stubs(store)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/recipes/%s/merge", target.ID.String())
+
+			data, err := encodeJSON(MergeRecipesParams{SourceID: tc.sourceID.String()})
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+			setAuth(t, request, server.tokenMaker, authHeaderTypeBearer, user.Email, time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
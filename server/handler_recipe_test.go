@@ -0,0 +1,482 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	database "github.com/andreiz53/cookinator/database/handlers"
+	databaseMock "github.com/andreiz53/cookinator/database/mocks"
+	"github.com/andreiz53/cookinator/types"
+	"github.com/andreiz53/cookinator/util"
+)
+
+func randomRecipeItems() []types.RecipeItem {
+	var items []types.RecipeItem
+	for i := 0; i < 3; i++ {
+		items = append(items, types.RecipeItem{
+			ID:       uuid.New(),
+			Quantity: float64(util.RandomInt(1, 500)),
+			Unit:     types.MeasureUnits[util.RandomInt(0, len(types.MeasureUnits)-1)],
+		})
+	}
+	return items
+}
+
+func randomRecipe(t *testing.T, familyID uuid.UUID) database.Recipe {
+	items, err := json.Marshal(randomRecipeItems())
+	require.NoError(t, err)
+	return database.Recipe{
+		ID:             uuid.New(),
+		Name:           util.RandomName(),
+		CookingProcess: util.RandomString(64),
+		FamilyID:       familyID,
+		Items:          items,
+	}
+}
+
+func randomFamilyUser(t *testing.T) database.User {
+	user := randomUser(t)
+	user.FamilyID = uuid.New()
+	return user
+}
+
+func requireBodyMatchRecipe(t *testing.T, body *bytes.Buffer, recipe database.Recipe, favorited bool) {
+	gotRecipe, err := decodeJSON[Recipe](body)
+	require.NoError(t, err)
+	require.NotEmpty(t, gotRecipe)
+
+	require.Equal(t, recipe.ID, gotRecipe.ID)
+	require.Equal(t, recipe.Name, gotRecipe.Name)
+	require.Equal(t, recipe.CookingProcess, gotRecipe.CookingProcess)
+	require.Equal(t, recipe.FamilyID, gotRecipe.FamilyID)
+	require.Equal(t, favorited, gotRecipe.Favorited)
+}
+
+func TestCreateRecipe(t *testing.T) {
+	user := randomFamilyUser(t)
+	recipe := randomRecipe(t, user.FamilyID)
+
+	params := CreateRecipeParams{
+		Name:            recipe.Name,
+		CookingProcess:  "Chop the vegetables. Fry them for 10 minutes. Bake for 30 minutes.",
+		Items:           randomRecipeItems(),
+		PrepTimeMinutes: 15,
+	}
+
+	testCases := []struct {
+		name          string
+		params        CreateRecipeParams
+		stubs         func(store *databaseMock.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "OK",
+			params: params,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetRecipesByFamilyID(mock.Anything, user.FamilyID).
+					Times(1).Return([]database.Recipe{}, nil)
+				store.EXPECT().
+					CreateRecipe(mock.Anything, mock.MatchedBy(func(arg database.CreateRecipeParams) bool {
+						return arg.PrepTimeMinutes == 15 &&
+							arg.CookTimeMinutes == 40 &&
+							arg.ActiveTimeMinutes == 25 &&
+							arg.Difficulty == types.DifficultyMedium
+					})).
+					Times(1).Return(recipe, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+				requireBodyMatchRecipe(t, recorder.Body, recipe, false)
+			},
+		},
+		{
+			name:   "BadRequest",
+			params: CreateRecipeParams{},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					CreateRecipe(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "NoFamily",
+			params: params,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(database.User{ID: user.ID, Email: user.Email}, nil)
+				store.EXPECT().
+					CreateRecipe(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:   "InternalServerError",
+			params: params,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetRecipesByFamilyID(mock.Anything, user.FamilyID).
+					Times(1).Return([]database.Recipe{}, nil)
+				store.EXPECT().
+					CreateRecipe(mock.Anything, mock.Anything).
+					Times(1).Return(database.Recipe{}, pgx.ErrTxClosed)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			server := newTestServer(t, store)
+
+			tc.stubs(store)
+
+			recorder := httptest.NewRecorder()
+			url := "/recipes"
+
+			data, err := encodeJSON(tc.params)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+			setAuth(t, request, server.tokenMaker, authHeaderTypeBearer, user.Email, time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestGetRecipes(t *testing.T) {
+	user := randomFamilyUser(t)
+	var recipes []database.Recipe
+	for i := 0; i < 3; i++ {
+		recipes = append(recipes, randomRecipe(t, user.FamilyID))
+	}
+
+	testCases := []struct {
+		name          string
+		query         string
+		stubs         func(store *databaseMock.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					FilterRecipesByFamilyID(mock.Anything, database.FilterRecipesByFamilyIDParams{FamilyID: user.FamilyID}).
+					Times(1).Return(recipes, nil)
+				store.EXPECT().
+					GetFavoriteRecipeIDsByUserID(mock.Anything, user.ID).
+					Times(1).Return([]uuid.UUID{recipes[1].ID}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				gotRecipes, err := decodeJSON[[]Recipe](recorder.Body)
+				require.NoError(t, err)
+				require.Len(t, gotRecipes, len(recipes))
+				for i, recipe := range gotRecipes {
+					require.Equal(t, recipes[i].ID, recipe.ID)
+					require.Equal(t, i == 1, recipe.Favorited)
+				}
+			},
+		},
+		{
+			name: "InternalServerError",
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					FilterRecipesByFamilyID(mock.Anything, mock.Anything).
+					Times(1).Return([]database.Recipe{}, pgx.ErrTxClosed)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+		{
+			name:  "Filtered",
+			query: "?max_total_time=1h30m&difficulty=easy&sort=total_time&only_possible=true",
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					FilterRecipesByFamilyID(mock.Anything, database.FilterRecipesByFamilyIDParams{
+						FamilyID:     user.FamilyID,
+						MaxTotalTime: pgtype.Int4{Int32: 90, Valid: true},
+						Difficulty:   pgtype.Text{String: types.DifficultyEasy, Valid: true},
+						OnlyPossible: true,
+						SortBy:       "total_time",
+					}).
+					Times(1).Return(recipes[:1], nil)
+				store.EXPECT().
+					GetFavoriteRecipeIDsByUserID(mock.Anything, user.ID).
+					Times(1).Return([]uuid.UUID{}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:  "InvalidMaxTotalTime",
+			query: "?max_total_time=soon",
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					FilterRecipesByFamilyID(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "InvalidDifficulty",
+			query: "?difficulty=impossible",
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					FilterRecipesByFamilyID(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			server := newTestServer(t, store)
+
+			tc.stubs(store)
+
+			recorder := httptest.NewRecorder()
+			url := "/recipes" + tc.query
+
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+			setAuth(t, request, server.tokenMaker, authHeaderTypeBearer, user.Email, time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestGetRecipeByID(t *testing.T) {
+	user := randomFamilyUser(t)
+	recipe := randomRecipe(t, user.FamilyID)
+	otherRecipe := randomRecipe(t, uuid.New())
+
+	testCases := []struct {
+		name          string
+		recipeID      uuid.UUID
+		stubs         func(store *databaseMock.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "OK",
+			recipeID: recipe.ID,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetRecipeByID(mock.Anything, recipe.ID).
+					Times(1).Return(recipe, nil)
+				store.EXPECT().
+					IsRecipeFavorited(mock.Anything, database.IsRecipeFavoritedParams{UserID: user.ID, RecipeID: recipe.ID}).
+					Times(1).Return(true, nil)
+				store.EXPECT().
+					GetRecipeEquipment(mock.Anything, recipe.ID).
+					Times(1).Return([]database.GetRecipeEquipmentRow{{EquipmentID: 1, Name: "oven", Heavy: true}}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				gotRecipe, err := decodeJSON[Recipe](recorder.Body)
+				require.NoError(t, err)
+				require.Equal(t, recipe.ID, gotRecipe.ID)
+				require.True(t, gotRecipe.Favorited)
+				require.Equal(t, []RecipeEquipment{{EquipmentID: 1, Name: "oven", Heavy: true}}, gotRecipe.Equipment)
+			},
+		},
+		{
+			name:     "BadRequest",
+			recipeID: uuid.UUID{},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetRecipeByID(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:     "NotFound",
+			recipeID: recipe.ID,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetRecipeByID(mock.Anything, recipe.ID).
+					Times(1).Return(database.Recipe{}, pgx.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:     "Forbidden",
+			recipeID: otherRecipe.ID,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetRecipeByID(mock.Anything, otherRecipe.ID).
+					Times(1).Return(otherRecipe, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:     "Unauthorized",
+			recipeID: recipe.ID,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(database.User{}, pgx.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			server := newTestServer(t, store)
+
+			tc.stubs(store)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/recipes/%s", tc.recipeID.String())
+
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+			setAuth(t, request, server.tokenMaker, authHeaderTypeBearer, user.Email, time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestAddFavorite(t *testing.T) {
+	user := randomFamilyUser(t)
+	recipe := randomRecipe(t, user.FamilyID)
+
+	testCases := []struct {
+		name          string
+		recipeID      uuid.UUID
+		stubs         func(store *databaseMock.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "OK",
+			recipeID: recipe.ID,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetRecipeByID(mock.Anything, recipe.ID).
+					Times(1).Return(recipe, nil)
+				store.EXPECT().
+					AddFavorite(mock.Anything, database.AddFavoriteParams{UserID: user.ID, RecipeID: recipe.ID}).
+					Times(1).Return(nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:     "NotFound",
+			recipeID: recipe.ID,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetRecipeByID(mock.Anything, recipe.ID).
+					Times(1).Return(database.Recipe{}, pgx.ErrNoRows)
+				store.EXPECT().
+					AddFavorite(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			server := newTestServer(t, store)
+
+			tc.stubs(store)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/recipes/%s/favorite", tc.recipeID.String())
+
+			request, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+			setAuth(t, request, server.tokenMaker, authHeaderTypeBearer, user.Email, time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
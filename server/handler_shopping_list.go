@@ -0,0 +1,1018 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	database "github.com/andreiz53/cookinator/database/handlers"
+	"github.com/andreiz53/cookinator/types"
+	"github.com/andreiz53/cookinator/util"
+)
+
+// ShoppingItem is the total quantity of an ingredient needed in one unit
+type ShoppingItem struct {
+	IngredientID int32             `json:"ingredient_id"`
+	Name         string            `json:"name"`
+	Quantity     float64           `json:"quantity"`
+	Unit         types.MeasureUnit `json:"unit"`
+}
+
+// ShoppingList is what a family buys for the meals of a week, generated from its meal plan and edited after
+type ShoppingList struct {
+	ID         uuid.UUID             `json:"id"`
+	CreatedAt  pgtype.Timestamp      `json:"created_at"`
+	UpdatedAt  pgtype.Timestamp      `json:"updated_at"`
+	FamilyID   uuid.UUID             `json:"family_id"`
+	MealPlanID uuid.UUID             `json:"meal_plan_id"`
+	Items      []ShoppingListItem    `json:"items,omitempty"`
+	Aisles     []ShoppingListAisle   `json:"aisles,omitempty"`
+	Needs      []ShoppingNeed        `json:"needs,omitempty"`
+	Staples    []Staple              `json:"staples,omitempty"`
+	Changes    []GeneratedItemChange `json:"changes,omitempty"`
+	Unmatched  []UnmatchedItem       `json:"unmatched,omitempty"`
+}
+
+// UnmatchedItem is an item of a planned meal without a known ingredient. It can't be added up with the others,
+// so it is reported with the meal it is cooked for and the family adds it to the list by hand.
+type UnmatchedItem struct {
+	ItemID   uuid.UUID         `json:"item_id"`
+	Quantity float64           `json:"quantity"`
+	Unit     types.MeasureUnit `json:"unit"`
+	Source   ShoppingSource    `json:"source"`
+}
+
+// ShoppingListAisle is the items of a shopping list found in the same aisle of a store
+type ShoppingListAisle struct {
+	Category types.GroceryCategory `json:"category"`
+	Items    []ShoppingListItem    `json:"items"`
+}
+
+// ShoppingListItem is a line of a shopping list, Manual items were added by the family and Edited ones were
+// generated and changed by the family since. Packages are the
+// packages the quantity is bought in, and the Surplus of them is left over for the inventory. Sources are
+// the meals and staples a generated item is bought for.
+// CheckedAt and CheckedBy tell when and by whom the item was last checked or unchecked.
+type ShoppingListItem struct {
+	ID           uuid.UUID             `json:"id"`
+	IngredientID int32                 `json:"ingredient_id,omitempty"`
+	Name         string                `json:"name"`
+	Quantity     float64               `json:"quantity"`
+	Unit         types.MeasureUnit     `json:"unit"`
+	Category     types.GroceryCategory `json:"category"`
+	Packages     json.RawMessage       `json:"packages"`
+	Surplus      float64               `json:"surplus"`
+	Sources      json.RawMessage       `json:"sources"`
+	Manual       bool                  `json:"manual"`
+	Edited       bool                  `json:"edited"`
+	Checked      bool                  `json:"checked"`
+	CheckedAt    pgtype.Timestamp      `json:"checked_at"`
+	CheckedBy    pgtype.UUID           `json:"checked_by"`
+}
+
+type ShoppingListParams struct {
+	ID string `uri:"id" binding:"required,uuid4_rfc4122"`
+}
+
+// ShoppingListQuery picks the store whose aisle order the items are grouped in
+type ShoppingListQuery struct {
+	StoreLayoutID string `form:"store_layout_id" binding:"omitempty,uuid4_rfc4122"`
+}
+
+// GenerateShoppingListQuery buys the cheapest packages instead of the ones leaving the least surplus
+type GenerateShoppingListQuery struct {
+	Cheapest bool `form:"cheapest"`
+}
+
+type ShoppingListItemParams struct {
+	ID     string `uri:"id" binding:"required,uuid4_rfc4122"`
+	ItemID string `uri:"item_id" binding:"required,uuid4_rfc4122"`
+}
+
+// CreateShoppingListItemParams adds an item by hand, IngredientID is optional for things that aren't ingredients.
+// An item of an ingredient is in the aisle of the ingredient, Category is for the others.
+type CreateShoppingListItemParams struct {
+	IngredientID int32                 `json:"ingredient_id" binding:"omitempty,min=1"`
+	Name         string                `json:"name" binding:"required,min=1,max=255"`
+	Quantity     float64               `json:"quantity" binding:"required,gt=0"`
+	Unit         types.MeasureUnit     `json:"unit" binding:"required,oneof=g mL tsp tbsp pc cup"`
+	Category     types.GroceryCategory `json:"category" binding:"omitempty,oneof=produce bakery meat seafood dairy pantry spices beverages frozen other"`
+}
+
+type UpdateShoppingListItemParams struct {
+	Name     string                `json:"name" binding:"required,min=1,max=255"`
+	Quantity float64               `json:"quantity" binding:"required,gt=0"`
+	Unit     types.MeasureUnit     `json:"unit" binding:"required,oneof=g mL tsp tbsp pc cup"`
+	Category types.GroceryCategory `json:"category" binding:"omitempty,oneof=produce bakery meat seafood dairy pantry spices beverages frozen other"`
+}
+
+func DBShoppingListToShoppingList(arg database.ShoppingList) ShoppingList {
+	return ShoppingList{
+		ID:         arg.ID,
+		CreatedAt:  arg.CreatedAt,
+		UpdatedAt:  arg.UpdatedAt,
+		FamilyID:   arg.FamilyID,
+		MealPlanID: arg.MealPlanID,
+	}
+}
+
+func DBShoppingListsToShoppingLists(arg []database.ShoppingList) []ShoppingList {
+	lists := []ShoppingList{}
+	for _, list := range arg {
+		lists = append(lists, DBShoppingListToShoppingList(list))
+	}
+	return lists
+}
+
+func DBShoppingListItemToShoppingListItem(arg database.ShoppingListItem) ShoppingListItem {
+	return ShoppingListItem{
+		ID:           arg.ID,
+		IngredientID: arg.IngredientID.Int32,
+		Name:         arg.Name,
+		Quantity:     arg.Quantity,
+		Unit:         types.MeasureUnit(arg.Unit),
+		Category:     types.GroceryCategory(arg.Category),
+		Packages:     arg.Packages,
+		Surplus:      arg.Surplus,
+		Sources:      arg.Sources,
+		Manual:       arg.Manual,
+		Edited:       arg.Edited,
+		Checked:      arg.Checked,
+		CheckedAt:    arg.CheckedAt,
+		CheckedBy:    arg.CheckedBy,
+	}
+}
+
+func DBShoppingListItemsToShoppingListItems(arg []database.ShoppingListItem) []ShoppingListItem {
+	items := []ShoppingListItem{}
+	for _, item := range arg {
+		items = append(items, DBShoppingListItemToShoppingListItem(item))
+	}
+	return items
+}
+
+// ShoppingListAisles groups the items by aisle in the order the store is walked. Aisles the order leaves out
+// follow in the usual order, and aisles without items are left out.
+func ShoppingListAisles(items []ShoppingListItem, order []types.GroceryCategory) []ShoppingListAisle {
+	byCategory := map[types.GroceryCategory][]ShoppingListItem{}
+	for _, item := range items {
+		byCategory[item.Category] = append(byCategory[item.Category], item)
+	}
+
+	aisles := []ShoppingListAisle{}
+	walk := append(append([]types.GroceryCategory{}, order...), types.GroceryCategories...)
+	for _, category := range walk {
+		if items, ok := byCategory[category]; ok {
+			aisles = append(aisles, ShoppingListAisle{Category: category, Items: items})
+			delete(byCategory, category)
+		}
+	}
+	for category, items := range byCategory {
+		aisles = append(aisles, ShoppingListAisle{Category: category, Items: items})
+	}
+	return aisles
+}
+
+// MealPlanRecipeItems scales the items of every cooked meal from the servings of its recipe to the servings cooked.
+// Leftovers were bought for with the meal they are left from.
+func MealPlanRecipeItems(entries []database.GetMealPlanEntriesRow, recipes map[uuid.UUID]database.Recipe) ([]types.RecipeItem, error) {
+	result := []types.RecipeItem{}
+	for _, entry := range entries {
+		recipe, ok := recipes[entry.RecipeID]
+		if entry.LeftoverOf.Valid || !ok {
+			continue
+		}
+		var items []types.RecipeItem
+		err := json.Unmarshal(recipe.Items, &items)
+		if err != nil {
+			return nil, err
+		}
+		scale := float64(cookedServings(entry.Servings, entry.BatchServings)) / float64(max(recipe.Servings, 1))
+		for _, item := range items {
+			item.Quantity *= scale
+			result = append(result, item)
+		}
+	}
+	return result, nil
+}
+
+// UnmatchedRecipeItems returns the items of every cooked meal whose ingredient isn't known, scaled like
+// MealPlanRecipeItems does, so they aren't lost when the known ones are added up
+func UnmatchedRecipeItems(entries []database.GetMealPlanEntriesRow, recipes map[uuid.UUID]database.Recipe, ingredients map[int32]database.Ingredient) ([]UnmatchedItem, error) {
+	result := []UnmatchedItem{}
+	for _, entry := range entries {
+		recipe, ok := recipes[entry.RecipeID]
+		if entry.LeftoverOf.Valid || !ok {
+			continue
+		}
+		var items []types.RecipeItem
+		err := json.Unmarshal(recipe.Items, &items)
+		if err != nil {
+			return nil, err
+		}
+		servings := cookedServings(entry.Servings, entry.BatchServings)
+		scale := float64(servings) / float64(max(recipe.Servings, 1))
+		for _, item := range items {
+			if _, ok := ingredients[item.IngredientID]; ok {
+				continue
+			}
+			result = append(result, UnmatchedItem{
+				ItemID:   item.ID,
+				Quantity: roundQuantity(item.Quantity*scale, item.Unit),
+				Unit:     item.Unit,
+				Source: ShoppingSource{
+					Kind:     ShoppingSourceMeal,
+					ID:       entry.ID,
+					Name:     recipe.Name,
+					Day:      entry.Day.Time.Format(util.DateLayout),
+					Slot:     entry.Slot,
+					Servings: servings,
+				},
+			})
+		}
+	}
+	return result, nil
+}
+
+// ingredientDensity is the density of an ingredient in g/mL, zero when it isn't known
+func ingredientDensity(ingredient database.Ingredient) float64 {
+	density, err := ingredient.Density.Float64Value()
+	if err != nil || !density.Valid || density.Float64 <= 0 {
+		return 0
+	}
+	return density.Float64
+}
+
+// AggregateShoppingItems adds up the quantities of the same ingredient. Volumes in different units are merged
+// into millilitres, and volumes and weights are merged into grams when the density of the ingredient is known.
+// Pieces are rounded up to whole ones. Items without a known ingredient can't be added up and are left out,
+// UnmatchedRecipeItems reports them.
+func AggregateShoppingItems(items []types.RecipeItem, ingredients map[int32]database.Ingredient) []ShoppingItem {
+	totals := map[int32]map[types.MeasureUnit]float64{}
+	for _, item := range items {
+		if _, ok := ingredients[item.IngredientID]; !ok {
+			continue
+		}
+		if totals[item.IngredientID] == nil {
+			totals[item.IngredientID] = map[types.MeasureUnit]float64{}
+		}
+		totals[item.IngredientID][item.Unit] += item.Quantity
+	}
+
+	result := []ShoppingItem{}
+	for id, units := range totals {
+		volumes := []types.MeasureUnit{}
+		millilitres := 0.0
+		for unit, quantity := range units {
+			if ml, ok := unit.Millilitres(quantity); ok {
+				volumes = append(volumes, unit)
+				millilitres += ml
+			}
+		}
+		if len(volumes) > 1 {
+			for _, unit := range volumes {
+				delete(units, unit)
+			}
+			units[types.MeasureUnitMillilitres] = millilitres
+			volumes = []types.MeasureUnit{types.MeasureUnitMillilitres}
+		}
+		density := ingredientDensity(ingredients[id])
+		if _, ok := units[types.MeasureUnitGrams]; ok && len(volumes) == 1 && density > 0 {
+			delete(units, volumes[0])
+			units[types.MeasureUnitGrams] += millilitres * density
+		}
+
+		for unit, quantity := range units {
+			result = append(result, ShoppingItem{
+				IngredientID: id,
+				Name:         ingredients[id].Name,
+				Quantity:     roundQuantity(quantity, unit),
+				Unit:         unit,
+			})
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Name != result[j].Name {
+			return result[i].Name < result[j].Name
+		}
+		return result[i].Unit < result[j].Unit
+	})
+	return result
+}
+
+// ShoppingSourceKind is what a generated item is bought for
+type ShoppingSourceKind string
+
+const (
+	ShoppingSourceMeal   ShoppingSourceKind = "meal"
+	ShoppingSourceStaple ShoppingSourceKind = "staple"
+)
+
+// ShoppingSource is a meal of the plan or a staple a generated item is bought for. ID is the meal plan entry
+// or the staple, and Name is the recipe cooked or the ingredient bought.
+type ShoppingSource struct {
+	Kind     ShoppingSourceKind `json:"kind"`
+	ID       uuid.UUID          `json:"id"`
+	Name     string             `json:"name"`
+	Day      string             `json:"day,omitempty"`
+	Slot     string             `json:"slot,omitempty"`
+	Servings int32              `json:"servings,omitempty"`
+}
+
+// ShoppingSources are the meals cooked and the staples due that need each ingredient, in the order of the plan.
+// Leftovers were bought for with the meal they are left from.
+func ShoppingSources(entries []database.GetMealPlanEntriesRow, recipes map[uuid.UUID]database.Recipe, staples []database.GetStaplesByFamilyIDRow) (map[int32][]ShoppingSource, error) {
+	sources := map[int32][]ShoppingSource{}
+	for _, entry := range entries {
+		recipe, ok := recipes[entry.RecipeID]
+		if entry.LeftoverOf.Valid || !ok {
+			continue
+		}
+		var items []types.RecipeItem
+		err := json.Unmarshal(recipe.Items, &items)
+		if err != nil {
+			return nil, err
+		}
+		source := ShoppingSource{
+			Kind:     ShoppingSourceMeal,
+			ID:       entry.ID,
+			Name:     recipe.Name,
+			Day:      entry.Day.Time.Format(util.DateLayout),
+			Slot:     entry.Slot,
+			Servings: cookedServings(entry.Servings, entry.BatchServings),
+		}
+		seen := map[int32]bool{}
+		for _, item := range items {
+			if seen[item.IngredientID] {
+				continue
+			}
+			seen[item.IngredientID] = true
+			sources[item.IngredientID] = append(sources[item.IngredientID], source)
+		}
+	}
+	for _, staple := range staples {
+		sources[staple.IngredientID] = append(sources[staple.IngredientID], ShoppingSource{
+			Kind: ShoppingSourceStaple,
+			ID:   staple.ID,
+			Name: staple.IngredientName,
+		})
+	}
+	return sources, nil
+}
+
+// GeneratedItemChangeKind is how generating a shopping list again changed one of its items
+type GeneratedItemChangeKind string
+
+const (
+	GeneratedItemAdded   GeneratedItemChangeKind = "added"
+	GeneratedItemUpdated GeneratedItemChangeKind = "updated"
+	GeneratedItemRemoved GeneratedItemChangeKind = "removed"
+	// a checked item was bought already and an edited one was changed by the family, both are kept
+	// as they are, even if what the plan needs of them changed
+	GeneratedItemKept GeneratedItemChangeKind = "kept"
+)
+
+// GeneratedItemChange is a change to a generated item of a shopping list. AddedSources are the meals and
+// staples the item is now bought for, and RemovedSources the ones it isn't bought for anymore.
+type GeneratedItemChange struct {
+	Change           GeneratedItemChangeKind `json:"change"`
+	IngredientID     int32                   `json:"ingredient_id"`
+	Name             string                  `json:"name"`
+	Unit             types.MeasureUnit       `json:"unit"`
+	Quantity         float64                 `json:"quantity"`
+	PreviousQuantity float64                 `json:"previous_quantity"`
+	AddedSources     []ShoppingSource        `json:"added_sources,omitempty"`
+	RemovedSources   []ShoppingSource        `json:"removed_sources,omitempty"`
+}
+
+// ShoppingListDelta is what changes on a shopping list generated again: the generated items to create,
+// update and delete, and the changes shown to the family
+type ShoppingListDelta struct {
+	Create  []database.CreateShoppingListItemParams
+	Update  []database.UpdateGeneratedShoppingListItemParams
+	Delete  []uuid.UUID
+	Changes []GeneratedItemChange
+}
+
+type generatedItemKey struct {
+	ingredientID int32
+	unit         string
+}
+
+// keyOf is the key of an item of the list, by the unit it was generated in since the family can change its unit
+func keyOf(item database.ShoppingListItem) generatedItemKey {
+	return generatedItemKey{item.IngredientID.Int32, item.GeneratedUnit.String}
+}
+
+// DiffShoppingList compares the items of a list, dismissed ones included, with the items generated again,
+// matching them by ingredient and the unit they were generated in. Only the generated items whose quantity,
+// packages or sources changed are updated. Items added by hand are never changed, and neither are checked or
+// edited items, which are reported as kept when they would change. Dismissed items stay dismissed and are
+// not reported.
+func DiffShoppingList(existing []database.ShoppingListItem, generated []database.CreateShoppingListItemParams) (ShoppingListDelta, error) {
+	delta := ShoppingListDelta{
+		Create:  []database.CreateShoppingListItemParams{},
+		Update:  []database.UpdateGeneratedShoppingListItemParams{},
+		Delete:  []uuid.UUID{},
+		Changes: []GeneratedItemChange{},
+	}
+
+	current := map[generatedItemKey]database.ShoppingListItem{}
+	for _, item := range existing {
+		if item.Manual {
+			continue
+		}
+		key := keyOf(item)
+		if _, ok := current[key]; ok {
+			if !keptItem(item) {
+				delta.Delete = append(delta.Delete, item.ID)
+			}
+			continue
+		}
+		current[key] = item
+	}
+
+	for _, params := range generated {
+		key := generatedItemKey{params.IngredientID.Int32, params.Unit}
+		item, ok := current[key]
+		if !ok {
+			sources, err := decodeShoppingSources(params.Sources)
+			if err != nil {
+				return delta, err
+			}
+			delta.Create = append(delta.Create, params)
+			delta.Changes = append(delta.Changes, GeneratedItemChange{
+				Change:       GeneratedItemAdded,
+				IngredientID: params.IngredientID.Int32,
+				Name:         params.Name,
+				Unit:         types.MeasureUnit(params.Unit),
+				Quantity:     params.Quantity,
+				AddedSources: sources,
+			})
+			continue
+		}
+		delete(current, key)
+
+		change, changed, err := generatedItemChange(item, params)
+		if err != nil {
+			return delta, err
+		}
+		if !changed || item.Dismissed {
+			continue
+		}
+		if keptItem(item) {
+			change.Change = GeneratedItemKept
+		} else {
+			change.Change = GeneratedItemUpdated
+			delta.Update = append(delta.Update, database.UpdateGeneratedShoppingListItemParams{
+				ID:       item.ID,
+				Quantity: params.Quantity,
+				Packages: params.Packages,
+				Surplus:  params.Surplus,
+				Sources:  params.Sources,
+			})
+		}
+		delta.Changes = append(delta.Changes, change)
+	}
+
+	// what is left isn't needed anymore, in the order of the list
+	for _, item := range existing {
+		key := keyOf(item)
+		if left, ok := current[key]; !ok || left.ID != item.ID || item.Dismissed {
+			continue
+		}
+		sources, err := decodeShoppingSources(item.Sources)
+		if err != nil {
+			return delta, err
+		}
+		change := GeneratedItemChange{
+			Change:           GeneratedItemRemoved,
+			IngredientID:     item.IngredientID.Int32,
+			Name:             item.Name,
+			Unit:             types.MeasureUnit(item.Unit),
+			PreviousQuantity: item.Quantity,
+			RemovedSources:   sources,
+		}
+		if keptItem(item) {
+			change.Change = GeneratedItemKept
+		} else {
+			delta.Delete = append(delta.Delete, item.ID)
+		}
+		delta.Changes = append(delta.Changes, change)
+	}
+
+	sort.SliceStable(delta.Changes, func(i, j int) bool {
+		if delta.Changes[i].Name != delta.Changes[j].Name {
+			return delta.Changes[i].Name < delta.Changes[j].Name
+		}
+		return delta.Changes[i].Unit < delta.Changes[j].Unit
+	})
+	return delta, nil
+}
+
+// keptItem reports whether generating the list again leaves a generated item as it is:
+// checked, edited or dismissed by the family
+func keptItem(item database.ShoppingListItem) bool {
+	return item.Checked || item.Edited || item.Dismissed
+}
+
+// generatedItemChange compares an item with the same item generated again, and reports whether it changed
+func generatedItemChange(item database.ShoppingListItem, params database.CreateShoppingListItemParams) (GeneratedItemChange, bool, error) {
+	change := GeneratedItemChange{
+		IngredientID:     params.IngredientID.Int32,
+		Name:             item.Name,
+		Unit:             types.MeasureUnit(item.Unit),
+		Quantity:         params.Quantity,
+		PreviousQuantity: item.Quantity,
+	}
+
+	before, err := decodeShoppingSources(item.Sources)
+	if err != nil {
+		return change, false, err
+	}
+	after, err := decodeShoppingSources(params.Sources)
+	if err != nil {
+		return change, false, err
+	}
+	change.AddedSources = missingShoppingSources(after, before)
+	change.RemovedSources = missingShoppingSources(before, after)
+
+	var packagesBefore, packagesAfter []PackageCount
+	err = json.Unmarshal(item.Packages, &packagesBefore)
+	if err != nil {
+		return change, false, err
+	}
+	err = json.Unmarshal(params.Packages, &packagesAfter)
+	if err != nil {
+		return change, false, err
+	}
+
+	changed := item.Quantity != params.Quantity || item.Surplus != params.Surplus ||
+		!slices.Equal(packagesBefore, packagesAfter) ||
+		len(change.AddedSources) > 0 || len(change.RemovedSources) > 0
+	return change, changed, nil
+}
+
+func decodeShoppingSources(raw []byte) ([]ShoppingSource, error) {
+	sources := []ShoppingSource{}
+	if len(raw) == 0 {
+		return sources, nil
+	}
+	err := json.Unmarshal(raw, &sources)
+	return sources, err
+}
+
+// missingShoppingSources are the sources that aren't in others
+func missingShoppingSources(sources, others []ShoppingSource) []ShoppingSource {
+	missing := []ShoppingSource{}
+	for _, source := range sources {
+		if !slices.Contains(others, source) {
+			missing = append(missing, source)
+		}
+	}
+	return missing
+}
+
+// familyShoppingList loads a shopping list and makes sure it belongs to the user's family.
+// It writes the error response itself and returns false on failure.
+func (s *Server) familyShoppingList(ctx *gin.Context, user database.User, id uuid.UUID) (database.ShoppingList, bool) {
+	list, err := s.store.GetShoppingListByID(ctx, id)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, respondWithErorr(err))
+			return list, false
+		}
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return list, false
+	}
+	if list.FamilyID != user.FamilyID {
+		ctx.JSON(http.StatusForbidden, respondWithErorr(errForbidden))
+		return list, false
+	}
+	return list, true
+}
+
+// shoppingListItem loads an item and makes sure it is on the given list.
+// It writes the error response itself and returns false on failure.
+func (s *Server) shoppingListItem(ctx *gin.Context, list database.ShoppingList, id uuid.UUID) (database.ShoppingListItem, bool) {
+	item, err := s.store.GetShoppingListItemByID(ctx, id)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, respondWithErorr(err))
+			return item, false
+		}
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return item, false
+	}
+	if item.ShoppingListID != list.ID || item.Dismissed {
+		ctx.JSON(http.StatusNotFound, respondWithErorr(pgx.ErrNoRows))
+		return item, false
+	}
+	return item, true
+}
+
+// generateShoppingList adds up what the meals of a week and the staples due that week need, and buys what the
+// kitchen inventory doesn't cover, in whole packages when the ingredient is sold in packages. Generating it again
+// after the plan changed only changes the generated items that changed, and reports the changes. Items added by
+// hand, checked and edited items are kept, and dismissed items aren't added again.
+func (s *Server) generateShoppingList(ctx *gin.Context) {
+	var uri GetMealPlanByIDParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	var query GenerateShoppingListQuery
+	err = ctx.ShouldBindQuery(&query)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	plan, ok := s.familyMealPlan(ctx, user, uuid.MustParse(uri.ID))
+	if !ok {
+		return
+	}
+
+	entries, err := s.store.GetMealPlanEntries(ctx, plan.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+	recipes, err := s.store.GetRecipesByFamilyID(ctx, plan.FamilyID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+	byID := map[uuid.UUID]database.Recipe{}
+	for _, recipe := range recipes {
+		byID[recipe.ID] = recipe
+	}
+	ingredients, ok := s.ingredientsByID(ctx)
+	if !ok {
+		return
+	}
+
+	items, err := MealPlanRecipeItems(entries, byID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+	unmatched, err := UnmatchedRecipeItems(entries, byID, ingredients)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+	staples, err := s.store.GetStaplesByFamilyID(ctx, plan.FamilyID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+	staples = DueStaples(staples, plan.WeekStart)
+	items = append(items, StapleRecipeItems(staples)...)
+	sources, err := ShoppingSources(entries, byID, staples)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+	inventory, err := s.store.GetInventoryByFamilyID(ctx, plan.FamilyID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	packages, ok := s.ingredientPackages(ctx)
+	if !ok {
+		return
+	}
+
+	needs := SubtractInventory(AggregateShoppingItems(items, ingredients), inventory, ingredients)
+	needs = PackageNeeds(needs, packages, ingredients, query.Cheapest)
+	params := []database.CreateShoppingListItemParams{}
+	for _, need := range needs {
+		if need.ToBuy == 0 {
+			continue
+		}
+		counts := need.Packages
+		if counts == nil {
+			counts = []PackageCount{}
+		}
+		raw, err := json.Marshal(counts)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+			return
+		}
+		needSources := sources[need.IngredientID]
+		if needSources == nil {
+			needSources = []ShoppingSource{}
+		}
+		rawSources, err := json.Marshal(needSources)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+			return
+		}
+		params = append(params, database.CreateShoppingListItemParams{
+			IngredientID:  pgtype.Int4{Int32: need.IngredientID, Valid: true},
+			Name:          need.Name,
+			Quantity:      need.Purchased,
+			Unit:          string(need.Unit),
+			Category:      ingredientCategory(types.GroceryCategory(ingredients[need.IngredientID].Category)),
+			Packages:      raw,
+			Surplus:       need.Surplus,
+			Sources:       rawSources,
+			GeneratedUnit: pgtype.Text{String: string(need.Unit), Valid: true},
+		})
+	}
+
+	existing := []database.ShoppingListItem{}
+	current, err := s.store.GetShoppingListByMealPlanID(ctx, plan.ID)
+	switch err {
+	case nil:
+		existing, err = s.store.GetAllShoppingListItems(ctx, current.ID)
+	case pgx.ErrNoRows:
+		err = nil
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+	delta, err := DiffShoppingList(existing, params)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	result, err := s.store.GenerateShoppingListTx(ctx, database.GenerateShoppingListTxParams{
+		FamilyID:   plan.FamilyID,
+		MealPlanID: plan.ID,
+		Create:     delta.Create,
+		Update:     delta.Update,
+		Delete:     delta.Delete,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	list := DBShoppingListToShoppingList(result.List)
+	list.Items = DBShoppingListItemsToShoppingListItems(result.Items)
+	list.Needs = needs
+	list.Staples = DBStaplesToStaples(staples)
+	list.Changes = delta.Changes
+	list.Unmatched = unmatched
+	ctx.JSON(http.StatusCreated, list)
+}
+
+func (s *Server) getShoppingLists(ctx *gin.Context) {
+	var uri FamilyMealPlansParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	familyID := uuid.MustParse(uri.ID)
+	_, ok := s.authFamilyMember(ctx, familyID)
+	if !ok {
+		return
+	}
+
+	lists, err := s.store.GetShoppingListsByFamilyID(ctx, familyID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, DBShoppingListsToShoppingLists(lists))
+}
+
+// getShoppingListByID groups the items by aisle, in the order of the store layout when one is picked
+func (s *Server) getShoppingListByID(ctx *gin.Context) {
+	var uri ShoppingListParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	var query ShoppingListQuery
+	err = ctx.ShouldBindQuery(&query)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	dbList, ok := s.familyShoppingList(ctx, user, uuid.MustParse(uri.ID))
+	if !ok {
+		return
+	}
+
+	order, ok := s.storeLayoutOrder(ctx, user, query.StoreLayoutID)
+	if !ok {
+		return
+	}
+
+	items, err := s.store.GetShoppingListItems(ctx, dbList.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	list := DBShoppingListToShoppingList(dbList)
+	list.Aisles = ShoppingListAisles(DBShoppingListItemsToShoppingListItems(items), order)
+	ctx.JSON(http.StatusOK, list)
+}
+
+func (s *Server) deleteShoppingList(ctx *gin.Context) {
+	var uri ShoppingListParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	list, ok := s.familyShoppingList(ctx, user, uuid.MustParse(uri.ID))
+	if !ok {
+		return
+	}
+
+	err = s.store.DeleteShoppingList(ctx, list.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, respondWithMessage(fmt.Sprintf("deleted shopping list with id %s", uri.ID)))
+}
+
+func (s *Server) createShoppingListItem(ctx *gin.Context) {
+	var uri ShoppingListParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	var request CreateShoppingListItemParams
+	err = ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	list, ok := s.familyShoppingList(ctx, user, uuid.MustParse(uri.ID))
+	if !ok {
+		return
+	}
+
+	category := request.Category
+	if request.IngredientID != 0 {
+		ingredient, err := s.store.GetIngredientByID(ctx, request.IngredientID)
+		if err != nil {
+			if err == pgx.ErrNoRows {
+				ctx.JSON(http.StatusNotFound, respondWithErorr(err))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+			return
+		}
+		category = types.GroceryCategory(ingredient.Category)
+	}
+
+	item, err := s.store.CreateShoppingListItem(ctx, database.CreateShoppingListItemParams{
+		ShoppingListID: list.ID,
+		IngredientID:   pgtype.Int4{Int32: request.IngredientID, Valid: request.IngredientID != 0},
+		Name:           request.Name,
+		Quantity:       request.Quantity,
+		Unit:           string(request.Unit),
+		Category:       ingredientCategory(category),
+		Packages:       []byte("[]"),
+		Sources:        []byte("[]"),
+		Manual:         true,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, DBShoppingListItemToShoppingListItem(item))
+}
+
+// updateShoppingListItem changes an item, a generated item changed by the family is edited and isn't
+// changed anymore when the list is generated again
+func (s *Server) updateShoppingListItem(ctx *gin.Context) {
+	var uri ShoppingListItemParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	var request UpdateShoppingListItemParams
+	err = ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	list, ok := s.familyShoppingList(ctx, user, uuid.MustParse(uri.ID))
+	if !ok {
+		return
+	}
+	item, ok := s.shoppingListItem(ctx, list, uuid.MustParse(uri.ItemID))
+	if !ok {
+		return
+	}
+
+	item, err = s.store.UpdateShoppingListItem(ctx, database.UpdateShoppingListItemParams{
+		ID:       item.ID,
+		Name:     request.Name,
+		Quantity: request.Quantity,
+		Unit:     string(request.Unit),
+		Category: shoppingListItemCategory(item, request.Category),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, DBShoppingListItemToShoppingListItem(item))
+}
+
+// shoppingListItemCategory keeps the aisle of an item unless another one is given
+func shoppingListItemCategory(item database.ShoppingListItem, category types.GroceryCategory) string {
+	if category == "" {
+		return item.Category
+	}
+	return string(category)
+}
+
+// deleteShoppingListItem deletes an item added by hand, generated items are dismissed instead,
+// so generating the list again doesn't add them back
+func (s *Server) deleteShoppingListItem(ctx *gin.Context) {
+	var uri ShoppingListItemParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	list, ok := s.familyShoppingList(ctx, user, uuid.MustParse(uri.ID))
+	if !ok {
+		return
+	}
+	item, ok := s.shoppingListItem(ctx, list, uuid.MustParse(uri.ItemID))
+	if !ok {
+		return
+	}
+
+	if item.Manual {
+		err = s.store.DeleteShoppingListItem(ctx, item.ID)
+	} else {
+		err = s.store.DismissShoppingListItem(ctx, item.ID)
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, respondWithMessage(fmt.Sprintf("deleted item with id %s", uri.ItemID)))
+}
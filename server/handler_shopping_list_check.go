@@ -0,0 +1,145 @@
+package server
+
+import (
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	database "github.com/andreiz53/cookinator/database/handlers"
+)
+
+// shoppingListKeepAlive is how often an idle stream is pinged, so proxies don't close it
+const shoppingListKeepAlive = 30 * time.Second
+
+// CheckShoppingListItemParams checks or unchecks an item. ChangedAt is when it happened on the device,
+// now when it is left out, so the latest change wins even when it reaches the server first.
+type CheckShoppingListItemParams struct {
+	Checked   *bool  `json:"checked" binding:"required"`
+	ChangedAt string `json:"changed_at" binding:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+}
+
+// checkShoppingListItem checks or unchecks an item. A change older than the last one is discarded
+// and the item is returned as the newer change left it.
+func (s *Server) checkShoppingListItem(ctx *gin.Context) {
+	var uri ShoppingListItemParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	var request CheckShoppingListItemParams
+	err = ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+	changedAt := time.Now()
+	if request.ChangedAt != "" {
+		at, err := time.Parse(time.RFC3339, request.ChangedAt)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+			return
+		}
+		// a clock running ahead can't win over the changes after it
+		if at.Before(changedAt) {
+			changedAt = at
+		}
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	list, ok := s.familyShoppingList(ctx, user, uuid.MustParse(uri.ID))
+	if !ok {
+		return
+	}
+	item, ok := s.shoppingListItem(ctx, list, uuid.MustParse(uri.ItemID))
+	if !ok {
+		return
+	}
+
+	checked, err := s.store.CheckShoppingListItem(ctx, database.CheckShoppingListItemParams{
+		ID:        item.ID,
+		Checked:   *request.Checked,
+		CheckedAt: pgtype.Timestamp{Time: changedAt.UTC().Truncate(time.Microsecond), Valid: true},
+		CheckedBy: pgtype.UUID{Bytes: user.ID, Valid: true},
+	})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			ctx.JSON(http.StatusOK, DBShoppingListItemToShoppingListItem(item))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, DBShoppingListItemToShoppingListItem(checked))
+}
+
+// streamShoppingList sends the items of a list as an items event, then every change of an item as an insert,
+// update or delete event, until the client goes away. The items are sent again when changes may have been missed.
+func (s *Server) streamShoppingList(ctx *gin.Context) {
+	var uri ShoppingListParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	list, ok := s.familyShoppingList(ctx, user, uuid.MustParse(uri.ID))
+	if !ok {
+		return
+	}
+
+	// subscribing before loading the items, a change in between is sent after them rather than lost
+	changes := s.shoppingLists.subscribe(list.ID)
+	defer s.shoppingLists.unsubscribe(list.ID, changes)
+
+	items, err := s.store.GetShoppingListItems(ctx, list.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.Header("Cache-Control", "no-cache")
+	ctx.Header("Connection", "keep-alive")
+	ctx.SSEvent("items", DBShoppingListItemsToShoppingListItems(items))
+	ctx.Writer.Flush()
+
+	keepAlive := time.NewTicker(shoppingListKeepAlive)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case <-ctx.Request.Context().Done():
+			return
+		case change := <-changes:
+			if change.Op == shoppingListResync {
+				items, err := s.store.GetShoppingListItems(ctx, list.ID)
+				if err != nil {
+					// ending the stream makes the client connect again and load the items then
+					log.Println("could not resync shopping list:", err)
+					return
+				}
+				ctx.SSEvent("items", DBShoppingListItemsToShoppingListItems(items))
+				break
+			}
+			ctx.SSEvent(change.Op, DBShoppingListItemToShoppingListItem(change.Item))
+		case <-keepAlive.C:
+			ctx.SSEvent("ping", "")
+		}
+		ctx.Writer.Flush()
+	}
+}
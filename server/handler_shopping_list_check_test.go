@@ -0,0 +1,326 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	database "github.com/andreiz53/cookinator/database/handlers"
+	databaseMock "github.com/andreiz53/cookinator/database/mocks"
+)
+
+// checkedItem is the checked state of an item in a response
+type checkedItem struct {
+	Checked   bool      `json:"checked"`
+	CheckedAt string    `json:"checked_at"`
+	CheckedBy uuid.UUID `json:"checked_by"`
+}
+
+func TestCheckShoppingListItem(t *testing.T) {
+	user := randomFamilyUser(t)
+	list := randomShoppingList(user.FamilyID)
+	item := randomShoppingListItem(list)
+	changedAt := time.Date(2026, time.October, 17, 10, 30, 0, 0, time.UTC)
+	checked := true
+
+	// the other shopper unchecked it a minute later, while this change was on its way
+	newer := item
+	newer.CheckedAt = pgtype.Timestamp{Time: changedAt.Add(time.Minute), Valid: true}
+
+	testCases := []struct {
+		name          string
+		item          database.ShoppingListItem
+		params        CheckShoppingListItemParams
+		stubs         func(store *databaseMock.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "OK",
+			item:   item,
+			params: CheckShoppingListItemParams{Checked: &checked, ChangedAt: changedAt.Format(time.RFC3339)},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				updated := item
+				updated.Checked = true
+				updated.CheckedAt = pgtype.Timestamp{Time: changedAt, Valid: true}
+				updated.CheckedBy = pgtype.UUID{Bytes: user.ID, Valid: true}
+				store.EXPECT().
+					CheckShoppingListItem(mock.Anything, database.CheckShoppingListItemParams{
+						ID:        item.ID,
+						Checked:   true,
+						CheckedAt: updated.CheckedAt,
+						CheckedBy: updated.CheckedBy,
+					}).
+					Times(1).Return(updated, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				response, err := decodeJSON[checkedItem](recorder.Body)
+				require.NoError(t, err)
+				require.True(t, response.Checked)
+				require.Equal(t, "2026-10-17T10:30:00Z", response.CheckedAt)
+				require.Equal(t, user.ID, response.CheckedBy)
+			},
+		},
+		{
+			name:   "OlderChange",
+			item:   newer,
+			params: CheckShoppingListItemParams{Checked: &checked, ChangedAt: changedAt.Format(time.RFC3339)},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					CheckShoppingListItem(mock.Anything, mock.Anything).
+					Times(1).Return(database.ShoppingListItem{}, pgx.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				response, err := decodeJSON[checkedItem](recorder.Body)
+				require.NoError(t, err)
+				require.False(t, response.Checked)
+				require.Equal(t, "2026-10-17T10:31:00Z", response.CheckedAt)
+			},
+		},
+		{
+			name:   "MissingChecked",
+			item:   item,
+			params: CheckShoppingListItemParams{},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			server := newTestServer(t, store)
+
+			if tc.params.Checked != nil {
+				store.EXPECT().
+					GetShoppingListByID(mock.Anything, list.ID).
+					Times(1).Return(list, nil)
+				store.EXPECT().
+					GetShoppingListItemByID(mock.Anything, tc.item.ID).
+					Times(1).Return(tc.item, nil)
+			}
+			tc.stubs(store)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/shopping-lists/%s/items/%s/check", list.ID.String(), tc.item.ID.String())
+			data, err := encodeJSON(tc.params)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(data))
+			require.NoError(t, err)
+			setAuth(t, request, server.tokenMaker, authHeaderTypeBearer, user.Email, time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestStreamShoppingList(t *testing.T) {
+	user := randomFamilyUser(t)
+	list := randomShoppingList(user.FamilyID)
+	item := randomShoppingListItem(list)
+	changed := item
+	changed.Checked = true
+
+	store := new(databaseMock.MockStore)
+	server := newTestServer(t, store)
+	store.EXPECT().
+		GetUserByEmail(mock.Anything, user.Email).
+		Times(1).Return(user, nil)
+	store.EXPECT().
+		GetShoppingListByID(mock.Anything, list.ID).
+		Times(1).Return(list, nil)
+	store.EXPECT().
+		GetShoppingListItems(mock.Anything, list.ID).
+		Times(2).Return([]database.ShoppingListItem{item}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	recorder := httptest.NewRecorder()
+	url := fmt.Sprintf("/shopping-lists/%s/events", list.ID.String())
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	require.NoError(t, err)
+	setAuth(t, request, server.tokenMaker, authHeaderTypeBearer, user.Email, time.Minute)
+
+	done := make(chan bool)
+	go func() {
+		server.router.ServeHTTP(recorder, request)
+		close(done)
+	}()
+
+	subscribed := func() (chan ShoppingListChange, bool) {
+		server.shoppingLists.mu.Lock()
+		defer server.shoppingLists.mu.Unlock()
+		for changes := range server.shoppingLists.subscribers[list.ID] {
+			return changes, true
+		}
+		return nil, false
+	}
+	require.Eventually(t, func() bool {
+		_, ok := subscribed()
+		return ok
+	}, time.Second, time.Millisecond)
+
+	// a change of another list isn't sent
+	server.shoppingLists.publish(ShoppingListChange{Op: "update", ShoppingListID: randomShoppingList(user.FamilyID).ID, Item: item})
+	server.shoppingLists.publish(ShoppingListChange{Op: "update", ShoppingListID: list.ID, Item: changed})
+	// after the hub listens again the items are sent again
+	server.shoppingLists.resync()
+	require.Eventually(t, func() bool {
+		changes, _ := subscribed()
+		return len(changes) == 0
+	}, time.Second, time.Millisecond)
+
+	cancel()
+	<-done
+	_, ok := subscribed()
+	require.False(t, ok)
+
+	body := recorder.Body.String()
+	require.True(t, strings.HasPrefix(recorder.Header().Get("Content-Type"), "text/event-stream"))
+	require.True(t, strings.HasPrefix(body, "event:items\n"))
+	require.Contains(t, body, "event:update\n")
+	require.Equal(t, 2, strings.Count(body, "event:items\n"))
+	require.Equal(t, 3, strings.Count(body, item.ID.String()))
+	require.Contains(t, body, `"checked":true`)
+}
+
+func TestShoppingListHubChange(t *testing.T) {
+	list := randomShoppingList(uuid.New())
+	item := randomShoppingListItem(list)
+
+	payload := fmt.Sprintf(`{"op":"update","shopping_list_id":"%s","item_id":"%s"}`, list.ID, item.ID)
+	notification, err := decodeShoppingListNotification(payload)
+	require.NoError(t, err)
+	require.Equal(t, shoppingListNotification{Op: "update", ShoppingListID: list.ID, ItemID: item.ID}, notification)
+
+	_, err = decodeShoppingListNotification(`{"op":`)
+	require.Error(t, err)
+
+	testCases := []struct {
+		name         string
+		notification shoppingListNotification
+		subscribe    bool
+		stubs        func(store *databaseMock.MockStore)
+		check        func(t *testing.T, change ShoppingListChange, ok bool, err error)
+	}{
+		{
+			name:         "Update",
+			notification: notification,
+			subscribe:    true,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetShoppingListItemByID(mock.Anything, item.ID).
+					Times(1).Return(item, nil)
+			},
+			check: func(t *testing.T, change ShoppingListChange, ok bool, err error) {
+				require.NoError(t, err)
+				require.True(t, ok)
+				require.Equal(t, "update", change.Op)
+				require.Equal(t, item, change.Item)
+			},
+		},
+		{
+			name:         "Delete",
+			notification: shoppingListNotification{Op: "delete", ShoppingListID: list.ID, ItemID: item.ID},
+			subscribe:    true,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetShoppingListItemByID(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			check: func(t *testing.T, change ShoppingListChange, ok bool, err error) {
+				require.NoError(t, err)
+				require.True(t, ok)
+				require.Equal(t, item.ID, change.Item.ID)
+				require.Equal(t, list.ID, change.Item.ShoppingListID)
+			},
+		},
+		{
+			name:         "Dismissed",
+			notification: notification,
+			subscribe:    true,
+			stubs: func(store *databaseMock.MockStore) {
+				dismissed := item
+				dismissed.Dismissed = true
+				store.EXPECT().
+					GetShoppingListItemByID(mock.Anything, item.ID).
+					Times(1).Return(dismissed, nil)
+			},
+			check: func(t *testing.T, change ShoppingListChange, ok bool, err error) {
+				require.NoError(t, err)
+				require.True(t, ok)
+				require.Equal(t, "delete", change.Op)
+				require.Equal(t, item.ID, change.Item.ID)
+			},
+		},
+		{
+			name:         "DeletedSince",
+			notification: notification,
+			subscribe:    true,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetShoppingListItemByID(mock.Anything, item.ID).
+					Times(1).Return(database.ShoppingListItem{}, pgx.ErrNoRows)
+			},
+			check: func(t *testing.T, change ShoppingListChange, ok bool, err error) {
+				require.NoError(t, err)
+				require.False(t, ok)
+			},
+		},
+		{
+			name:         "NotStreamed",
+			notification: notification,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetShoppingListItemByID(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			check: func(t *testing.T, change ShoppingListChange, ok bool, err error) {
+				require.NoError(t, err)
+				require.False(t, ok)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			tc.stubs(store)
+
+			hub := newShoppingListHub(store)
+			if tc.subscribe {
+				hub.subscribe(list.ID)
+			}
+			change, ok, err := hub.change(context.Background(), tc.notification)
+			tc.check(t, change, ok, err)
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/andreiz53/cookinator/checklist"
+	"github.com/andreiz53/cookinator/types"
+)
+
+// ExportShoppingListQuery picks the format of an export, text by default. Items are grouped in the aisle order
+// of the store layout when one is picked, and shown in the user's unit system unless Units asks for another.
+type ExportShoppingListQuery struct {
+	Format        checklist.Format `form:"format" binding:"omitempty,oneof=text markdown csv html"`
+	StoreLayoutID string           `form:"store_layout_id" binding:"omitempty,uuid4_rfc4122"`
+	Units         types.UnitSystem `form:"units" binding:"omitempty,oneof=metric imperial"`
+}
+
+// ShoppingListToChecklist turns the aisles of a shopping list into a checklist with a section per aisle,
+// quantities are converted to the unit system
+func ShoppingListToChecklist(title string, aisles []ShoppingListAisle, system types.UnitSystem) checklist.List {
+	list := checklist.List{Title: title, Sections: []checklist.Section{}}
+	for _, aisle := range aisles {
+		name := string(aisle.Category)
+		section := checklist.Section{Name: strings.ToUpper(name[:1]) + name[1:]}
+		for _, item := range aisle.Items {
+			quantity, unit := types.DisplayQuantity(item.Quantity, item.Unit, system)
+			section.Items = append(section.Items, checklist.Item{
+				Name:     item.Name,
+				Quantity: quantity,
+				Unit:     unit,
+				Checked:  item.Checked,
+			})
+		}
+		list.Sections = append(list.Sections, section)
+	}
+	return list
+}
+
+// exportShoppingList writes a shopping list to paste into a message, import into a spreadsheet or print
+func (s *Server) exportShoppingList(ctx *gin.Context) {
+	var uri ShoppingListParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	var query ExportShoppingListQuery
+	err = ctx.ShouldBindQuery(&query)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+	if query.Format == "" {
+		query.Format = checklist.FormatText
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+	if query.Units == "" {
+		query.Units = types.UnitSystem(user.UnitSystem)
+	}
+
+	list, ok := s.familyShoppingList(ctx, user, uuid.MustParse(uri.ID))
+	if !ok {
+		return
+	}
+	order, ok := s.storeLayoutOrder(ctx, user, query.StoreLayoutID)
+	if !ok {
+		return
+	}
+
+	plan, err := s.store.GetMealPlanByID(ctx, list.MealPlanID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+	items, err := s.store.GetShoppingListItems(ctx, list.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	title := fmt.Sprintf("Shopping list for the week of %s", plan.WeekStart.Time.Format("January 2, 2006"))
+	aisles := ShoppingListAisles(DBShoppingListItemsToShoppingListItems(items), order)
+
+	var body bytes.Buffer
+	err = checklist.Write(&body, query.Format, ShoppingListToChecklist(title, aisles, query.Units))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	contentType, _ := checklist.ContentType(query.Format)
+	if query.Format == checklist.FormatCSV {
+		ctx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="shopping-list-%s.csv"`, plan.WeekStart.Time.Format("2006-01-02")))
+	}
+	ctx.Data(http.StatusOK, contentType, body.Bytes())
+}
@@ -0,0 +1,172 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/andreiz53/cookinator/checklist"
+	database "github.com/andreiz53/cookinator/database/handlers"
+	databaseMock "github.com/andreiz53/cookinator/database/mocks"
+	"github.com/andreiz53/cookinator/types"
+)
+
+func TestShoppingListToChecklist(t *testing.T) {
+	aisles := []ShoppingListAisle{
+		{Category: types.GroceryCategoryBakery, Items: []ShoppingListItem{
+			{Name: "flour", Quantity: 1000, Unit: types.MeasureUnitGrams, Checked: true},
+		}},
+		{Category: types.GroceryCategoryDairy, Items: []ShoppingListItem{
+			{Name: "milk", Quantity: 2, Unit: types.MeasureUnitCup},
+			{Name: "eggs", Quantity: 6, Unit: types.MeasureUnitPiece},
+		}},
+	}
+
+	list := ShoppingListToChecklist("Groceries", aisles, types.UnitSystemMetric)
+	require.Equal(t, checklist.List{
+		Title: "Groceries",
+		Sections: []checklist.Section{
+			{Name: "Bakery", Items: []checklist.Item{{Name: "flour", Quantity: 1000, Unit: "g", Checked: true}}},
+			{Name: "Dairy", Items: []checklist.Item{
+				{Name: "milk", Quantity: 480, Unit: "mL"},
+				{Name: "eggs", Quantity: 6, Unit: "pc"},
+			}},
+		},
+	}, list)
+
+	list = ShoppingListToChecklist("Groceries", aisles, types.UnitSystemImperial)
+	require.InDelta(t, 2.2, list.Sections[0].Items[0].Quantity, 0.01)
+	require.Equal(t, "lb", list.Sections[0].Items[0].Unit)
+	require.Equal(t, 2.0, list.Sections[1].Items[0].Quantity)
+	require.Equal(t, "cup", list.Sections[1].Items[0].Unit)
+}
+
+func TestExportShoppingList(t *testing.T) {
+	user := randomFamilyUser(t)
+	user.UnitSystem = types.UnitSystemImperial
+	plan := randomMealPlan(user.FamilyID)
+	list := randomShoppingList(user.FamilyID)
+	list.MealPlanID = plan.ID
+	item := randomShoppingListItem(list)
+	item.Name = "rice"
+	item.Quantity = 453.59237
+	item.Checked = true
+	otherList := randomShoppingList(uuid.New())
+
+	testCases := []struct {
+		name          string
+		query         string
+		list          database.ShoppingList
+		stubs         func(store *databaseMock.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "Text",
+			query: "",
+			list:  list,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetShoppingListByID(mock.Anything, list.ID).
+					Times(1).Return(list, nil)
+				store.EXPECT().
+					GetMealPlanByID(mock.Anything, plan.ID).
+					Times(1).Return(plan, nil)
+				store.EXPECT().
+					GetShoppingListItems(mock.Anything, list.ID).
+					Times(1).Return([]database.ShoppingListItem{item}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, "text/plain; charset=utf-8", recorder.Header().Get("Content-Type"))
+
+				body := recorder.Body.String()
+				require.True(t, strings.HasPrefix(body, "Shopping list for the week of "+plan.WeekStart.Time.Format("January 2, 2006")))
+				require.Contains(t, body, "PRODUCE\n☑ 1 lb rice\n")
+			},
+		},
+		{
+			name:  "MetricCSV",
+			query: "?format=csv&units=metric",
+			list:  list,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetShoppingListByID(mock.Anything, list.ID).
+					Times(1).Return(list, nil)
+				store.EXPECT().
+					GetMealPlanByID(mock.Anything, plan.ID).
+					Times(1).Return(plan, nil)
+				store.EXPECT().
+					GetShoppingListItems(mock.Anything, list.ID).
+					Times(1).Return([]database.ShoppingListItem{item}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, "text/csv; charset=utf-8", recorder.Header().Get("Content-Type"))
+				require.Contains(t, recorder.Header().Get("Content-Disposition"), "attachment")
+				require.Contains(t, recorder.Body.String(), "Produce,TRUE,453.59,g,rice\n")
+			},
+		},
+		{
+			name:  "UnknownFormat",
+			query: "?format=pdf",
+			list:  list,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "OtherFamily",
+			query: "?format=html",
+			list:  otherList,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetShoppingListByID(mock.Anything, otherList.ID).
+					Times(1).Return(otherList, nil)
+				store.EXPECT().
+					GetShoppingListItems(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			server := newTestServer(t, store)
+			tc.stubs(store)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/shopping-lists/%s/export%s", tc.list.ID.String(), tc.query)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+			setAuth(t, request, server.tokenMaker, authHeaderTypeBearer, user.Email, time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
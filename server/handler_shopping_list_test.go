@@ -0,0 +1,663 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	database "github.com/andreiz53/cookinator/database/handlers"
+	databaseMock "github.com/andreiz53/cookinator/database/mocks"
+	"github.com/andreiz53/cookinator/types"
+	"github.com/andreiz53/cookinator/util"
+)
+
+func randomShoppingList(familyID uuid.UUID) database.ShoppingList {
+	return database.ShoppingList{
+		ID:         uuid.New(),
+		FamilyID:   familyID,
+		MealPlanID: uuid.New(),
+	}
+}
+
+func randomShoppingListItem(list database.ShoppingList) database.ShoppingListItem {
+	return database.ShoppingListItem{
+		ID:             uuid.New(),
+		ShoppingListID: list.ID,
+		IngredientID:   pgtype.Int4{Int32: int32(util.RandomInt(1, 1000)), Valid: true},
+		Name:           util.RandomName(),
+		Quantity:       float64(util.RandomInt(1, 500)),
+		Unit:           types.MeasureUnitGrams,
+		GeneratedUnit:  pgtype.Text{String: types.MeasureUnitGrams, Valid: true},
+		Category:       types.GroceryCategoryProduce,
+	}
+}
+
+func TestAggregateShoppingItems(t *testing.T) {
+	flour := database.Ingredient{ID: 1, Name: "flour"}
+	butter := database.Ingredient{ID: 2, Name: "butter"}
+	milk := database.Ingredient{ID: 3, Name: "milk"}
+	sugar := database.Ingredient{ID: 4, Name: "sugar"}
+	err := sugar.Density.Scan("0.85")
+	require.NoError(t, err)
+	ingredients := map[int32]database.Ingredient{flour.ID: flour, butter.ID: butter, milk.ID: milk, sugar.ID: sugar}
+
+	items := []types.RecipeItem{
+		{IngredientID: flour.ID, Quantity: 250, Unit: types.MeasureUnitGrams},
+		{IngredientID: butter.ID, Quantity: 100, Unit: types.MeasureUnitGrams},
+		{IngredientID: flour.ID, Quantity: 125.5, Unit: types.MeasureUnitGrams},
+		{IngredientID: flour.ID, Quantity: 2, Unit: types.MeasureUnitCup},
+		{IngredientID: milk.ID, Quantity: 1, Unit: types.MeasureUnitCup},
+		{IngredientID: milk.ID, Quantity: 2, Unit: types.MeasureUnitTablespoon},
+		{IngredientID: sugar.ID, Quantity: 100, Unit: types.MeasureUnitGrams},
+		{IngredientID: sugar.ID, Quantity: 1, Unit: types.MeasureUnitCup},
+		{IngredientID: butter.ID, Quantity: 1.5, Unit: types.MeasureUnitPiece},
+		{IngredientID: 0, Quantity: 1, Unit: types.MeasureUnitPiece},
+	}
+
+	list := AggregateShoppingItems(items, ingredients)
+	require.Equal(t, []ShoppingItem{
+		{IngredientID: butter.ID, Name: "butter", Quantity: 100, Unit: types.MeasureUnitGrams},
+		{IngredientID: butter.ID, Name: "butter", Quantity: 2, Unit: types.MeasureUnitPiece},
+		// flour has no density, so its cups can't be weighed
+		{IngredientID: flour.ID, Name: "flour", Quantity: 2, Unit: types.MeasureUnitCup},
+		{IngredientID: flour.ID, Name: "flour", Quantity: 375.5, Unit: types.MeasureUnitGrams},
+		{IngredientID: milk.ID, Name: "milk", Quantity: 270, Unit: types.MeasureUnitMillilitres},
+		{IngredientID: sugar.ID, Name: "sugar", Quantity: 304, Unit: types.MeasureUnitGrams},
+	}, list)
+}
+
+func TestShoppingListAisles(t *testing.T) {
+	milk := ShoppingListItem{Name: "milk", Category: types.GroceryCategoryDairy}
+	bread := ShoppingListItem{Name: "bread", Category: types.GroceryCategoryBakery}
+	apples := ShoppingListItem{Name: "apples", Category: types.GroceryCategoryProduce}
+	pears := ShoppingListItem{Name: "pears", Category: types.GroceryCategoryProduce}
+	items := []ShoppingListItem{apples, bread, milk, pears}
+
+	aisles := ShoppingListAisles(items, []types.GroceryCategory{types.GroceryCategoryDairy, types.GroceryCategoryProduce})
+	require.Equal(t, []ShoppingListAisle{
+		{Category: types.GroceryCategoryDairy, Items: []ShoppingListItem{milk}},
+		{Category: types.GroceryCategoryProduce, Items: []ShoppingListItem{apples, pears}},
+		{Category: types.GroceryCategoryBakery, Items: []ShoppingListItem{bread}},
+	}, aisles)
+
+	aisles = ShoppingListAisles(items, nil)
+	require.Len(t, aisles, 3)
+	require.Equal(t, types.GroceryCategory(types.GroceryCategoryProduce), aisles[0].Category)
+	require.Equal(t, types.GroceryCategory(types.GroceryCategoryBakery), aisles[1].Category)
+	require.Equal(t, types.GroceryCategory(types.GroceryCategoryDairy), aisles[2].Category)
+}
+
+func TestMealPlanRecipeItems(t *testing.T) {
+	plan := randomMealPlan(uuid.New())
+	recipe := randomRecipe(t, plan.FamilyID)
+	recipe.Servings = 4
+	raw, err := json.Marshal([]types.RecipeItem{{IngredientID: 1, Quantity: 200, Unit: types.MeasureUnitGrams}})
+	require.NoError(t, err)
+	recipe.Items = raw
+	recipes := map[uuid.UUID]database.Recipe{recipe.ID: recipe}
+
+	batch := randomMealPlanEntry(plan, recipe, 0, types.MealSlotDinner)
+	entries := []database.GetMealPlanEntriesRow{
+		{ID: uuid.New(), RecipeID: recipe.ID, Servings: 2},
+		{ID: batch.ID, RecipeID: recipe.ID, Servings: 4, BatchServings: pgtype.Int4{Int32: 8, Valid: true}},
+		{ID: uuid.New(), RecipeID: recipe.ID, Servings: 4, LeftoverOf: pgtype.UUID{Bytes: batch.ID, Valid: true}},
+	}
+
+	items, err := MealPlanRecipeItems(entries, recipes)
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	require.Equal(t, 100.0, items[0].Quantity)
+	require.Equal(t, 400.0, items[1].Quantity)
+}
+
+func TestDiffShoppingList(t *testing.T) {
+	list := randomShoppingList(uuid.New())
+	dinner := ShoppingSource{Kind: ShoppingSourceMeal, ID: uuid.New(), Name: "risotto", Day: "2026-10-22", Slot: "dinner", Servings: 4}
+	lunch := ShoppingSource{Kind: ShoppingSourceMeal, ID: uuid.New(), Name: "salad", Day: "2026-10-20", Slot: "lunch", Servings: 2}
+	encode := func(sources ...ShoppingSource) []byte {
+		raw, err := json.Marshal(sources)
+		require.NoError(t, err)
+		return raw
+	}
+	existingItem := func(ingredientID int32, name string, quantity float64, checked bool, sources ...ShoppingSource) database.ShoppingListItem {
+		return database.ShoppingListItem{
+			ID:             uuid.New(),
+			ShoppingListID: list.ID,
+			IngredientID:   pgtype.Int4{Int32: ingredientID, Valid: true},
+			Name:           name,
+			Quantity:       quantity,
+			Unit:           types.MeasureUnitGrams,
+			GeneratedUnit:  pgtype.Text{String: types.MeasureUnitGrams, Valid: true},
+			Packages:       []byte("[]"),
+			Checked:        checked,
+			Sources:        encode(sources...),
+		}
+	}
+	generatedItem := func(ingredientID int32, name string, quantity float64, sources ...ShoppingSource) database.CreateShoppingListItemParams {
+		return database.CreateShoppingListItemParams{
+			IngredientID:  pgtype.Int4{Int32: ingredientID, Valid: true},
+			Name:          name,
+			Quantity:      quantity,
+			Unit:          types.MeasureUnitGrams,
+			Packages:      []byte("[]"),
+			Sources:       encode(sources...),
+			GeneratedUnit: pgtype.Text{String: types.MeasureUnitGrams, Valid: true},
+		}
+	}
+
+	// the salad for lunch was swapped for a risotto for dinner
+	rice := existingItem(1, "rice", 300, false, dinner)
+	lettuce := existingItem(2, "lettuce", 200, false, lunch)
+	cheese := existingItem(3, "cheese", 100, true, lunch)
+	butter := existingItem(4, "butter", 50, true, dinner)
+	manual := randomShoppingListItem(list)
+	manual.Manual = true
+	existing := []database.ShoppingListItem{rice, lettuce, cheese, butter, manual}
+	generated := []database.CreateShoppingListItemParams{
+		generatedItem(1, "rice", 300, dinner),
+		generatedItem(4, "butter", 80, dinner),
+		generatedItem(5, "stock", 1000, dinner),
+	}
+
+	delta, err := DiffShoppingList(existing, generated)
+	require.NoError(t, err)
+	require.Equal(t, []database.CreateShoppingListItemParams{generated[2]}, delta.Create)
+	require.Empty(t, delta.Update)
+	require.Equal(t, []uuid.UUID{lettuce.ID}, delta.Delete)
+	require.Equal(t, []GeneratedItemChange{
+		{Change: GeneratedItemKept, IngredientID: 4, Name: "butter", Unit: types.MeasureUnitGrams, Quantity: 80, PreviousQuantity: 50, AddedSources: []ShoppingSource{}, RemovedSources: []ShoppingSource{}},
+		{Change: GeneratedItemKept, IngredientID: 3, Name: "cheese", Unit: types.MeasureUnitGrams, PreviousQuantity: 100, RemovedSources: []ShoppingSource{lunch}},
+		{Change: GeneratedItemRemoved, IngredientID: 2, Name: "lettuce", Unit: types.MeasureUnitGrams, PreviousQuantity: 200, RemovedSources: []ShoppingSource{lunch}},
+		{Change: GeneratedItemAdded, IngredientID: 5, Name: "stock", Unit: types.MeasureUnitGrams, Quantity: 1000, AddedSources: []ShoppingSource{dinner}},
+	}, delta.Changes)
+
+	// nothing changes when the plan didn't
+	delta, err = DiffShoppingList([]database.ShoppingListItem{rice, manual}, generated[:1])
+	require.NoError(t, err)
+	require.Empty(t, delta.Create)
+	require.Empty(t, delta.Update)
+	require.Empty(t, delta.Delete)
+	require.Empty(t, delta.Changes)
+
+	// the rice was edited by the family, the stock and the lettuce were dismissed
+	edited := existingItem(1, "rice", 500, false, dinner)
+	edited.Edited = true
+	stock := existingItem(5, "stock", 500, false, lunch)
+	stock.Dismissed = true
+	dismissed := lettuce
+	dismissed.Dismissed = true
+	delta, err = DiffShoppingList([]database.ShoppingListItem{edited, stock, dismissed}, generated)
+	require.NoError(t, err)
+	require.Equal(t, []database.CreateShoppingListItemParams{generated[1]}, delta.Create)
+	require.Empty(t, delta.Update)
+	require.Empty(t, delta.Delete)
+	require.Equal(t, []GeneratedItemChange{
+		{Change: GeneratedItemAdded, IngredientID: 4, Name: "butter", Unit: types.MeasureUnitGrams, Quantity: 80, AddedSources: []ShoppingSource{dinner}},
+		{Change: GeneratedItemKept, IngredientID: 1, Name: "rice", Unit: types.MeasureUnitGrams, Quantity: 300, PreviousQuantity: 500, AddedSources: []ShoppingSource{}, RemovedSources: []ShoppingSource{}},
+	}, delta.Changes)
+
+	// the family buys the rice by the cup, it still matches the rice generated in grams
+	edited.Unit = types.MeasureUnitCup
+	edited.Quantity = 2
+	delta, err = DiffShoppingList([]database.ShoppingListItem{edited}, generated[:1])
+	require.NoError(t, err)
+	require.Empty(t, delta.Create)
+	require.Empty(t, delta.Delete)
+	require.Equal(t, []GeneratedItemChange{
+		{Change: GeneratedItemKept, IngredientID: 1, Name: "rice", Unit: types.MeasureUnitCup, Quantity: 300, PreviousQuantity: 2, AddedSources: []ShoppingSource{}, RemovedSources: []ShoppingSource{}},
+	}, delta.Changes)
+}
+
+func TestGenerateShoppingList(t *testing.T) {
+	user := randomFamilyUser(t)
+	plan := randomMealPlan(user.FamilyID)
+	list := randomShoppingList(user.FamilyID)
+	list.MealPlanID = plan.ID
+
+	rice := database.Ingredient{ID: 7, Name: "rice", Category: types.GroceryCategoryPantry}
+	recipe := randomRecipe(t, user.FamilyID)
+	recipe.Servings = 2
+	// the stock was typed in without an ingredient, it can't be added up with the rest
+	stock := types.RecipeItem{ID: uuid.New(), Quantity: 250, Unit: types.MeasureUnitMillilitres}
+	raw, err := json.Marshal([]types.RecipeItem{{IngredientID: rice.ID, Quantity: 150, Unit: types.MeasureUnitGrams}, stock})
+	require.NoError(t, err)
+	recipe.Items = raw
+	entries := []database.GetMealPlanEntriesRow{
+		{ID: uuid.New(), RecipeID: recipe.ID, Day: plan.WeekStart, Slot: "dinner", Servings: 4},
+		{ID: uuid.New(), RecipeID: recipe.ID, Day: util.NewDate(plan.WeekStart.Time.AddDate(0, 0, 3)), Slot: "dinner", Servings: 2},
+	}
+	// 50 g of rice are bought every week, bread every other week but not this one
+	staples := []database.GetStaplesByFamilyIDRow{
+		{ID: uuid.New(), FamilyID: user.FamilyID, IngredientID: rice.ID, IngredientName: rice.Name, Quantity: 50, Unit: types.MeasureUnitGrams, EveryWeeks: 1, FirstWeek: util.NewDate(plan.WeekStart.Time.AddDate(0, 0, -14))},
+		{ID: uuid.New(), FamilyID: user.FamilyID, IngredientID: 99, IngredientName: "bread", Quantity: 1, Unit: types.MeasureUnitPiece, EveryWeeks: 2, FirstWeek: util.NewDate(plan.WeekStart.Time.AddDate(0, 0, -7))},
+	}
+	// rice is sold in 250 g bags
+	bag := database.IngredientPackage{ID: uuid.New(), IngredientID: rice.ID, Quantity: 250, Unit: types.MeasureUnitGrams}
+	counts := []PackageCount{{PackageID: bag.ID, Quantity: 250, Unit: types.MeasureUnitGrams, Count: 1}}
+	raw, err = json.Marshal(counts)
+	require.NoError(t, err)
+	sources := []ShoppingSource{
+		{Kind: ShoppingSourceMeal, ID: entries[0].ID, Name: recipe.Name, Day: entries[0].Day.Time.Format(util.DateLayout), Slot: "dinner", Servings: 4},
+		{Kind: ShoppingSourceMeal, ID: entries[1].ID, Name: recipe.Name, Day: entries[1].Day.Time.Format(util.DateLayout), Slot: "dinner", Servings: 2},
+		{Kind: ShoppingSourceStaple, ID: staples[0].ID, Name: rice.Name},
+	}
+	rawSources, err := json.Marshal(sources)
+	require.NoError(t, err)
+	generated := database.CreateShoppingListItemParams{
+		IngredientID:  pgtype.Int4{Int32: rice.ID, Valid: true},
+		Name:          rice.Name,
+		Quantity:      250,
+		Unit:          types.MeasureUnitGrams,
+		Category:      types.GroceryCategoryPantry,
+		Packages:      raw,
+		Sources:       rawSources,
+		GeneratedUnit: pgtype.Text{String: types.MeasureUnitGrams, Valid: true},
+	}
+	item := database.ShoppingListItem{
+		ID:             uuid.New(),
+		ShoppingListID: list.ID,
+		IngredientID:   generated.IngredientID,
+		Name:           rice.Name,
+		Quantity:       250,
+		Unit:           types.MeasureUnitGrams,
+		GeneratedUnit:  generated.GeneratedUnit,
+		Packages:       raw,
+		Sources:        rawSources,
+	}
+	// before Thursday's dinner was planned, a bag was bought for the first dinner and the staple
+	previous := item
+	previous.Sources, err = json.Marshal([]ShoppingSource{sources[0], sources[2]})
+	require.NoError(t, err)
+	manual := randomShoppingListItem(list)
+	manual.Manual = true
+	// 500 g are needed and the last 50 g are never cooked into
+	inventory := []database.GetInventoryByFamilyIDRow{
+		{ID: uuid.New(), FamilyID: user.FamilyID, IngredientID: rice.ID, IngredientName: rice.Name, Quantity: 300, Unit: types.MeasureUnitGrams, KeepAtLeast: 50},
+	}
+
+	testCases := []struct {
+		name          string
+		plan          database.MealPlan
+		stubs         func(store *databaseMock.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			plan: plan,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetMealPlanEntries(mock.Anything, plan.ID).
+					Times(1).Return(entries, nil)
+				store.EXPECT().
+					GetRecipesByFamilyID(mock.Anything, user.FamilyID).
+					Times(1).Return([]database.Recipe{recipe}, nil)
+				store.EXPECT().
+					GetIngredients(mock.Anything).
+					Times(1).Return([]database.Ingredient{rice}, nil)
+				store.EXPECT().
+					GetStaplesByFamilyID(mock.Anything, user.FamilyID).
+					Times(1).Return(staples, nil)
+				store.EXPECT().
+					GetInventoryByFamilyID(mock.Anything, user.FamilyID).
+					Times(1).Return(inventory, nil)
+				store.EXPECT().
+					GetIngredientPackages(mock.Anything).
+					Times(1).Return([]database.IngredientPackage{bag}, nil)
+				store.EXPECT().
+					GetShoppingListByMealPlanID(mock.Anything, plan.ID).
+					Times(1).Return(database.ShoppingList{}, pgx.ErrNoRows)
+				store.EXPECT().
+					GenerateShoppingListTx(mock.Anything, database.GenerateShoppingListTxParams{
+						FamilyID:   user.FamilyID,
+						MealPlanID: plan.ID,
+						Create:     []database.CreateShoppingListItemParams{generated},
+						Update:     []database.UpdateGeneratedShoppingListItemParams{},
+						Delete:     []uuid.UUID{},
+					}).
+					Times(1).Return(database.GenerateShoppingListTxResult{
+					List:  list,
+					Items: []database.ShoppingListItem{item},
+				}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				response, err := decodeJSON[ShoppingList](recorder.Body)
+				require.NoError(t, err)
+				require.Equal(t, list.ID, response.ID)
+				require.Len(t, response.Items, 1)
+				require.Equal(t, 250.0, response.Items[0].Quantity)
+				require.Zero(t, response.Items[0].Surplus)
+				require.False(t, response.Items[0].Manual)
+				require.Equal(t, []ShoppingNeed{{
+					IngredientID: rice.ID,
+					Name:         rice.Name,
+					Unit:         types.MeasureUnitGrams,
+					Required:     500,
+					OnHand:       300,
+					KeepAtLeast:  50,
+					ToBuy:        250,
+					Packages:     counts,
+					Purchased:    250,
+				}}, response.Needs)
+				require.Len(t, response.Staples, 1)
+				require.Equal(t, staples[0].ID, response.Staples[0].ID)
+				require.JSONEq(t, string(rawSources), string(response.Items[0].Sources))
+				require.Len(t, response.Changes, 1)
+				require.Equal(t, GeneratedItemAdded, response.Changes[0].Change)
+				require.Equal(t, sources, response.Changes[0].AddedSources)
+				require.Equal(t, []UnmatchedItem{
+					{ItemID: stock.ID, Quantity: 500, Unit: types.MeasureUnitMillilitres, Source: sources[0]},
+					{ItemID: stock.ID, Quantity: 250, Unit: types.MeasureUnitMillilitres, Source: sources[1]},
+				}, response.Unmatched)
+			},
+		},
+		{
+			name: "PlanChanged",
+			plan: plan,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetMealPlanEntries(mock.Anything, plan.ID).
+					Times(1).Return(entries, nil)
+				store.EXPECT().
+					GetRecipesByFamilyID(mock.Anything, user.FamilyID).
+					Times(1).Return([]database.Recipe{recipe}, nil)
+				store.EXPECT().
+					GetIngredients(mock.Anything).
+					Times(1).Return([]database.Ingredient{rice}, nil)
+				store.EXPECT().
+					GetStaplesByFamilyID(mock.Anything, user.FamilyID).
+					Times(1).Return(staples, nil)
+				store.EXPECT().
+					GetInventoryByFamilyID(mock.Anything, user.FamilyID).
+					Times(1).Return(inventory, nil)
+				store.EXPECT().
+					GetIngredientPackages(mock.Anything).
+					Times(1).Return([]database.IngredientPackage{bag}, nil)
+				store.EXPECT().
+					GetShoppingListByMealPlanID(mock.Anything, plan.ID).
+					Times(1).Return(list, nil)
+				store.EXPECT().
+					GetAllShoppingListItems(mock.Anything, list.ID).
+					Times(1).Return([]database.ShoppingListItem{previous, manual}, nil)
+				store.EXPECT().
+					GenerateShoppingListTx(mock.Anything, database.GenerateShoppingListTxParams{
+						FamilyID:   user.FamilyID,
+						MealPlanID: plan.ID,
+						Create:     []database.CreateShoppingListItemParams{},
+						Update: []database.UpdateGeneratedShoppingListItemParams{{
+							ID:       item.ID,
+							Quantity: 250,
+							Packages: raw,
+							Sources:  rawSources,
+						}},
+						Delete: []uuid.UUID{},
+					}).
+					Times(1).Return(database.GenerateShoppingListTxResult{
+					List:  list,
+					Items: []database.ShoppingListItem{item, manual},
+				}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				response, err := decodeJSON[ShoppingList](recorder.Body)
+				require.NoError(t, err)
+				require.Len(t, response.Items, 2)
+				require.Equal(t, []GeneratedItemChange{{
+					Change:           GeneratedItemUpdated,
+					IngredientID:     rice.ID,
+					Name:             rice.Name,
+					Unit:             types.MeasureUnitGrams,
+					Quantity:         250,
+					PreviousQuantity: 250,
+					AddedSources:     []ShoppingSource{sources[1]},
+				}}, response.Changes)
+			},
+		},
+		{
+			name: "OtherFamily",
+			plan: randomMealPlan(uuid.New()),
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GenerateShoppingListTx(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			server := newTestServer(t, store)
+
+			store.EXPECT().
+				GetUserByEmail(mock.Anything, user.Email).
+				Times(1).Return(user, nil)
+			store.EXPECT().
+				GetMealPlanByID(mock.Anything, tc.plan.ID).
+				Times(1).Return(tc.plan, nil)
+			tc.stubs(store)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/meal-plans/%s/shopping-list", tc.plan.ID.String())
+			request, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+			setAuth(t, request, server.tokenMaker, authHeaderTypeBearer, user.Email, time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestDeleteShoppingListItem(t *testing.T) {
+	user := randomFamilyUser(t)
+	list := randomShoppingList(user.FamilyID)
+	manual := randomShoppingListItem(list)
+	manual.Manual = true
+	generated := randomShoppingListItem(list)
+	dismissed := randomShoppingListItem(list)
+	dismissed.Dismissed = true
+
+	testCases := []struct {
+		name          string
+		item          database.ShoppingListItem
+		stubs         func(store *databaseMock.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Manual",
+			item: manual,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					DeleteShoppingListItem(mock.Anything, manual.ID).
+					Times(1).Return(nil)
+				store.EXPECT().
+					DismissShoppingListItem(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "Generated",
+			item: generated,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					DeleteShoppingListItem(mock.Anything, mock.Anything).
+					Times(0)
+				store.EXPECT().
+					DismissShoppingListItem(mock.Anything, generated.ID).
+					Times(1).Return(nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "Dismissed",
+			item: dismissed,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					DeleteShoppingListItem(mock.Anything, mock.Anything).
+					Times(0)
+				store.EXPECT().
+					DismissShoppingListItem(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			server := newTestServer(t, store)
+
+			store.EXPECT().
+				GetUserByEmail(mock.Anything, user.Email).
+				Times(1).Return(user, nil)
+			store.EXPECT().
+				GetShoppingListByID(mock.Anything, list.ID).
+				Times(1).Return(list, nil)
+			store.EXPECT().
+				GetShoppingListItemByID(mock.Anything, tc.item.ID).
+				Times(1).Return(tc.item, nil)
+			tc.stubs(store)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/shopping-lists/%s/items/%s", list.ID.String(), tc.item.ID.String())
+			request, err := http.NewRequest(http.MethodDelete, url, nil)
+			require.NoError(t, err)
+			setAuth(t, request, server.tokenMaker, authHeaderTypeBearer, user.Email, time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestUpdateShoppingListItem(t *testing.T) {
+	user := randomFamilyUser(t)
+	list := randomShoppingList(user.FamilyID)
+	item := randomShoppingListItem(list)
+	otherItem := randomShoppingListItem(randomShoppingList(user.FamilyID))
+	params := UpdateShoppingListItemParams{Name: item.Name, Quantity: 2, Unit: types.MeasureUnitPiece}
+
+	testCases := []struct {
+		name          string
+		item          database.ShoppingListItem
+		params        UpdateShoppingListItemParams
+		stubs         func(store *databaseMock.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "OK",
+			item:   item,
+			params: params,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetShoppingListByID(mock.Anything, list.ID).
+					Times(1).Return(list, nil)
+				store.EXPECT().
+					GetShoppingListItemByID(mock.Anything, item.ID).
+					Times(1).Return(item, nil)
+				updated := item
+				updated.Quantity = params.Quantity
+				updated.Unit = string(params.Unit)
+				store.EXPECT().
+					UpdateShoppingListItem(mock.Anything, database.UpdateShoppingListItemParams{
+						ID:       item.ID,
+						Name:     params.Name,
+						Quantity: params.Quantity,
+						Unit:     string(params.Unit),
+						Category: item.Category,
+					}).
+					Times(1).Return(updated, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				response, err := decodeJSON[ShoppingListItem](recorder.Body)
+				require.NoError(t, err)
+				require.Equal(t, 2.0, response.Quantity)
+				require.Equal(t, types.MeasureUnit(types.MeasureUnitPiece), response.Unit)
+			},
+		},
+		{
+			name:   "ItemOnOtherList",
+			item:   otherItem,
+			params: params,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					GetShoppingListByID(mock.Anything, list.ID).
+					Times(1).Return(list, nil)
+				store.EXPECT().
+					GetShoppingListItemByID(mock.Anything, otherItem.ID).
+					Times(1).Return(otherItem, nil)
+				store.EXPECT().
+					UpdateShoppingListItem(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:   "InvalidUnit",
+			item:   item,
+			params: UpdateShoppingListItemParams{Name: item.Name, Quantity: 2, Unit: "bag"},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			server := newTestServer(t, store)
+			tc.stubs(store)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/shopping-lists/%s/items/%s", list.ID.String(), tc.item.ID.String())
+			data, err := encodeJSON(tc.params)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(data))
+			require.NoError(t, err)
+			setAuth(t, request, server.tokenMaker, authHeaderTypeBearer, user.Email, time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,301 @@
+package server
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	database "github.com/andreiz53/cookinator/database/handlers"
+	"github.com/andreiz53/cookinator/types"
+	"github.com/andreiz53/cookinator/util"
+)
+
+// Staple is an ingredient the family buys every EveryWeeks weeks whatever it cooks, FirstWeek is the
+// Monday of the first week it is bought
+type Staple struct {
+	ID             uuid.UUID         `json:"id"`
+	CreatedAt      pgtype.Timestamp  `json:"created_at"`
+	UpdatedAt      pgtype.Timestamp  `json:"updated_at"`
+	FamilyID       uuid.UUID         `json:"family_id"`
+	IngredientID   int32             `json:"ingredient_id"`
+	IngredientName string            `json:"ingredient_name,omitempty"`
+	Quantity       float64           `json:"quantity"`
+	Unit           types.MeasureUnit `json:"unit"`
+	EveryWeeks     int32             `json:"every_weeks"`
+	FirstWeek      pgtype.Date       `json:"first_week"`
+}
+
+// CreateStapleParams adds a staple, FirstWeek is any day of the first week and defaults to the current one
+type CreateStapleParams struct {
+	IngredientID int32             `json:"ingredient_id" binding:"required,min=1"`
+	Quantity     float64           `json:"quantity" binding:"required,gt=0"`
+	Unit         types.MeasureUnit `json:"unit" binding:"required,oneof=g mL tsp tbsp pc cup"`
+	EveryWeeks   int32             `json:"every_weeks" binding:"required,min=1,max=52"`
+	FirstWeek    string            `json:"first_week" binding:"omitempty,datetime=2006-01-02"`
+}
+
+type UpdateStapleParams struct {
+	Quantity   float64           `json:"quantity" binding:"required,gt=0"`
+	Unit       types.MeasureUnit `json:"unit" binding:"required,oneof=g mL tsp tbsp pc cup"`
+	EveryWeeks int32             `json:"every_weeks" binding:"required,min=1,max=52"`
+	FirstWeek  string            `json:"first_week" binding:"omitempty,datetime=2006-01-02"`
+}
+
+type StapleParams struct {
+	ID string `uri:"id" binding:"required,uuid4_rfc4122"`
+}
+
+func DBStapleToStaple(arg database.Staple) Staple {
+	return Staple{
+		ID:           arg.ID,
+		CreatedAt:    arg.CreatedAt,
+		UpdatedAt:    arg.UpdatedAt,
+		FamilyID:     arg.FamilyID,
+		IngredientID: arg.IngredientID,
+		Quantity:     arg.Quantity,
+		Unit:         types.MeasureUnit(arg.Unit),
+		EveryWeeks:   arg.EveryWeeks,
+		FirstWeek:    arg.FirstWeek,
+	}
+}
+
+func DBStaplesToStaples(arg []database.GetStaplesByFamilyIDRow) []Staple {
+	staples := []Staple{}
+	for _, row := range arg {
+		staple := DBStapleToStaple(database.Staple{
+			ID:           row.ID,
+			CreatedAt:    row.CreatedAt,
+			UpdatedAt:    row.UpdatedAt,
+			FamilyID:     row.FamilyID,
+			IngredientID: row.IngredientID,
+			Quantity:     row.Quantity,
+			Unit:         row.Unit,
+			EveryWeeks:   row.EveryWeeks,
+			FirstWeek:    row.FirstWeek,
+		})
+		staple.IngredientName = row.IngredientName
+		staples = append(staples, staple)
+	}
+	return staples
+}
+
+// stapleFirstWeek is the Monday of the week of the given day, or of the current week when there is none
+func stapleFirstWeek(day string) (pgtype.Date, error) {
+	if day == "" {
+		return util.WeekStart(time.Now()), nil
+	}
+	date, err := util.ParseDate(day)
+	if err != nil {
+		return date, err
+	}
+	return util.WeekStart(date.Time), nil
+}
+
+// StapleDue reports whether a staple is bought in the week starting on weekStart
+func StapleDue(staple database.GetStaplesByFamilyIDRow, weekStart pgtype.Date) bool {
+	if weekStart.Time.Before(staple.FirstWeek.Time) {
+		return false
+	}
+	weeks := int(math.Round(weekStart.Time.Sub(staple.FirstWeek.Time).Hours() / 24 / 7))
+	return weeks%int(max(staple.EveryWeeks, 1)) == 0
+}
+
+// DueStaples are the staples bought in the week starting on weekStart
+func DueStaples(staples []database.GetStaplesByFamilyIDRow, weekStart pgtype.Date) []database.GetStaplesByFamilyIDRow {
+	due := []database.GetStaplesByFamilyIDRow{}
+	for _, staple := range staples {
+		if StapleDue(staple, weekStart) {
+			due = append(due, staple)
+		}
+	}
+	return due
+}
+
+// StapleRecipeItems turns staples into items, so they add up with what the meals need of the same ingredient
+func StapleRecipeItems(staples []database.GetStaplesByFamilyIDRow) []types.RecipeItem {
+	items := []types.RecipeItem{}
+	for _, staple := range staples {
+		items = append(items, types.RecipeItem{
+			IngredientID: staple.IngredientID,
+			Quantity:     staple.Quantity,
+			Unit:         types.MeasureUnit(staple.Unit),
+		})
+	}
+	return items
+}
+
+// familyStaple loads a staple and makes sure it belongs to the user's family.
+// It writes the error response itself and returns false on failure.
+func (s *Server) familyStaple(ctx *gin.Context, user database.User, id uuid.UUID) (database.Staple, bool) {
+	staple, err := s.store.GetStapleByID(ctx, id)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, respondWithErorr(err))
+			return staple, false
+		}
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return staple, false
+	}
+	if staple.FamilyID != user.FamilyID {
+		ctx.JSON(http.StatusForbidden, respondWithErorr(errForbidden))
+		return staple, false
+	}
+	return staple, true
+}
+
+func (s *Server) getStaples(ctx *gin.Context) {
+	var uri FamilyMealPlansParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	familyID := uuid.MustParse(uri.ID)
+	_, ok := s.authFamilyMember(ctx, familyID)
+	if !ok {
+		return
+	}
+
+	staples, err := s.store.GetStaplesByFamilyID(ctx, familyID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, DBStaplesToStaples(staples))
+}
+
+// createStaple adds a staple, each ingredient is a staple once and updated after
+func (s *Server) createStaple(ctx *gin.Context) {
+	var uri FamilyMealPlansParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	var request CreateStapleParams
+	err = ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	familyID := uuid.MustParse(uri.ID)
+	_, ok := s.authFamilyMember(ctx, familyID)
+	if !ok {
+		return
+	}
+
+	firstWeek, err := stapleFirstWeek(request.FirstWeek)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	staple, err := s.store.CreateStaple(ctx, database.CreateStapleParams{
+		FamilyID:     familyID,
+		IngredientID: request.IngredientID,
+		Quantity:     request.Quantity,
+		Unit:         string(request.Unit),
+		EveryWeeks:   request.EveryWeeks,
+		FirstWeek:    firstWeek,
+	})
+	if err != nil {
+		switch database.ErrorCode(err) {
+		case database.CodeDuplicateKey:
+			ctx.JSON(http.StatusConflict, respondWithErorr(err))
+		case database.CodeForeignKeyViolation:
+			ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		default:
+			ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, DBStapleToStaple(staple))
+}
+
+// updateStaple changes a staple, the first week is kept unless another one is given
+func (s *Server) updateStaple(ctx *gin.Context) {
+	var uri StapleParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	var request UpdateStapleParams
+	err = ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	staple, ok := s.familyStaple(ctx, user, uuid.MustParse(uri.ID))
+	if !ok {
+		return
+	}
+
+	firstWeek := staple.FirstWeek
+	if request.FirstWeek != "" {
+		firstWeek, err = stapleFirstWeek(request.FirstWeek)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+			return
+		}
+	}
+
+	staple, err = s.store.UpdateStaple(ctx, database.UpdateStapleParams{
+		ID:         staple.ID,
+		Quantity:   request.Quantity,
+		Unit:       string(request.Unit),
+		EveryWeeks: request.EveryWeeks,
+		FirstWeek:  firstWeek,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, DBStapleToStaple(staple))
+}
+
+func (s *Server) deleteStaple(ctx *gin.Context) {
+	var uri StapleParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	staple, ok := s.familyStaple(ctx, user, uuid.MustParse(uri.ID))
+	if !ok {
+		return
+	}
+
+	err = s.store.DeleteStaple(ctx, staple.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, respondWithMessage(fmt.Sprintf("deleted staple with id %s", uri.ID)))
+}
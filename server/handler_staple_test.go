@@ -0,0 +1,164 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	database "github.com/andreiz53/cookinator/database/handlers"
+	databaseMock "github.com/andreiz53/cookinator/database/mocks"
+	"github.com/andreiz53/cookinator/types"
+	"github.com/andreiz53/cookinator/util"
+)
+
+func TestDueStaples(t *testing.T) {
+	firstWeek, err := util.ParseDate("2026-10-05")
+	require.NoError(t, err)
+	milk := database.GetStaplesByFamilyIDRow{ID: uuid.New(), IngredientID: 1, Quantity: 2000, Unit: types.MeasureUnitMillilitres, EveryWeeks: 1, FirstWeek: firstWeek}
+	flour := database.GetStaplesByFamilyIDRow{ID: uuid.New(), IngredientID: 2, Quantity: 1000, Unit: types.MeasureUnitGrams, EveryWeeks: 2, FirstWeek: firstWeek}
+	staples := []database.GetStaplesByFamilyIDRow{milk, flour}
+
+	testCases := []struct {
+		week string
+		due  []database.GetStaplesByFamilyIDRow
+	}{
+		{week: "2026-09-28", due: []database.GetStaplesByFamilyIDRow{}},
+		{week: "2026-10-05", due: []database.GetStaplesByFamilyIDRow{milk, flour}},
+		{week: "2026-10-12", due: []database.GetStaplesByFamilyIDRow{milk}},
+		{week: "2026-10-19", due: []database.GetStaplesByFamilyIDRow{milk, flour}},
+		// the week changes to winter time in between
+		{week: "2026-11-02", due: []database.GetStaplesByFamilyIDRow{milk, flour}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.week, func(t *testing.T) {
+			week, err := util.ParseDate(tc.week)
+			require.NoError(t, err)
+			require.Equal(t, tc.due, DueStaples(staples, week))
+		})
+	}
+
+	require.Equal(t, []types.RecipeItem{
+		{IngredientID: 1, Quantity: 2000, Unit: types.MeasureUnitMillilitres},
+	}, StapleRecipeItems([]database.GetStaplesByFamilyIDRow{milk}))
+}
+
+func TestCreateStaple(t *testing.T) {
+	user := randomFamilyUser(t)
+	params := CreateStapleParams{IngredientID: 3, Quantity: 12, Unit: types.MeasureUnitPiece, EveryWeeks: 2, FirstWeek: "2026-10-22"}
+	arg := database.CreateStapleParams{
+		FamilyID:     user.FamilyID,
+		IngredientID: params.IngredientID,
+		Quantity:     params.Quantity,
+		Unit:         string(params.Unit),
+		EveryWeeks:   params.EveryWeeks,
+		FirstWeek:    util.WeekStart(time.Date(2026, time.October, 22, 0, 0, 0, 0, time.UTC)),
+	}
+	staple := database.Staple{
+		ID:           uuid.New(),
+		FamilyID:     user.FamilyID,
+		IngredientID: arg.IngredientID,
+		Quantity:     arg.Quantity,
+		Unit:         arg.Unit,
+		EveryWeeks:   arg.EveryWeeks,
+		FirstWeek:    arg.FirstWeek,
+	}
+
+	testCases := []struct {
+		name          string
+		params        CreateStapleParams
+		stubs         func(store *databaseMock.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "OK",
+			params: params,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					CreateStaple(mock.Anything, arg).
+					Times(1).Return(staple, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				response, err := decodeJSON[Staple](recorder.Body)
+				require.NoError(t, err)
+				require.Equal(t, staple.ID, response.ID)
+				require.Equal(t, int32(2), response.EveryWeeks)
+			},
+		},
+		{
+			name:   "AlreadyStaple",
+			params: params,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					CreateStaple(mock.Anything, arg).
+					Times(1).Return(database.Staple{}, database.ErrDuplicateKey)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name:   "NoFrequency",
+			params: CreateStapleParams{IngredientID: 3, Quantity: 12, Unit: types.MeasureUnitPiece},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					CreateStaple(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "OtherFamily",
+			params: params,
+			stubs: func(store *databaseMock.MockStore) {
+				other := randomFamilyUser(t)
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(other, nil)
+				store.EXPECT().
+					CreateStaple(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			server := newTestServer(t, store)
+			tc.stubs(store)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/families/%s/staples", user.FamilyID.String())
+			data, err := encodeJSON(tc.params)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+			setAuth(t, request, server.tokenMaker, authHeaderTypeBearer, user.Email, time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,231 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	database "github.com/andreiz53/cookinator/database/handlers"
+	"github.com/andreiz53/cookinator/types"
+)
+
+// StoreLayout is a store the family shops at, Categories are its aisles in the order they are walked
+type StoreLayout struct {
+	ID         uuid.UUID               `json:"id"`
+	CreatedAt  pgtype.Timestamp        `json:"created_at"`
+	UpdatedAt  pgtype.Timestamp        `json:"updated_at"`
+	FamilyID   uuid.UUID               `json:"family_id"`
+	Name       string                  `json:"name"`
+	Categories []types.GroceryCategory `json:"categories"`
+}
+
+type CreateStoreLayoutParams struct {
+	Name       string                  `json:"name" binding:"required,min=1,max=255"`
+	Categories []types.GroceryCategory `json:"categories" binding:"required,min=1,unique,dive,oneof=produce bakery meat seafood dairy pantry spices beverages frozen other"`
+}
+
+type UpdateStoreLayoutParams struct {
+	Name       string                  `json:"name" binding:"required,min=1,max=255"`
+	Categories []types.GroceryCategory `json:"categories" binding:"required,min=1,unique,dive,oneof=produce bakery meat seafood dairy pantry spices beverages frozen other"`
+}
+
+type StoreLayoutParams struct {
+	ID string `uri:"id" binding:"required,uuid4_rfc4122"`
+}
+
+func DBStoreLayoutToStoreLayout(arg database.StoreLayout) StoreLayout {
+	categories := []types.GroceryCategory{}
+	for _, category := range arg.Categories {
+		categories = append(categories, types.GroceryCategory(category))
+	}
+	return StoreLayout{
+		ID:         arg.ID,
+		CreatedAt:  arg.CreatedAt,
+		UpdatedAt:  arg.UpdatedAt,
+		FamilyID:   arg.FamilyID,
+		Name:       arg.Name,
+		Categories: categories,
+	}
+}
+
+func DBStoreLayoutsToStoreLayouts(arg []database.StoreLayout) []StoreLayout {
+	layouts := []StoreLayout{}
+	for _, layout := range arg {
+		layouts = append(layouts, DBStoreLayoutToStoreLayout(layout))
+	}
+	return layouts
+}
+
+func storeLayoutCategories(arg []types.GroceryCategory) []string {
+	categories := []string{}
+	for _, category := range arg {
+		categories = append(categories, string(category))
+	}
+	return categories
+}
+
+// familyStoreLayout loads a store layout and makes sure it belongs to the user's family.
+// It writes the error response itself and returns false on failure.
+func (s *Server) familyStoreLayout(ctx *gin.Context, user database.User, id uuid.UUID) (database.StoreLayout, bool) {
+	layout, err := s.store.GetStoreLayoutByID(ctx, id)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, respondWithErorr(err))
+			return layout, false
+		}
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return layout, false
+	}
+	if layout.FamilyID != user.FamilyID {
+		ctx.JSON(http.StatusForbidden, respondWithErorr(errForbidden))
+		return layout, false
+	}
+	return layout, true
+}
+
+// storeLayoutOrder is the aisle order of the store layout with the given id, an empty id has no order.
+// It writes the error response itself and returns false on failure.
+func (s *Server) storeLayoutOrder(ctx *gin.Context, user database.User, id string) ([]types.GroceryCategory, bool) {
+	if id == "" {
+		return []types.GroceryCategory{}, true
+	}
+	layout, ok := s.familyStoreLayout(ctx, user, uuid.MustParse(id))
+	if !ok {
+		return nil, false
+	}
+	return DBStoreLayoutToStoreLayout(layout).Categories, true
+}
+
+func (s *Server) getStoreLayouts(ctx *gin.Context) {
+	var uri FamilyMealPlansParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	familyID := uuid.MustParse(uri.ID)
+	_, ok := s.authFamilyMember(ctx, familyID)
+	if !ok {
+		return
+	}
+
+	layouts, err := s.store.GetStoreLayoutsByFamilyID(ctx, familyID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, DBStoreLayoutsToStoreLayouts(layouts))
+}
+
+func (s *Server) createStoreLayout(ctx *gin.Context) {
+	var uri FamilyMealPlansParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	var request CreateStoreLayoutParams
+	err = ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	familyID := uuid.MustParse(uri.ID)
+	_, ok := s.authFamilyMember(ctx, familyID)
+	if !ok {
+		return
+	}
+
+	layout, err := s.store.CreateStoreLayout(ctx, database.CreateStoreLayoutParams{
+		FamilyID:   familyID,
+		Name:       request.Name,
+		Categories: storeLayoutCategories(request.Categories),
+	})
+	if err != nil {
+		if database.ErrorCode(err) == database.CodeDuplicateKey {
+			ctx.JSON(http.StatusConflict, respondWithErorr(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, DBStoreLayoutToStoreLayout(layout))
+}
+
+func (s *Server) updateStoreLayout(ctx *gin.Context) {
+	var uri StoreLayoutParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	var request UpdateStoreLayoutParams
+	err = ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	layout, ok := s.familyStoreLayout(ctx, user, uuid.MustParse(uri.ID))
+	if !ok {
+		return
+	}
+
+	layout, err = s.store.UpdateStoreLayout(ctx, database.UpdateStoreLayoutParams{
+		ID:         layout.ID,
+		Name:       request.Name,
+		Categories: storeLayoutCategories(request.Categories),
+	})
+	if err != nil {
+		if database.ErrorCode(err) == database.CodeDuplicateKey {
+			ctx.JSON(http.StatusConflict, respondWithErorr(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, DBStoreLayoutToStoreLayout(layout))
+}
+
+func (s *Server) deleteStoreLayout(ctx *gin.Context) {
+	var uri StoreLayoutParams
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return
+	}
+
+	layout, ok := s.familyStoreLayout(ctx, user, uuid.MustParse(uri.ID))
+	if !ok {
+		return
+	}
+
+	err = s.store.DeleteStoreLayout(ctx, layout.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, respondWithMessage(fmt.Sprintf("deleted store layout with id %s", uri.ID)))
+}
@@ -0,0 +1,219 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	database "github.com/andreiz53/cookinator/database/handlers"
+	databaseMock "github.com/andreiz53/cookinator/database/mocks"
+	"github.com/andreiz53/cookinator/types"
+	"github.com/andreiz53/cookinator/util"
+)
+
+func randomStoreLayout(familyID uuid.UUID) database.StoreLayout {
+	return database.StoreLayout{
+		ID:         uuid.New(),
+		FamilyID:   familyID,
+		Name:       util.RandomName(),
+		Categories: []string{types.GroceryCategoryDairy, types.GroceryCategoryProduce},
+	}
+}
+
+func TestCreateStoreLayout(t *testing.T) {
+	user := randomFamilyUser(t)
+	layout := randomStoreLayout(user.FamilyID)
+	params := CreateStoreLayoutParams{
+		Name:       layout.Name,
+		Categories: []types.GroceryCategory{types.GroceryCategoryDairy, types.GroceryCategoryProduce},
+	}
+	arg := database.CreateStoreLayoutParams{
+		FamilyID:   user.FamilyID,
+		Name:       layout.Name,
+		Categories: layout.Categories,
+	}
+
+	testCases := []struct {
+		name          string
+		params        CreateStoreLayoutParams
+		stubs         func(store *databaseMock.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "OK",
+			params: params,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					CreateStoreLayout(mock.Anything, arg).
+					Times(1).Return(layout, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				response, err := decodeJSON[StoreLayout](recorder.Body)
+				require.NoError(t, err)
+				require.Equal(t, layout.ID, response.ID)
+				require.Equal(t, params.Categories, response.Categories)
+			},
+		},
+		{
+			name:   "DuplicateName",
+			params: params,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				store.EXPECT().
+					CreateStoreLayout(mock.Anything, arg).
+					Times(1).Return(database.StoreLayout{}, database.ErrDuplicateKey)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "RepeatedCategory",
+			params: CreateStoreLayoutParams{
+				Name:       layout.Name,
+				Categories: []types.GroceryCategory{types.GroceryCategoryDairy, types.GroceryCategoryDairy},
+			},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					CreateStoreLayout(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "UnknownCategory",
+			params: CreateStoreLayoutParams{
+				Name:       layout.Name,
+				Categories: []types.GroceryCategory{"garden"},
+			},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					CreateStoreLayout(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			server := newTestServer(t, store)
+			tc.stubs(store)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/families/%s/store-layouts", user.FamilyID.String())
+			data, err := encodeJSON(tc.params)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+			setAuth(t, request, server.tokenMaker, authHeaderTypeBearer, user.Email, time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestGetShoppingListByStoreLayout(t *testing.T) {
+	user := randomFamilyUser(t)
+	list := randomShoppingList(user.FamilyID)
+	layout := randomStoreLayout(user.FamilyID)
+	otherLayout := randomStoreLayout(uuid.New())
+
+	apples := randomShoppingListItem(list)
+	milk := randomShoppingListItem(list)
+	milk.Category = types.GroceryCategoryDairy
+	bread := randomShoppingListItem(list)
+	bread.Category = types.GroceryCategoryBakery
+
+	testCases := []struct {
+		name          string
+		layout        database.StoreLayout
+		stubs         func(store *databaseMock.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "OK",
+			layout: layout,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetStoreLayoutByID(mock.Anything, layout.ID).
+					Times(1).Return(layout, nil)
+				store.EXPECT().
+					GetShoppingListItems(mock.Anything, list.ID).
+					Times(1).Return([]database.ShoppingListItem{apples, bread, milk}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				response, err := decodeJSON[ShoppingList](recorder.Body)
+				require.NoError(t, err)
+				require.Empty(t, response.Items)
+				require.Len(t, response.Aisles, 3)
+				require.Equal(t, types.GroceryCategory(types.GroceryCategoryDairy), response.Aisles[0].Category)
+				require.Equal(t, milk.ID, response.Aisles[0].Items[0].ID)
+				require.Equal(t, types.GroceryCategory(types.GroceryCategoryProduce), response.Aisles[1].Category)
+				require.Equal(t, types.GroceryCategory(types.GroceryCategoryBakery), response.Aisles[2].Category)
+			},
+		},
+		{
+			name:   "OtherFamilyLayout",
+			layout: otherLayout,
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetStoreLayoutByID(mock.Anything, otherLayout.ID).
+					Times(1).Return(otherLayout, nil)
+				store.EXPECT().
+					GetShoppingListItems(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			server := newTestServer(t, store)
+
+			store.EXPECT().
+				GetUserByEmail(mock.Anything, user.Email).
+				Times(1).Return(user, nil)
+			store.EXPECT().
+				GetShoppingListByID(mock.Anything, list.ID).
+				Times(1).Return(list, nil)
+			tc.stubs(store)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/shopping-lists/%s?store_layout_id=%s", list.ID.String(), tc.layout.ID.String())
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+			setAuth(t, request, server.tokenMaker, authHeaderTypeBearer, user.Email, time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
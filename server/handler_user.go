@@ -10,6 +10,7 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 
 	database "github.com/andreiz53/cookinator/database/handlers"
+	"github.com/andreiz53/cookinator/types"
 	"github.com/andreiz53/cookinator/util"
 )
 
@@ -30,12 +31,13 @@ type loginUserResponse struct {
 }
 
 type User struct {
-	ID        uuid.UUID        `json:"id"`
-	CreatedAt pgtype.Timestamp `json:"created_at"`
-	UpdatedAt pgtype.Timestamp `json:"updated_at"`
-	FirstName string           `json:"first_name"`
-	Email     string           `json:"email"`
-	FamilyID  *uuid.UUID       `json:"family_id"`
+	ID         uuid.UUID        `json:"id"`
+	CreatedAt  pgtype.Timestamp `json:"created_at"`
+	UpdatedAt  pgtype.Timestamp `json:"updated_at"`
+	FirstName  string           `json:"first_name"`
+	Email      string           `json:"email"`
+	FamilyID   *uuid.UUID       `json:"family_id"`
+	UnitSystem types.UnitSystem `json:"unit_system"`
 }
 
 type getUserByIDRequest struct {
@@ -57,18 +59,24 @@ type updateUserInfoRequest struct {
 	FirstName string `json:"first_name" binding:"required,min=3"`
 }
 
+// updateUserUnitSystemRequest sets the units the authenticated user sees quantities in
+type updateUserUnitSystemRequest struct {
+	UnitSystem types.UnitSystem `json:"unit_system" binding:"required,oneof=metric imperial"`
+}
+
 type deleteUserRequest struct {
 	ID string `uri:"id" binding:"required,uuid4_rfc4122"`
 }
 
 func DBUserToUser(arg database.User) User {
 	return User{
-		ID:        arg.ID,
-		CreatedAt: arg.CreatedAt,
-		UpdatedAt: arg.UpdatedAt,
-		FirstName: arg.FirstName,
-		Email:     arg.Email,
-		FamilyID:  util.NullUUID(arg.FamilyID),
+		ID:         arg.ID,
+		CreatedAt:  arg.CreatedAt,
+		UpdatedAt:  arg.UpdatedAt,
+		FirstName:  arg.FirstName,
+		Email:      arg.Email,
+		FamilyID:   util.NullUUID(arg.FamilyID),
+		UnitSystem: types.UnitSystem(arg.UnitSystem),
 	}
 }
 
@@ -217,6 +225,32 @@ func (s *Server) updateUserInfo(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, DBUserToUser(user))
 }
 
+func (s *Server) updateUserUnitSystem(ctx *gin.Context) {
+	var request updateUserUnitSystemRequest
+
+	err := ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, respondWithErorr(err))
+		return
+	}
+
+	user, ok := s.authUser(ctx)
+	if !ok {
+		return
+	}
+
+	user, err = s.store.UpdateUserUnitSystem(ctx, database.UpdateUserUnitSystemParams{
+		ID:         user.ID,
+		UnitSystem: string(request.UnitSystem),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, DBUserToUser(user))
+}
+
 func (s *Server) deleteUser(ctx *gin.Context) {
 	var request deleteUserRequest
 
@@ -1,10 +1,12 @@
 package server
 
 import (
+	"bytes"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
@@ -12,6 +14,7 @@ import (
 
 	database "github.com/andreiz53/cookinator/database/handlers"
 	databaseMock "github.com/andreiz53/cookinator/database/mocks"
+	"github.com/andreiz53/cookinator/types"
 	"github.com/andreiz53/cookinator/util"
 )
 
@@ -20,10 +23,12 @@ func randomUser(t *testing.T) database.User {
 	require.NoError(t, err)
 	require.NotEmpty(t, hashedPassword)
 	return database.User{
-		ID:        uuid.New(),
-		FirstName: util.RandomFirstName(),
-		Email:     util.RandomEmail(),
-		Password:  hashedPassword,
+		ID:            uuid.New(),
+		FirstName:     util.RandomFirstName(),
+		Email:         util.RandomEmail(),
+		Password:      hashedPassword,
+		PortionFactor: 1,
+		UnitSystem:    types.UnitSystemMetric,
 	}
 
 }
@@ -66,6 +71,73 @@ func TestUpdateUserInfo(t *testing.T) {
 
 }
 
+func TestUpdateUserUnitSystem(t *testing.T) {
+	user := randomUser(t)
+
+	testCases := []struct {
+		name          string
+		params        updateUserUnitSystemRequest
+		stubs         func(store *databaseMock.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "OK",
+			params: updateUserUnitSystemRequest{UnitSystem: types.UnitSystemImperial},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(mock.Anything, user.Email).
+					Times(1).Return(user, nil)
+				updated := user
+				updated.UnitSystem = types.UnitSystemImperial
+				store.EXPECT().
+					UpdateUserUnitSystem(mock.Anything, database.UpdateUserUnitSystemParams{
+						ID:         user.ID,
+						UnitSystem: types.UnitSystemImperial,
+					}).
+					Times(1).Return(updated, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				response, err := decodeJSON[User](recorder.Body)
+				require.NoError(t, err)
+				require.Equal(t, types.UnitSystem(types.UnitSystemImperial), response.UnitSystem)
+			},
+		},
+		{
+			name:   "UnknownSystem",
+			params: updateUserUnitSystemRequest{UnitSystem: "nautical"},
+			stubs: func(store *databaseMock.MockStore) {
+				store.EXPECT().
+					UpdateUserUnitSystem(mock.Anything, mock.Anything).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(databaseMock.MockStore)
+			server := newTestServer(t, store)
+			tc.stubs(store)
+
+			recorder := httptest.NewRecorder()
+			data, err := encodeJSON(tc.params)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPut, "/users/unit-system", bytes.NewReader(data))
+			require.NoError(t, err)
+			setAuth(t, request, server.tokenMaker, authHeaderTypeBearer, user.Email, time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
 func TestDeleteUser(t *testing.T) {
 
 }
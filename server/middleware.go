@@ -6,7 +6,10 @@ import (
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 
+	database "github.com/andreiz53/cookinator/database/handlers"
 	"github.com/andreiz53/cookinator/token"
 )
 
@@ -16,6 +19,11 @@ const (
 	ctxAuthPayloadKey    = "auth_key"
 )
 
+var (
+	errNoFamily  = errors.New("user does not belong to a family")
+	errForbidden = errors.New("you do not have access to this resource")
+)
+
 func authMiddleware(tokenMaker token.Maker) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		authHeader := ctx.GetHeader(authHeaderKey)
@@ -50,3 +58,46 @@ func authMiddleware(tokenMaker token.Maker) gin.HandlerFunc {
 		ctx.Next()
 	}
 }
+
+// authUser returns the user the current request was authenticated as.
+// It writes the error response itself and returns false if the user can't be loaded.
+func (s *Server) authUser(ctx *gin.Context) (database.User, bool) {
+	payload := ctx.MustGet(ctxAuthPayloadKey).(*token.Payload)
+
+	user, err := s.store.GetUserByEmail(ctx, payload.Email)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			ctx.JSON(http.StatusUnauthorized, respondWithErorr(err))
+			return database.User{}, false
+		}
+		ctx.JSON(http.StatusInternalServerError, respondWithErorr(err))
+		return database.User{}, false
+	}
+	return user, true
+}
+
+// authFamilyUser returns the authenticated user and makes sure they belong to a family
+func (s *Server) authFamilyUser(ctx *gin.Context) (database.User, bool) {
+	user, ok := s.authUser(ctx)
+	if !ok {
+		return user, false
+	}
+	if user.FamilyID == uuid.Nil {
+		ctx.JSON(http.StatusForbidden, respondWithErorr(errNoFamily))
+		return user, false
+	}
+	return user, true
+}
+
+// authFamilyMember returns the authenticated user and makes sure they belong to the given family
+func (s *Server) authFamilyMember(ctx *gin.Context, familyID uuid.UUID) (database.User, bool) {
+	user, ok := s.authFamilyUser(ctx)
+	if !ok {
+		return user, false
+	}
+	if user.FamilyID != familyID {
+		ctx.JSON(http.StatusForbidden, respondWithErorr(errForbidden))
+		return user, false
+	}
+	return user, true
+}
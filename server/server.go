@@ -1,6 +1,8 @@
 package server
 
 import (
+	"context"
+
 	"github.com/gin-gonic/gin"
 
 	database "github.com/andreiz53/cookinator/database/handlers"
@@ -9,10 +11,11 @@ import (
 )
 
 type Server struct {
-	config     util.Config
-	router     *gin.Engine
-	store      database.Store
-	tokenMaker token.Maker
+	config        util.Config
+	router        *gin.Engine
+	store         database.Store
+	tokenMaker    token.Maker
+	shoppingLists *shoppingListHub
 }
 
 func NewServer(config util.Config, store database.Store) (*Server, error) {
@@ -21,9 +24,10 @@ func NewServer(config util.Config, store database.Store) (*Server, error) {
 		return nil, err
 	}
 	server := &Server{
-		config:     config,
-		store:      store,
-		tokenMaker: tokenMaker,
+		config:        config,
+		store:         store,
+		tokenMaker:    tokenMaker,
+		shoppingLists: newShoppingListHub(store),
 	}
 
 	server.setupRoutes()
@@ -51,9 +55,13 @@ func (server *Server) setupRoutes() {
 	router.GET("/ingredients/:id", server.getIngredientByID)
 	router.PUT("/ingredients", server.updateIngredient)
 	router.DELETE("/ingredients/:id", server.deleteIngredient)
+	router.GET("/ingredients/:id/packages", server.getIngredientPackages)
+	router.POST("/ingredients/:id/packages", server.createIngredientPackage)
+	router.DELETE("/ingredients/:id/packages/:package_id", server.deleteIngredientPackage)
 
 	// with authenticated user middleware
 	authRouter := router.Group("/").Use(authMiddleware(server.tokenMaker))
+	authRouter.PUT("/users/unit-system", server.updateUserUnitSystem)
 	authRouter.POST("/families", server.createFamily)
 	// no reason to expose this at the moment
 	router.GET("/families", server.getFamilies)
@@ -63,9 +71,141 @@ func (server *Server) setupRoutes() {
 	router.PUT("/families", server.updateFamily)
 	router.DELETE("/families/:id", server.deleteFamily)
 
+	// recipes of the authenticated user's family
+	authRouter.POST("/recipes", server.createRecipe)
+	authRouter.GET("/recipes", server.getRecipes)
+	authRouter.GET("/recipes/:id", server.getRecipeByID)
+	authRouter.PUT("/recipes", server.updateRecipe)
+	authRouter.DELETE("/recipes/:id", server.deleteRecipe)
+	authRouter.POST("/recipes/duplicates", server.checkRecipeDuplicates)
+	authRouter.POST("/recipes/:id/merge", server.mergeRecipes)
+	authRouter.PUT("/recipes/:id/equipment", server.setRecipeEquipment)
+
+	// kitchen equipment needed by recipes and owned by families
+	authRouter.POST("/equipment", server.createEquipment)
+	authRouter.GET("/equipment", server.getEquipment)
+	authRouter.GET("/families/:id/equipment", server.getFamilyEquipment)
+	authRouter.PUT("/families/:id/equipment", server.setFamilyEquipment)
+
+	authRouter.GET("/favorites", server.getFavorites)
+	authRouter.POST("/recipes/:id/favorite", server.addFavorite)
+	authRouter.DELETE("/recipes/:id/favorite", server.removeFavorite)
+
+	// cooking log
+	authRouter.POST("/recipes/:id/history", server.createCookLog)
+	authRouter.GET("/recipes/:id/history", server.getRecipeHistory)
+	authRouter.DELETE("/recipes/:id/history/:log_id", server.deleteCookLog)
+	authRouter.GET("/families/:id/cook-log", server.getFamilyCookLog)
+
+	// the dishes of a meal scheduled to finish together
+	authRouter.POST("/cook-sessions", server.createCookSession)
+
+	// birthdays and holidays with a menu scaled to the guests
+	authRouter.POST("/families/:id/events", server.createEvent)
+	authRouter.GET("/families/:id/events", server.getEvents)
+	authRouter.GET("/events/:id", server.getEventByID)
+	authRouter.PUT("/events/:id", server.updateEvent)
+	authRouter.DELETE("/events/:id", server.deleteEvent)
+	authRouter.POST("/events/:id/recipes", server.addEventRecipe)
+	authRouter.DELETE("/events/:id/recipes/:recipe_id", server.removeEventRecipe)
+	authRouter.GET("/events/:id/shopping-list", server.getEventShoppingList)
+	authRouter.GET("/events/:id/allergy-report", server.getEventAllergyReport)
+
+	// what to buy for the meals of a week, editable after it is generated
+	authRouter.POST("/meal-plans/:id/shopping-list", server.generateShoppingList)
+	authRouter.GET("/families/:id/shopping-lists", server.getShoppingLists)
+	authRouter.GET("/shopping-lists/:id", server.getShoppingListByID)
+	authRouter.DELETE("/shopping-lists/:id", server.deleteShoppingList)
+	authRouter.POST("/shopping-lists/:id/items", server.createShoppingListItem)
+	authRouter.PUT("/shopping-lists/:id/items/:item_id", server.updateShoppingListItem)
+	authRouter.DELETE("/shopping-lists/:id/items/:item_id", server.deleteShoppingListItem)
+	authRouter.PUT("/shopping-lists/:id/items/:item_id/check", server.checkShoppingListItem)
+	authRouter.GET("/shopping-lists/:id/events", server.streamShoppingList)
+	authRouter.GET("/shopping-lists/:id/export", server.exportShoppingList)
+
+	// what the family has in the kitchen, subtracted from generated shopping lists
+	authRouter.GET("/families/:id/inventory", server.getInventory)
+	authRouter.POST("/families/:id/inventory", server.createInventoryItem)
+	authRouter.PUT("/inventory/:id", server.updateInventoryItem)
+	authRouter.DELETE("/inventory/:id", server.deleteInventoryItem)
+
+	// what a family buys every week or every few weeks, added to generated shopping lists when due
+	authRouter.GET("/families/:id/staples", server.getStaples)
+	authRouter.POST("/families/:id/staples", server.createStaple)
+	authRouter.PUT("/staples/:id", server.updateStaple)
+	authRouter.DELETE("/staples/:id", server.deleteStaple)
+
+	// the stores a family shops at, with their aisles in the order they are walked
+	authRouter.GET("/families/:id/store-layouts", server.getStoreLayouts)
+	authRouter.POST("/families/:id/store-layouts", server.createStoreLayout)
+	authRouter.PUT("/store-layouts/:id", server.updateStoreLayout)
+	authRouter.DELETE("/store-layouts/:id", server.deleteStoreLayout)
+
+	// weekly meal plans of the authenticated user's family
+	authRouter.POST("/families/:id/meal-plans", server.createMealPlan)
+	authRouter.POST("/families/:id/meal-plans/generate", server.generateMealPlan)
+	authRouter.GET("/families/:id/meal-plans", server.getMealPlans)
+	authRouter.GET("/meal-plans/:id", server.getMealPlanByID)
+	authRouter.DELETE("/meal-plans/:id", server.deleteMealPlan)
+	authRouter.POST("/meal-plans/:id/entries", server.createMealPlanEntry)
+	authRouter.POST("/meal-plans/:id/batch", server.batchCook)
+	authRouter.PUT("/meal-plans/:id/entries/:entry_id", server.updateMealPlanEntry)
+	authRouter.DELETE("/meal-plans/:id/entries/:entry_id", server.deleteMealPlanEntry)
+
+	// who eats each meal, servings follow attendance unless they are set by hand
+	authRouter.GET("/families/:id/attendance", server.getFamilyAttendance)
+	authRouter.PUT("/families/:id/attendance/:user_id", server.setMemberAttendance)
+	authRouter.GET("/meal-plans/:id/attendance", server.getMealPlanAttendance)
+	authRouter.PUT("/meal-plans/:id/attendance", server.setMealAttendance)
+
+	// the family votes on a proposed week before it is made final
+	authRouter.POST("/meal-plans/:id/propose", server.proposeMealPlan)
+	authRouter.PUT("/meal-plans/:id/entries/:entry_id/vote", server.voteMealPlanEntry)
+	authRouter.DELETE("/meal-plans/:id/entries/:entry_id/vote", server.deleteMealPlanVote)
+	authRouter.GET("/meal-plans/:id/votes", server.getMealPlanVotes)
+	authRouter.POST("/meal-plans/:id/finalize", server.finalizeMealPlan)
+
+	// what to prepare ahead for the meals of a final week
+	authRouter.GET("/meal-plans/:id/prep-schedule", server.getMealPrepSchedule)
+
+	// reusable weeks saved as templates, and the templates a family cycles through
+	authRouter.POST("/meal-plans/:id/template", server.createMealPlanTemplate)
+	authRouter.GET("/families/:id/meal-plan-templates", server.getMealPlanTemplates)
+	authRouter.GET("/meal-plan-templates/:id", server.getMealPlanTemplateByID)
+	authRouter.DELETE("/meal-plan-templates/:id", server.deleteMealPlanTemplate)
+	authRouter.POST("/meal-plan-templates/:id/apply", server.applyMealPlanTemplateToWeek)
+	authRouter.PUT("/families/:id/meal-plan-rotation", server.setMealPlanRotation)
+	authRouter.GET("/families/:id/meal-plan-rotation", server.getMealPlanRotation)
+	authRouter.DELETE("/families/:id/meal-plan-rotation", server.deleteMealPlanRotation)
+	authRouter.POST("/families/:id/meal-plan-rotation/populate", server.populateMealPlanRotation)
+
+	// iCalendar feed of the meal plans, calendar apps authenticate with the feed token
+	authRouter.POST("/families/:id/calendar-feed", server.createCalendarFeed)
+	authRouter.GET("/families/:id/calendar-feed", server.getCalendarFeed)
+	authRouter.DELETE("/families/:id/calendar-feed", server.deleteCalendarFeed)
+	router.GET("/families/:id/meal-plan.ics", server.getMealPlanCalendar)
+
+	// family calendars imported to mark busy slots for meal plan generation
+	authRouter.POST("/families/:id/calendars", server.createFamilyCalendar)
+	authRouter.GET("/families/:id/calendars", server.getFamilyCalendars)
+	authRouter.POST("/families/:id/calendars/:calendar_id/sync", server.syncFamilyCalendar)
+	authRouter.DELETE("/families/:id/calendars/:calendar_id", server.deleteFamilyCalendar)
+	authRouter.GET("/families/:id/busy-slots", server.getBusySlots)
+
+	// private collections, or shared with the user's family
+	authRouter.POST("/collections", server.createCollection)
+	authRouter.GET("/collections", server.getCollections)
+	authRouter.GET("/collections/:id", server.getCollectionByID)
+	authRouter.PUT("/collections", server.updateCollection)
+	authRouter.DELETE("/collections/:id", server.deleteCollection)
+	authRouter.POST("/collections/:id/recipes", server.addCollectionRecipe)
+	authRouter.PUT("/collections/:id/recipes", server.reorderCollectionRecipes)
+	authRouter.DELETE("/collections/:id/recipes/:recipe_id", server.removeCollectionRecipe)
+
 	server.router = router
 }
 
 func (s *Server) Run(address string) error {
+	go s.shoppingLists.listen(context.Background(), s.config.DBSource)
 	return s.router.Run(address)
 }
@@ -0,0 +1,172 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	database "github.com/andreiz53/cookinator/database/handlers"
+)
+
+const (
+	// listenRetryDelay is how long the hub waits to listen again after losing its connection
+	listenRetryDelay = 5 * time.Second
+	// subscriberBuffer is how many changes a slow subscriber can fall behind before it misses some
+	subscriberBuffer = 32
+	// shoppingListResync is the op of a change telling the subscribers they may have missed changes
+	shoppingListResync = "resync"
+)
+
+// ShoppingListChange is a change of an item, Op is insert, update, delete or resync. The item of a delete only has
+// its ids and a resync has no item.
+type ShoppingListChange struct {
+	Op             string                    `json:"op"`
+	ShoppingListID uuid.UUID                 `json:"shopping_list_id"`
+	Item           database.ShoppingListItem `json:"item"`
+}
+
+// shoppingListNotification is a change as notified by the database. It only holds the ids of the item,
+// which keeps it under the size limit of notifications however large the item is.
+type shoppingListNotification struct {
+	Op             string    `json:"op"`
+	ShoppingListID uuid.UUID `json:"shopping_list_id"`
+	ItemID         uuid.UUID `json:"item_id"`
+}
+
+func decodeShoppingListNotification(payload string) (shoppingListNotification, error) {
+	var notification shoppingListNotification
+	err := json.Unmarshal([]byte(payload), &notification)
+	return notification, err
+}
+
+// shoppingListHub passes the changes of shopping list items to the clients streaming them. The changes come
+// from Postgres notifications, so a change made through any server instance reaches every client.
+type shoppingListHub struct {
+	store       database.Store
+	mu          sync.Mutex
+	subscribers map[uuid.UUID]map[chan ShoppingListChange]bool
+}
+
+func newShoppingListHub(store database.Store) *shoppingListHub {
+	return &shoppingListHub{store: store, subscribers: map[uuid.UUID]map[chan ShoppingListChange]bool{}}
+}
+
+func (h *shoppingListHub) subscribe(listID uuid.UUID) chan ShoppingListChange {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	changes := make(chan ShoppingListChange, subscriberBuffer)
+	if h.subscribers[listID] == nil {
+		h.subscribers[listID] = map[chan ShoppingListChange]bool{}
+	}
+	h.subscribers[listID][changes] = true
+	return changes
+}
+
+func (h *shoppingListHub) unsubscribe(listID uuid.UUID, changes chan ShoppingListChange) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	delete(h.subscribers[listID], changes)
+	if len(h.subscribers[listID]) == 0 {
+		delete(h.subscribers, listID)
+	}
+}
+
+func (h *shoppingListHub) subscribed(listID uuid.UUID) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return len(h.subscribers[listID]) > 0
+}
+
+// change turns a notification into the change passed to the subscribers, loading the item unless it was deleted.
+// Items of lists nobody streams aren't loaded, and neither are the ones deleted since, ok is false for both.
+func (h *shoppingListHub) change(ctx context.Context, notification shoppingListNotification) (ShoppingListChange, bool, error) {
+	change := ShoppingListChange{
+		Op:             notification.Op,
+		ShoppingListID: notification.ShoppingListID,
+		Item:           database.ShoppingListItem{ID: notification.ItemID, ShoppingListID: notification.ShoppingListID},
+	}
+	if !h.subscribed(notification.ShoppingListID) {
+		return change, false, nil
+	}
+	if notification.Op == "delete" {
+		return change, true, nil
+	}
+
+	item, err := h.store.GetShoppingListItemByID(ctx, notification.ItemID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return change, false, nil
+		}
+		return change, false, err
+	}
+	if item.Dismissed {
+		// to the clients a dismissed item is deleted
+		change.Op = "delete"
+		return change, true, nil
+	}
+	change.Item = item
+	return change, true, nil
+}
+
+// publish passes a change to the subscribers of its list, a subscriber too far behind misses it
+func (h *shoppingListHub) publish(change ShoppingListChange) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for changes := range h.subscribers[change.ShoppingListID] {
+		select {
+		case changes <- change:
+		default:
+		}
+	}
+}
+
+// resync tells the subscribers of every list that changes may have been missed, while the hub wasn't listening
+func (h *shoppingListHub) resync() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for listID, subscribers := range h.subscribers {
+		for changes := range subscribers {
+			select {
+			case changes <- ShoppingListChange{Op: shoppingListResync, ShoppingListID: listID}:
+			default:
+			}
+		}
+	}
+}
+
+// listen publishes the changes notified by the database until ctx is done, listening again when the connection
+// is lost. The subscribers are resynced every time it starts listening, as changes made before are never notified.
+func (h *shoppingListHub) listen(ctx context.Context, dbSource string) {
+	for ctx.Err() == nil {
+		err := database.Listen(ctx, dbSource, database.ShoppingListItemsChannel, h.resync, func(payload string) {
+			notification, err := decodeShoppingListNotification(payload)
+			if err != nil {
+				log.Println("invalid shopping list change:", err)
+				return
+			}
+			change, ok, err := h.change(ctx, notification)
+			if err != nil {
+				log.Println("could not load shopping list change:", err)
+				return
+			}
+			if ok {
+				h.publish(change)
+			}
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		log.Println("stopped listening to shopping list changes:", err)
+		time.Sleep(listenRetryDelay)
+	}
+}
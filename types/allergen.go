@@ -0,0 +1,30 @@
+package types
+
+// Allergen is something an ingredient contains that guests may have to avoid
+type Allergen string
+
+const (
+	AllergenGluten    = "gluten"
+	AllergenDairy     = "dairy"
+	AllergenEgg       = "egg"
+	AllergenPeanuts   = "peanuts"
+	AllergenTreeNuts  = "tree_nuts"
+	AllergenSoy       = "soy"
+	AllergenFish      = "fish"
+	AllergenShellfish = "shellfish"
+	AllergenSesame    = "sesame"
+	AllergenMeat      = "meat"
+)
+
+var Allergens = []Allergen{
+	AllergenGluten,
+	AllergenDairy,
+	AllergenEgg,
+	AllergenPeanuts,
+	AllergenTreeNuts,
+	AllergenSoy,
+	AllergenFish,
+	AllergenShellfish,
+	AllergenSesame,
+	AllergenMeat,
+}
@@ -0,0 +1,23 @@
+package types
+
+// DietaryRestriction is either an allergen a guest avoids or a diet leaving out several of them
+type DietaryRestriction string
+
+const (
+	DietVegetarian = "vegetarian"
+	DietVegan      = "vegan"
+)
+
+// DietAllergens are the allergens each diet leaves out
+var DietAllergens = map[DietaryRestriction][]Allergen{
+	DietVegetarian: {AllergenMeat, AllergenFish, AllergenShellfish},
+	DietVegan:      {AllergenMeat, AllergenFish, AllergenShellfish, AllergenDairy, AllergenEgg},
+}
+
+// Avoids returns the allergens a restriction leaves out
+func (r DietaryRestriction) Avoids() []Allergen {
+	if allergens, ok := DietAllergens[r]; ok {
+		return allergens
+	}
+	return []Allergen{Allergen(r)}
+}
@@ -0,0 +1,15 @@
+package types
+
+type Difficulty string
+
+const (
+	DifficultyEasy   = "easy"
+	DifficultyMedium = "medium"
+	DifficultyHard   = "hard"
+)
+
+var Difficulties = []Difficulty{
+	DifficultyEasy,
+	DifficultyMedium,
+	DifficultyHard,
+}
@@ -0,0 +1,31 @@
+package types
+
+// GroceryCategory is the aisle of a store an ingredient is found in
+type GroceryCategory string
+
+const (
+	GroceryCategoryProduce   = "produce"
+	GroceryCategoryBakery    = "bakery"
+	GroceryCategoryMeat      = "meat"
+	GroceryCategorySeafood   = "seafood"
+	GroceryCategoryDairy     = "dairy"
+	GroceryCategoryPantry    = "pantry"
+	GroceryCategorySpices    = "spices"
+	GroceryCategoryBeverages = "beverages"
+	GroceryCategoryFrozen    = "frozen"
+	GroceryCategoryOther     = "other"
+)
+
+// GroceryCategories are in the order a store is usually walked, fresh food first and frozen food last
+var GroceryCategories = []GroceryCategory{
+	GroceryCategoryProduce,
+	GroceryCategoryBakery,
+	GroceryCategoryMeat,
+	GroceryCategorySeafood,
+	GroceryCategoryDairy,
+	GroceryCategoryPantry,
+	GroceryCategorySpices,
+	GroceryCategoryBeverages,
+	GroceryCategoryFrozen,
+	GroceryCategoryOther,
+}
@@ -0,0 +1,10 @@
+package types
+
+// MealPlanStatus is where a meal plan is in the weekly planning, from a draft to a final week
+type MealPlanStatus string
+
+const (
+	MealPlanStatusDraft    = "draft"
+	MealPlanStatusProposed = "proposed"
+	MealPlanStatusFinal    = "final"
+)
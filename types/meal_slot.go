@@ -0,0 +1,31 @@
+package types
+
+type MealSlot string
+
+const (
+	MealSlotBreakfast = "breakfast"
+	MealSlotLunch     = "lunch"
+	MealSlotDinner    = "dinner"
+	MealSlotSnack     = "snack"
+)
+
+var MealSlots = []MealSlot{
+	MealSlotBreakfast,
+	MealSlotLunch,
+	MealSlotDinner,
+	MealSlotSnack,
+}
+
+// Order sorts the meals of a day, snacks come before dinner like in the meal plan listing
+func (s MealSlot) Order() int {
+	switch s {
+	case MealSlotBreakfast:
+		return 0
+	case MealSlotLunch:
+		return 1
+	case MealSlotSnack:
+		return 2
+	default:
+		return 3
+	}
+}
@@ -19,3 +19,36 @@ var MeasureUnits = []MeasureUnit{
 	MeasureUnitTablespoon,
 	MeasureUnitTeaspoon,
 }
+
+// unitMillilitres is how many millilitres a volume unit holds
+var unitMillilitres = map[MeasureUnit]float64{
+	MeasureUnitMillilitres: 1,
+	MeasureUnitTeaspoon:    5,
+	MeasureUnitTablespoon:  15,
+	MeasureUnitCup:         240,
+}
+
+// Millilitres converts a quantity of a volume unit to millilitres, ok is false for grams and pieces
+func (u MeasureUnit) Millilitres(quantity float64) (float64, bool) {
+	factor, ok := unitMillilitres[u]
+	return quantity * factor, ok
+}
+
+// ConvertQuantity converts a quantity between units. Volumes convert to each other and to grams
+// when the density in g/mL is known, pieces only to pieces.
+func ConvertQuantity(quantity float64, from, to MeasureUnit, density float64) (float64, bool) {
+	if from == to {
+		return quantity, true
+	}
+	fromMillilitres, fromVolume := from.Millilitres(quantity)
+	toMillilitres, toVolume := to.Millilitres(1)
+	switch {
+	case fromVolume && toVolume:
+		return fromMillilitres / toMillilitres, true
+	case fromVolume && to == MeasureUnitGrams && density > 0:
+		return fromMillilitres * density, true
+	case from == MeasureUnitGrams && toVolume && density > 0:
+		return quantity / density / toMillilitres, true
+	}
+	return 0, false
+}
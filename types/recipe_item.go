@@ -5,7 +5,8 @@ import (
 )
 
 type RecipeItem struct {
-	ID       uuid.UUID   `json:"id"`
-	Quantity float64     `json:"quantity"`
-	Unit     MeasureUnit `json:"unit"`
+	ID           uuid.UUID   `json:"id"`
+	IngredientID int32       `json:"ingredient_id" binding:"omitempty,min=1"`
+	Quantity     float64     `json:"quantity" binding:"required,gt=0"`
+	Unit         MeasureUnit `json:"unit" binding:"required"`
 }
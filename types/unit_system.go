@@ -0,0 +1,45 @@
+package types
+
+// UnitSystem is the system of units quantities are shown in
+type UnitSystem string
+
+const (
+	UnitSystemMetric   = "metric"
+	UnitSystemImperial = "imperial"
+)
+
+var UnitSystems = []UnitSystem{
+	UnitSystemMetric,
+	UnitSystemImperial,
+}
+
+const (
+	gramsPerOunce            = 28.349523125
+	ouncesPerPound           = 16
+	millilitresPerFluidOunce = 29.5735295625
+	fluidOuncesPerQuart      = 32
+)
+
+// DisplayQuantity converts a quantity to the unit it is shown in. Imperial shows grams as ounces or pounds
+// and millilitres as fluid ounces or quarts, metric shows cups as millilitres. Spoons and pieces are the
+// same in both systems.
+func DisplayQuantity(quantity float64, unit MeasureUnit, system UnitSystem) (float64, string) {
+	switch {
+	case system == UnitSystemImperial && unit == MeasureUnitGrams:
+		ounces := quantity / gramsPerOunce
+		if ounces >= ouncesPerPound {
+			return ounces / ouncesPerPound, "lb"
+		}
+		return ounces, "oz"
+	case system == UnitSystemImperial && unit == MeasureUnitMillilitres:
+		fluidOunces := quantity / millilitresPerFluidOunce
+		if fluidOunces >= fluidOuncesPerQuart {
+			return fluidOunces / fluidOuncesPerQuart, "qt"
+		}
+		return fluidOunces, "fl oz"
+	case system != UnitSystemImperial && unit == MeasureUnitCup:
+		millilitres, _ := unit.Millilitres(quantity)
+		return millilitres, MeasureUnitMillilitres
+	}
+	return quantity, string(unit)
+}
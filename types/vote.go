@@ -0,0 +1,10 @@
+package types
+
+// Vote is what a family member thinks of a proposed entry, a veto takes the recipe out of the week
+type Vote string
+
+const (
+	VoteUp   = "up"
+	VoteDown = "down"
+	VoteVeto = "veto"
+)
@@ -1,6 +1,9 @@
 package util
 
-import "github.com/google/uuid"
+import (
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
 
 func NullUUID(arg uuid.UUID) *uuid.UUID {
 	var id *uuid.UUID
@@ -11,3 +14,8 @@ func NullUUID(arg uuid.UUID) *uuid.UUID {
 
 	return id
 }
+
+// PgUUID converts arg to a nullable UUID column, uuid.Nil being NULL
+func PgUUID(arg uuid.UUID) pgtype.UUID {
+	return pgtype.UUID{Bytes: arg, Valid: arg != uuid.Nil}
+}
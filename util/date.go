@@ -0,0 +1,67 @@
+package util
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// DateLayout is the layout used for dates in requests and responses
+const DateLayout = "2006-01-02"
+
+// NewDate converts t to a pgtype.Date, dropping the time of day
+func NewDate(t time.Time) pgtype.Date {
+	year, month, day := t.Date()
+	return pgtype.Date{
+		Time:  time.Date(year, month, day, 0, 0, 0, 0, time.UTC),
+		Valid: true,
+	}
+}
+
+// ParseDate parses a date formatted as DateLayout
+func ParseDate(value string) (pgtype.Date, error) {
+	t, err := time.Parse(DateLayout, value)
+	if err != nil {
+		return pgtype.Date{}, err
+	}
+	return NewDate(t), nil
+}
+
+// Weekday returns the day of the week of t counting from Monday, which is 0
+func Weekday(t time.Time) int {
+	return (int(t.Weekday()) + 6) % 7
+}
+
+// WeekStart returns the Monday of the week t falls in
+func WeekStart(t time.Time) pgtype.Date {
+	return NewDate(t.AddDate(0, 0, -Weekday(t)))
+}
+
+// InWeek reports whether day falls in the week starting on weekStart
+func InWeek(day, weekStart pgtype.Date) bool {
+	return !day.Time.Before(weekStart.Time) && day.Time.Before(weekStart.Time.AddDate(0, 0, 7))
+}
+
+// ParseISOWeek parses an ISO 8601 week like 2026-W43 and returns its Monday
+func ParseISOWeek(value string) (pgtype.Date, error) {
+	var year, week int
+	_, err := fmt.Sscanf(value, "%4d-W%2d", &year, &week)
+	if err != nil || len(value) != len("2026-W43") {
+		return pgtype.Date{}, fmt.Errorf("invalid ISO week %q, expected a value like 2026-W43", value)
+	}
+
+	// the 4th of January is always in the first week of the year
+	firstWeek := WeekStart(time.Date(year, time.January, 4, 0, 0, 0, 0, time.UTC))
+	monday := firstWeek.Time.AddDate(0, 0, (week-1)*7)
+	if y, w := monday.ISOWeek(); week < 1 || y != year || w != week {
+		return pgtype.Date{}, fmt.Errorf("year %d has no week %d", year, week)
+	}
+	return NewDate(monday), nil
+}
+
+// ISOWeek formats the week of t like 2026-W43
+func ISOWeek(t time.Time) string {
+	year, week := t.ISOWeek()
+	return fmt.Sprintf("%04d-W%02d", year, week)
+}
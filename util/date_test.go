@@ -0,0 +1,65 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDate(t *testing.T) {
+	date, err := ParseDate("2026-10-19")
+	require.NoError(t, err)
+	require.True(t, date.Valid)
+	require.Equal(t, time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC), date.Time)
+
+	_, err = ParseDate("19/10/2026")
+	require.Error(t, err)
+}
+
+func TestNewDate(t *testing.T) {
+	date := NewDate(time.Date(2026, time.October, 19, 18, 30, 0, 0, time.UTC))
+	require.True(t, date.Valid)
+	require.Equal(t, time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC), date.Time)
+}
+
+func TestWeekStart(t *testing.T) {
+	monday := time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 7; i++ {
+		day := monday.AddDate(0, 0, i).Add(20 * time.Hour)
+		require.Equal(t, monday, WeekStart(day).Time)
+		require.Equal(t, i, Weekday(day))
+	}
+}
+
+func TestInWeek(t *testing.T) {
+	weekStart := NewDate(time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC))
+
+	require.True(t, InWeek(weekStart, weekStart))
+	require.True(t, InWeek(NewDate(time.Date(2026, time.October, 25, 0, 0, 0, 0, time.UTC)), weekStart))
+	require.False(t, InWeek(NewDate(time.Date(2026, time.October, 26, 0, 0, 0, 0, time.UTC)), weekStart))
+	require.False(t, InWeek(NewDate(time.Date(2026, time.October, 18, 0, 0, 0, 0, time.UTC)), weekStart))
+}
+
+func TestParseISOWeek(t *testing.T) {
+	testCases := []struct {
+		week     string
+		expected time.Time
+	}{
+		{"2026-W43", time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)},
+		{"2026-W01", time.Date(2025, time.December, 29, 0, 0, 0, 0, time.UTC)},
+		{"2020-W53", time.Date(2020, time.December, 28, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tc := range testCases {
+		date, err := ParseISOWeek(tc.week)
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, date.Time)
+		require.Equal(t, tc.week, ISOWeek(date.Time))
+	}
+
+	for _, week := range []string{"2026-43", "2026-W00", "2025-W53", "2026-W4", "next"} {
+		_, err := ParseISOWeek(week)
+		require.Error(t, err, week)
+	}
+}